@@ -0,0 +1,580 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enttest
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/rest"
+)
+
+// fuzzHandler returns the HTTP handler used by all generated fuzz targets.
+func fuzzHandler(f *testing.F, db *ent.Client) http.Handler {
+	f.Helper()
+
+	srv, err := rest.NewServer(db, &rest.ServerConfig{})
+	if err != nil {
+		f.Fatalf("failed to create server: %v", err)
+		return nil
+	}
+	return srv.Handler()
+}
+
+// fuzzQuery executes a request against the provided path using the fuzzed query
+// string (sent as a form-encoded body for methods other than GET), and ensures the
+// parameter binder either accepted the query (responding with the provided status
+// code), or rejected it with a 400. Panics are reported by the fuzzing engine itself.
+func fuzzQuery(t *testing.T, handler http.Handler, method, path, query string, status int) {
+	t.Helper()
+
+	var req *http.Request
+	if method == http.MethodGet {
+		req = httptest.NewRequest(method, path, http.NoBody)
+		req.URL.RawQuery = query
+	} else {
+		req = httptest.NewRequest(method, path, strings.NewReader(query))
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	switch rec.Code {
+	case status, http.StatusBadRequest, http.StatusNotFound:
+		return
+	default:
+		t.Fatalf("unexpected status code %d for query %q: %s", rec.Code, query, rec.Body.String())
+	}
+}
+
+// FuzzListCategories feeds arbitrary query strings through the parameter binder of
+// "GET /categories" (filtering, sorting and pagination), asserting that it never
+// panics and always returns either a valid result or a 400. Invoke it from a
+// _test.go file, for example:
+//
+//	func FuzzListCategories(f *testing.F) {
+//		enttest.FuzzListCategories(f, enttest.Open(f, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func FuzzListCategories(f *testing.F, db *ent.Client) {
+	for _, seed := range []string{
+		"",
+		"pretty=true",
+		"page=1&per_page=10",
+		"page=0&per_page=-1",
+		"sort=id&order=desc",
+		"filter_op=or",
+		"id.eq=1",
+		"id.neq=1",
+		"id.in=1",
+		"id.notIn=1",
+		"createdAt.gt=1",
+		"createdAt.lt=1",
+		"updatedAt.gt=1",
+		"updatedAt.lt=1",
+	} {
+		f.Add(seed)
+	}
+
+	handler := fuzzHandler(f, db)
+
+	f.Fuzz(func(t *testing.T, query string) {
+		fuzzQuery(t, handler, "GET", "/categories", query, 200)
+	})
+}
+
+// FuzzListFollows feeds arbitrary query strings through the parameter binder of
+// "GET /follows" (filtering, sorting and pagination), asserting that it never
+// panics and always returns either a valid result or a 400. Invoke it from a
+// _test.go file, for example:
+//
+//	func FuzzListFollows(f *testing.F) {
+//		enttest.FuzzListFollows(f, enttest.Open(f, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func FuzzListFollows(f *testing.F, db *ent.Client) {
+	for _, seed := range []string{
+		"",
+		"pretty=true",
+		"page=1&per_page=10",
+		"page=0&per_page=-1",
+		"filter_op=or",
+	} {
+		f.Add(seed)
+	}
+
+	handler := fuzzHandler(f, db)
+
+	f.Fuzz(func(t *testing.T, query string) {
+		fuzzQuery(t, handler, "GET", "/follows", query, 200)
+	})
+}
+
+// FuzzListFriendships feeds arbitrary query strings through the parameter binder of
+// "GET /friendships" (filtering, sorting and pagination), asserting that it never
+// panics and always returns either a valid result or a 400. Invoke it from a
+// _test.go file, for example:
+//
+//	func FuzzListFriendships(f *testing.F) {
+//		enttest.FuzzListFriendships(f, enttest.Open(f, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func FuzzListFriendships(f *testing.F, db *ent.Client) {
+	for _, seed := range []string{
+		"",
+		"pretty=true",
+		"page=1&per_page=10",
+		"page=0&per_page=-1",
+		"sort=id&order=desc",
+		"filter_op=or",
+		"id.eq=1",
+		"id.neq=1",
+		"id.in=1",
+		"id.notIn=1",
+		"userID.eq=1",
+		"userID.neq=1",
+		"userID.in=1",
+		"userID.notIn=1",
+		"friendID.eq=1",
+		"friendID.neq=1",
+		"friendID.in=1",
+		"friendID.notIn=1",
+		"has.user=1",
+		"user.createdAt.gt=1",
+		"user.createdAt.lt=1",
+		"user.updatedAt.gt=1",
+		"user.updatedAt.lt=1",
+		"user.name.eq=1",
+		"user.name.neq=1",
+		"user.name.in=1",
+		"user.name.notIn=1",
+		"user.name.ieq=1",
+		"user.name.has=1",
+		"user.name.ihas=1",
+		"user.name.prefix=1",
+		"user.name.suffix=1",
+		"user.type.eq=1",
+		"user.type.neq=1",
+		"user.type.in=1",
+		"user.type.notIn=1",
+		"user.description.null=1",
+		"user.description.has=1",
+		"user.description.ihas=1",
+		"user.enabled.eq=1",
+		"user.email.eq=1",
+		"user.email.neq=1",
+		"user.email.null=1",
+		"user.email.in=1",
+		"user.email.notIn=1",
+		"user.email.ieq=1",
+		"user.email.has=1",
+		"user.email.ihas=1",
+		"user.email.prefix=1",
+		"user.email.suffix=1",
+		"has.friend=1",
+		"friend.createdAt.gt=1",
+		"friend.createdAt.lt=1",
+		"friend.updatedAt.gt=1",
+		"friend.updatedAt.lt=1",
+		"friend.name.eq=1",
+		"friend.name.neq=1",
+		"friend.name.in=1",
+		"friend.name.notIn=1",
+		"friend.name.ieq=1",
+		"friend.name.has=1",
+		"friend.name.ihas=1",
+		"friend.name.prefix=1",
+		"friend.name.suffix=1",
+		"friend.type.eq=1",
+		"friend.type.neq=1",
+		"friend.type.in=1",
+		"friend.type.notIn=1",
+		"friend.description.null=1",
+		"friend.description.has=1",
+		"friend.description.ihas=1",
+		"friend.enabled.eq=1",
+		"friend.email.eq=1",
+		"friend.email.neq=1",
+		"friend.email.null=1",
+		"friend.email.in=1",
+		"friend.email.notIn=1",
+		"friend.email.ieq=1",
+		"friend.email.has=1",
+		"friend.email.ihas=1",
+		"friend.email.prefix=1",
+		"friend.email.suffix=1",
+	} {
+		f.Add(seed)
+	}
+
+	handler := fuzzHandler(f, db)
+
+	f.Fuzz(func(t *testing.T, query string) {
+		fuzzQuery(t, handler, "GET", "/friendships", query, 200)
+	})
+}
+
+// FuzzListPets feeds arbitrary query strings through the parameter binder of
+// "GET /pets" (filtering, sorting and pagination), asserting that it never
+// panics and always returns either a valid result or a 400. Invoke it from a
+// _test.go file, for example:
+//
+//	func FuzzListPets(f *testing.F) {
+//		enttest.FuzzListPets(f, enttest.Open(f, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func FuzzListPets(f *testing.F, db *ent.Client) {
+	for _, seed := range []string{
+		"",
+		"pretty=true",
+		"page=1&per_page=10",
+		"page=0&per_page=-1",
+		"sort=name&order=desc",
+		"filter_op=or",
+		"id.eq=1",
+		"id.neq=1",
+		"id.in=1",
+		"id.notIn=1",
+		"name.eq=1",
+		"name.neq=1",
+		"name.in=1",
+		"name.notIn=1",
+		"name.ieq=1",
+		"name.has=1",
+		"name.ihas=1",
+		"name.prefix=1",
+		"name.suffix=1",
+		"nicknames.null=1",
+		"age.eq=1",
+		"age.neq=1",
+		"age.gt=1",
+		"age.lt=1",
+		"age.in=1",
+		"age.notIn=1",
+		"type.eq=1",
+		"type.neq=1",
+		"type.in=1",
+		"type.notIn=1",
+		"has.category=1",
+		"category.id.eq=1",
+		"category.id.neq=1",
+		"category.id.in=1",
+		"category.id.notIn=1",
+		"category.createdAt.gt=1",
+		"category.createdAt.lt=1",
+		"category.updatedAt.gt=1",
+		"category.updatedAt.lt=1",
+		"has.owner=1",
+		"owner.id.eq=1",
+		"owner.id.neq=1",
+		"owner.id.in=1",
+		"owner.id.notIn=1",
+		"owner.createdAt.gt=1",
+		"owner.createdAt.lt=1",
+		"owner.updatedAt.gt=1",
+		"owner.updatedAt.lt=1",
+		"owner.name.eq=1",
+		"owner.name.neq=1",
+		"owner.name.in=1",
+		"owner.name.notIn=1",
+		"owner.name.ieq=1",
+		"owner.name.has=1",
+		"owner.name.ihas=1",
+		"owner.name.prefix=1",
+		"owner.name.suffix=1",
+		"owner.type.eq=1",
+		"owner.type.neq=1",
+		"owner.type.in=1",
+		"owner.type.notIn=1",
+		"owner.description.null=1",
+		"owner.description.has=1",
+		"owner.description.ihas=1",
+		"owner.enabled.eq=1",
+		"owner.email.eq=1",
+		"owner.email.neq=1",
+		"owner.email.null=1",
+		"owner.email.in=1",
+		"owner.email.notIn=1",
+		"owner.email.ieq=1",
+		"owner.email.has=1",
+		"owner.email.ihas=1",
+		"owner.email.prefix=1",
+		"owner.email.suffix=1",
+		"has.friend=1",
+		"friend.id.eq=1",
+		"friend.id.neq=1",
+		"friend.id.in=1",
+		"friend.id.notIn=1",
+		"friend.name.eq=1",
+		"friend.name.neq=1",
+		"friend.name.in=1",
+		"friend.name.notIn=1",
+		"friend.name.ieq=1",
+		"friend.name.has=1",
+		"friend.name.ihas=1",
+		"friend.name.prefix=1",
+		"friend.name.suffix=1",
+		"friend.nicknames.null=1",
+		"friend.age.eq=1",
+		"friend.age.neq=1",
+		"friend.age.gt=1",
+		"friend.age.lt=1",
+		"friend.age.in=1",
+		"friend.age.notIn=1",
+		"friend.type.eq=1",
+		"friend.type.neq=1",
+		"friend.type.in=1",
+		"friend.type.notIn=1",
+		"has.followedBy=1",
+		"followedBy.id.eq=1",
+		"followedBy.id.neq=1",
+		"followedBy.id.in=1",
+		"followedBy.id.notIn=1",
+		"followedBy.createdAt.gt=1",
+		"followedBy.createdAt.lt=1",
+		"followedBy.updatedAt.gt=1",
+		"followedBy.updatedAt.lt=1",
+		"followedBy.name.eq=1",
+		"followedBy.name.neq=1",
+		"followedBy.name.in=1",
+		"followedBy.name.notIn=1",
+		"followedBy.name.ieq=1",
+		"followedBy.name.has=1",
+		"followedBy.name.ihas=1",
+		"followedBy.name.prefix=1",
+		"followedBy.name.suffix=1",
+		"followedBy.type.eq=1",
+		"followedBy.type.neq=1",
+		"followedBy.type.in=1",
+		"followedBy.type.notIn=1",
+		"followedBy.description.null=1",
+		"followedBy.description.has=1",
+		"followedBy.description.ihas=1",
+		"followedBy.enabled.eq=1",
+		"followedBy.email.eq=1",
+		"followedBy.email.neq=1",
+		"followedBy.email.null=1",
+		"followedBy.email.in=1",
+		"followedBy.email.notIn=1",
+		"followedBy.email.ieq=1",
+		"followedBy.email.has=1",
+		"followedBy.email.ihas=1",
+		"followedBy.email.prefix=1",
+		"followedBy.email.suffix=1",
+		"has.following=1",
+	} {
+		f.Add(seed)
+	}
+
+	handler := fuzzHandler(f, db)
+
+	f.Fuzz(func(t *testing.T, query string) {
+		fuzzQuery(t, handler, "GET", "/pets", query, 200)
+	})
+}
+
+// FuzzListSettings feeds arbitrary query strings through the parameter binder of
+// "GET /settings" (filtering, sorting and pagination), asserting that it never
+// panics and always returns either a valid result or a 400. Invoke it from a
+// _test.go file, for example:
+//
+//	func FuzzListSettings(f *testing.F) {
+//		enttest.FuzzListSettings(f, enttest.Open(f, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func FuzzListSettings(f *testing.F, db *ent.Client) {
+	for _, seed := range []string{
+		"",
+		"pretty=true",
+		"page=1&per_page=10",
+		"page=0&per_page=-1",
+		"sort=id&order=desc",
+		"filter_op=or",
+		"id.eq=1",
+		"id.neq=1",
+		"id.in=1",
+		"id.notIn=1",
+		"createdAt.gt=1",
+		"createdAt.lt=1",
+		"updatedAt.gt=1",
+		"updatedAt.lt=1",
+	} {
+		f.Add(seed)
+	}
+
+	handler := fuzzHandler(f, db)
+
+	f.Fuzz(func(t *testing.T, query string) {
+		fuzzQuery(t, handler, "GET", "/settings", query, 200)
+	})
+}
+
+// FuzzListUsers feeds arbitrary query strings through the parameter binder of
+// "GET /users" (filtering, sorting and pagination), asserting that it never
+// panics and always returns either a valid result or a 400. Invoke it from a
+// _test.go file, for example:
+//
+//	func FuzzListUsers(f *testing.F) {
+//		enttest.FuzzListUsers(f, enttest.Open(f, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func FuzzListUsers(f *testing.F, db *ent.Client) {
+	for _, seed := range []string{
+		"",
+		"pretty=true",
+		"page=1&per_page=10",
+		"page=0&per_page=-1",
+		"sort=name&order=desc",
+		"filter_op=or",
+		"id.eq=1",
+		"id.neq=1",
+		"id.in=1",
+		"id.notIn=1",
+		"createdAt.gt=1",
+		"createdAt.lt=1",
+		"updatedAt.gt=1",
+		"updatedAt.lt=1",
+		"name.eq=1",
+		"name.neq=1",
+		"name.in=1",
+		"name.notIn=1",
+		"name.ieq=1",
+		"name.has=1",
+		"name.ihas=1",
+		"name.prefix=1",
+		"name.suffix=1",
+		"type.eq=1",
+		"type.neq=1",
+		"type.in=1",
+		"type.notIn=1",
+		"description.null=1",
+		"description.has=1",
+		"description.ihas=1",
+		"enabled.eq=1",
+		"email.eq=1",
+		"email.neq=1",
+		"email.null=1",
+		"email.in=1",
+		"email.notIn=1",
+		"email.ieq=1",
+		"email.has=1",
+		"email.ihas=1",
+		"email.prefix=1",
+		"email.suffix=1",
+		"has.pet=1",
+		"pet.id.eq=1",
+		"pet.id.neq=1",
+		"pet.id.in=1",
+		"pet.id.notIn=1",
+		"pet.name.eq=1",
+		"pet.name.neq=1",
+		"pet.name.in=1",
+		"pet.name.notIn=1",
+		"pet.name.ieq=1",
+		"pet.name.has=1",
+		"pet.name.ihas=1",
+		"pet.name.prefix=1",
+		"pet.name.suffix=1",
+		"pet.nicknames.null=1",
+		"pet.age.eq=1",
+		"pet.age.neq=1",
+		"pet.age.gt=1",
+		"pet.age.lt=1",
+		"pet.age.in=1",
+		"pet.age.notIn=1",
+		"pet.type.eq=1",
+		"pet.type.neq=1",
+		"pet.type.in=1",
+		"pet.type.notIn=1",
+		"has.followedPet=1",
+		"followedPet.id.eq=1",
+		"followedPet.id.neq=1",
+		"followedPet.id.in=1",
+		"followedPet.id.notIn=1",
+		"followedPet.name.eq=1",
+		"followedPet.name.neq=1",
+		"followedPet.name.in=1",
+		"followedPet.name.notIn=1",
+		"followedPet.name.ieq=1",
+		"followedPet.name.has=1",
+		"followedPet.name.ihas=1",
+		"followedPet.name.prefix=1",
+		"followedPet.name.suffix=1",
+		"followedPet.nicknames.null=1",
+		"followedPet.age.eq=1",
+		"followedPet.age.neq=1",
+		"followedPet.age.gt=1",
+		"followedPet.age.lt=1",
+		"followedPet.age.in=1",
+		"followedPet.age.notIn=1",
+		"followedPet.type.eq=1",
+		"followedPet.type.neq=1",
+		"followedPet.type.in=1",
+		"followedPet.type.notIn=1",
+		"has.friend=1",
+		"friend.id.eq=1",
+		"friend.id.neq=1",
+		"friend.id.in=1",
+		"friend.id.notIn=1",
+		"friend.createdAt.gt=1",
+		"friend.createdAt.lt=1",
+		"friend.updatedAt.gt=1",
+		"friend.updatedAt.lt=1",
+		"friend.name.eq=1",
+		"friend.name.neq=1",
+		"friend.name.in=1",
+		"friend.name.notIn=1",
+		"friend.name.ieq=1",
+		"friend.name.has=1",
+		"friend.name.ihas=1",
+		"friend.name.prefix=1",
+		"friend.name.suffix=1",
+		"friend.type.eq=1",
+		"friend.type.neq=1",
+		"friend.type.in=1",
+		"friend.type.notIn=1",
+		"friend.description.null=1",
+		"friend.description.has=1",
+		"friend.description.ihas=1",
+		"friend.enabled.eq=1",
+		"friend.email.eq=1",
+		"friend.email.neq=1",
+		"friend.email.null=1",
+		"friend.email.in=1",
+		"friend.email.notIn=1",
+		"friend.email.ieq=1",
+		"friend.email.has=1",
+		"friend.email.ihas=1",
+		"friend.email.prefix=1",
+		"friend.email.suffix=1",
+		"has.following=1",
+		"has.friendship=1",
+		"friendship.id.eq=1",
+		"friendship.id.neq=1",
+		"friendship.id.in=1",
+		"friendship.id.notIn=1",
+		"friendship.userID.eq=1",
+		"friendship.userID.neq=1",
+		"friendship.userID.in=1",
+		"friendship.userID.notIn=1",
+		"friendship.friendID.eq=1",
+		"friendship.friendID.neq=1",
+		"friendship.friendID.in=1",
+		"friendship.friendID.notIn=1",
+		"search.eq=1",
+		"search.neq=1",
+		"search.in=1",
+		"search.notIn=1",
+		"search.ieq=1",
+		"search.has=1",
+		"search.ihas=1",
+		"search.prefix=1",
+		"search.suffix=1",
+	} {
+		f.Add(seed)
+	}
+
+	handler := fuzzHandler(f, db)
+
+	f.Fuzz(func(t *testing.T, query string) {
+		fuzzQuery(t, handler, "GET", "/users", query, 200)
+	})
+}
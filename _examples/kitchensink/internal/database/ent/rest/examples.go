@@ -0,0 +1,106 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// exampleFixture decodes an example payload into a fresh map, so callers are free
+// to modify the returned value.
+func exampleFixture(payload string) map[string]any {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		panic(fmt.Sprintf("failed to decode example payload: %v", err))
+	}
+	return v
+}
+
+// ExampleCategoryCreate returns a deterministic example payload for creating
+// a Category entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleCategoryCreate() map[string]any {
+	return exampleFixture("{\"ints\":[1],\"name\":\"Example Name\",\"nillable\":\"test\",\"pets\":[1],\"strings\":[\"FOO\"]}")
+}
+
+// ExampleCategoryUpdate returns a deterministic example payload for updating
+// a Category entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleCategoryUpdate() map[string]any {
+	return exampleFixture("{\"add_pets\":[1],\"ints\":[1],\"name\":\"Example Name\",\"nillable\":\"test\",\"remove_pets\":[1],\"strings\":[\"FOO\"]}")
+}
+
+// ExampleFollowCreate returns a deterministic example payload for creating
+// a Follow entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFollowCreate() map[string]any {
+	return exampleFixture("{\"pet_id\":1,\"user_id\":1}")
+}
+
+// ExampleFollowUpdate returns a deterministic example payload for updating
+// a Follow entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFollowUpdate() map[string]any {
+	return exampleFixture("{\"pet_id\":1,\"user_id\":1}")
+}
+
+// ExampleFriendshipCreate returns a deterministic example payload for creating
+// a Friendship entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFriendshipCreate() map[string]any {
+	return exampleFixture("{\"created_at\":\"2024-01-01T00:00:00Z\",\"friend_id\":1,\"user_id\":1}")
+}
+
+// ExampleFriendshipUpdate returns a deterministic example payload for updating
+// a Friendship entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFriendshipUpdate() map[string]any {
+	return exampleFixture("{\"created_at\":\"2024-01-01T00:00:00Z\",\"friend_id\":1,\"user_id\":1}")
+}
+
+// ExamplePetCreate returns a deterministic example payload for creating
+// a Pet entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExamplePetCreate() map[string]any {
+	return exampleFixture("{\"age\":2,\"categories\":[1],\"followed_by\":[1],\"friends\":[1],\"name\":\"Kuro\",\"nicknames\":[\"Example Name\"],\"owner\":1,\"type\":\"DOG\"}")
+}
+
+// ExamplePetUpdate returns a deterministic example payload for updating
+// a Pet entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExamplePetUpdate() map[string]any {
+	return exampleFixture("{\"add_categories\":[1],\"add_followed_by\":[1],\"add_friends\":[1],\"age\":2,\"categories\":[1],\"name\":\"Kuro\",\"nicknames\":[\"Example Name\"],\"owner\":1,\"remove_categories\":[1],\"remove_followed_by\":[1],\"remove_friends\":[1],\"type\":\"DOG\"}")
+}
+
+// ExampleSettingUpdate returns a deterministic example payload for updating
+// a Setting entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleSettingUpdate() map[string]any {
+	return exampleFixture("{\"add_admins\":[1],\"global_banner\":\"example\",\"remove_admins\":[1]}")
+}
+
+// ExampleUserCreate returns a deterministic example payload for creating
+// a User entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleUserCreate() map[string]any {
+	return exampleFixture("{\"avatar\":\"ZXhhbXBsZQ==\",\"description\":\"Jon Smith\",\"email\":\"John.Smith@example.com\",\"enabled\":true,\"followed_pets\":[1],\"friends\":[1],\"friendships\":[1],\"github_data\":{},\"name\":\"Example Name\",\"password_hashed\":\"example\",\"pets\":[1],\"profile_url\":\"http://127.0.0.1/\",\"type\":\"USER\"}")
+}
+
+// ExampleUserUpdate returns a deterministic example payload for updating
+// a User entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleUserUpdate() map[string]any {
+	return exampleFixture("{\"add_followed_pets\":[1],\"add_friends\":[1],\"add_friendships\":[1],\"add_pets\":[1],\"avatar\":\"ZXhhbXBsZQ==\",\"description\":\"Jon Smith\",\"email\":\"John.Smith@example.com\",\"enabled\":true,\"github_data\":{},\"name\":\"Example Name\",\"password_hashed\":\"example\",\"profile_url\":\"http://127.0.0.1/\",\"remove_followed_pets\":[1],\"remove_friends\":[1],\"remove_friendships\":[1],\"remove_pets\":[1],\"type\":\"USER\"}")
+}
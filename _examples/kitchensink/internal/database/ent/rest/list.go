@@ -4,9 +4,11 @@ package rest
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"slices"
+	"strconv"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -26,7 +28,7 @@ type PageConfig struct {
 }
 
 var (
-	firstPage = 1
+	firstPage PageNumber = 1
 	// DefaultPageConfig defines the page configuration for LIST-related endpoints
 	// for all entities by default. If the configuration is not overridden for a
 	// specific entity, this will be used.
@@ -89,11 +91,15 @@ type PagableQuery[P any, T any] interface {
 
 // PagedResponse is the JSON response structure for paged queries.
 type PagedResponse[T any] struct {
-	Page       int  `json:"page"`         // Current page number.
-	TotalCount int  `json:"total_count"`  // Total number of items.
-	LastPage   int  `json:"last_page"`    // Last page number.
-	IsLastPage bool `json:"is_last_page"` // Whether this is the last page.
-	Content    []*T `json:"content"`      // Paged data.
+	Page         int  `json:"page"`          // Current page number.
+	TotalCount   int  `json:"total_count"`   // Total number of items.
+	LastPage     int  `json:"last_page"`     // Last page number.
+	IsFirstPage  bool `json:"is_first_page"` // Whether this is the first page.
+	IsLastPage   bool `json:"is_last_page"`  // Whether this is the last page.
+	PerPage      int  `json:"-"`             // Number of items per page.
+	NextPage     *int `json:"-"`             // Next page number, if any.
+	PreviousPage *int `json:"-"`             // Previous page number, if any.
+	Content      []*T `json:"content"`       // Paged data.
 }
 
 // GetPage returns the current page number.
@@ -111,16 +117,60 @@ func (p *PagedResponse[T]) GetLastPage() int {
 	return p.LastPage
 }
 
+// GetIsFirstPage returns whether this is the first page.
+func (p *PagedResponse[T]) GetIsFirstPage() bool {
+	return p.IsFirstPage
+}
+
 // GetIsLastPage returns whether this is the last page.
 func (p *PagedResponse[T]) GetIsLastPage() bool {
 	return p.IsLastPage
 }
 
+// PageNumber is the page number of paginated queries. Besides page numbers, "first" and
+// "last" are accepted, where the last page is resolved once the total number of results
+// is known (see [PageLast]).
+type PageNumber int
+
+// PageLast is the page number which is resolved to the last page of results by
+// ApplyPagination.
+const PageLast PageNumber = -1
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (p *PageNumber) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "first":
+		*p = firstPage
+	case "last":
+		*p = PageLast
+	default:
+		v, err := strconv.Atoi(string(b))
+		if err != nil || v < 1 {
+			return fmt.Errorf("invalid page %q, must be a page number (>= 1), \"first\" or \"last\"", b)
+		}
+		*p = PageNumber(v)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting both numbers and
+// strings.
+func (p *PageNumber) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		b = []byte(s)
+	}
+	return p.UnmarshalText(b)
+}
+
 type Paginated[P PagableQuery[P, T], T any] struct {
-	Page         *int `json:"page"     form:"page,omitempty"`
-	ItemsPerPage *int `json:"per_page" form:"per_page,omitempty"`
-	ResultCount  int  `json:"-"        form:"-"` // ResultCount is populated by the query execution inside of ApplyPagination.
-	LastPage     int  `json:"-"        form:"-"` // LastPage is populated by the query execution inside of ApplyPagination.
+	Page         *PageNumber `json:"page"     form:"page,omitempty"`
+	ItemsPerPage *int        `json:"per_page" form:"per_page,omitempty"`
+	ResultCount  int         `json:"-"        form:"-"` // ResultCount is populated by the query execution inside of ApplyPagination.
+	LastPage     int         `json:"-"        form:"-"` // LastPage is populated by the query execution inside of ApplyPagination.
 
 	hasApplied bool `json:"-" form:"-"`
 }
@@ -148,7 +198,7 @@ func (p *Paginated[P, T]) ApplyPagination(ctx context.Context, query P, pageConf
 		return query, &ErrBadRequest{Err: fmt.Errorf("per_page %d is out of bounds, must be <= %d", *p.ItemsPerPage, pageConfig.MaxItemsPerPage)}
 	}
 
-	if *p.Page < 1 {
+	if *p.Page < 1 && *p.Page != PageLast {
 		return query, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, must be >= 1", *p.Page)}
 	}
 
@@ -166,12 +216,17 @@ func (p *Paginated[P, T]) ApplyPagination(ctx context.Context, query P, pageConf
 		p.LastPage = 1
 	}
 
-	if *p.Page > p.LastPage {
+	if *p.Page == PageLast {
+		page := PageNumber(p.LastPage)
+		p.Page = &page
+	}
+
+	if int(*p.Page) > p.LastPage {
 		return query, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, last page is %d", *p.Page, p.LastPage)}
 	}
 
 	p.hasApplied = true
-	return query.Limit(*p.ItemsPerPage).Offset((*p.Page - 1) * *p.ItemsPerPage), nil
+	return query.Limit(*p.ItemsPerPage).Offset((int(*p.Page) - 1) * *p.ItemsPerPage), nil
 }
 
 // ExecutePaginated executes the query and returns a paged response. If ApplyPagination
@@ -190,13 +245,37 @@ func (p *Paginated[P, T]) ExecutePaginated(ctx context.Context, query P, pageCon
 		return nil, err
 	}
 
-	return &PagedResponse[T]{
-		Page:       *p.Page,
-		TotalCount: p.ResultCount,
-		LastPage:   p.LastPage,
-		IsLastPage: *p.Page == p.LastPage,
-		Content:    data,
-	}, nil
+	page := int(*p.Page)
+
+	resp := &PagedResponse[T]{
+		Page:        page,
+		TotalCount:  p.ResultCount,
+		LastPage:    p.LastPage,
+		IsFirstPage: page == 1,
+		IsLastPage:  page == p.LastPage,
+		PerPage:     *p.ItemsPerPage,
+		Content:     data,
+	}
+
+	if !resp.IsLastPage {
+		next := page + 1
+		resp.NextPage = &next
+	}
+
+	if page > 1 {
+		prev := page - 1
+		resp.PreviousPage = &prev
+	}
+	return resp, nil
+}
+
+// listResult wraps the results of a non-paginated list query, so they can be returned
+// from handlers which expect a pointer result.
+func listResult[T any](results []*T, err error) (*[]*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
 }
 
 // FilterOperation represents if all or any (one or more) filters should be applied.
@@ -212,6 +291,28 @@ var (
 	FilterOperations = []FilterOperation{FilterOperationAnd, FilterOperationOr}
 )
 
+// validateFilter validates the provided filter values, each of which must pass at least
+// one of the provided validators (e.g. the enum or field validators of the filtered
+// fields), so filters which can never match return an error rather than no results.
+func validateFilter[T any](param string, values []T, validators ...func(T) error) error {
+	for _, v := range values {
+		var err error
+		for _, validate := range validators {
+			if validate == nil {
+				err = nil
+				break
+			}
+			if err = validate(v); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return &ErrBadRequest{Err: fmt.Errorf("invalid value provided for filter %q: %w", param, err)}
+		}
+	}
+	return nil
+}
+
 type Filtered[P ~func(*sql.Selector)] struct {
 	// FilterOperation controls how multiple predicates are applied together.
 	FilterOperation *FilterOperation `json:"filter_op,omitempty" form:"filter_op,omitempty"`
@@ -291,16 +392,23 @@ func (l *ListCategoryParams) ApplySorting(query *ent.CategoryQuery) error {
 	if err := l.Sorted.Validate(CategorySortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakCategory(query, orderAsc)
 		return nil
 	}
-	applySortingCategory(query, *l.Field, *l.Order)
+	applySortingCategory(query, CategorySortConfig.column(*l.Field), *l.Order)
 	return nil
 }
 
 // Exec wraps all logic (filtering, sorting, pagination, eager loading) and
 // executes all necessary queries, returning the results.
 func (l *ListCategoryParams) Exec(ctx context.Context, query *ent.CategoryQuery) (results *PagedResponse[ent.Category], err error) {
+	return l.ExecWithPageConfig(ctx, query, CategoryPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListCategoryParams) ExecWithPageConfig(ctx context.Context, query *ent.CategoryQuery, pageConfig *PageConfig) (results *PagedResponse[ent.Category], err error) {
 	predicates, err := l.FilterPredicates()
 	if err != nil {
 		return nil, err
@@ -310,7 +418,7 @@ func (l *ListCategoryParams) Exec(ctx context.Context, query *ent.CategoryQuery)
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, CategoryPageConfig)
+	return l.ExecutePaginated(ctx, query, pageConfig)
 }
 
 // ListFollowParams defines parameters for listing Follows via a GET request.
@@ -324,21 +432,28 @@ func (l *ListFollowParams) ApplySorting(query *ent.FollowsQuery) error {
 	if err := l.Sorted.Validate(FollowSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakFollow(query, orderAsc)
 		return nil
 	}
-	applySortingFollow(query, *l.Field, *l.Order)
+	applySortingFollow(query, FollowSortConfig.column(*l.Field), *l.Order)
 	return nil
 }
 
 // Exec wraps all logic (filtering, sorting, pagination, eager loading) and
 // executes all necessary queries, returning the results.
 func (l *ListFollowParams) Exec(ctx context.Context, query *ent.FollowsQuery) (results *PagedResponse[ent.Follows], err error) {
+	return l.ExecWithPageConfig(ctx, query, FollowPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListFollowParams) ExecWithPageConfig(ctx context.Context, query *ent.FollowsQuery, pageConfig *PageConfig) (results *PagedResponse[ent.Follows], err error) {
 	err = l.ApplySorting(EagerLoadFollow(query))
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, FollowPageConfig)
+	return l.ExecutePaginated(ctx, query, pageConfig)
 }
 
 // ListFriendshipParams defines parameters for listing Friendships via a GET request.
@@ -588,15 +703,27 @@ func (l *ListFriendshipParams) FilterPredicates() (predicate.Friendship, error)
 		predicates = append(predicates, friendship.HasUserWith(user.NameHasSuffix(*l.EdgeUserNameHasSuffix)))
 	}
 	if l.EdgeUserTypeEQ != nil {
+		if err := validateFilter("user.type.eq", []user.Type{*l.EdgeUserTypeEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.TypeEQ(*l.EdgeUserTypeEQ)))
 	}
 	if l.EdgeUserTypeNEQ != nil {
+		if err := validateFilter("user.type.neq", []user.Type{*l.EdgeUserTypeNEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.TypeNEQ(*l.EdgeUserTypeNEQ)))
 	}
 	if l.EdgeUserTypeIn != nil {
+		if err := validateFilter("user.type.in", l.EdgeUserTypeIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.TypeIn(l.EdgeUserTypeIn...)))
 	}
 	if l.EdgeUserTypeNotIn != nil {
+		if err := validateFilter("user.type.notIn", l.EdgeUserTypeNotIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.TypeNotIn(l.EdgeUserTypeNotIn...)))
 	}
 	if l.EdgeUserDescriptionIsNil != nil {
@@ -616,9 +743,15 @@ func (l *ListFriendshipParams) FilterPredicates() (predicate.Friendship, error)
 		predicates = append(predicates, friendship.HasUserWith(user.EnabledEQ(*l.EdgeUserEnabledEQ)))
 	}
 	if l.EdgeUserEmailEQ != nil {
+		if err := validateFilter("user.email.eq", []string{*l.EdgeUserEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.EmailEQ(*l.EdgeUserEmailEQ)))
 	}
 	if l.EdgeUserEmailNEQ != nil {
+		if err := validateFilter("user.email.neq", []string{*l.EdgeUserEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.EmailNEQ(*l.EdgeUserEmailNEQ)))
 	}
 	if l.EdgeUserEmailIsNil != nil {
@@ -629,9 +762,15 @@ func (l *ListFriendshipParams) FilterPredicates() (predicate.Friendship, error)
 		}
 	}
 	if l.EdgeUserEmailIn != nil {
+		if err := validateFilter("user.email.in", l.EdgeUserEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.EmailIn(l.EdgeUserEmailIn...)))
 	}
 	if l.EdgeUserEmailNotIn != nil {
+		if err := validateFilter("user.email.notIn", l.EdgeUserEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasUserWith(user.EmailNotIn(l.EdgeUserEmailNotIn...)))
 	}
 	if l.EdgeUserEmailEqualFold != nil {
@@ -696,15 +835,27 @@ func (l *ListFriendshipParams) FilterPredicates() (predicate.Friendship, error)
 		predicates = append(predicates, friendship.HasFriendWith(user.NameHasSuffix(*l.EdgeFriendNameHasSuffix)))
 	}
 	if l.EdgeFriendTypeEQ != nil {
+		if err := validateFilter("friend.type.eq", []user.Type{*l.EdgeFriendTypeEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.TypeEQ(*l.EdgeFriendTypeEQ)))
 	}
 	if l.EdgeFriendTypeNEQ != nil {
+		if err := validateFilter("friend.type.neq", []user.Type{*l.EdgeFriendTypeNEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.TypeNEQ(*l.EdgeFriendTypeNEQ)))
 	}
 	if l.EdgeFriendTypeIn != nil {
+		if err := validateFilter("friend.type.in", l.EdgeFriendTypeIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.TypeIn(l.EdgeFriendTypeIn...)))
 	}
 	if l.EdgeFriendTypeNotIn != nil {
+		if err := validateFilter("friend.type.notIn", l.EdgeFriendTypeNotIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.TypeNotIn(l.EdgeFriendTypeNotIn...)))
 	}
 	if l.EdgeFriendDescriptionIsNil != nil {
@@ -724,9 +875,15 @@ func (l *ListFriendshipParams) FilterPredicates() (predicate.Friendship, error)
 		predicates = append(predicates, friendship.HasFriendWith(user.EnabledEQ(*l.EdgeFriendEnabledEQ)))
 	}
 	if l.EdgeFriendEmailEQ != nil {
+		if err := validateFilter("friend.email.eq", []string{*l.EdgeFriendEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.EmailEQ(*l.EdgeFriendEmailEQ)))
 	}
 	if l.EdgeFriendEmailNEQ != nil {
+		if err := validateFilter("friend.email.neq", []string{*l.EdgeFriendEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.EmailNEQ(*l.EdgeFriendEmailNEQ)))
 	}
 	if l.EdgeFriendEmailIsNil != nil {
@@ -737,9 +894,15 @@ func (l *ListFriendshipParams) FilterPredicates() (predicate.Friendship, error)
 		}
 	}
 	if l.EdgeFriendEmailIn != nil {
+		if err := validateFilter("friend.email.in", l.EdgeFriendEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.EmailIn(l.EdgeFriendEmailIn...)))
 	}
 	if l.EdgeFriendEmailNotIn != nil {
+		if err := validateFilter("friend.email.notIn", l.EdgeFriendEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, friendship.HasFriendWith(user.EmailNotIn(l.EdgeFriendEmailNotIn...)))
 	}
 	if l.EdgeFriendEmailEqualFold != nil {
@@ -766,16 +929,23 @@ func (l *ListFriendshipParams) ApplySorting(query *ent.FriendshipQuery) error {
 	if err := l.Sorted.Validate(FriendshipSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakFriendship(query, orderAsc)
 		return nil
 	}
-	applySortingFriendship(query, *l.Field, *l.Order)
+	applySortingFriendship(query, FriendshipSortConfig.column(*l.Field), *l.Order)
 	return nil
 }
 
 // Exec wraps all logic (filtering, sorting, pagination, eager loading) and
 // executes all necessary queries, returning the results.
 func (l *ListFriendshipParams) Exec(ctx context.Context, query *ent.FriendshipQuery) (results *PagedResponse[ent.Friendship], err error) {
+	return l.ExecWithPageConfig(ctx, query, FriendshipPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListFriendshipParams) ExecWithPageConfig(ctx context.Context, query *ent.FriendshipQuery, pageConfig *PageConfig) (results *PagedResponse[ent.Friendship], err error) {
 	predicates, err := l.FilterPredicates()
 	if err != nil {
 		return nil, err
@@ -785,7 +955,7 @@ func (l *ListFriendshipParams) Exec(ctx context.Context, query *ent.FriendshipQu
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, FriendshipPageConfig)
+	return l.ExecutePaginated(ctx, query, pageConfig)
 }
 
 // ListPetParams defines parameters for listing Pets via a GET request.
@@ -1109,9 +1279,15 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		}
 	}
 	if l.PetAgeEQ != nil {
+		if err := validateFilter("age.eq", []int{*l.PetAgeEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.AgeEQ(*l.PetAgeEQ))
 	}
 	if l.PetAgeNEQ != nil {
+		if err := validateFilter("age.neq", []int{*l.PetAgeNEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.AgeNEQ(*l.PetAgeNEQ))
 	}
 	if l.PetAgeGT != nil {
@@ -1121,21 +1297,39 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		predicates = append(predicates, pet.AgeLT(*l.PetAgeLT))
 	}
 	if l.PetAgeIn != nil {
+		if err := validateFilter("age.in", l.PetAgeIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.AgeIn(l.PetAgeIn...))
 	}
 	if l.PetAgeNotIn != nil {
+		if err := validateFilter("age.notIn", l.PetAgeNotIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.AgeNotIn(l.PetAgeNotIn...))
 	}
 	if l.PetTypeEQ != nil {
+		if err := validateFilter("type.eq", []pet.Type{*l.PetTypeEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.TypeEQ(*l.PetTypeEQ))
 	}
 	if l.PetTypeNEQ != nil {
+		if err := validateFilter("type.neq", []pet.Type{*l.PetTypeNEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.TypeNEQ(*l.PetTypeNEQ))
 	}
 	if l.PetTypeIn != nil {
+		if err := validateFilter("type.in", l.PetTypeIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.TypeIn(l.PetTypeIn...))
 	}
 	if l.PetTypeNotIn != nil {
+		if err := validateFilter("type.notIn", l.PetTypeNotIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.TypeNotIn(l.PetTypeNotIn...))
 	}
 	if l.EdgeHasCategory != nil {
@@ -1228,15 +1422,27 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		predicates = append(predicates, pet.HasOwnerWith(user.NameHasSuffix(*l.EdgeOwnerNameHasSuffix)))
 	}
 	if l.EdgeOwnerTypeEQ != nil {
+		if err := validateFilter("owner.type.eq", []user.Type{*l.EdgeOwnerTypeEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.TypeEQ(*l.EdgeOwnerTypeEQ)))
 	}
 	if l.EdgeOwnerTypeNEQ != nil {
+		if err := validateFilter("owner.type.neq", []user.Type{*l.EdgeOwnerTypeNEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.TypeNEQ(*l.EdgeOwnerTypeNEQ)))
 	}
 	if l.EdgeOwnerTypeIn != nil {
+		if err := validateFilter("owner.type.in", l.EdgeOwnerTypeIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.TypeIn(l.EdgeOwnerTypeIn...)))
 	}
 	if l.EdgeOwnerTypeNotIn != nil {
+		if err := validateFilter("owner.type.notIn", l.EdgeOwnerTypeNotIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.TypeNotIn(l.EdgeOwnerTypeNotIn...)))
 	}
 	if l.EdgeOwnerDescriptionIsNil != nil {
@@ -1256,9 +1462,15 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		predicates = append(predicates, pet.HasOwnerWith(user.EnabledEQ(*l.EdgeOwnerEnabledEQ)))
 	}
 	if l.EdgeOwnerEmailEQ != nil {
+		if err := validateFilter("owner.email.eq", []string{*l.EdgeOwnerEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.EmailEQ(*l.EdgeOwnerEmailEQ)))
 	}
 	if l.EdgeOwnerEmailNEQ != nil {
+		if err := validateFilter("owner.email.neq", []string{*l.EdgeOwnerEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.EmailNEQ(*l.EdgeOwnerEmailNEQ)))
 	}
 	if l.EdgeOwnerEmailIsNil != nil {
@@ -1269,9 +1481,15 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		}
 	}
 	if l.EdgeOwnerEmailIn != nil {
+		if err := validateFilter("owner.email.in", l.EdgeOwnerEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.EmailIn(l.EdgeOwnerEmailIn...)))
 	}
 	if l.EdgeOwnerEmailNotIn != nil {
+		if err := validateFilter("owner.email.notIn", l.EdgeOwnerEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasOwnerWith(user.EmailNotIn(l.EdgeOwnerEmailNotIn...)))
 	}
 	if l.EdgeOwnerEmailEqualFold != nil {
@@ -1343,9 +1561,15 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		}
 	}
 	if l.EdgeFriendAgeEQ != nil {
+		if err := validateFilter("friend.age.eq", []int{*l.EdgeFriendAgeEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.AgeEQ(*l.EdgeFriendAgeEQ)))
 	}
 	if l.EdgeFriendAgeNEQ != nil {
+		if err := validateFilter("friend.age.neq", []int{*l.EdgeFriendAgeNEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNEQ(*l.EdgeFriendAgeNEQ)))
 	}
 	if l.EdgeFriendAgeGT != nil {
@@ -1355,21 +1579,39 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		predicates = append(predicates, pet.HasFriendsWith(pet.AgeLT(*l.EdgeFriendAgeLT)))
 	}
 	if l.EdgeFriendAgeIn != nil {
+		if err := validateFilter("friend.age.in", l.EdgeFriendAgeIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.AgeIn(l.EdgeFriendAgeIn...)))
 	}
 	if l.EdgeFriendAgeNotIn != nil {
+		if err := validateFilter("friend.age.notIn", l.EdgeFriendAgeNotIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNotIn(l.EdgeFriendAgeNotIn...)))
 	}
 	if l.EdgeFriendTypeEQ != nil {
+		if err := validateFilter("friend.type.eq", []pet.Type{*l.EdgeFriendTypeEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.TypeEQ(*l.EdgeFriendTypeEQ)))
 	}
 	if l.EdgeFriendTypeNEQ != nil {
+		if err := validateFilter("friend.type.neq", []pet.Type{*l.EdgeFriendTypeNEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNEQ(*l.EdgeFriendTypeNEQ)))
 	}
 	if l.EdgeFriendTypeIn != nil {
+		if err := validateFilter("friend.type.in", l.EdgeFriendTypeIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.TypeIn(l.EdgeFriendTypeIn...)))
 	}
 	if l.EdgeFriendTypeNotIn != nil {
+		if err := validateFilter("friend.type.notIn", l.EdgeFriendTypeNotIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNotIn(l.EdgeFriendTypeNotIn...)))
 	}
 	if l.EdgeHasFollowedBy != nil {
@@ -1431,15 +1673,27 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		predicates = append(predicates, pet.HasFollowedByWith(user.NameHasSuffix(*l.EdgeFollowedByNameHasSuffix)))
 	}
 	if l.EdgeFollowedByTypeEQ != nil {
+		if err := validateFilter("followedBy.type.eq", []user.Type{*l.EdgeFollowedByTypeEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.TypeEQ(*l.EdgeFollowedByTypeEQ)))
 	}
 	if l.EdgeFollowedByTypeNEQ != nil {
+		if err := validateFilter("followedBy.type.neq", []user.Type{*l.EdgeFollowedByTypeNEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.TypeNEQ(*l.EdgeFollowedByTypeNEQ)))
 	}
 	if l.EdgeFollowedByTypeIn != nil {
+		if err := validateFilter("followedBy.type.in", l.EdgeFollowedByTypeIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.TypeIn(l.EdgeFollowedByTypeIn...)))
 	}
 	if l.EdgeFollowedByTypeNotIn != nil {
+		if err := validateFilter("followedBy.type.notIn", l.EdgeFollowedByTypeNotIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.TypeNotIn(l.EdgeFollowedByTypeNotIn...)))
 	}
 	if l.EdgeFollowedByDescriptionIsNil != nil {
@@ -1459,9 +1713,15 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		predicates = append(predicates, pet.HasFollowedByWith(user.EnabledEQ(*l.EdgeFollowedByEnabledEQ)))
 	}
 	if l.EdgeFollowedByEmailEQ != nil {
+		if err := validateFilter("followedBy.email.eq", []string{*l.EdgeFollowedByEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.EmailEQ(*l.EdgeFollowedByEmailEQ)))
 	}
 	if l.EdgeFollowedByEmailNEQ != nil {
+		if err := validateFilter("followedBy.email.neq", []string{*l.EdgeFollowedByEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.EmailNEQ(*l.EdgeFollowedByEmailNEQ)))
 	}
 	if l.EdgeFollowedByEmailIsNil != nil {
@@ -1472,9 +1732,15 @@ func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
 		}
 	}
 	if l.EdgeFollowedByEmailIn != nil {
+		if err := validateFilter("followedBy.email.in", l.EdgeFollowedByEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.EmailIn(l.EdgeFollowedByEmailIn...)))
 	}
 	if l.EdgeFollowedByEmailNotIn != nil {
+		if err := validateFilter("followedBy.email.notIn", l.EdgeFollowedByEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, pet.HasFollowedByWith(user.EmailNotIn(l.EdgeFollowedByEmailNotIn...)))
 	}
 	if l.EdgeFollowedByEmailEqualFold != nil {
@@ -1508,16 +1774,23 @@ func (l *ListPetParams) ApplySorting(query *ent.PetQuery) error {
 	if err := l.Sorted.Validate(PetSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakPet(query, orderAsc)
 		return nil
 	}
-	applySortingPet(query, *l.Field, *l.Order)
+	applySortingPet(query, PetSortConfig.column(*l.Field), *l.Order)
 	return nil
 }
 
 // Exec wraps all logic (filtering, sorting, pagination, eager loading) and
 // executes all necessary queries, returning the results.
 func (l *ListPetParams) Exec(ctx context.Context, query *ent.PetQuery) (results *PagedResponse[ent.Pet], err error) {
+	return l.ExecWithPageConfig(ctx, query, PetPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListPetParams) ExecWithPageConfig(ctx context.Context, query *ent.PetQuery, pageConfig *PageConfig) (results *PagedResponse[ent.Pet], err error) {
 	predicates, err := l.FilterPredicates()
 	if err != nil {
 		return nil, err
@@ -1527,7 +1800,7 @@ func (l *ListPetParams) Exec(ctx context.Context, query *ent.PetQuery) (results
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, PetPageConfig)
+	return l.ExecutePaginated(ctx, query, pageConfig)
 }
 
 // ListSettingParams defines parameters for listing Settings via a GET request.
@@ -1591,16 +1864,23 @@ func (l *ListSettingParams) ApplySorting(query *ent.SettingsQuery) error {
 	if err := l.Sorted.Validate(SettingSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakSetting(query, orderAsc)
 		return nil
 	}
-	applySortingSetting(query, *l.Field, *l.Order)
+	applySortingSetting(query, SettingSortConfig.column(*l.Field), *l.Order)
 	return nil
 }
 
 // Exec wraps all logic (filtering, sorting, pagination, eager loading) and
 // executes all necessary queries, returning the results.
 func (l *ListSettingParams) Exec(ctx context.Context, query *ent.SettingsQuery) (results *PagedResponse[ent.Settings], err error) {
+	return l.ExecWithPageConfig(ctx, query, SettingPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListSettingParams) ExecWithPageConfig(ctx context.Context, query *ent.SettingsQuery, pageConfig *PageConfig) (results *PagedResponse[ent.Settings], err error) {
 	predicates, err := l.FilterPredicates()
 	if err != nil {
 		return nil, err
@@ -1610,7 +1890,7 @@ func (l *ListSettingParams) Exec(ctx context.Context, query *ent.SettingsQuery)
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, SettingPageConfig)
+	return l.ExecutePaginated(ctx, query, pageConfig)
 }
 
 // ListUserParams defines parameters for listing Users via a GET request.
@@ -1966,15 +2246,27 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		predicates = append(predicates, user.NameHasSuffix(*l.UserNameHasSuffix))
 	}
 	if l.UserTypeEQ != nil {
+		if err := validateFilter("type.eq", []user.Type{*l.UserTypeEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.TypeEQ(*l.UserTypeEQ))
 	}
 	if l.UserTypeNEQ != nil {
+		if err := validateFilter("type.neq", []user.Type{*l.UserTypeNEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.TypeNEQ(*l.UserTypeNEQ))
 	}
 	if l.UserTypeIn != nil {
+		if err := validateFilter("type.in", l.UserTypeIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.TypeIn(l.UserTypeIn...))
 	}
 	if l.UserTypeNotIn != nil {
+		if err := validateFilter("type.notIn", l.UserTypeNotIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.TypeNotIn(l.UserTypeNotIn...))
 	}
 	if l.UserDescriptionIsNil != nil {
@@ -1994,9 +2286,15 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		predicates = append(predicates, user.EnabledEQ(*l.UserEnabledEQ))
 	}
 	if l.UserEmailEQ != nil {
+		if err := validateFilter("email.eq", []string{*l.UserEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.EmailEQ(*l.UserEmailEQ))
 	}
 	if l.UserEmailNEQ != nil {
+		if err := validateFilter("email.neq", []string{*l.UserEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.EmailNEQ(*l.UserEmailNEQ))
 	}
 	if l.UserEmailIsNil != nil {
@@ -2007,9 +2305,15 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		}
 	}
 	if l.UserEmailIn != nil {
+		if err := validateFilter("email.in", l.UserEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.EmailIn(l.UserEmailIn...))
 	}
 	if l.UserEmailNotIn != nil {
+		if err := validateFilter("email.notIn", l.UserEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.EmailNotIn(l.UserEmailNotIn...))
 	}
 	if l.UserEmailEqualFold != nil {
@@ -2081,9 +2385,15 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		}
 	}
 	if l.EdgePetAgeEQ != nil {
+		if err := validateFilter("pet.age.eq", []int{*l.EdgePetAgeEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.AgeEQ(*l.EdgePetAgeEQ)))
 	}
 	if l.EdgePetAgeNEQ != nil {
+		if err := validateFilter("pet.age.neq", []int{*l.EdgePetAgeNEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.AgeNEQ(*l.EdgePetAgeNEQ)))
 	}
 	if l.EdgePetAgeGT != nil {
@@ -2093,21 +2403,39 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		predicates = append(predicates, user.HasPetsWith(pet.AgeLT(*l.EdgePetAgeLT)))
 	}
 	if l.EdgePetAgeIn != nil {
+		if err := validateFilter("pet.age.in", l.EdgePetAgeIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.AgeIn(l.EdgePetAgeIn...)))
 	}
 	if l.EdgePetAgeNotIn != nil {
+		if err := validateFilter("pet.age.notIn", l.EdgePetAgeNotIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.AgeNotIn(l.EdgePetAgeNotIn...)))
 	}
 	if l.EdgePetTypeEQ != nil {
+		if err := validateFilter("pet.type.eq", []pet.Type{*l.EdgePetTypeEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.TypeEQ(*l.EdgePetTypeEQ)))
 	}
 	if l.EdgePetTypeNEQ != nil {
+		if err := validateFilter("pet.type.neq", []pet.Type{*l.EdgePetTypeNEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.TypeNEQ(*l.EdgePetTypeNEQ)))
 	}
 	if l.EdgePetTypeIn != nil {
+		if err := validateFilter("pet.type.in", l.EdgePetTypeIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.TypeIn(l.EdgePetTypeIn...)))
 	}
 	if l.EdgePetTypeNotIn != nil {
+		if err := validateFilter("pet.type.notIn", l.EdgePetTypeNotIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasPetsWith(pet.TypeNotIn(l.EdgePetTypeNotIn...)))
 	}
 	if l.EdgeHasFollowedPet != nil {
@@ -2164,9 +2492,15 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		}
 	}
 	if l.EdgeFollowedPetAgeEQ != nil {
+		if err := validateFilter("followedPet.age.eq", []int{*l.EdgeFollowedPetAgeEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeEQ(*l.EdgeFollowedPetAgeEQ)))
 	}
 	if l.EdgeFollowedPetAgeNEQ != nil {
+		if err := validateFilter("followedPet.age.neq", []int{*l.EdgeFollowedPetAgeNEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeNEQ(*l.EdgeFollowedPetAgeNEQ)))
 	}
 	if l.EdgeFollowedPetAgeGT != nil {
@@ -2176,21 +2510,39 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeLT(*l.EdgeFollowedPetAgeLT)))
 	}
 	if l.EdgeFollowedPetAgeIn != nil {
+		if err := validateFilter("followedPet.age.in", l.EdgeFollowedPetAgeIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeIn(l.EdgeFollowedPetAgeIn...)))
 	}
 	if l.EdgeFollowedPetAgeNotIn != nil {
+		if err := validateFilter("followedPet.age.notIn", l.EdgeFollowedPetAgeNotIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.AgeNotIn(l.EdgeFollowedPetAgeNotIn...)))
 	}
 	if l.EdgeFollowedPetTypeEQ != nil {
+		if err := validateFilter("followedPet.type.eq", []pet.Type{*l.EdgeFollowedPetTypeEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeEQ(*l.EdgeFollowedPetTypeEQ)))
 	}
 	if l.EdgeFollowedPetTypeNEQ != nil {
+		if err := validateFilter("followedPet.type.neq", []pet.Type{*l.EdgeFollowedPetTypeNEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeNEQ(*l.EdgeFollowedPetTypeNEQ)))
 	}
 	if l.EdgeFollowedPetTypeIn != nil {
+		if err := validateFilter("followedPet.type.in", l.EdgeFollowedPetTypeIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeIn(l.EdgeFollowedPetTypeIn...)))
 	}
 	if l.EdgeFollowedPetTypeNotIn != nil {
+		if err := validateFilter("followedPet.type.notIn", l.EdgeFollowedPetTypeNotIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFollowedPetsWith(pet.TypeNotIn(l.EdgeFollowedPetTypeNotIn...)))
 	}
 	if l.EdgeHasFriend != nil {
@@ -2252,15 +2604,27 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		predicates = append(predicates, user.HasFriendsWith(user.NameHasSuffix(*l.EdgeFriendNameHasSuffix)))
 	}
 	if l.EdgeFriendTypeEQ != nil {
+		if err := validateFilter("friend.type.eq", []user.Type{*l.EdgeFriendTypeEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.TypeEQ(*l.EdgeFriendTypeEQ)))
 	}
 	if l.EdgeFriendTypeNEQ != nil {
+		if err := validateFilter("friend.type.neq", []user.Type{*l.EdgeFriendTypeNEQ}, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.TypeNEQ(*l.EdgeFriendTypeNEQ)))
 	}
 	if l.EdgeFriendTypeIn != nil {
+		if err := validateFilter("friend.type.in", l.EdgeFriendTypeIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.TypeIn(l.EdgeFriendTypeIn...)))
 	}
 	if l.EdgeFriendTypeNotIn != nil {
+		if err := validateFilter("friend.type.notIn", l.EdgeFriendTypeNotIn, user.TypeValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.TypeNotIn(l.EdgeFriendTypeNotIn...)))
 	}
 	if l.EdgeFriendDescriptionIsNil != nil {
@@ -2280,9 +2644,15 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		predicates = append(predicates, user.HasFriendsWith(user.EnabledEQ(*l.EdgeFriendEnabledEQ)))
 	}
 	if l.EdgeFriendEmailEQ != nil {
+		if err := validateFilter("friend.email.eq", []string{*l.EdgeFriendEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.EmailEQ(*l.EdgeFriendEmailEQ)))
 	}
 	if l.EdgeFriendEmailNEQ != nil {
+		if err := validateFilter("friend.email.neq", []string{*l.EdgeFriendEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.EmailNEQ(*l.EdgeFriendEmailNEQ)))
 	}
 	if l.EdgeFriendEmailIsNil != nil {
@@ -2293,9 +2663,15 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 		}
 	}
 	if l.EdgeFriendEmailIn != nil {
+		if err := validateFilter("friend.email.in", l.EdgeFriendEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.EmailIn(l.EdgeFriendEmailIn...)))
 	}
 	if l.EdgeFriendEmailNotIn != nil {
+		if err := validateFilter("friend.email.notIn", l.EdgeFriendEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
 		predicates = append(predicates, user.HasFriendsWith(user.EmailNotIn(l.EdgeFriendEmailNotIn...)))
 	}
 	if l.EdgeFriendEmailEqualFold != nil {
@@ -2427,6 +2803,7 @@ func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
 			user.EmailHasSuffix(*l.UserFilterGroupSearchHasSuffix),
 		))
 	}
+
 	return l.ApplyFilterOperation(predicates...)
 }
 
@@ -2435,16 +2812,23 @@ func (l *ListUserParams) ApplySorting(query *ent.UserQuery) error {
 	if err := l.Sorted.Validate(UserSortConfig); err != nil {
 		return err
 	}
-	if l.Field == nil { // No custom sort field provided and no defaults, so don't do anything.
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakUser(query, orderAsc)
 		return nil
 	}
-	applySortingUser(query, *l.Field, *l.Order)
+	applySortingUser(query, UserSortConfig.column(*l.Field), *l.Order)
 	return nil
 }
 
 // Exec wraps all logic (filtering, sorting, pagination, eager loading) and
 // executes all necessary queries, returning the results.
 func (l *ListUserParams) Exec(ctx context.Context, query *ent.UserQuery) (results *PagedResponse[ent.User], err error) {
+	return l.ExecWithPageConfig(ctx, query, UserPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListUserParams) ExecWithPageConfig(ctx context.Context, query *ent.UserQuery, pageConfig *PageConfig) (results *PagedResponse[ent.User], err error) {
 	predicates, err := l.FilterPredicates()
 	if err != nil {
 		return nil, err
@@ -2454,5 +2838,5 @@ func (l *ListUserParams) Exec(ctx context.Context, query *ent.UserQuery) (result
 	if err != nil {
 		return nil, err
 	}
-	return l.ExecutePaginated(ctx, query, UserPageConfig)
+	return l.ExecutePaginated(ctx, query, pageConfig)
 }
@@ -4,12 +4,18 @@ package rest
 
 import (
 	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
 	_ "embed"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"html/template"
 	"io"
+	"math"
+	mathrand "math/rand/v2"
 	"net/http"
 	"net/url"
 	"slices"
@@ -17,6 +23,7 @@ import (
 	"strings"
 	"time"
 
+	"entgo.io/ent/dialect/sql/sqlgraph"
 	"github.com/go-playground/form/v4"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/category"
@@ -73,6 +80,64 @@ func IsBadRequest(err error) bool {
 	return errors.As(err, &target)
 }
 
+// ErrUnprocessable is returned when a request is well-formed, but fails validation
+// (e.g. JSON values of the wrong type).
+type ErrUnprocessable struct {
+	Err error
+}
+
+func (e ErrUnprocessable) Error() string {
+	return fmt.Sprintf("unprocessable request: %s", e.Err)
+}
+
+func (e ErrUnprocessable) Unwrap() error {
+	return e.Err
+}
+
+// IsUnprocessable returns true if the unwrapped/underlying error is of type ErrUnprocessable.
+func IsUnprocessable(err error) bool {
+	var target *ErrUnprocessable
+	return errors.As(err, &target)
+}
+
+// ErrUnknownField is returned when a create/update request includes a field which
+// doesn't exist on the entity.
+type ErrUnknownField struct {
+	Err error
+}
+
+func (e ErrUnknownField) Error() string {
+	return fmt.Sprintf("unknown field: %s", e.Err)
+}
+
+func (e ErrUnknownField) Unwrap() error {
+	return e.Err
+}
+
+// IsUnknownField returns true if the unwrapped/underlying error is of type ErrUnknownField.
+func IsUnknownField(err error) bool {
+	var target *ErrUnknownField
+	return errors.As(err, &target)
+}
+
+type ErrConflict struct {
+	Err error
+}
+
+func (e ErrConflict) Error() string {
+	return fmt.Sprintf("conflict: %s", e.Err)
+}
+
+func (e ErrConflict) Unwrap() error {
+	return e.Err
+}
+
+// IsConflict returns true if the unwrapped/underlying error is of type ErrConflict.
+func IsConflict(err error) bool {
+	var target *ErrConflict
+	return errors.As(err, &target)
+}
+
 var ErrEndpointNotFound = errors.New("endpoint not found")
 
 // IsEndpointNotFound returns true if the unwrapped/underlying error is of type ErrEndpointNotFound.
@@ -120,11 +185,35 @@ var (
 	DefaultDecodeMaxMemory int64 = 8 << 20
 )
 
+func init() {
+	RegisterPageDecoders(DefaultDecoder)
+}
+
+// RegisterPageDecoders registers decoders for the PageNumber type with the provided
+// decoder, which also accept "first" and "last". This is done automatically for
+// DefaultDecoder, but must be called when providing your own.
+func RegisterPageDecoders(d *form.Decoder) {
+	d.RegisterCustomTypeFunc(func(vals []string) (any, error) {
+		var v PageNumber
+		if err := v.UnmarshalText([]byte(vals[0])); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}, PageNumber(0))
+}
+
 // Bind decodes the request body to the given struct. At this time the only supported
 // content-types are application/json, application/x-www-form-urlencoded, as well as
 // GET parameters.
-func Bind(r *http.Request, v any) error {
-	err := r.ParseForm()
+func Bind(r *http.Request, v any) (err error) {
+	defer func() {
+		// The form decoder panics on some malformed parameter names (e.g. "]").
+		if rerr := recover(); rerr != nil {
+			err = &ErrBadRequest{Err: fmt.Errorf("error decoding %s request into required format (%T): %v", r.Method, v, rerr)}
+		}
+	}()
+
+	err = r.ParseForm()
 	if err != nil {
 		return &ErrBadRequest{Err: fmt.Errorf("parsing form parameters: %w", err)}
 	}
@@ -138,7 +227,9 @@ func Bind(r *http.Request, v any) error {
 			dec := json.NewDecoder(r.Body)
 			dec.DisallowUnknownFields()
 			defer r.Body.Close()
-			err = dec.Decode(v)
+			if err = dec.Decode(v); err != nil {
+				return jsonDecodeError(r, v, err)
+			}
 		case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"):
 			err = r.ParseMultipartForm(DefaultDecodeMaxMemory)
 			if err == nil {
@@ -157,11 +248,29 @@ func Bind(r *http.Request, v any) error {
 	return nil
 }
 
+// jsonDecodeError wraps the provided JSON decoding error, distinguishing malformed
+// JSON from well-formed JSON which doesn't match the expected structure (or
+// contains unknown fields).
+func jsonDecodeError(r *http.Request, v any, err error) error {
+	err = fmt.Errorf("error decoding %s request into required format (%T): %w", r.Method, v, err)
+
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &syntaxErr), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return &ErrBadRequest{Err: err}
+	case strings.Contains(err.Error(), "json: unknown field "):
+		return &ErrUnknownField{Err: err}
+	default:
+		return &ErrUnprocessable{Err: err}
+	}
+}
+
 // Req simplifies making an HTTP handler that returns a single result, and an error.
 // The result, if not nil, must be JSON-marshalable. If result is nil, [http.StatusNoContent]
 // will be returned.
 func Req[Resp any](s *Server, op Operation, fn func(*http.Request) (*Resp, error)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
 		results, err := fn(r)
 		handleResponse(s, w, r, op, results, err)
 	}
@@ -171,6 +280,7 @@ func Req[Resp any](s *Server, op Operation, fn func(*http.Request) (*Resp, error
 // handler function.
 func ReqID[Resp any](s *Server, op Operation, fn func(*http.Request, int) (*Resp, error)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
 		id, err := strconv.Atoi(r.PathValue("id"))
 		if err != nil {
 			handleResponse[Resp](s, w, r, op, nil, err)
@@ -185,6 +295,7 @@ func ReqID[Resp any](s *Server, op Operation, fn func(*http.Request, int) (*Resp
 // to the handler function.
 func ReqParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request, *Params) (*Resp, error)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
 		params := new(Params)
 		if err := Bind(r, params); err != nil {
 			handleResponse[Resp](s, w, r, op, nil, err)
@@ -199,6 +310,7 @@ func ReqParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request,
 // body/query params, and provides it to the handler function.
 func ReqIDParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request, int, *Params) (*Resp, error)) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
 		id, err := strconv.Atoi(r.PathValue("id"))
 		if err != nil {
 			handleResponse[Resp](s, w, r, op, nil, err)
@@ -215,6 +327,177 @@ func ReqIDParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request
 	}
 }
 
+// ReqCompositeID is similar to ReqID, but for entities with a composite ID, which is
+// parsed from multiple path parameters using the provided parse function.
+func ReqCompositeID[ID, Resp any](s *Server, op Operation, parse func(*http.Request) (ID, error), fn func(*http.Request, ID) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		id, err := parse(r)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqCompositeIDParam is similar to ReqIDParam, but for entities with a composite ID,
+// which is parsed from multiple path parameters using the provided parse function.
+func ReqCompositeIDParam[ID, Params, Resp any](s *Server, op Operation, parse func(*http.Request) (ID, error), fn func(*http.Request, ID, *Params) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		id, err := parse(r)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		params := new(Params)
+		err = Bind(r, params)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id, params)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// RetryPolicy configures the retries of mutations (which are executed within a
+// transaction) which fail with a transient error (see [IsTransientError]). The zero
+// value uses the defaults of each field.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a transaction, including the
+	// first attempt. Defaults to 3. Set to 1 to disable retries.
+	MaxAttempts int
+
+	// Backoff returns the delay before the provided retry (starting at 1). Defaults
+	// to an exponential backoff starting at 10ms, with jitter.
+	Backoff func(retry int) time.Duration
+
+	// RetryAfter is the delay returned through the "Retry-After" header of responses
+	// to transient errors (rounded up to whole seconds). Defaults to 1 second.
+	RetryAfter time.Duration
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 3
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	if p != nil && p.Backoff != nil {
+		return p.Backoff(retry)
+	}
+	d := 10 * time.Millisecond << min(retry-1, 10)
+	return d/2 + mathrand.N(d/2+1)
+}
+
+func (p *RetryPolicy) retryAfter() time.Duration {
+	if p == nil || p.RetryAfter <= 0 {
+		return time.Second
+	}
+	return p.RetryAfter
+}
+
+// transientErrorStates holds the SQLSTATE codes of transient errors (serialization
+// failures and deadlocks), and the status code of responses to them.
+var transientErrorStates = map[string]int{
+	"40001": http.StatusConflict,           // serialization_failure
+	"40P01": http.StatusServiceUnavailable, // deadlock_detected
+}
+
+// transientErrorMessages holds the messages of transient errors of drivers which
+// don't expose a SQLSTATE code, and the status code of responses to them.
+var transientErrorMessages = map[string]int{
+	"Error 1213":         http.StatusServiceUnavailable, // MySQL: deadlock found.
+	"Error 1205":         http.StatusServiceUnavailable, // MySQL: lock wait timeout exceeded.
+	"database is locked": http.StatusServiceUnavailable, // SQLite: SQLITE_BUSY.
+	"SQLITE_BUSY":        http.StatusServiceUnavailable,
+}
+
+// transientErrorStatus returns the status code of responses to the provided error if
+// it's a transient error (see [IsTransientError]), i.e. 409 for serialization
+// failures, and 503 for deadlocks and lock timeouts, or 0 otherwise.
+func transientErrorStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) {
+		if status, ok := transientErrorStates[state.SQLState()]; ok {
+			return status
+		}
+	}
+
+	msg := err.Error()
+	for text, status := range transientErrorMessages {
+		if strings.Contains(msg, text) {
+			return status
+		}
+	}
+	return 0
+}
+
+// IsTransientError returns true if the provided error is a transient database error,
+// i.e. a serialization failure, deadlock or lock timeout, which may succeed when
+// retried. Transactions of mutations are retried when they fail with a transient
+// error (see [ServerConfig.Retry]), and responses to transient errors include a
+// "Retry-After" header.
+func IsTransientError(err error) bool {
+	return transientErrorStatus(err) != 0
+}
+
+// withTx runs the provided function within a transaction of the client of the
+// provided context, committing if no error is returned, and rolling back otherwise.
+// Transactions which fail with a transient error are retried (see
+// [ServerConfig.Retry]).
+func withTx[T any](s *Server, ctx context.Context, fn func(tx *ent.Client) (*T, error)) (*T, error) {
+	for retry := 1; ; retry++ {
+		result, err := runTx(ctx, s.client(ctx), fn)
+		if err == nil || !IsTransientError(err) || retry >= s.config.Retry.maxAttempts() {
+			return result, err
+		}
+
+		timer := time.NewTimer(s.config.Retry.backoff(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// runTx runs the provided function within a transaction, committing if no error is
+// returned, and rolling back otherwise. If the provided client is already
+// transactional (e.g. provided through [UseEntContext]), the function is run within
+// its transaction, which is left to its owner.
+func runTx[T any](ctx context.Context, db *ent.Client, fn func(tx *ent.Client) (*T, error)) (*T, error) {
+	tx, err := db.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return fn(db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := fn(tx.Client())
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %w", err, rerr)
+		}
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 // Links represents a set of linkable-relationsips that can be represented through
 // the "Link" header. Note that all urls must be url-encoded already.
 type Links map[string]string
@@ -237,28 +520,266 @@ type linkablePagedResource interface {
 	GetIsLastPage() bool
 }
 
-// Spec returns the OpenAPI spec for the server implementation.
-func (s *Server) Spec(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Type", "application/json")
-	if !s.config.DisableSpecInjectServer && s.config.BaseURL != "" {
-		spec := map[string]any{}
-		err := json.Unmarshal(OpenAPI, &spec)
-		if err != nil {
-			panic(fmt.Sprintf("failed to unmarshal spec: %v", err))
+// Route describes an endpoint of an entity, as mounted by [Server.Handler].
+type Route struct {
+	Method      string    // The HTTP method, e.g. "GET".
+	Pattern     string    // The path pattern, relative to [ServerConfig.BasePath], e.g. "/pets/{id}".
+	Operation   Operation // The operation of the endpoint.
+	OperationID string    // The OpenAPI operation ID, e.g. "getPet".
+	Entity      string    // The name of the entity, e.g. "Pet".
+}
+
+// URL returns the path of the route (relative to [ServerConfig.BasePath]), with its
+// path parameters replaced by the provided params (in order), e.g. "/pets/1".
+func (r Route) URL(params ...any) (string, error) {
+	var b strings.Builder
+	pattern := r.Pattern
+
+	var i int
+	for {
+		start := strings.IndexByte(pattern, '{')
+		if start == -1 {
+			b.WriteString(pattern)
+			break
 		}
 
-		type Server struct {
-			URL string `json:"url"`
+		end := strings.IndexByte(pattern[start:], '}')
+		if end == -1 {
+			return "", fmt.Errorf("invalid pattern %q for operation %q", r.Pattern, r.OperationID)
 		}
+		end += start
 
-		if _, ok := spec["servers"]; !ok {
-			spec["servers"] = []Server{{URL: s.config.BaseURL}}
-			JSON(w, r, http.StatusOK, spec)
-			return
+		if i >= len(params) {
+			return "", fmt.Errorf("missing path parameter %s for operation %q", pattern[start:end+1], r.OperationID)
+		}
+
+		b.WriteString(pattern[:start])
+		b.WriteString(url.PathEscape(fmt.Sprint(params[i])))
+		pattern = pattern[end+1:]
+		i++
+	}
+
+	if i != len(params) {
+		return "", fmt.Errorf("operation %q expects %d path parameters, got %d", r.OperationID, i, len(params))
+	}
+	return b.String(), nil
+}
+
+// routes are the endpoints of all entities, see [Routes].
+var routes = []Route{
+	{Method: "GET", Pattern: "/categories", Operation: OperationList, OperationID: "listCategories", Entity: "Category"},
+	{Method: "GET", Pattern: "/categories/{id}", Operation: OperationRead, OperationID: "getCategory", Entity: "Category"},
+	{Method: "GET", Pattern: "/categories/{id}/pets", Operation: OperationList, OperationID: "listCategoryPets", Entity: "Category"},
+	{Method: "POST", Pattern: "/categories", Operation: OperationCreate, OperationID: "createCategory", Entity: "Category"},
+	{Method: "PATCH", Pattern: "/categories/{id}", Operation: OperationUpdate, OperationID: "updateCategory", Entity: "Category"},
+	{Method: "DELETE", Pattern: "/categories/{id}", Operation: OperationDelete, OperationID: "deleteCategory", Entity: "Category"},
+	{Method: "GET", Pattern: "/follows", Operation: OperationList, OperationID: "listFollows", Entity: "Follows"},
+	{Method: "GET", Pattern: "/follows/{user_id}/{pet_id}", Operation: OperationRead, OperationID: "getFollow", Entity: "Follows"},
+	{Method: "POST", Pattern: "/follows", Operation: OperationCreate, OperationID: "createFollow", Entity: "Follows"},
+	{Method: "PATCH", Pattern: "/follows/{user_id}/{pet_id}", Operation: OperationUpdate, OperationID: "updateFollow", Entity: "Follows"},
+	{Method: "DELETE", Pattern: "/follows/{user_id}/{pet_id}", Operation: OperationDelete, OperationID: "deleteFollow", Entity: "Follows"},
+	{Method: "GET", Pattern: "/friendships", Operation: OperationList, OperationID: "listFriendships", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/friendships/{id}", Operation: OperationRead, OperationID: "getFriendship", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/friendships/{id}/user", Operation: OperationRead, OperationID: "getFriendshipUser", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/friendships/{id}/friend", Operation: OperationRead, OperationID: "getFriendshipFriend", Entity: "Friendship"},
+	{Method: "POST", Pattern: "/friendships", Operation: OperationCreate, OperationID: "createFriendship", Entity: "Friendship"},
+	{Method: "PATCH", Pattern: "/friendships/{id}", Operation: OperationUpdate, OperationID: "updateFriendship", Entity: "Friendship"},
+	{Method: "DELETE", Pattern: "/friendships/{id}", Operation: OperationDelete, OperationID: "deleteFriendship", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/pets", Operation: OperationList, OperationID: "listPets", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}", Operation: OperationRead, OperationID: "getPet", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/categories", Operation: OperationList, OperationID: "listPetCategories", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/owner", Operation: OperationRead, OperationID: "getPetOwner", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/friends", Operation: OperationList, OperationID: "listPetFriends", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/followed-by", Operation: OperationList, OperationID: "listPetFollowedBys", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/following", Operation: OperationList, OperationID: "listPetFollowings", Entity: "Pet"},
+	{Method: "POST", Pattern: "/pets/{id}/following", Operation: OperationCreate, OperationID: "createPetFollowing", Entity: "Pet"},
+	{Method: "POST", Pattern: "/pets", Operation: OperationCreate, OperationID: "createPet", Entity: "Pet"},
+	{Method: "PATCH", Pattern: "/pets/{id}", Operation: OperationUpdate, OperationID: "updatePet", Entity: "Pet"},
+	{Method: "DELETE", Pattern: "/pets/{id}", Operation: OperationDelete, OperationID: "deletePet", Entity: "Pet"},
+	{Method: "GET", Pattern: "/settings", Operation: OperationList, OperationID: "listSettings", Entity: "Settings"},
+	{Method: "GET", Pattern: "/settings/{id}", Operation: OperationRead, OperationID: "getSetting", Entity: "Settings"},
+	{Method: "GET", Pattern: "/settings/{id}/admins", Operation: OperationList, OperationID: "listSettingAdmins", Entity: "Settings"},
+	{Method: "PATCH", Pattern: "/settings/{id}", Operation: OperationUpdate, OperationID: "updateSetting", Entity: "Settings"},
+	{Method: "GET", Pattern: "/users", Operation: OperationList, OperationID: "listUsers", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}", Operation: OperationRead, OperationID: "getUser", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/pets", Operation: OperationList, OperationID: "listUserPets", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/followed-pets", Operation: OperationList, OperationID: "listUserFollowedPets", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/friends", Operation: OperationList, OperationID: "listUserFriends", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/following", Operation: OperationList, OperationID: "listUserFollowings", Entity: "User"},
+	{Method: "POST", Pattern: "/users/{id}/following", Operation: OperationCreate, OperationID: "createUserFollowing", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/friendships", Operation: OperationList, OperationID: "listUserFriendships", Entity: "User"},
+	{Method: "POST", Pattern: "/users/{id}/friendships", Operation: OperationCreate, OperationID: "createUserFriendship", Entity: "User"},
+	{Method: "POST", Pattern: "/users", Operation: OperationCreate, OperationID: "createUser", Entity: "User"},
+	{Method: "PATCH", Pattern: "/users/{id}", Operation: OperationUpdate, OperationID: "updateUser", Entity: "User"},
+	{Method: "DELETE", Pattern: "/users/{id}", Operation: OperationDelete, OperationID: "deleteUser", Entity: "User"},
+}
+
+// Routes returns the endpoints of all entities, as mounted by [Server.Handler]. This
+// can be used to build links to endpoints, or to sync routes with an API gateway.
+func Routes() []Route {
+	return slices.Clone(routes)
+}
+
+// URLFor returns the path (relative to [ServerConfig.BasePath]) of the endpoint with
+// the provided operation ID (see [Routes]), with its path parameters replaced by the
+// provided params (in order). For example, URLFor("getPet", 1) returns "/pets/1".
+func URLFor(operationID string, params ...any) (string, error) {
+	for _, r := range routes {
+		if r.OperationID == operationID {
+			return r.URL(params...)
 		}
 	}
+	return "", fmt.Errorf("unknown operation %q", operationID)
+}
+
+// specVariant is a pre-rendered variant of the OpenAPI spec, as served by
+// [Server.Spec].
+type specVariant struct {
+	data        []byte // The encoded spec.
+	gzip        []byte // The gzip compressed version of data.
+	etag        string // A strong ETag of data.
+	contentType string // The content type of data.
+}
+
+// newSpecVariant pre-renders the provided OpenAPI spec (JSON or YAML encoded),
+// injecting the server URL (see [ServerConfig.DisableSpecInjectServer]).
+func (s *Server) newSpecVariant(data []byte, isYAML bool) (*specVariant, error) {
+	if !s.config.DisableSpecInjectServer && s.config.BaseURL != "" {
+		if isYAML {
+			// The YAML spec is generated with top-level keys at the start of a
+			// line, so a servers key can be detected, and prepended, without
+			// having to decode the spec.
+			if !bytes.HasPrefix(data, []byte("servers:")) && !bytes.Contains(data, []byte("\nservers:")) {
+				uri, err := json.Marshal(s.config.BaseURL)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal server URL: %w", err)
+				}
+				data = append([]byte("servers:\n    - url: "+string(uri)+"\n"), data...)
+			}
+		} else {
+			spec := map[string]any{}
+			err := json.Unmarshal(data, &spec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal spec: %w", err)
+			}
+
+			type Server struct {
+				URL string `json:"url"`
+			}
+
+			if _, ok := spec["servers"]; !ok {
+				spec["servers"] = []Server{{URL: s.config.BaseURL}}
+				data, err = json.Marshal(spec)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal spec: %w", err)
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	gw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = gw.Write(data); err != nil {
+		return nil, err
+	}
+	if err = gw.Close(); err != nil {
+		return nil, err
+	}
+
+	contentType := "application/json"
+	if isYAML {
+		contentType = "application/yaml"
+	}
+
+	sum := sha256.Sum256(data)
+	return &specVariant{
+		data:        data,
+		gzip:        buf.Bytes(),
+		etag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		contentType: contentType,
+	}, nil
+}
+
+// Spec returns the OpenAPI spec for the server implementation, as JSON. Supports
+// conditional requests (through the ETag of the spec), gzip compression, and
+// cross-origin requests. The specs of additional generation targets can be selected
+// through the "version" query parameter (e.g. "?version=public").
+func (s *Server) Spec(w http.ResponseWriter, r *http.Request) {
+	s.serveSpec(w, r, s.specs)
+}
+
+// serveSpec serves the requested variant of the provided specs (see [Server.Spec]).
+func (s *Server) serveSpec(w http.ResponseWriter, r *http.Request, specs map[string]*specVariant) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "*")
+		w.Header().Set("Access-Control-Max-Age", "86400")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	spec, ok := specs[r.URL.Query().Get("version")]
+	if !ok {
+		handleResponse[struct{}](s, w, r, "", nil, &ErrBadRequest{
+			Err: fmt.Errorf("unknown spec version %q", r.URL.Query().Get("version")),
+		})
+		return
+	}
+
+	w.Header().Set("Access-Control-Expose-Headers", "ETag")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("ETag", spec.etag)
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	if etagMatches(r.Header.Get("If-None-Match"), spec.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", spec.contentType)
+	if acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(spec.gzip)
+		return
+	}
 	w.WriteHeader(http.StatusOK)
-	_, _ = w.Write(OpenAPI)
+	_, _ = w.Write(spec.data)
+}
+
+// etagMatches returns true if the provided If-None-Match header matches the
+// provided ETag (using weak comparison).
+func etagMatches(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip returns true if the client accepts gzip compressed responses.
+func acceptsGzip(r *http.Request) bool {
+	for _, v := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(v), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
 }
 
 var scalarTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
@@ -322,13 +843,13 @@ type ServerConfig struct {
 	// to prefill BasePath. This is not required if BasePath is provided.
 	BaseURL string
 
-	// BasePath if provided, and the /openapi.json endpoint is enabled, will allow annotating
+	// BasePath if provided, and the spec endpoints are enabled, will allow annotating
 	// API responses with "Link" headers. See [ServerConfig.EnableLinks] for more information.
 	BasePath string
 
-	// DisableSpecHandler if set to true, will disable the /openapi.json endpoint. This will also
-	// disable the embedded API reference documentation, see [ServerConfig.DisableDocs] for more
-	// information.
+	// DisableSpecHandler if set to true, will disable the spec endpoints (e.g. /openapi.json).
+	// This will also disable the embedded API reference documentation, see
+	// [ServerConfig.DisableDocs] for more information.
 	DisableSpecHandler bool
 
 	// DisableSpecInjectServer if set to true, will disable the automatic injection of the
@@ -359,11 +880,72 @@ type ServerConfig struct {
 	// default implementation will use the X-Request-Id header, otherwise an empty
 	// string will be returned. If using go-chi, middleware.GetReqID will be used.
 	GetReqID func(r *http.Request) string
+
+	// ResponseHeaders provides the values of response headers (e.g. those documented
+	// through GlobalResponseHeaders, like X-Ratelimit-Limit), which are set before the
+	// response is written.
+	ResponseHeaders ResponseHeaderProvider
+
+	// ClientSelector selects the ent client used by each request (e.g. to route read
+	// operations to a read-replica, and mutations to the primary). If not provided, the
+	// client provided to [NewServer] is used for all requests.
+	ClientSelector ClientSelector
+
+	// Interceptors returns the ent interceptors of the provided request, which apply to
+	// all queries executed by the handler of the request (e.g. to scope queries to the
+	// tenant of the request, or filter out soft-deleted entities), rather than being
+	// registered globally on the client. See [RequestInterceptor].
+	Interceptors func(r *http.Request, op Operation) []ent.Interceptor
+
+	// Hooks returns the ent hooks of the provided request, which apply to all mutations
+	// executed by the handler of the request (e.g. to set the tenant or author of created
+	// entities). See [RequestHook].
+	Hooks func(r *http.Request, op Operation) []ent.Hook
+
+	// Retry configures the retries of transactions of mutations which fail with a
+	// transient error, e.g. a serialization failure or deadlock (see [IsTransientError]).
+	// If not provided, the defaults of [RetryPolicy] are used.
+	Retry *RetryPolicy
+}
+
+// ResponseHeaderProvider provides the values of response headers. The entity is the
+// result of the operation (e.g. *ent.Pet, or a paged response for lists), or nil for
+// errors and responses without a body.
+type ResponseHeaderProvider interface {
+	ResponseHeaders(ctx context.Context, op Operation, entity any) map[string]string
+}
+
+// ResponseHeadersFunc is an adapter to allow the use of ordinary functions as a
+// [ResponseHeaderProvider].
+type ResponseHeadersFunc func(ctx context.Context, op Operation, entity any) map[string]string
+
+// ResponseHeaders calls fn(ctx, op, entity).
+func (fn ResponseHeadersFunc) ResponseHeaders(ctx context.Context, op Operation, entity any) map[string]string {
+	return fn(ctx, op, entity)
+}
+
+// ClientSelector selects the ent client used by each request.
+type ClientSelector interface {
+	// SelectClient returns the client to use for the provided request of the provided
+	// operation, where primary is the client provided to [NewServer]. Returning nil
+	// uses the primary client. Note that mutations which re-fetch the entity (see
+	// Config.ReadYourWrites in entrest) use the same client for both.
+	SelectClient(r *http.Request, op Operation, primary *ent.Client) *ent.Client
+}
+
+// ClientSelectorFunc is an adapter to allow the use of ordinary functions as a
+// [ClientSelector].
+type ClientSelectorFunc func(r *http.Request, op Operation, primary *ent.Client) *ent.Client
+
+// SelectClient calls fn(r, op, primary).
+func (fn ClientSelectorFunc) SelectClient(r *http.Request, op Operation, primary *ent.Client) *ent.Client {
+	return fn(r, op, primary)
 }
 
 type Server struct {
 	db     *ent.Client
 	config *ServerConfig
+	specs  map[string]*specVariant
 }
 
 // NewServer returns a new auto-generated server implementation for your ent schema.
@@ -377,6 +959,12 @@ func NewServer(db *ent.Client, config *ServerConfig) (*Server, error) {
 	if s.config == nil {
 		s.config = &ServerConfig{}
 	}
+	if s.config.Interceptors != nil {
+		db.Intercept(RequestInterceptor)
+	}
+	if s.config.Hooks != nil {
+		db.Use(RequestHook)
+	}
 	if s.config.BaseURL != "" && s.config.BasePath == "" {
 		uri, err := url.Parse(s.config.BaseURL)
 		if err != nil {
@@ -393,9 +981,131 @@ func NewServer(db *ent.Client, config *ServerConfig) (*Server, error) {
 		}
 		s.config.BasePath = strings.TrimRight(s.config.BasePath, "/")
 	}
+	if !s.config.DisableSpecHandler {
+		variants := map[string][]byte{"": OpenAPI}
+
+		s.specs = make(map[string]*specVariant, len(variants))
+		for version, data := range variants {
+			variant, err := s.newSpecVariant(data, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to prepare %q spec: %w", version, err)
+			}
+			s.specs[version] = variant
+		}
+	}
 	return s, nil
 }
 
+type clientKey struct{}
+
+// withClient returns the provided request, with the client selected for the provided
+// operation (see [ServerConfig.ClientSelector]), and the interceptors and hooks of the
+// operation, stored in its context.
+func (s *Server) withClient(r *http.Request, op Operation) *http.Request {
+	r = s.withInterceptors(r, op)
+	if s.config.ClientSelector == nil {
+		return r
+	}
+
+	db := s.config.ClientSelector.SelectClient(r, op, s.db)
+	if db == nil || db == s.db {
+		return r
+	}
+	return r.WithContext(ent.NewContext(context.WithValue(r.Context(), clientKey{}, db), db))
+}
+
+// client returns the client selected for the request of the provided context (see
+// [ServerConfig.ClientSelector]), or the primary client.
+func (s *Server) client(ctx context.Context) *ent.Client {
+	if db, ok := ctx.Value(clientKey{}).(*ent.Client); ok {
+		return db
+	}
+	return s.db
+}
+
+type (
+	requestInterceptorsKey struct{}
+	requestHooksKey        struct{}
+)
+
+// withInterceptors returns the provided request, with the ent interceptors and hooks
+// of the provided operation (see [ServerConfig.Interceptors] and [ServerConfig.Hooks])
+// stored in its context.
+func (s *Server) withInterceptors(r *http.Request, op Operation) *http.Request {
+	ctx := r.Context()
+
+	if s.config.Interceptors != nil {
+		if inters := s.config.Interceptors(r, op); len(inters) > 0 {
+			ctx = context.WithValue(ctx, requestInterceptorsKey{}, inters)
+		}
+	}
+
+	if s.config.Hooks != nil {
+		if hooks := s.config.Hooks(r, op); len(hooks) > 0 {
+			ctx = context.WithValue(ctx, requestHooksKey{}, hooks)
+		}
+	}
+
+	if ctx == r.Context() {
+		return r
+	}
+	return r.WithContext(ctx)
+}
+
+// RequestInterceptor is an ent interceptor which applies the interceptors of the
+// request (see [ServerConfig.Interceptors]) to all queries executed with the request
+// context, including traversal interceptors (e.g. tenant scoping, or soft-delete
+// filters). It's registered on the client provided to [NewServer] when
+// [ServerConfig.Interceptors] is provided, and must be registered on any other client
+// returned by [ServerConfig.ClientSelector].
+var RequestInterceptor ent.Interceptor = requestInterceptor{}
+
+type requestInterceptor struct{}
+
+// Intercept implements [ent.Interceptor].
+func (requestInterceptor) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		inters, _ := ctx.Value(requestInterceptorsKey{}).([]ent.Interceptor)
+
+		querier := next
+		for i := len(inters) - 1; i >= 0; i-- {
+			querier = inters[i].Intercept(querier)
+		}
+		return querier.Query(ctx, q)
+	})
+}
+
+// Traverse implements [ent.Traverser].
+func (requestInterceptor) Traverse(ctx context.Context, q ent.Query) error {
+	inters, _ := ctx.Value(requestInterceptorsKey{}).([]ent.Interceptor)
+
+	for _, inter := range inters {
+		if trv, ok := inter.(ent.Traverser); ok {
+			if err := trv.Traverse(ctx, q); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RequestHook is an ent hook which applies the hooks of the request (see
+// [ServerConfig.Hooks]) to all mutations executed with the request context. It's
+// registered on the client provided to [NewServer] when [ServerConfig.Hooks] is
+// provided, and must be registered on any other client returned by
+// [ServerConfig.ClientSelector].
+func RequestHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		hooks, _ := ctx.Value(requestHooksKey{}).([]ent.Hook)
+
+		mutator := next
+		for i := len(hooks) - 1; i >= 0; i-- {
+			mutator = hooks[i](mutator)
+		}
+		return mutator.Mutate(ctx, m)
+	})
+}
+
 // DefaultErrorHandler is the default error handler for the Server.
 func (s *Server) DefaultErrorHandler(w http.ResponseWriter, r *http.Request, op Operation, err error) {
 	ts := time.Now().UTC().Format(time.RFC3339)
@@ -414,14 +1124,29 @@ func (s *Server) DefaultErrorHandler(w http.ResponseWriter, r *http.Request, op
 		resp.Code = http.StatusMethodNotAllowed
 	case IsBadRequest(err):
 		resp.Code = http.StatusBadRequest
+	case IsUnknownField(err):
+		resp.Code = 400
+	case IsUnprocessable(err):
+		resp.Code = 422
+	case IsConflict(err):
+		resp.Code = http.StatusConflict
+	case IsTransientError(err):
+		// Serialization failures, deadlocks and lock timeouts, which remain after all
+		// retries of the transaction are exhausted.
+		resp.Code = transientErrorStatus(err)
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(s.config.Retry.retryAfter().Seconds()))))
 	case errors.Is(err, privacy.Deny):
 		resp.Code = http.StatusForbidden
 	case ent.IsNotFound(err):
 		resp.Code = http.StatusNotFound
+	case sqlgraph.IsForeignKeyConstraintError(err) && (op == OperationCreate || op == OperationUpdate):
+		// The entity references another entity (e.g. through an edge field) which
+		// doesn't exist. Deleting an entity which is still referenced is a conflict.
+		resp.Code = http.StatusNotFound
 	case ent.IsConstraintError(err), ent.IsNotSingular(err):
 		resp.Code = http.StatusConflict
 	case ent.IsValidationError(err):
-		resp.Code = http.StatusBadRequest
+		resp.Code = 422
 	case errors.As(err, &numErr):
 		resp.Code = http.StatusBadRequest
 		resp.Error = fmt.Sprintf("invalid ID provided: %v", err)
@@ -477,6 +1202,16 @@ func handleResponse[Resp any](s *Server, w http.ResponseWriter, r *http.Request,
 			w.Header().Set("Link", v)
 		}
 	}
+	if s.config.ResponseHeaders != nil {
+		var entity any
+		if err == nil && resp != nil {
+			entity = resp
+		}
+		for k, v := range s.config.ResponseHeaders.ResponseHeaders(r.Context(), op, entity) {
+			w.Header().Set(k, v)
+		}
+	}
+
 	if err != nil {
 		if s.config.ErrorHandler != nil {
 			s.config.ErrorHandler(w, r, op, err)
@@ -489,15 +1224,15 @@ func handleResponse[Resp any](s *Server, w http.ResponseWriter, r *http.Request,
 		type pagedResp interface {
 			GetTotalCount() int
 		}
-		if v, ok := any(resp).(pagedResp); ok && v.GetTotalCount() == 0 && r.Method == http.MethodGet {
+		if v, ok := any(resp).(pagedResp); ok && v.GetTotalCount() == 0 && op == OperationList {
 			JSON(w, r, http.StatusNotFound, resp)
 			return
 		}
-		if r.Method == http.MethodPost {
-			JSON(w, r, http.StatusCreated, resp)
-			return
+		status := http.StatusOK
+		if op == OperationCreate {
+			status = http.StatusCreated
 		}
-		JSON(w, r, http.StatusOK, resp)
+		JSON(w, r, status, resp)
 		return
 	}
 	w.WriteHeader(http.StatusNoContent)
@@ -525,7 +1260,10 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("PATCH /categories/{id}", ReqIDParam(s, OperationUpdate, s.UpdateCategory))
 	mux.HandleFunc("DELETE /categories/{id}", ReqID(s, OperationDelete, s.DeleteCategory))
 	mux.HandleFunc("GET /follows", ReqParam(s, OperationList, s.ListFollows))
+	mux.HandleFunc("GET /follows/{user_id}/{pet_id}", ReqCompositeID(s, OperationRead, parseFollowID, s.GetFollow))
 	mux.HandleFunc("POST /follows", ReqParam(s, OperationCreate, s.CreateFollow))
+	mux.HandleFunc("PATCH /follows/{user_id}/{pet_id}", ReqCompositeIDParam(s, OperationUpdate, parseFollowID, s.UpdateFollow))
+	mux.HandleFunc("DELETE /follows/{user_id}/{pet_id}", ReqCompositeID(s, OperationDelete, parseFollowID, s.DeleteFollow))
 	mux.HandleFunc("GET /friendships", ReqParam(s, OperationList, s.ListFriendships))
 	mux.HandleFunc("GET /friendships/{id}", ReqID(s, OperationRead, s.GetFriendship))
 	mux.HandleFunc("GET /friendships/{id}/user", ReqID(s, OperationRead, s.GetFriendshipUser))
@@ -539,6 +1277,8 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("GET /pets/{id}/owner", ReqID(s, OperationRead, s.GetPetOwner))
 	mux.HandleFunc("GET /pets/{id}/friends", ReqIDParam(s, OperationList, s.ListPetFriends))
 	mux.HandleFunc("GET /pets/{id}/followed-by", ReqIDParam(s, OperationList, s.ListPetFollowedBys))
+	mux.HandleFunc("GET /pets/{id}/following", ReqIDParam(s, OperationList, s.ListPetFollowings))
+	mux.HandleFunc("POST /pets/{id}/following", ReqIDParam(s, OperationCreate, s.CreatePetFollowing))
 	mux.HandleFunc("POST /pets", ReqParam(s, OperationCreate, s.CreatePet))
 	mux.HandleFunc("PATCH /pets/{id}", ReqIDParam(s, OperationUpdate, s.UpdatePet))
 	mux.HandleFunc("DELETE /pets/{id}", ReqID(s, OperationDelete, s.DeletePet))
@@ -551,13 +1291,17 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("GET /users/{id}/pets", ReqIDParam(s, OperationList, s.ListUserPets))
 	mux.HandleFunc("GET /users/{id}/followed-pets", ReqIDParam(s, OperationList, s.ListUserFollowedPets))
 	mux.HandleFunc("GET /users/{id}/friends", ReqIDParam(s, OperationList, s.ListUserFriends))
+	mux.HandleFunc("GET /users/{id}/following", ReqIDParam(s, OperationList, s.ListUserFollowings))
+	mux.HandleFunc("POST /users/{id}/following", ReqIDParam(s, OperationCreate, s.CreateUserFollowing))
 	mux.HandleFunc("GET /users/{id}/friendships", ReqIDParam(s, OperationList, s.ListUserFriendships))
+	mux.HandleFunc("POST /users/{id}/friendships", ReqIDParam(s, OperationCreate, s.CreateUserFriendship))
 	mux.HandleFunc("POST /users", ReqParam(s, OperationCreate, s.CreateUser))
 	mux.HandleFunc("PATCH /users/{id}", ReqIDParam(s, OperationUpdate, s.UpdateUser))
 	mux.HandleFunc("DELETE /users/{id}", ReqID(s, OperationDelete, s.DeleteUser))
 
 	if !s.config.DisableSpecHandler {
 		mux.HandleFunc("GET /openapi.json", s.Spec)
+		mux.HandleFunc("OPTIONS /openapi.json", s.Spec)
 	}
 
 	if !s.config.DisableSpecHandler && !s.config.DisableDocsHandler {
@@ -570,190 +1314,276 @@ func (s *Server) Handler() http.Handler {
 	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
 		handleResponse[struct{}](s, w, r, "", nil, ErrEndpointNotFound)
 	})
-	return http.StripPrefix(s.config.BasePath, UseEntContext(s.db)(mux))
+	var handler http.Handler = mux
+	return http.StripPrefix(s.config.BasePath, UseEntContext(s.db)(handler))
 }
 
 // ListCategories maps to "GET /categories".
 func (s *Server) ListCategories(r *http.Request, p *ListCategoryParams) (*PagedResponse[ent.Category], error) {
-	return p.Exec(r.Context(), s.db.Category.Query())
+	return p.Exec(r.Context(), s.client(r.Context()).Category.Query())
 }
 
 // GetCategory maps to "GET /categories/{id}".
 func (s *Server) GetCategory(r *http.Request, categoryID int) (*ent.Category, error) {
-	return EagerLoadCategory(s.db.Category.Query().Where(category.ID(categoryID))).Only(r.Context())
+	return EagerLoadCategory(s.client(r.Context()).Category.Query().Where(category.ID(categoryID))).Only(r.Context())
 }
 
 // ListCategoryPets maps to "GET /categories/{id}/pets".
 func (s *Server) ListCategoryPets(r *http.Request, categoryID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
-	return p.Exec(r.Context(), s.db.Category.Query().Where(category.ID(categoryID)).QueryPets())
+	return p.Exec(r.Context(), s.client(r.Context()).Category.Query().Where(category.ID(categoryID)).QueryPets())
 }
 
 // CreateCategory maps to "POST /categories".
 func (s *Server) CreateCategory(r *http.Request, p *CreateCategoryParams) (*ent.Category, error) {
-	return p.Exec(r.Context(), s.db.Category.Create(), s.db.Category.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Category, error) {
+		return p.Exec(r.Context(), tx.Category.Create(), tx.Category.Query())
+	})
 }
 
 // UpdateCategory maps to "PATCH /categories/{id}".
 func (s *Server) UpdateCategory(r *http.Request, categoryID int, p *UpdateCategoryParams) (*ent.Category, error) {
-	return p.Exec(r.Context(), s.db.Category.UpdateOneID(categoryID), s.db.Category.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Category, error) {
+		return p.Exec(r.Context(), tx.Category.UpdateOneID(categoryID), tx.Category.Query())
+	})
 }
 
 // DeleteCategory maps to "DELETE /categories/{id}".
 func (s *Server) DeleteCategory(r *http.Request, categoryID int) (*struct{}, error) {
-	return nil, s.db.Category.DeleteOneID(categoryID).Exec(r.Context())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		return nil, tx.Category.DeleteOneID(categoryID).Exec(r.Context())
+	})
 }
 
 // ListFollows maps to "GET /follows".
 func (s *Server) ListFollows(r *http.Request, p *ListFollowParams) (*PagedResponse[ent.Follows], error) {
-	return p.Exec(r.Context(), s.db.Follows.Query())
+	return p.Exec(r.Context(), s.client(r.Context()).Follows.Query())
+}
+
+// GetFollow maps to "GET /follows/{user_id}/{pet_id}".
+func (s *Server) GetFollow(r *http.Request, id FollowID) (*ent.Follows, error) {
+	return EagerLoadFollow(s.client(r.Context()).Follows.Query().Where(id.Predicate())).Only(r.Context())
 }
 
 // CreateFollow maps to "POST /follows".
 func (s *Server) CreateFollow(r *http.Request, p *CreateFollowParams) (*ent.Follows, error) {
-	return p.Exec(r.Context(), s.db.Follows.Create(), s.db.Follows.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Follows, error) {
+		return p.Exec(r.Context(), tx.Follows.Create(), tx.Follows.Query())
+	})
+}
+
+// UpdateFollow maps to "PATCH /follows/{user_id}/{pet_id}".
+func (s *Server) UpdateFollow(r *http.Request, id FollowID, p *UpdateFollowParams) (*ent.Follows, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Follows, error) {
+		entity, err := tx.Follows.Query().Where(id.Predicate()).Only(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		return p.Exec(r.Context(), tx.Follows.UpdateOne(entity), tx.Follows.Query())
+	})
+}
+
+// DeleteFollow maps to "DELETE /follows/{user_id}/{pet_id}".
+func (s *Server) DeleteFollow(r *http.Request, id FollowID) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		// Ensure the entity exists first, so a not found error is returned otherwise.
+		_, err := tx.Follows.Query().Where(id.Predicate()).Only(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Follows.Delete().Where(id.Predicate()).Exec(r.Context())
+		return nil, err
+	})
 }
 
 // ListFriendships maps to "GET /friendships".
 func (s *Server) ListFriendships(r *http.Request, p *ListFriendshipParams) (*PagedResponse[ent.Friendship], error) {
-	return p.Exec(r.Context(), s.db.Friendship.Query())
+	return p.Exec(r.Context(), s.client(r.Context()).Friendship.Query())
 }
 
 // GetFriendship maps to "GET /friendships/{id}".
 func (s *Server) GetFriendship(r *http.Request, friendshipID int) (*ent.Friendship, error) {
-	return EagerLoadFriendship(s.db.Friendship.Query().Where(friendship.ID(friendshipID))).Only(r.Context())
+	return EagerLoadFriendship(s.client(r.Context()).Friendship.Query().Where(friendship.ID(friendshipID))).Only(r.Context())
 }
 
 // GetFriendshipUser maps to "GET /friendships/{id}/user".
 func (s *Server) GetFriendshipUser(r *http.Request, friendshipID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.Friendship.Query().Where(friendship.ID(friendshipID)).QueryUser()).Only(r.Context())
+	return EagerLoadUser(s.client(r.Context()).Friendship.Query().Where(friendship.ID(friendshipID)).QueryUser()).Only(r.Context())
 }
 
 // GetFriendshipFriend maps to "GET /friendships/{id}/friend".
 func (s *Server) GetFriendshipFriend(r *http.Request, friendshipID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.Friendship.Query().Where(friendship.ID(friendshipID)).QueryFriend()).Only(r.Context())
+	return EagerLoadUser(s.client(r.Context()).Friendship.Query().Where(friendship.ID(friendshipID)).QueryFriend()).Only(r.Context())
 }
 
 // CreateFriendship maps to "POST /friendships".
 func (s *Server) CreateFriendship(r *http.Request, p *CreateFriendshipParams) (*ent.Friendship, error) {
-	return p.Exec(r.Context(), s.db.Friendship.Create(), s.db.Friendship.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Friendship, error) {
+		return p.Exec(r.Context(), tx.Friendship.Create(), tx.Friendship.Query())
+	})
 }
 
 // UpdateFriendship maps to "PATCH /friendships/{id}".
 func (s *Server) UpdateFriendship(r *http.Request, friendshipID int, p *UpdateFriendshipParams) (*ent.Friendship, error) {
-	return p.Exec(r.Context(), s.db.Friendship.UpdateOneID(friendshipID), s.db.Friendship.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Friendship, error) {
+		return p.Exec(r.Context(), tx.Friendship.UpdateOneID(friendshipID), tx.Friendship.Query())
+	})
 }
 
 // DeleteFriendship maps to "DELETE /friendships/{id}".
 func (s *Server) DeleteFriendship(r *http.Request, friendshipID int) (*struct{}, error) {
-	return nil, s.db.Friendship.DeleteOneID(friendshipID).Exec(r.Context())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		return nil, tx.Friendship.DeleteOneID(friendshipID).Exec(r.Context())
+	})
 }
 
 // ListPets maps to "GET /pets".
 func (s *Server) ListPets(r *http.Request, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
-	return p.Exec(r.Context(), s.db.Pet.Query())
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query())
 }
 
 // GetPet maps to "GET /pets/{id}".
 func (s *Server) GetPet(r *http.Request, petID int) (*ent.Pet, error) {
-	return EagerLoadPet(s.db.Pet.Query().Where(pet.ID(petID))).Only(r.Context())
+	return EagerLoadPet(s.client(r.Context()).Pet.Query().Where(pet.ID(petID))).Only(r.Context())
 }
 
 // ListPetCategories maps to "GET /pets/{id}/categories".
 func (s *Server) ListPetCategories(r *http.Request, petID int, p *ListCategoryParams) (*PagedResponse[ent.Category], error) {
-	return p.Exec(r.Context(), s.db.Pet.Query().Where(pet.ID(petID)).QueryCategories())
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryCategories())
 }
 
 // GetPetOwner maps to "GET /pets/{id}/owner".
 func (s *Server) GetPetOwner(r *http.Request, petID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.Pet.Query().Where(pet.ID(petID)).QueryOwner()).Only(r.Context())
+	return EagerLoadUser(s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryOwner()).Only(r.Context())
 }
 
 // ListPetFriends maps to "GET /pets/{id}/friends".
 func (s *Server) ListPetFriends(r *http.Request, petID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
-	return p.Exec(r.Context(), s.db.Pet.Query().Where(pet.ID(petID)).QueryFriends())
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryFriends())
 }
 
 // ListPetFollowedBys maps to "GET /pets/{id}/followed-by".
 func (s *Server) ListPetFollowedBys(r *http.Request, petID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
-	return p.Exec(r.Context(), s.db.Pet.Query().Where(pet.ID(petID)).QueryFollowedBy())
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryFollowedBy())
+}
+
+// ListPetFollowings maps to "GET /pets/{id}/following".
+func (s *Server) ListPetFollowings(r *http.Request, petID int, p *ListFollowParams) (*PagedResponse[ent.Follows], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryFollowing())
+}
+
+// CreatePetFollowing maps to "POST /pets/{id}/following".
+func (s *Server) CreatePetFollowing(r *http.Request, petID int, p *CreateFollowParams) (*ent.Follows, error) {
+	p.PetID = petID
+	return p.Exec(r.Context(), s.client(r.Context()).Follows.Create(), s.client(r.Context()).Follows.Query())
 }
 
 // CreatePet maps to "POST /pets".
 func (s *Server) CreatePet(r *http.Request, p *CreatePetParams) (*ent.Pet, error) {
-	return p.Exec(r.Context(), s.db.Pet.Create(), s.db.Pet.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Pet, error) {
+		return p.Exec(r.Context(), tx.Pet.Create(), tx.Pet.Query())
+	})
 }
 
 // UpdatePet maps to "PATCH /pets/{id}".
 func (s *Server) UpdatePet(r *http.Request, petID int, p *UpdatePetParams) (*ent.Pet, error) {
-	return p.Exec(r.Context(), s.db.Pet.UpdateOneID(petID), s.db.Pet.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Pet, error) {
+		return p.Exec(r.Context(), tx.Pet.UpdateOneID(petID), tx.Pet.Query())
+	})
 }
 
 // DeletePet maps to "DELETE /pets/{id}".
 func (s *Server) DeletePet(r *http.Request, petID int) (*struct{}, error) {
-	return nil, s.db.Pet.DeleteOneID(petID).Exec(r.Context())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		return nil, tx.Pet.DeleteOneID(petID).Exec(r.Context())
+	})
 }
 
 // ListSettings maps to "GET /settings".
 func (s *Server) ListSettings(r *http.Request, p *ListSettingParams) (*PagedResponse[ent.Settings], error) {
-	return p.Exec(r.Context(), s.db.Settings.Query())
+	return p.Exec(r.Context(), s.client(r.Context()).Settings.Query())
 }
 
 // GetSetting maps to "GET /settings/{id}".
 func (s *Server) GetSetting(r *http.Request, settingID int) (*ent.Settings, error) {
-	return EagerLoadSetting(s.db.Settings.Query().Where(settings.ID(settingID))).Only(r.Context())
+	return EagerLoadSetting(s.client(r.Context()).Settings.Query().Where(settings.ID(settingID))).Only(r.Context())
 }
 
 // ListSettingAdmins maps to "GET /settings/{id}/admins".
 func (s *Server) ListSettingAdmins(r *http.Request, settingID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
-	return p.Exec(r.Context(), s.db.Settings.Query().Where(settings.ID(settingID)).QueryAdmins())
+	return p.Exec(r.Context(), s.client(r.Context()).Settings.Query().Where(settings.ID(settingID)).QueryAdmins())
 }
 
 // UpdateSetting maps to "PATCH /settings/{id}".
 func (s *Server) UpdateSetting(r *http.Request, settingID int, p *UpdateSettingParams) (*ent.Settings, error) {
-	return p.Exec(r.Context(), s.db.Settings.UpdateOneID(settingID), s.db.Settings.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Settings, error) {
+		return p.Exec(r.Context(), tx.Settings.UpdateOneID(settingID), tx.Settings.Query())
+	})
 }
 
 // ListUsers maps to "GET /users".
 func (s *Server) ListUsers(r *http.Request, p *ListUserParams) (*PagedResponse[ent.User], error) {
-	return p.Exec(r.Context(), s.db.User.Query())
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query())
 }
 
 // GetUser maps to "GET /users/{id}".
 func (s *Server) GetUser(r *http.Request, userID int) (*ent.User, error) {
-	return EagerLoadUser(s.db.User.Query().Where(user.ID(userID))).Only(r.Context())
+	return EagerLoadUser(s.client(r.Context()).User.Query().Where(user.ID(userID))).Only(r.Context())
 }
 
 // ListUserPets maps to "GET /users/{id}/pets".
 func (s *Server) ListUserPets(r *http.Request, userID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
-	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryPets())
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryPets())
 }
 
 // ListUserFollowedPets maps to "GET /users/{id}/followed-pets".
 func (s *Server) ListUserFollowedPets(r *http.Request, userID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
-	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryFollowedPets())
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFollowedPets())
 }
 
 // ListUserFriends maps to "GET /users/{id}/friends".
 func (s *Server) ListUserFriends(r *http.Request, userID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
-	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryFriends())
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFriends())
+}
+
+// ListUserFollowings maps to "GET /users/{id}/following".
+func (s *Server) ListUserFollowings(r *http.Request, userID int, p *ListFollowParams) (*PagedResponse[ent.Follows], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFollowing())
+}
+
+// CreateUserFollowing maps to "POST /users/{id}/following".
+func (s *Server) CreateUserFollowing(r *http.Request, userID int, p *CreateFollowParams) (*ent.Follows, error) {
+	p.UserID = userID
+	return p.Exec(r.Context(), s.client(r.Context()).Follows.Create(), s.client(r.Context()).Follows.Query())
 }
 
 // ListUserFriendships maps to "GET /users/{id}/friendships".
 func (s *Server) ListUserFriendships(r *http.Request, userID int, p *ListFriendshipParams) (*PagedResponse[ent.Friendship], error) {
-	return p.Exec(r.Context(), s.db.User.Query().Where(user.ID(userID)).QueryFriendships())
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFriendships())
+}
+
+// CreateUserFriendship maps to "POST /users/{id}/friendships".
+func (s *Server) CreateUserFriendship(r *http.Request, userID int, p *CreateFriendshipParams) (*ent.Friendship, error) {
+	p.UserID = userID
+	return p.Exec(r.Context(), s.client(r.Context()).Friendship.Create(), s.client(r.Context()).Friendship.Query())
 }
 
 // CreateUser maps to "POST /users".
 func (s *Server) CreateUser(r *http.Request, p *CreateUserParams) (*ent.User, error) {
-	return p.Exec(r.Context(), s.db.User.Create(), s.db.User.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.User, error) {
+		return p.Exec(r.Context(), tx.User.Create(), tx.User.Query())
+	})
 }
 
 // UpdateUser maps to "PATCH /users/{id}".
 func (s *Server) UpdateUser(r *http.Request, userID int, p *UpdateUserParams) (*ent.User, error) {
-	return p.Exec(r.Context(), s.db.User.UpdateOneID(userID), s.db.User.Query())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.User, error) {
+		return p.Exec(r.Context(), tx.User.UpdateOneID(userID), tx.User.Query())
+	})
 }
 
 // DeleteUser maps to "DELETE /users/{id}".
 func (s *Server) DeleteUser(r *http.Request, userID int) (*struct{}, error) {
-	return nil, s.db.User.DeleteOneID(userID).Exec(r.Context())
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		return nil, tx.User.DeleteOneID(userID).Exec(r.Context())
+	})
 }
@@ -69,6 +69,18 @@ type SortConfig struct {
 	Fields       []string
 	DefaultField string
 	DefaultOrder orderDirection
+
+	// Columns maps sort fields to their internal field names, for those which differ
+	// (e.g. "createdAt" to "created_at").
+	Columns map[string]string
+}
+
+// column returns the internal field name of the provided sort field.
+func (c *SortConfig) column(field string) string {
+	if v, ok := c.Columns[field]; ok {
+		return v
+	}
+	return field
 }
 
 type orderDirection string
@@ -185,6 +197,14 @@ var (
 // applySortingCategory applies sorting to the query based on the provided sort and
 // order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
 func applySortingCategory(query *ent.CategoryQuery, field string, order orderDirection) *ent.CategoryQuery {
+	switch field {
+	case "random", category.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakCategory(query, order)
+	}
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -216,9 +236,25 @@ func applySortingCategory(query *ent.CategoryQuery, field string, order orderDir
 	return query.Order(withFieldSelector(field, order))
 }
 
+// applySortingTiebreakCategory orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakCategory(query *ent.CategoryQuery, order orderDirection) *ent.CategoryQuery {
+	return query.Order(
+		withFieldSelector(category.FieldID, order),
+	)
+}
+
 // applySortingFollow applies sorting to the query based on the provided sort and
 // order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
 func applySortingFollow(query *ent.FollowsQuery, field string, order orderDirection) *ent.FollowsQuery {
+	switch field {
+	case "random":
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakFollow(query, order)
+	}
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -235,9 +271,26 @@ func applySortingFollow(query *ent.FollowsQuery, field string, order orderDirect
 	return query.Order(withFieldSelector(field, order))
 }
 
+// applySortingTiebreakFollow orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakFollow(query *ent.FollowsQuery, order orderDirection) *ent.FollowsQuery {
+	return query.Order(
+		withFieldSelector(follows.FieldUserID, order),
+		withFieldSelector(follows.FieldPetID, order),
+	)
+}
+
 // applySortingFriendship applies sorting to the query based on the provided sort and
 // order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
 func applySortingFriendship(query *ent.FriendshipQuery, field string, order orderDirection) *ent.FriendshipQuery {
+	switch field {
+	case "random", friendship.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakFriendship(query, order)
+	}
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -254,9 +307,25 @@ func applySortingFriendship(query *ent.FriendshipQuery, field string, order orde
 	return query.Order(withFieldSelector(field, order))
 }
 
+// applySortingTiebreakFriendship orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakFriendship(query *ent.FriendshipQuery, order orderDirection) *ent.FriendshipQuery {
+	return query.Order(
+		withFieldSelector(friendship.FieldID, order),
+	)
+}
+
 // applySortingPet applies sorting to the query based on the provided sort and
 // order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
 func applySortingPet(query *ent.PetQuery, field string, order orderDirection) *ent.PetQuery {
+	switch field {
+	case "random", pet.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakPet(query, order)
+	}
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -317,9 +386,25 @@ func applySortingPet(query *ent.PetQuery, field string, order orderDirection) *e
 	return query.Order(withFieldSelector(field, order))
 }
 
+// applySortingTiebreakPet orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakPet(query *ent.PetQuery, order orderDirection) *ent.PetQuery {
+	return query.Order(
+		withFieldSelector(pet.FieldID, order),
+	)
+}
+
 // applySortingSetting applies sorting to the query based on the provided sort and
 // order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
 func applySortingSetting(query *ent.SettingsQuery, field string, order orderDirection) *ent.SettingsQuery {
+	switch field {
+	case "random", settings.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakSetting(query, order)
+	}
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -351,9 +436,25 @@ func applySortingSetting(query *ent.SettingsQuery, field string, order orderDire
 	return query.Order(withFieldSelector(field, order))
 }
 
+// applySortingTiebreakSetting orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakSetting(query *ent.SettingsQuery, order orderDirection) *ent.SettingsQuery {
+	return query.Order(
+		withFieldSelector(settings.FieldID, order),
+	)
+}
+
 // applySortingUser applies sorting to the query based on the provided sort and
 // order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
 func applySortingUser(query *ent.UserQuery, field string, order orderDirection) *ent.UserQuery {
+	switch field {
+	case "random", user.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakUser(query, order)
+	}
 	if parts := strings.Split(field, "."); len(parts) > 1 {
 		dir := withOrderTerm(order)
 
@@ -420,3 +521,11 @@ func applySortingUser(query *ent.UserQuery, field string, order orderDirection)
 	}
 	return query.Order(withFieldSelector(field, order))
 }
+
+// applySortingTiebreakUser orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakUser(query *ent.UserQuery, order orderDirection) *ent.UserQuery {
+	return query.Order(
+		withFieldSelector(user.FieldID, order),
+	)
+}
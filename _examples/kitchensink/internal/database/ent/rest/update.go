@@ -9,6 +9,7 @@ import (
 	github "github.com/google/go-github/v63/github"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/follows"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/friendship"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/pet"
 	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/ent/settings"
@@ -62,6 +63,38 @@ func (c *UpdateCategoryParams) Exec(ctx context.Context, builder *ent.CategoryUp
 	return EagerLoadCategory(query.Where(category.ID(result.ID))).Only(ctx)
 }
 
+// UpdateFollowParams defines parameters for updating a Follow via a PATCH request.
+type UpdateFollowParams struct {
+	UserID Option[int] `json:"user_id"`
+	PetID  Option[int] `json:"pet_id"`
+}
+
+func (u *UpdateFollowParams) ApplyInputs(builder *ent.FollowsUpdateOne) *ent.FollowsUpdateOne {
+	if v, ok := u.UserID.Get(); ok {
+		builder.SetUserID(v)
+	}
+	if v, ok := u.PetID.Get(); ok {
+		builder.SetPetID(v)
+	}
+
+	return builder
+}
+
+// Exec wraps all logic (mapping all provided values to the build), updates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges.
+func (c *UpdateFollowParams) Exec(ctx context.Context, builder *ent.FollowsUpdateOne, query *ent.FollowsQuery) (*ent.Follows, error) {
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// Since Follow entities have a composite ID, we have to query by all fields of the ID.
+	return EagerLoadFollow(query.Where(
+		follows.UserIDEQ(result.UserID),
+		follows.PetIDEQ(result.PetID),
+	)).Only(ctx)
+}
+
 // UpdateFriendshipParams defines parameters for updating a Friendship via a PATCH request.
 type UpdateFriendshipParams struct {
 	CreatedAt Option[time.Time] `json:"created_at"`
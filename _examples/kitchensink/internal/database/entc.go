@@ -19,6 +19,7 @@ func main() {
 		SpecFromPath:          "../base-openapi.json", // Using a base spec to start with, not required.
 		Handler:               entrest.HandlerStdlib,
 		WithTesting:           true,
+		WithBenchmarks:        true,
 		StrictMutate:          true,
 		ListNotFound:          true,
 		DefaultFilterID:       true,
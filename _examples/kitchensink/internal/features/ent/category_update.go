@@ -0,0 +1,646 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/predicate"
+)
+
+// CategoryUpdate is the builder for updating Category entities.
+type CategoryUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CategoryMutation
+}
+
+// Where appends a list predicates to the CategoryUpdate builder.
+func (cu *CategoryUpdate) Where(ps ...predicate.Category) *CategoryUpdate {
+	cu.mutation.Where(ps...)
+	return cu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cu *CategoryUpdate) SetUpdatedAt(t time.Time) *CategoryUpdate {
+	cu.mutation.SetUpdatedAt(t)
+	return cu
+}
+
+// SetName sets the "name" field.
+func (cu *CategoryUpdate) SetName(s string) *CategoryUpdate {
+	cu.mutation.SetName(s)
+	return cu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableName(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetName(*s)
+	}
+	return cu
+}
+
+// SetReadonly sets the "readonly" field.
+func (cu *CategoryUpdate) SetReadonly(s string) *CategoryUpdate {
+	cu.mutation.SetReadonly(s)
+	return cu
+}
+
+// SetNillableReadonly sets the "readonly" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableReadonly(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetReadonly(*s)
+	}
+	return cu
+}
+
+// SetSkipInSpec sets the "skip_in_spec" field.
+func (cu *CategoryUpdate) SetSkipInSpec(s string) *CategoryUpdate {
+	cu.mutation.SetSkipInSpec(s)
+	return cu
+}
+
+// SetNillableSkipInSpec sets the "skip_in_spec" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableSkipInSpec(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetSkipInSpec(*s)
+	}
+	return cu
+}
+
+// ClearSkipInSpec clears the value of the "skip_in_spec" field.
+func (cu *CategoryUpdate) ClearSkipInSpec() *CategoryUpdate {
+	cu.mutation.ClearSkipInSpec()
+	return cu
+}
+
+// SetNillable sets the "nillable" field.
+func (cu *CategoryUpdate) SetNillable(s string) *CategoryUpdate {
+	cu.mutation.SetNillable(s)
+	return cu
+}
+
+// SetNillableNillable sets the "nillable" field if the given value is not nil.
+func (cu *CategoryUpdate) SetNillableNillable(s *string) *CategoryUpdate {
+	if s != nil {
+		cu.SetNillable(*s)
+	}
+	return cu
+}
+
+// SetStrings sets the "strings" field.
+func (cu *CategoryUpdate) SetStrings(s []string) *CategoryUpdate {
+	cu.mutation.SetStrings(s)
+	return cu
+}
+
+// AppendStrings appends s to the "strings" field.
+func (cu *CategoryUpdate) AppendStrings(s []string) *CategoryUpdate {
+	cu.mutation.AppendStrings(s)
+	return cu
+}
+
+// ClearStrings clears the value of the "strings" field.
+func (cu *CategoryUpdate) ClearStrings() *CategoryUpdate {
+	cu.mutation.ClearStrings()
+	return cu
+}
+
+// SetInts sets the "ints" field.
+func (cu *CategoryUpdate) SetInts(i []int) *CategoryUpdate {
+	cu.mutation.SetInts(i)
+	return cu
+}
+
+// AppendInts appends i to the "ints" field.
+func (cu *CategoryUpdate) AppendInts(i []int) *CategoryUpdate {
+	cu.mutation.AppendInts(i)
+	return cu
+}
+
+// ClearInts clears the value of the "ints" field.
+func (cu *CategoryUpdate) ClearInts() *CategoryUpdate {
+	cu.mutation.ClearInts()
+	return cu
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by IDs.
+func (cu *CategoryUpdate) AddPetIDs(ids ...int) *CategoryUpdate {
+	cu.mutation.AddPetIDs(ids...)
+	return cu
+}
+
+// AddPets adds the "pets" edges to the Pet entity.
+func (cu *CategoryUpdate) AddPets(p ...*Pet) *CategoryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.AddPetIDs(ids...)
+}
+
+// Mutation returns the CategoryMutation object of the builder.
+func (cu *CategoryUpdate) Mutation() *CategoryMutation {
+	return cu.mutation
+}
+
+// ClearPets clears all "pets" edges to the Pet entity.
+func (cu *CategoryUpdate) ClearPets() *CategoryUpdate {
+	cu.mutation.ClearPets()
+	return cu
+}
+
+// RemovePetIDs removes the "pets" edge to Pet entities by IDs.
+func (cu *CategoryUpdate) RemovePetIDs(ids ...int) *CategoryUpdate {
+	cu.mutation.RemovePetIDs(ids...)
+	return cu
+}
+
+// RemovePets removes "pets" edges to Pet entities.
+func (cu *CategoryUpdate) RemovePets(p ...*Pet) *CategoryUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cu.RemovePetIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (cu *CategoryUpdate) Save(ctx context.Context) (int, error) {
+	cu.defaults()
+	return withHooks(ctx, cu.sqlSave, cu.mutation, cu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cu *CategoryUpdate) SaveX(ctx context.Context) int {
+	affected, err := cu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (cu *CategoryUpdate) Exec(ctx context.Context) error {
+	_, err := cu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cu *CategoryUpdate) ExecX(ctx context.Context) {
+	if err := cu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cu *CategoryUpdate) defaults() {
+	if _, ok := cu.mutation.UpdatedAt(); !ok {
+		v := category.UpdateDefaultUpdatedAt()
+		cu.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cu *CategoryUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(category.Table, category.Columns, sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt))
+	if ps := cu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cu.mutation.UpdatedAt(); ok {
+		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cu.mutation.Name(); ok {
+		_spec.SetField(category.FieldName, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Readonly(); ok {
+		_spec.SetField(category.FieldReadonly, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.SkipInSpec(); ok {
+		_spec.SetField(category.FieldSkipInSpec, field.TypeString, value)
+	}
+	if cu.mutation.SkipInSpecCleared() {
+		_spec.ClearField(category.FieldSkipInSpec, field.TypeString)
+	}
+	if value, ok := cu.mutation.Nillable(); ok {
+		_spec.SetField(category.FieldNillable, field.TypeString, value)
+	}
+	if value, ok := cu.mutation.Strings(); ok {
+		_spec.SetField(category.FieldStrings, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedStrings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, category.FieldStrings, value)
+		})
+	}
+	if cu.mutation.StringsCleared() {
+		_spec.ClearField(category.FieldStrings, field.TypeJSON)
+	}
+	if value, ok := cu.mutation.Ints(); ok {
+		_spec.SetField(category.FieldInts, field.TypeJSON, value)
+	}
+	if value, ok := cu.mutation.AppendedInts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, category.FieldInts, value)
+		})
+	}
+	if cu.mutation.IntsCleared() {
+		_spec.ClearField(category.FieldInts, field.TypeJSON)
+	}
+	if cu.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.PetsTable,
+			Columns: category.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.RemovedPetsIDs(); len(nodes) > 0 && !cu.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.PetsTable,
+			Columns: category.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cu.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.PetsTable,
+			Columns: category.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, cu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{category.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	cu.mutation.done = true
+	return n, nil
+}
+
+// CategoryUpdateOne is the builder for updating a single Category entity.
+type CategoryUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CategoryMutation
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (cuo *CategoryUpdateOne) SetUpdatedAt(t time.Time) *CategoryUpdateOne {
+	cuo.mutation.SetUpdatedAt(t)
+	return cuo
+}
+
+// SetName sets the "name" field.
+func (cuo *CategoryUpdateOne) SetName(s string) *CategoryUpdateOne {
+	cuo.mutation.SetName(s)
+	return cuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableName(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetName(*s)
+	}
+	return cuo
+}
+
+// SetReadonly sets the "readonly" field.
+func (cuo *CategoryUpdateOne) SetReadonly(s string) *CategoryUpdateOne {
+	cuo.mutation.SetReadonly(s)
+	return cuo
+}
+
+// SetNillableReadonly sets the "readonly" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableReadonly(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetReadonly(*s)
+	}
+	return cuo
+}
+
+// SetSkipInSpec sets the "skip_in_spec" field.
+func (cuo *CategoryUpdateOne) SetSkipInSpec(s string) *CategoryUpdateOne {
+	cuo.mutation.SetSkipInSpec(s)
+	return cuo
+}
+
+// SetNillableSkipInSpec sets the "skip_in_spec" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableSkipInSpec(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetSkipInSpec(*s)
+	}
+	return cuo
+}
+
+// ClearSkipInSpec clears the value of the "skip_in_spec" field.
+func (cuo *CategoryUpdateOne) ClearSkipInSpec() *CategoryUpdateOne {
+	cuo.mutation.ClearSkipInSpec()
+	return cuo
+}
+
+// SetNillable sets the "nillable" field.
+func (cuo *CategoryUpdateOne) SetNillable(s string) *CategoryUpdateOne {
+	cuo.mutation.SetNillable(s)
+	return cuo
+}
+
+// SetNillableNillable sets the "nillable" field if the given value is not nil.
+func (cuo *CategoryUpdateOne) SetNillableNillable(s *string) *CategoryUpdateOne {
+	if s != nil {
+		cuo.SetNillable(*s)
+	}
+	return cuo
+}
+
+// SetStrings sets the "strings" field.
+func (cuo *CategoryUpdateOne) SetStrings(s []string) *CategoryUpdateOne {
+	cuo.mutation.SetStrings(s)
+	return cuo
+}
+
+// AppendStrings appends s to the "strings" field.
+func (cuo *CategoryUpdateOne) AppendStrings(s []string) *CategoryUpdateOne {
+	cuo.mutation.AppendStrings(s)
+	return cuo
+}
+
+// ClearStrings clears the value of the "strings" field.
+func (cuo *CategoryUpdateOne) ClearStrings() *CategoryUpdateOne {
+	cuo.mutation.ClearStrings()
+	return cuo
+}
+
+// SetInts sets the "ints" field.
+func (cuo *CategoryUpdateOne) SetInts(i []int) *CategoryUpdateOne {
+	cuo.mutation.SetInts(i)
+	return cuo
+}
+
+// AppendInts appends i to the "ints" field.
+func (cuo *CategoryUpdateOne) AppendInts(i []int) *CategoryUpdateOne {
+	cuo.mutation.AppendInts(i)
+	return cuo
+}
+
+// ClearInts clears the value of the "ints" field.
+func (cuo *CategoryUpdateOne) ClearInts() *CategoryUpdateOne {
+	cuo.mutation.ClearInts()
+	return cuo
+}
+
+// AddPetIDs adds the "pets" edge to the Pet entity by IDs.
+func (cuo *CategoryUpdateOne) AddPetIDs(ids ...int) *CategoryUpdateOne {
+	cuo.mutation.AddPetIDs(ids...)
+	return cuo
+}
+
+// AddPets adds the "pets" edges to the Pet entity.
+func (cuo *CategoryUpdateOne) AddPets(p ...*Pet) *CategoryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.AddPetIDs(ids...)
+}
+
+// Mutation returns the CategoryMutation object of the builder.
+func (cuo *CategoryUpdateOne) Mutation() *CategoryMutation {
+	return cuo.mutation
+}
+
+// ClearPets clears all "pets" edges to the Pet entity.
+func (cuo *CategoryUpdateOne) ClearPets() *CategoryUpdateOne {
+	cuo.mutation.ClearPets()
+	return cuo
+}
+
+// RemovePetIDs removes the "pets" edge to Pet entities by IDs.
+func (cuo *CategoryUpdateOne) RemovePetIDs(ids ...int) *CategoryUpdateOne {
+	cuo.mutation.RemovePetIDs(ids...)
+	return cuo
+}
+
+// RemovePets removes "pets" edges to Pet entities.
+func (cuo *CategoryUpdateOne) RemovePets(p ...*Pet) *CategoryUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return cuo.RemovePetIDs(ids...)
+}
+
+// Where appends a list predicates to the CategoryUpdate builder.
+func (cuo *CategoryUpdateOne) Where(ps ...predicate.Category) *CategoryUpdateOne {
+	cuo.mutation.Where(ps...)
+	return cuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (cuo *CategoryUpdateOne) Select(field string, fields ...string) *CategoryUpdateOne {
+	cuo.fields = append([]string{field}, fields...)
+	return cuo
+}
+
+// Save executes the query and returns the updated Category entity.
+func (cuo *CategoryUpdateOne) Save(ctx context.Context) (*Category, error) {
+	cuo.defaults()
+	return withHooks(ctx, cuo.sqlSave, cuo.mutation, cuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (cuo *CategoryUpdateOne) SaveX(ctx context.Context) *Category {
+	node, err := cuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (cuo *CategoryUpdateOne) Exec(ctx context.Context) error {
+	_, err := cuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (cuo *CategoryUpdateOne) ExecX(ctx context.Context) {
+	if err := cuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (cuo *CategoryUpdateOne) defaults() {
+	if _, ok := cuo.mutation.UpdatedAt(); !ok {
+		v := category.UpdateDefaultUpdatedAt()
+		cuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (cuo *CategoryUpdateOne) sqlSave(ctx context.Context) (_node *Category, err error) {
+	_spec := sqlgraph.NewUpdateSpec(category.Table, category.Columns, sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt))
+	id, ok := cuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Category.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := cuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, category.FieldID)
+		for _, f := range fields {
+			if !category.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != category.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := cuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := cuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(category.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if value, ok := cuo.mutation.Name(); ok {
+		_spec.SetField(category.FieldName, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Readonly(); ok {
+		_spec.SetField(category.FieldReadonly, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.SkipInSpec(); ok {
+		_spec.SetField(category.FieldSkipInSpec, field.TypeString, value)
+	}
+	if cuo.mutation.SkipInSpecCleared() {
+		_spec.ClearField(category.FieldSkipInSpec, field.TypeString)
+	}
+	if value, ok := cuo.mutation.Nillable(); ok {
+		_spec.SetField(category.FieldNillable, field.TypeString, value)
+	}
+	if value, ok := cuo.mutation.Strings(); ok {
+		_spec.SetField(category.FieldStrings, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedStrings(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, category.FieldStrings, value)
+		})
+	}
+	if cuo.mutation.StringsCleared() {
+		_spec.ClearField(category.FieldStrings, field.TypeJSON)
+	}
+	if value, ok := cuo.mutation.Ints(); ok {
+		_spec.SetField(category.FieldInts, field.TypeJSON, value)
+	}
+	if value, ok := cuo.mutation.AppendedInts(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, category.FieldInts, value)
+		})
+	}
+	if cuo.mutation.IntsCleared() {
+		_spec.ClearField(category.FieldInts, field.TypeJSON)
+	}
+	if cuo.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.PetsTable,
+			Columns: category.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.RemovedPetsIDs(); len(nodes) > 0 && !cuo.mutation.PetsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.PetsTable,
+			Columns: category.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := cuo.mutation.PetsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   category.PetsTable,
+			Columns: category.PetsPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Category{config: cuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, cuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{category.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	cuo.mutation.done = true
+	return _node, nil
+}
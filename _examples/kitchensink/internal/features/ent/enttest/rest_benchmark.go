@@ -0,0 +1,534 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enttest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/go-chi/chi/v5"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/rest"
+)
+
+// benchHandler returns the HTTP handler used by all generated benchmarks.
+func benchHandler(b *testing.B, db *ent.Client) http.Handler {
+	b.Helper()
+
+	srv, err := rest.NewServer(db, &rest.ServerConfig{})
+	if err != nil {
+		b.Fatalf("failed to create server: %v", err)
+		return nil
+	}
+	r := chi.NewRouter()
+	r.Route("/", srv.Handler)
+	return r
+}
+
+// benchRequest executes a single request against the handler, failing the benchmark
+// if the response status code doesn't match the expected status code.
+func benchRequest(b *testing.B, handler http.Handler, method, path string, body []byte, status int) *httptest.ResponseRecorder {
+	b.Helper()
+
+	var r io.Reader = http.NoBody
+	if body != nil {
+		r = bytes.NewReader(body)
+	}
+
+	req := httptest.NewRequest(method, path, r)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != status {
+		b.Fatalf("unexpected status code %d for %s %s (expected %d): %s", rec.Code, method, path, status, rec.Body.String())
+	}
+	return rec
+}
+
+// benchSeq is used to make unique fields unique for each seeded entity.
+var benchSeq atomic.Int64
+
+// benchUnique returns a unique value for a unique field, based on its example value.
+func benchUnique(v any, numeric bool) any {
+	n := benchSeq.Add(1)
+	if numeric {
+		return n
+	}
+	return fmt.Sprintf("%d%v", n, v)
+}
+
+// benchCreate creates a single entity through the create endpoint (with the provided
+// method), using the provided payload, returning its ID (from the response body, or
+// the "Location" header if the response has no body).
+func benchCreate(b *testing.B, handler http.Handler, method, path string, status int, payload map[string]any) string {
+	b.Helper()
+
+	rec := benchRequest(b, handler, method, path, benchMarshal(b, payload), status)
+
+	if loc := rec.Header().Get("Location"); loc != "" && rec.Body.Len() == 0 {
+		return loc[strings.LastIndex(loc, "/")+1:]
+	}
+
+	var v map[string]any
+	dec := json.NewDecoder(rec.Body)
+	dec.UseNumber()
+	if err := dec.Decode(&v); err != nil {
+		b.Fatalf("failed to decode create response: %v", err)
+	}
+	return fmt.Sprint(v["id"])
+}
+
+// benchMarshal marshals the provided payload, failing the benchmark on error.
+func benchMarshal(b *testing.B, payload map[string]any) []byte {
+	b.Helper()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		b.Fatalf("failed to marshal payload: %v", err)
+	}
+	return body
+}
+
+// No create, read, update or delete benchmarks are generated for Category, as
+// entities can't be seeded: required field "readonly" can't be provided when creating.
+
+// BenchmarkListCategories benchmarks "GET /categories". Invoke it from a _test.go file, for example:
+//
+//	func BenchmarkListCategories(b *testing.B) {
+//		enttest.BenchmarkListCategories(b, enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func BenchmarkListCategories(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", "/categories", nil, 200)
+	}
+}
+
+// No create, read, update or delete benchmarks are generated for Follow, as
+// entities can't be seeded: schema doesn't have a single ID field.
+
+// BenchmarkListFollows benchmarks "GET /follows". Invoke it from a _test.go file, for example:
+//
+//	func BenchmarkListFollows(b *testing.B) {
+//		enttest.BenchmarkListFollows(b, enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func BenchmarkListFollows(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", "/follows", nil, 200)
+	}
+}
+
+// benchPayloadFriendship returns a payload for creating a Friendship entity, based on
+// [rest.ExampleFriendshipCreate]. Required edges are seeded first, optional edges are
+// omitted, and unique fields are unique for each payload.
+func benchPayloadFriendship(b *testing.B, handler http.Handler) map[string]any {
+	b.Helper()
+
+	payload := rest.ExampleFriendshipCreate()
+	payload["userID"] = json.Number(benchSeedUser(b, handler))
+	payload["friendID"] = json.Number(benchSeedUser(b, handler))
+	return payload
+}
+
+// benchSeedFriendship creates a Friendship entity through the create endpoint, using the
+// payload from benchPayloadFriendship, returning its ID.
+func benchSeedFriendship(b *testing.B, handler http.Handler) string {
+	b.Helper()
+	return benchCreate(b, handler, "POST", "/friendships", 201, benchPayloadFriendship(b, handler))
+}
+
+// BenchmarkListFriendships benchmarks "GET /friendships", after
+// seeding a single Friendship entity. Invoke it from a _test.go file, for example:
+//
+//	func BenchmarkListFriendships(b *testing.B) {
+//		enttest.BenchmarkListFriendships(b, enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func BenchmarkListFriendships(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	benchSeedFriendship(b, handler)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", "/friendships", nil, 200)
+	}
+}
+
+// BenchmarkCreateFriendship benchmarks "POST /friendships", using payloads
+// from [rest.ExampleFriendshipCreate]. Seeding of required edges (if any) is excluded
+// from the timings.
+func BenchmarkCreateFriendship(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		b.StopTimer()
+		body := benchMarshal(b, benchPayloadFriendship(b, handler))
+		b.StartTimer()
+
+		benchRequest(b, handler, "POST", "/friendships", body, 201)
+	}
+}
+
+// BenchmarkGetFriendship benchmarks "GET /friendships/{id}", against a
+// seeded Friendship entity.
+func BenchmarkGetFriendship(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	path := "/friendships" + "/" + benchSeedFriendship(b, handler)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", path, nil, 200)
+	}
+}
+
+// BenchmarkUpdateFriendship benchmarks "PATCH /friendships/{id}", against a
+// seeded Friendship entity, using the example payload from [rest.ExampleFriendshipUpdate]
+// (without edges).
+func BenchmarkUpdateFriendship(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	path := "/friendships" + "/" + benchSeedFriendship(b, handler)
+
+	payload := rest.ExampleFriendshipUpdate()
+	delete(payload, "userID")
+	delete(payload, "addUser")
+	delete(payload, "removeUser")
+	delete(payload, "friendID")
+	delete(payload, "addFriend")
+	delete(payload, "removeFriend")
+	body := benchMarshal(b, payload)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "PATCH", path, body, 200)
+	}
+}
+
+// BenchmarkDeleteFriendship benchmarks "DELETE /friendships/{id}". Seeding of
+// each deleted Friendship entity is excluded from the timings.
+func BenchmarkDeleteFriendship(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		b.StopTimer()
+		path := "/friendships" + "/" + benchSeedFriendship(b, handler)
+		b.StartTimer()
+
+		benchRequest(b, handler, "DELETE", path, nil, 204)
+	}
+}
+
+// benchPayloadPet returns a payload for creating a Pet entity, based on
+// [rest.ExamplePetCreate]. Required edges are seeded first, optional edges are
+// omitted, and unique fields are unique for each payload.
+func benchPayloadPet(b *testing.B, handler http.Handler) map[string]any {
+	b.Helper()
+
+	payload := rest.ExamplePetCreate()
+	delete(payload, "categories")
+	delete(payload, "owner")
+	delete(payload, "friends")
+	delete(payload, "followedBy")
+	return payload
+}
+
+// benchSeedPet creates a Pet entity through the create endpoint, using the
+// payload from benchPayloadPet, returning its ID.
+func benchSeedPet(b *testing.B, handler http.Handler) string {
+	b.Helper()
+	return benchCreate(b, handler, "POST", "/pets", 201, benchPayloadPet(b, handler))
+}
+
+// BenchmarkListPets benchmarks "GET /pets", after
+// seeding a single Pet entity. Invoke it from a _test.go file, for example:
+//
+//	func BenchmarkListPets(b *testing.B) {
+//		enttest.BenchmarkListPets(b, enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func BenchmarkListPets(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	benchSeedPet(b, handler)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", "/pets", nil, 200)
+	}
+}
+
+// BenchmarkCreatePet benchmarks "POST /pets", using payloads
+// from [rest.ExamplePetCreate]. Seeding of required edges (if any) is excluded
+// from the timings.
+func BenchmarkCreatePet(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		b.StopTimer()
+		body := benchMarshal(b, benchPayloadPet(b, handler))
+		b.StartTimer()
+
+		benchRequest(b, handler, "POST", "/pets", body, 201)
+	}
+}
+
+// BenchmarkGetPet benchmarks "GET /pets/{id}", against a
+// seeded Pet entity.
+func BenchmarkGetPet(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	path := "/pets" + "/" + benchSeedPet(b, handler)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", path, nil, 200)
+	}
+}
+
+// BenchmarkUpdatePet benchmarks "PATCH /pets/{id}", against a
+// seeded Pet entity, using the example payload from [rest.ExamplePetUpdate]
+// (without edges).
+func BenchmarkUpdatePet(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	path := "/pets" + "/" + benchSeedPet(b, handler)
+
+	payload := rest.ExamplePetUpdate()
+	delete(payload, "categories")
+	delete(payload, "addCategories")
+	delete(payload, "removeCategories")
+	delete(payload, "owner")
+	delete(payload, "addOwner")
+	delete(payload, "removeOwner")
+	delete(payload, "friends")
+	delete(payload, "addFriends")
+	delete(payload, "removeFriends")
+	delete(payload, "followedBy")
+	delete(payload, "addFollowedBy")
+	delete(payload, "removeFollowedBy")
+	body := benchMarshal(b, payload)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "PATCH", path, body, 200)
+	}
+}
+
+// BenchmarkDeletePet benchmarks "DELETE /pets/{id}". Seeding of
+// each deleted Pet entity is excluded from the timings.
+func BenchmarkDeletePet(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		b.StopTimer()
+		path := "/pets" + "/" + benchSeedPet(b, handler)
+		b.StartTimer()
+
+		benchRequest(b, handler, "DELETE", path, nil, 204)
+	}
+}
+
+// BenchmarkListSettings benchmarks "GET /settings". Invoke it from a _test.go file, for example:
+//
+//	func BenchmarkListSettings(b *testing.B) {
+//		enttest.BenchmarkListSettings(b, enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func BenchmarkListSettings(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", "/settings", nil, 200)
+	}
+}
+
+// benchPayloadUser returns a payload for creating a User entity, based on
+// [rest.ExampleUserCreate]. Required edges are seeded first, optional edges are
+// omitted, and unique fields are unique for each payload.
+func benchPayloadUser(b *testing.B, handler http.Handler) map[string]any {
+	b.Helper()
+
+	payload := rest.ExampleUserCreate()
+	delete(payload, "pets")
+	delete(payload, "followedPets")
+	delete(payload, "friends")
+	delete(payload, "friendships")
+	return payload
+}
+
+// benchSeedUser creates a User entity through the create endpoint, using the
+// payload from benchPayloadUser, returning its ID.
+func benchSeedUser(b *testing.B, handler http.Handler) string {
+	b.Helper()
+	return benchCreate(b, handler, "POST", "/users", 201, benchPayloadUser(b, handler))
+}
+
+// BenchmarkListUsers benchmarks "GET /users", after
+// seeding a single User entity. Invoke it from a _test.go file, for example:
+//
+//	func BenchmarkListUsers(b *testing.B) {
+//		enttest.BenchmarkListUsers(b, enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//	}
+func BenchmarkListUsers(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	benchSeedUser(b, handler)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", "/users", nil, 200)
+	}
+}
+
+// BenchmarkCreateUser benchmarks "POST /users", using payloads
+// from [rest.ExampleUserCreate]. Seeding of required edges (if any) is excluded
+// from the timings.
+func BenchmarkCreateUser(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		b.StopTimer()
+		body := benchMarshal(b, benchPayloadUser(b, handler))
+		b.StartTimer()
+
+		benchRequest(b, handler, "POST", "/users", body, 201)
+	}
+}
+
+// BenchmarkGetUser benchmarks "GET /users/{id}", against a
+// seeded User entity.
+func BenchmarkGetUser(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	path := "/users" + "/" + benchSeedUser(b, handler)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "GET", path, nil, 200)
+	}
+}
+
+// BenchmarkUpdateUser benchmarks "PATCH /users/{id}", against a
+// seeded User entity, using the example payload from [rest.ExampleUserUpdate]
+// (without edges).
+func BenchmarkUpdateUser(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+	path := "/users" + "/" + benchSeedUser(b, handler)
+
+	payload := rest.ExampleUserUpdate()
+	delete(payload, "pets")
+	delete(payload, "addPets")
+	delete(payload, "removePets")
+	delete(payload, "followedPets")
+	delete(payload, "addFollowedPets")
+	delete(payload, "removeFollowedPets")
+	delete(payload, "friends")
+	delete(payload, "addFriends")
+	delete(payload, "removeFriends")
+	delete(payload, "friendships")
+	delete(payload, "addFriendships")
+	delete(payload, "removeFriendships")
+	body := benchMarshal(b, payload)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		benchRequest(b, handler, "PATCH", path, body, 200)
+	}
+}
+
+// BenchmarkDeleteUser benchmarks "DELETE /users/{id}". Seeding of
+// each deleted User entity is excluded from the timings.
+func BenchmarkDeleteUser(b *testing.B, db *ent.Client) {
+	handler := benchHandler(b, db)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for range b.N {
+		b.StopTimer()
+		path := "/users" + "/" + benchSeedUser(b, handler)
+		b.StartTimer()
+
+		benchRequest(b, handler, "DELETE", path, nil, 204)
+	}
+}
+
+// Benchmarks returns all generated benchmarks, keyed by name, for example to run all of
+// them as sub-benchmarks from a _test.go file:
+//
+//	func BenchmarkREST(b *testing.B) {
+//		for name, fn := range enttest.Benchmarks() {
+//			b.Run(name, func(b *testing.B) {
+//				fn(b, enttest.Open(b, "sqlite3", "file:ent?mode=memory&_fk=1"))
+//			})
+//		}
+//	}
+func Benchmarks() map[string]func(b *testing.B, db *ent.Client) {
+	return map[string]func(b *testing.B, db *ent.Client){
+		"BenchmarkListCategories":   BenchmarkListCategories,
+		"BenchmarkListFollows":      BenchmarkListFollows,
+		"BenchmarkListFriendships":  BenchmarkListFriendships,
+		"BenchmarkCreateFriendship": BenchmarkCreateFriendship,
+		"BenchmarkGetFriendship":    BenchmarkGetFriendship,
+		"BenchmarkUpdateFriendship": BenchmarkUpdateFriendship,
+		"BenchmarkDeleteFriendship": BenchmarkDeleteFriendship,
+		"BenchmarkListPets":         BenchmarkListPets,
+		"BenchmarkCreatePet":        BenchmarkCreatePet,
+		"BenchmarkGetPet":           BenchmarkGetPet,
+		"BenchmarkUpdatePet":        BenchmarkUpdatePet,
+		"BenchmarkDeletePet":        BenchmarkDeletePet,
+		"BenchmarkListSettings":     BenchmarkListSettings,
+		"BenchmarkListUsers":        BenchmarkListUsers,
+		"BenchmarkCreateUser":       BenchmarkCreateUser,
+		"BenchmarkGetUser":          BenchmarkGetUser,
+		"BenchmarkUpdateUser":       BenchmarkUpdateUser,
+		"BenchmarkDeleteUser":       BenchmarkDeleteUser,
+	}
+}
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package friendship
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldUserID, v))
+}
+
+// FriendID applies equality check predicate on the "friend_id" field. It's identical to FriendIDEQ.
+func FriendID(v int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldFriendID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Friendship {
+	return predicate.Friendship(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// FriendIDEQ applies the EQ predicate on the "friend_id" field.
+func FriendIDEQ(v int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldEQ(FieldFriendID, v))
+}
+
+// FriendIDNEQ applies the NEQ predicate on the "friend_id" field.
+func FriendIDNEQ(v int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNEQ(FieldFriendID, v))
+}
+
+// FriendIDIn applies the In predicate on the "friend_id" field.
+func FriendIDIn(vs ...int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldIn(FieldFriendID, vs...))
+}
+
+// FriendIDNotIn applies the NotIn predicate on the "friend_id" field.
+func FriendIDNotIn(vs ...int) predicate.Friendship {
+	return predicate.Friendship(sql.FieldNotIn(FieldFriendID, vs...))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Friendship {
+	return predicate.Friendship(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Friendship {
+	return predicate.Friendship(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFriend applies the HasEdge predicate on the "friend" edge.
+func HasFriend() predicate.Friendship {
+	return predicate.Friendship(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, false, FriendTable, FriendColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFriendWith applies the HasEdge predicate on the "friend" edge with a given conditions (other predicates).
+func HasFriendWith(preds ...predicate.User) predicate.Friendship {
+	return predicate.Friendship(func(s *sql.Selector) {
+		step := newFriendStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Friendship) predicate.Friendship {
+	return predicate.Friendship(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Friendship) predicate.Friendship {
+	return predicate.Friendship(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Friendship) predicate.Friendship {
+	return predicate.Friendship(sql.NotPredicates(p))
+}
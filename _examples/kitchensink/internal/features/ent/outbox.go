@@ -0,0 +1,166 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/outbox"
+)
+
+// Outbox is the model entity for the Outbox schema.
+type Outbox struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// The event which occurred, e.g. "pet.created".
+	Event string `json:"event"`
+	// The name of the schema of the entity, e.g. "Pet".
+	Entity string `json:"entity"`
+	// The ID of the entity.
+	EntityID int `json:"entityID"`
+	// The JSON encoded entity, or only its ID for deleted entities.
+	Payload []byte `json:"-"`
+	// When the event occurred.
+	CreatedAt time.Time `json:"createdAt"`
+	// When the entry was processed, or nil if it hasn't been processed yet.
+	ProcessedAt  *time.Time `json:"processedAt"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Outbox) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case outbox.FieldPayload:
+			values[i] = new([]byte)
+		case outbox.FieldID, outbox.FieldEntityID:
+			values[i] = new(sql.NullInt64)
+		case outbox.FieldEvent, outbox.FieldEntity:
+			values[i] = new(sql.NullString)
+		case outbox.FieldCreatedAt, outbox.FieldProcessedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Outbox fields.
+func (o *Outbox) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case outbox.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			o.ID = int(value.Int64)
+		case outbox.FieldEvent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field event", values[i])
+			} else if value.Valid {
+				o.Event = value.String
+			}
+		case outbox.FieldEntity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field entity", values[i])
+			} else if value.Valid {
+				o.Entity = value.String
+			}
+		case outbox.FieldEntityID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field entity_id", values[i])
+			} else if value.Valid {
+				o.EntityID = int(value.Int64)
+			}
+		case outbox.FieldPayload:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field payload", values[i])
+			} else if value != nil {
+				o.Payload = *value
+			}
+		case outbox.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				o.CreatedAt = value.Time
+			}
+		case outbox.FieldProcessedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field processed_at", values[i])
+			} else if value.Valid {
+				o.ProcessedAt = new(time.Time)
+				*o.ProcessedAt = value.Time
+			}
+		default:
+			o.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Outbox.
+// This includes values selected through modifiers, order, etc.
+func (o *Outbox) Value(name string) (ent.Value, error) {
+	return o.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Outbox.
+// Note that you need to call Outbox.Unwrap() before calling this method if this Outbox
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (o *Outbox) Update() *OutboxUpdateOne {
+	return NewOutboxClient(o.config).UpdateOne(o)
+}
+
+// Unwrap unwraps the Outbox entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (o *Outbox) Unwrap() *Outbox {
+	_tx, ok := o.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Outbox is not a transactional entity")
+	}
+	o.config.driver = _tx.drv
+	return o
+}
+
+// String implements the fmt.Stringer.
+func (o *Outbox) String() string {
+	var builder strings.Builder
+	builder.WriteString("Outbox(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", o.ID))
+	builder.WriteString("event=")
+	builder.WriteString(o.Event)
+	builder.WriteString(", ")
+	builder.WriteString("entity=")
+	builder.WriteString(o.Entity)
+	builder.WriteString(", ")
+	builder.WriteString("entity_id=")
+	builder.WriteString(fmt.Sprintf("%v", o.EntityID))
+	builder.WriteString(", ")
+	builder.WriteString("payload=")
+	builder.WriteString(fmt.Sprintf("%v", o.Payload))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(o.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := o.ProcessedAt; v != nil {
+		builder.WriteString("processed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Outboxes is a parsable slice of Outbox.
+type Outboxes []*Outbox
@@ -0,0 +1,254 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/outbox"
+)
+
+// OutboxCreate is the builder for creating a Outbox entity.
+type OutboxCreate struct {
+	config
+	mutation *OutboxMutation
+	hooks    []Hook
+}
+
+// SetEvent sets the "event" field.
+func (oc *OutboxCreate) SetEvent(s string) *OutboxCreate {
+	oc.mutation.SetEvent(s)
+	return oc
+}
+
+// SetEntity sets the "entity" field.
+func (oc *OutboxCreate) SetEntity(s string) *OutboxCreate {
+	oc.mutation.SetEntity(s)
+	return oc
+}
+
+// SetEntityID sets the "entity_id" field.
+func (oc *OutboxCreate) SetEntityID(i int) *OutboxCreate {
+	oc.mutation.SetEntityID(i)
+	return oc
+}
+
+// SetPayload sets the "payload" field.
+func (oc *OutboxCreate) SetPayload(b []byte) *OutboxCreate {
+	oc.mutation.SetPayload(b)
+	return oc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (oc *OutboxCreate) SetCreatedAt(t time.Time) *OutboxCreate {
+	oc.mutation.SetCreatedAt(t)
+	return oc
+}
+
+// SetProcessedAt sets the "processed_at" field.
+func (oc *OutboxCreate) SetProcessedAt(t time.Time) *OutboxCreate {
+	oc.mutation.SetProcessedAt(t)
+	return oc
+}
+
+// SetNillableProcessedAt sets the "processed_at" field if the given value is not nil.
+func (oc *OutboxCreate) SetNillableProcessedAt(t *time.Time) *OutboxCreate {
+	if t != nil {
+		oc.SetProcessedAt(*t)
+	}
+	return oc
+}
+
+// Mutation returns the OutboxMutation object of the builder.
+func (oc *OutboxCreate) Mutation() *OutboxMutation {
+	return oc.mutation
+}
+
+// Save creates the Outbox in the database.
+func (oc *OutboxCreate) Save(ctx context.Context) (*Outbox, error) {
+	return withHooks(ctx, oc.sqlSave, oc.mutation, oc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (oc *OutboxCreate) SaveX(ctx context.Context) *Outbox {
+	v, err := oc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (oc *OutboxCreate) Exec(ctx context.Context) error {
+	_, err := oc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (oc *OutboxCreate) ExecX(ctx context.Context) {
+	if err := oc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (oc *OutboxCreate) check() error {
+	if _, ok := oc.mutation.Event(); !ok {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required field "Outbox.event"`)}
+	}
+	if _, ok := oc.mutation.Entity(); !ok {
+		return &ValidationError{Name: "entity", err: errors.New(`ent: missing required field "Outbox.entity"`)}
+	}
+	if _, ok := oc.mutation.EntityID(); !ok {
+		return &ValidationError{Name: "entity_id", err: errors.New(`ent: missing required field "Outbox.entity_id"`)}
+	}
+	if _, ok := oc.mutation.Payload(); !ok {
+		return &ValidationError{Name: "payload", err: errors.New(`ent: missing required field "Outbox.payload"`)}
+	}
+	if _, ok := oc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Outbox.created_at"`)}
+	}
+	return nil
+}
+
+func (oc *OutboxCreate) sqlSave(ctx context.Context) (*Outbox, error) {
+	if err := oc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := oc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, oc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	oc.mutation.id = &_node.ID
+	oc.mutation.done = true
+	return _node, nil
+}
+
+func (oc *OutboxCreate) createSpec() (*Outbox, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Outbox{config: oc.config}
+		_spec = sqlgraph.NewCreateSpec(outbox.Table, sqlgraph.NewFieldSpec(outbox.FieldID, field.TypeInt))
+	)
+	if value, ok := oc.mutation.Event(); ok {
+		_spec.SetField(outbox.FieldEvent, field.TypeString, value)
+		_node.Event = value
+	}
+	if value, ok := oc.mutation.Entity(); ok {
+		_spec.SetField(outbox.FieldEntity, field.TypeString, value)
+		_node.Entity = value
+	}
+	if value, ok := oc.mutation.EntityID(); ok {
+		_spec.SetField(outbox.FieldEntityID, field.TypeInt, value)
+		_node.EntityID = value
+	}
+	if value, ok := oc.mutation.Payload(); ok {
+		_spec.SetField(outbox.FieldPayload, field.TypeBytes, value)
+		_node.Payload = value
+	}
+	if value, ok := oc.mutation.CreatedAt(); ok {
+		_spec.SetField(outbox.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := oc.mutation.ProcessedAt(); ok {
+		_spec.SetField(outbox.FieldProcessedAt, field.TypeTime, value)
+		_node.ProcessedAt = &value
+	}
+	return _node, _spec
+}
+
+// OutboxCreateBulk is the builder for creating many Outbox entities in bulk.
+type OutboxCreateBulk struct {
+	config
+	err      error
+	builders []*OutboxCreate
+}
+
+// Save creates the Outbox entities in the database.
+func (ocb *OutboxCreateBulk) Save(ctx context.Context) ([]*Outbox, error) {
+	if ocb.err != nil {
+		return nil, ocb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ocb.builders))
+	nodes := make([]*Outbox, len(ocb.builders))
+	mutators := make([]Mutator, len(ocb.builders))
+	for i := range ocb.builders {
+		func(i int, root context.Context) {
+			builder := ocb.builders[i]
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OutboxMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ocb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ocb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ocb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ocb *OutboxCreateBulk) SaveX(ctx context.Context) []*Outbox {
+	v, err := ocb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ocb *OutboxCreateBulk) Exec(ctx context.Context) error {
+	_, err := ocb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ocb *OutboxCreateBulk) ExecX(ctx context.Context) {
+	if err := ocb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1012 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/predicate"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/user"
+)
+
+// PetUpdate is the builder for updating Pet entities.
+type PetUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PetMutation
+}
+
+// Where appends a list predicates to the PetUpdate builder.
+func (pu *PetUpdate) Where(ps ...predicate.Pet) *PetUpdate {
+	pu.mutation.Where(ps...)
+	return pu
+}
+
+// SetName sets the "name" field.
+func (pu *PetUpdate) SetName(s string) *PetUpdate {
+	pu.mutation.SetName(s)
+	return pu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (pu *PetUpdate) SetNillableName(s *string) *PetUpdate {
+	if s != nil {
+		pu.SetName(*s)
+	}
+	return pu
+}
+
+// SetNicknames sets the "nicknames" field.
+func (pu *PetUpdate) SetNicknames(s []string) *PetUpdate {
+	pu.mutation.SetNicknames(s)
+	return pu
+}
+
+// AppendNicknames appends s to the "nicknames" field.
+func (pu *PetUpdate) AppendNicknames(s []string) *PetUpdate {
+	pu.mutation.AppendNicknames(s)
+	return pu
+}
+
+// ClearNicknames clears the value of the "nicknames" field.
+func (pu *PetUpdate) ClearNicknames() *PetUpdate {
+	pu.mutation.ClearNicknames()
+	return pu
+}
+
+// SetAge sets the "age" field.
+func (pu *PetUpdate) SetAge(i int) *PetUpdate {
+	pu.mutation.ResetAge()
+	pu.mutation.SetAge(i)
+	return pu
+}
+
+// SetNillableAge sets the "age" field if the given value is not nil.
+func (pu *PetUpdate) SetNillableAge(i *int) *PetUpdate {
+	if i != nil {
+		pu.SetAge(*i)
+	}
+	return pu
+}
+
+// AddAge adds i to the "age" field.
+func (pu *PetUpdate) AddAge(i int) *PetUpdate {
+	pu.mutation.AddAge(i)
+	return pu
+}
+
+// SetType sets the "type" field.
+func (pu *PetUpdate) SetType(pe pet.Type) *PetUpdate {
+	pu.mutation.SetType(pe)
+	return pu
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (pu *PetUpdate) SetNillableType(pe *pet.Type) *PetUpdate {
+	if pe != nil {
+		pu.SetType(*pe)
+	}
+	return pu
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (pu *PetUpdate) AddCategoryIDs(ids ...int) *PetUpdate {
+	pu.mutation.AddCategoryIDs(ids...)
+	return pu
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (pu *PetUpdate) AddCategories(c ...*Category) *PetUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.AddCategoryIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (pu *PetUpdate) SetOwnerID(id int) *PetUpdate {
+	pu.mutation.SetOwnerID(id)
+	return pu
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (pu *PetUpdate) SetNillableOwnerID(id *int) *PetUpdate {
+	if id != nil {
+		pu = pu.SetOwnerID(*id)
+	}
+	return pu
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (pu *PetUpdate) SetOwner(u *User) *PetUpdate {
+	return pu.SetOwnerID(u.ID)
+}
+
+// AddFriendIDs adds the "friends" edge to the Pet entity by IDs.
+func (pu *PetUpdate) AddFriendIDs(ids ...int) *PetUpdate {
+	pu.mutation.AddFriendIDs(ids...)
+	return pu
+}
+
+// AddFriends adds the "friends" edges to the Pet entity.
+func (pu *PetUpdate) AddFriends(p ...*Pet) *PetUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.AddFriendIDs(ids...)
+}
+
+// AddFollowedByIDs adds the "followed_by" edge to the User entity by IDs.
+func (pu *PetUpdate) AddFollowedByIDs(ids ...int) *PetUpdate {
+	pu.mutation.AddFollowedByIDs(ids...)
+	return pu
+}
+
+// AddFollowedBy adds the "followed_by" edges to the User entity.
+func (pu *PetUpdate) AddFollowedBy(u ...*User) *PetUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pu.AddFollowedByIDs(ids...)
+}
+
+// Mutation returns the PetMutation object of the builder.
+func (pu *PetUpdate) Mutation() *PetMutation {
+	return pu.mutation
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (pu *PetUpdate) ClearCategories() *PetUpdate {
+	pu.mutation.ClearCategories()
+	return pu
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (pu *PetUpdate) RemoveCategoryIDs(ids ...int) *PetUpdate {
+	pu.mutation.RemoveCategoryIDs(ids...)
+	return pu
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (pu *PetUpdate) RemoveCategories(c ...*Category) *PetUpdate {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return pu.RemoveCategoryIDs(ids...)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (pu *PetUpdate) ClearOwner() *PetUpdate {
+	pu.mutation.ClearOwner()
+	return pu
+}
+
+// ClearFriends clears all "friends" edges to the Pet entity.
+func (pu *PetUpdate) ClearFriends() *PetUpdate {
+	pu.mutation.ClearFriends()
+	return pu
+}
+
+// RemoveFriendIDs removes the "friends" edge to Pet entities by IDs.
+func (pu *PetUpdate) RemoveFriendIDs(ids ...int) *PetUpdate {
+	pu.mutation.RemoveFriendIDs(ids...)
+	return pu
+}
+
+// RemoveFriends removes "friends" edges to Pet entities.
+func (pu *PetUpdate) RemoveFriends(p ...*Pet) *PetUpdate {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return pu.RemoveFriendIDs(ids...)
+}
+
+// ClearFollowedBy clears all "followed_by" edges to the User entity.
+func (pu *PetUpdate) ClearFollowedBy() *PetUpdate {
+	pu.mutation.ClearFollowedBy()
+	return pu
+}
+
+// RemoveFollowedByIDs removes the "followed_by" edge to User entities by IDs.
+func (pu *PetUpdate) RemoveFollowedByIDs(ids ...int) *PetUpdate {
+	pu.mutation.RemoveFollowedByIDs(ids...)
+	return pu
+}
+
+// RemoveFollowedBy removes "followed_by" edges to User entities.
+func (pu *PetUpdate) RemoveFollowedBy(u ...*User) *PetUpdate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return pu.RemoveFollowedByIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (pu *PetUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, pu.sqlSave, pu.mutation, pu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (pu *PetUpdate) SaveX(ctx context.Context) int {
+	affected, err := pu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (pu *PetUpdate) Exec(ctx context.Context) error {
+	_, err := pu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (pu *PetUpdate) ExecX(ctx context.Context) {
+	if err := pu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (pu *PetUpdate) check() error {
+	if v, ok := pu.mutation.Age(); ok {
+		if err := pet.AgeValidator(v); err != nil {
+			return &ValidationError{Name: "age", err: fmt.Errorf(`ent: validator failed for field "Pet.age": %w`, err)}
+		}
+	}
+	if v, ok := pu.mutation.GetType(); ok {
+		if err := pet.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Pet.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (pu *PetUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := pu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pet.Table, pet.Columns, sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt))
+	if ps := pu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := pu.mutation.Name(); ok {
+		_spec.SetField(pet.FieldName, field.TypeString, value)
+	}
+	if value, ok := pu.mutation.Nicknames(); ok {
+		_spec.SetField(pet.FieldNicknames, field.TypeJSON, value)
+	}
+	if value, ok := pu.mutation.AppendedNicknames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pet.FieldNicknames, value)
+		})
+	}
+	if pu.mutation.NicknamesCleared() {
+		_spec.ClearField(pet.FieldNicknames, field.TypeJSON)
+	}
+	if value, ok := pu.mutation.Age(); ok {
+		_spec.SetField(pet.FieldAge, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.AddedAge(); ok {
+		_spec.AddField(pet.FieldAge, field.TypeInt, value)
+	}
+	if value, ok := pu.mutation.GetType(); ok {
+		_spec.SetField(pet.FieldType, field.TypeEnum, value)
+	}
+	if pu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.CategoriesTable,
+			Columns: pet.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !pu.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.CategoriesTable,
+			Columns: pet.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.CategoriesTable,
+			Columns: pet.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pet.OwnerTable,
+			Columns: []string{pet.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pet.OwnerTable,
+			Columns: []string{pet.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pet.FriendsTable,
+			Columns: pet.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedFriendsIDs(); len(nodes) > 0 && !pu.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pet.FriendsTable,
+			Columns: pet.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.FriendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pet.FriendsTable,
+			Columns: pet.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if pu.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.FollowedByTable,
+			Columns: pet.FollowedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		createE := &FollowsCreate{config: pu.config, mutation: newFollowsMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !pu.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.FollowedByTable,
+			Columns: pet.FollowedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &FollowsCreate{config: pu.config, mutation: newFollowsMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := pu.mutation.FollowedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.FollowedByTable,
+			Columns: pet.FollowedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &FollowsCreate{config: pu.config, mutation: newFollowsMutation(pu.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, pu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	pu.mutation.done = true
+	return n, nil
+}
+
+// PetUpdateOne is the builder for updating a single Pet entity.
+type PetUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PetMutation
+}
+
+// SetName sets the "name" field.
+func (puo *PetUpdateOne) SetName(s string) *PetUpdateOne {
+	puo.mutation.SetName(s)
+	return puo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableName(s *string) *PetUpdateOne {
+	if s != nil {
+		puo.SetName(*s)
+	}
+	return puo
+}
+
+// SetNicknames sets the "nicknames" field.
+func (puo *PetUpdateOne) SetNicknames(s []string) *PetUpdateOne {
+	puo.mutation.SetNicknames(s)
+	return puo
+}
+
+// AppendNicknames appends s to the "nicknames" field.
+func (puo *PetUpdateOne) AppendNicknames(s []string) *PetUpdateOne {
+	puo.mutation.AppendNicknames(s)
+	return puo
+}
+
+// ClearNicknames clears the value of the "nicknames" field.
+func (puo *PetUpdateOne) ClearNicknames() *PetUpdateOne {
+	puo.mutation.ClearNicknames()
+	return puo
+}
+
+// SetAge sets the "age" field.
+func (puo *PetUpdateOne) SetAge(i int) *PetUpdateOne {
+	puo.mutation.ResetAge()
+	puo.mutation.SetAge(i)
+	return puo
+}
+
+// SetNillableAge sets the "age" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableAge(i *int) *PetUpdateOne {
+	if i != nil {
+		puo.SetAge(*i)
+	}
+	return puo
+}
+
+// AddAge adds i to the "age" field.
+func (puo *PetUpdateOne) AddAge(i int) *PetUpdateOne {
+	puo.mutation.AddAge(i)
+	return puo
+}
+
+// SetType sets the "type" field.
+func (puo *PetUpdateOne) SetType(pe pet.Type) *PetUpdateOne {
+	puo.mutation.SetType(pe)
+	return puo
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableType(pe *pet.Type) *PetUpdateOne {
+	if pe != nil {
+		puo.SetType(*pe)
+	}
+	return puo
+}
+
+// AddCategoryIDs adds the "categories" edge to the Category entity by IDs.
+func (puo *PetUpdateOne) AddCategoryIDs(ids ...int) *PetUpdateOne {
+	puo.mutation.AddCategoryIDs(ids...)
+	return puo
+}
+
+// AddCategories adds the "categories" edges to the Category entity.
+func (puo *PetUpdateOne) AddCategories(c ...*Category) *PetUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.AddCategoryIDs(ids...)
+}
+
+// SetOwnerID sets the "owner" edge to the User entity by ID.
+func (puo *PetUpdateOne) SetOwnerID(id int) *PetUpdateOne {
+	puo.mutation.SetOwnerID(id)
+	return puo
+}
+
+// SetNillableOwnerID sets the "owner" edge to the User entity by ID if the given value is not nil.
+func (puo *PetUpdateOne) SetNillableOwnerID(id *int) *PetUpdateOne {
+	if id != nil {
+		puo = puo.SetOwnerID(*id)
+	}
+	return puo
+}
+
+// SetOwner sets the "owner" edge to the User entity.
+func (puo *PetUpdateOne) SetOwner(u *User) *PetUpdateOne {
+	return puo.SetOwnerID(u.ID)
+}
+
+// AddFriendIDs adds the "friends" edge to the Pet entity by IDs.
+func (puo *PetUpdateOne) AddFriendIDs(ids ...int) *PetUpdateOne {
+	puo.mutation.AddFriendIDs(ids...)
+	return puo
+}
+
+// AddFriends adds the "friends" edges to the Pet entity.
+func (puo *PetUpdateOne) AddFriends(p ...*Pet) *PetUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.AddFriendIDs(ids...)
+}
+
+// AddFollowedByIDs adds the "followed_by" edge to the User entity by IDs.
+func (puo *PetUpdateOne) AddFollowedByIDs(ids ...int) *PetUpdateOne {
+	puo.mutation.AddFollowedByIDs(ids...)
+	return puo
+}
+
+// AddFollowedBy adds the "followed_by" edges to the User entity.
+func (puo *PetUpdateOne) AddFollowedBy(u ...*User) *PetUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return puo.AddFollowedByIDs(ids...)
+}
+
+// Mutation returns the PetMutation object of the builder.
+func (puo *PetUpdateOne) Mutation() *PetMutation {
+	return puo.mutation
+}
+
+// ClearCategories clears all "categories" edges to the Category entity.
+func (puo *PetUpdateOne) ClearCategories() *PetUpdateOne {
+	puo.mutation.ClearCategories()
+	return puo
+}
+
+// RemoveCategoryIDs removes the "categories" edge to Category entities by IDs.
+func (puo *PetUpdateOne) RemoveCategoryIDs(ids ...int) *PetUpdateOne {
+	puo.mutation.RemoveCategoryIDs(ids...)
+	return puo
+}
+
+// RemoveCategories removes "categories" edges to Category entities.
+func (puo *PetUpdateOne) RemoveCategories(c ...*Category) *PetUpdateOne {
+	ids := make([]int, len(c))
+	for i := range c {
+		ids[i] = c[i].ID
+	}
+	return puo.RemoveCategoryIDs(ids...)
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (puo *PetUpdateOne) ClearOwner() *PetUpdateOne {
+	puo.mutation.ClearOwner()
+	return puo
+}
+
+// ClearFriends clears all "friends" edges to the Pet entity.
+func (puo *PetUpdateOne) ClearFriends() *PetUpdateOne {
+	puo.mutation.ClearFriends()
+	return puo
+}
+
+// RemoveFriendIDs removes the "friends" edge to Pet entities by IDs.
+func (puo *PetUpdateOne) RemoveFriendIDs(ids ...int) *PetUpdateOne {
+	puo.mutation.RemoveFriendIDs(ids...)
+	return puo
+}
+
+// RemoveFriends removes "friends" edges to Pet entities.
+func (puo *PetUpdateOne) RemoveFriends(p ...*Pet) *PetUpdateOne {
+	ids := make([]int, len(p))
+	for i := range p {
+		ids[i] = p[i].ID
+	}
+	return puo.RemoveFriendIDs(ids...)
+}
+
+// ClearFollowedBy clears all "followed_by" edges to the User entity.
+func (puo *PetUpdateOne) ClearFollowedBy() *PetUpdateOne {
+	puo.mutation.ClearFollowedBy()
+	return puo
+}
+
+// RemoveFollowedByIDs removes the "followed_by" edge to User entities by IDs.
+func (puo *PetUpdateOne) RemoveFollowedByIDs(ids ...int) *PetUpdateOne {
+	puo.mutation.RemoveFollowedByIDs(ids...)
+	return puo
+}
+
+// RemoveFollowedBy removes "followed_by" edges to User entities.
+func (puo *PetUpdateOne) RemoveFollowedBy(u ...*User) *PetUpdateOne {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return puo.RemoveFollowedByIDs(ids...)
+}
+
+// Where appends a list predicates to the PetUpdate builder.
+func (puo *PetUpdateOne) Where(ps ...predicate.Pet) *PetUpdateOne {
+	puo.mutation.Where(ps...)
+	return puo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (puo *PetUpdateOne) Select(field string, fields ...string) *PetUpdateOne {
+	puo.fields = append([]string{field}, fields...)
+	return puo
+}
+
+// Save executes the query and returns the updated Pet entity.
+func (puo *PetUpdateOne) Save(ctx context.Context) (*Pet, error) {
+	return withHooks(ctx, puo.sqlSave, puo.mutation, puo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (puo *PetUpdateOne) SaveX(ctx context.Context) *Pet {
+	node, err := puo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (puo *PetUpdateOne) Exec(ctx context.Context) error {
+	_, err := puo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (puo *PetUpdateOne) ExecX(ctx context.Context) {
+	if err := puo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (puo *PetUpdateOne) check() error {
+	if v, ok := puo.mutation.Age(); ok {
+		if err := pet.AgeValidator(v); err != nil {
+			return &ValidationError{Name: "age", err: fmt.Errorf(`ent: validator failed for field "Pet.age": %w`, err)}
+		}
+	}
+	if v, ok := puo.mutation.GetType(); ok {
+		if err := pet.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "Pet.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (puo *PetUpdateOne) sqlSave(ctx context.Context) (_node *Pet, err error) {
+	if err := puo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(pet.Table, pet.Columns, sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt))
+	id, ok := puo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Pet.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := puo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, pet.FieldID)
+		for _, f := range fields {
+			if !pet.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != pet.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := puo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := puo.mutation.Name(); ok {
+		_spec.SetField(pet.FieldName, field.TypeString, value)
+	}
+	if value, ok := puo.mutation.Nicknames(); ok {
+		_spec.SetField(pet.FieldNicknames, field.TypeJSON, value)
+	}
+	if value, ok := puo.mutation.AppendedNicknames(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, pet.FieldNicknames, value)
+		})
+	}
+	if puo.mutation.NicknamesCleared() {
+		_spec.ClearField(pet.FieldNicknames, field.TypeJSON)
+	}
+	if value, ok := puo.mutation.Age(); ok {
+		_spec.SetField(pet.FieldAge, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.AddedAge(); ok {
+		_spec.AddField(pet.FieldAge, field.TypeInt, value)
+	}
+	if value, ok := puo.mutation.GetType(); ok {
+		_spec.SetField(pet.FieldType, field.TypeEnum, value)
+	}
+	if puo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.CategoriesTable,
+			Columns: pet.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedCategoriesIDs(); len(nodes) > 0 && !puo.mutation.CategoriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.CategoriesTable,
+			Columns: pet.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.CategoriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.CategoriesTable,
+			Columns: pet.CategoriesPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(category.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.OwnerCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pet.OwnerTable,
+			Columns: []string{pet.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.OwnerIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   pet.OwnerTable,
+			Columns: []string{pet.OwnerColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pet.FriendsTable,
+			Columns: pet.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedFriendsIDs(); len(nodes) > 0 && !puo.mutation.FriendsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pet.FriendsTable,
+			Columns: pet.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.FriendsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: false,
+			Table:   pet.FriendsTable,
+			Columns: pet.FriendsPrimaryKey,
+			Bidi:    true,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(pet.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if puo.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.FollowedByTable,
+			Columns: pet.FollowedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		createE := &FollowsCreate{config: puo.config, mutation: newFollowsMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.RemovedFollowedByIDs(); len(nodes) > 0 && !puo.mutation.FollowedByCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.FollowedByTable,
+			Columns: pet.FollowedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &FollowsCreate{config: puo.config, mutation: newFollowsMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := puo.mutation.FollowedByIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2M,
+			Inverse: true,
+			Table:   pet.FollowedByTable,
+			Columns: pet.FollowedByPrimaryKey,
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		createE := &FollowsCreate{config: puo.config, mutation: newFollowsMutation(puo.config, OpCreate)}
+		createE.defaults()
+		_, specE := createE.createSpec()
+		edge.Target.Fields = specE.Fields
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Pet{config: puo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, puo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{pet.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	puo.mutation.done = true
+	return _node, nil
+}
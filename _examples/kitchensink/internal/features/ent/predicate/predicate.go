@@ -0,0 +1,31 @@
+// Code generated by ent, DO NOT EDIT.
+
+package predicate
+
+import (
+	"entgo.io/ent/dialect/sql"
+)
+
+// Category is the predicate function for category builders.
+type Category func(*sql.Selector)
+
+// Follows is the predicate function for follows builders.
+type Follows func(*sql.Selector)
+
+// Friendship is the predicate function for friendship builders.
+type Friendship func(*sql.Selector)
+
+// Outbox is the predicate function for outbox builders.
+type Outbox func(*sql.Selector)
+
+// Pet is the predicate function for pet builders.
+type Pet func(*sql.Selector)
+
+// Settings is the predicate function for settings builders.
+type Settings func(*sql.Selector)
+
+// Skipped is the predicate function for skipped builders.
+type Skipped func(*sql.Selector)
+
+// User is the predicate function for user builders.
+type User func(*sql.Selector)
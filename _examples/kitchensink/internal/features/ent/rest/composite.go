@@ -0,0 +1,40 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/follows"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/predicate"
+)
+
+// FollowID is the composite ID of a Follow entity, which is provided through
+// multiple path parameters.
+type FollowID struct {
+	UserID int `json:"userID" form:"userID"`
+	PetID  int `json:"petID" form:"petID"`
+}
+
+// Predicate returns a predicate which matches the Follow with the composite ID.
+func (id FollowID) Predicate() predicate.Follows {
+	return follows.And(
+		follows.UserIDEQ(id.UserID),
+		follows.PetIDEQ(id.PetID),
+	)
+}
+
+// parseFollowID parses the composite ID of a Follow from the path parameters
+// of the provided request.
+func parseFollowID(r *http.Request) (id FollowID, err error) {
+	err = DefaultDecoder.Decode(&id, url.Values{
+		"userID": {r.PathValue("userID")},
+		"petID":  {r.PathValue("petID")},
+	})
+	if err != nil {
+		return id, &ErrBadRequest{Err: fmt.Errorf("invalid ID provided: %w", err)}
+	}
+	return id, nil
+}
@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"time"
+
+	github "github.com/google/go-github/v63/github"
+	schema "github.com/lrstanley/entrest/_examples/kitchensink/internal/database/schema"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/user"
+)
+
+// CategoryDTO is the representation of a Category in responses, which is decoupled from
+// [ent.Category], so the wire format isn't affected by changes to the ent schema which
+// aren't reflected in the REST API.
+type CategoryDTO struct {
+	// ID of the Category entity.
+	ID int `json:"id,omitempty"`
+	// Time in which the resource was initially created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time that the resource was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	Name      string    `json:"name"`
+	Readonly  string    `json:"readonly"`
+	Nillable  *string   `json:"nillable"`
+	Strings   []string  `json:"strings"`
+	Ints      []int     `json:"ints"`
+	// Edges holds the eager-loaded edges of the Category.
+	Edges CategoryEdgesDTO `json:"edges"`
+}
+
+// CategoryEdgesDTO holds the eager-loaded edges of a CategoryDTO.
+type CategoryEdgesDTO struct {
+	Pets []*PetDTO `json:"pets,omitempty"`
+}
+
+// NewCategoryDTO maps the provided Category (including its eager-loaded edges) to its
+// representation in responses. Returns nil if the provided Category is nil.
+func NewCategoryDTO(e *ent.Category) *CategoryDTO {
+	if e == nil {
+		return nil
+	}
+
+	dto := &CategoryDTO{
+		ID:        e.ID,
+		CreatedAt: e.CreatedAt,
+		UpdatedAt: e.UpdatedAt,
+		Name:      e.Name,
+		Readonly:  e.Readonly,
+		Nillable:  e.Nillable,
+		Strings:   e.Strings,
+		Ints:      e.Ints,
+	}
+	dto.Edges.Pets = NewPetDTOs(e.Edges.Pets)
+	return dto
+}
+
+// NewCategoryDTOs maps the provided Categories to their representation in responses
+// (see [NewCategoryDTO]). Returns nil if the provided slice is nil.
+func NewCategoryDTOs(v []*ent.Category) []*CategoryDTO {
+	if v == nil {
+		return nil
+	}
+
+	dtos := make([]*CategoryDTO, len(v))
+	for i := range v {
+		dtos[i] = NewCategoryDTO(v[i])
+	}
+	return dtos
+}
+
+// FollowsDTO is the representation of a Follows in responses, which is decoupled from
+// [ent.Follows], so the wire format isn't affected by changes to the ent schema which
+// aren't reflected in the REST API.
+type FollowsDTO struct {
+	FollowedAt time.Time `json:"followedAt"`
+	UserID     int       `json:"userID"`
+	PetID      int       `json:"petID"`
+	// Edges holds the eager-loaded edges of the Follows.
+	Edges FollowsEdgesDTO `json:"edges"`
+}
+
+// FollowsEdgesDTO holds the eager-loaded edges of a FollowsDTO.
+type FollowsEdgesDTO struct {
+	// The user that is following the pet.
+	User *UserDTO `json:"user,omitempty"`
+	// The pet that is being followed by the user.
+	Pet *PetDTO `json:"pet,omitempty"`
+}
+
+// NewFollowsDTO maps the provided Follows (including its eager-loaded edges) to its
+// representation in responses. Returns nil if the provided Follows is nil.
+func NewFollowsDTO(e *ent.Follows) *FollowsDTO {
+	if e == nil {
+		return nil
+	}
+
+	dto := &FollowsDTO{
+		FollowedAt: e.FollowedAt,
+		UserID:     e.UserID,
+		PetID:      e.PetID,
+	}
+	dto.Edges.User = NewUserDTO(e.Edges.User)
+	dto.Edges.Pet = NewPetDTO(e.Edges.Pet)
+	return dto
+}
+
+// NewFollowsDTOs maps the provided Follows to their representation in responses
+// (see [NewFollowsDTO]). Returns nil if the provided slice is nil.
+func NewFollowsDTOs(v []*ent.Follows) []*FollowsDTO {
+	if v == nil {
+		return nil
+	}
+
+	dtos := make([]*FollowsDTO, len(v))
+	for i := range v {
+		dtos[i] = NewFollowsDTO(v[i])
+	}
+	return dtos
+}
+
+// FriendshipDTO is the representation of a Friendship in responses, which is decoupled from
+// [ent.Friendship], so the wire format isn't affected by changes to the ent schema which
+// aren't reflected in the REST API.
+type FriendshipDTO struct {
+	// ID of the Friendship entity.
+	ID        int       `json:"id,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UserID    int       `json:"userID"`
+	FriendID  int       `json:"friendID"`
+	// Edges holds the eager-loaded edges of the Friendship.
+	Edges FriendshipEdgesDTO `json:"edges"`
+}
+
+// FriendshipEdgesDTO holds the eager-loaded edges of a FriendshipDTO.
+type FriendshipEdgesDTO struct {
+	User   *UserDTO `json:"user,omitempty"`
+	Friend *UserDTO `json:"friend,omitempty"`
+}
+
+// NewFriendshipDTO maps the provided Friendship (including its eager-loaded edges) to its
+// representation in responses. Returns nil if the provided Friendship is nil.
+func NewFriendshipDTO(e *ent.Friendship) *FriendshipDTO {
+	if e == nil {
+		return nil
+	}
+
+	dto := &FriendshipDTO{
+		ID:        e.ID,
+		CreatedAt: e.CreatedAt,
+		UserID:    e.UserID,
+		FriendID:  e.FriendID,
+	}
+	dto.Edges.User = NewUserDTO(e.Edges.User)
+	dto.Edges.Friend = NewUserDTO(e.Edges.Friend)
+	return dto
+}
+
+// NewFriendshipDTOs maps the provided Friendships to their representation in responses
+// (see [NewFriendshipDTO]). Returns nil if the provided slice is nil.
+func NewFriendshipDTOs(v []*ent.Friendship) []*FriendshipDTO {
+	if v == nil {
+		return nil
+	}
+
+	dtos := make([]*FriendshipDTO, len(v))
+	for i := range v {
+		dtos[i] = NewFriendshipDTO(v[i])
+	}
+	return dtos
+}
+
+// PetDTO is the representation of a Pet in responses, which is decoupled from
+// [ent.Pet], so the wire format isn't affected by changes to the ent schema which
+// aren't reflected in the REST API.
+type PetDTO struct {
+	// ID of the Pet entity.
+	ID        int      `json:"id,omitempty"`
+	Name      string   `json:"name"`
+	Nicknames []string `json:"nicknames"`
+	Age       int      `json:"age"`
+	Type      pet.Type `json:"type"`
+	// Edges holds the eager-loaded edges of the Pet.
+	Edges PetEdgesDTO `json:"edges"`
+}
+
+// PetEdgesDTO holds the eager-loaded edges of a PetDTO.
+type PetEdgesDTO struct {
+	// Categories that the pet belongs to.
+	Categories []*CategoryDTO `json:"categories,omitempty"`
+	// The user that owns the pet.
+	Owner *UserDTO `json:"owner,omitempty"`
+	// Pets that this pet is friends with.
+	Friends []*PetDTO `json:"friends,omitempty"`
+	// Users that this pet is followed by.
+	FollowedBy []*UserDTO    `json:"followedBy,omitempty"`
+	Following  []*FollowsDTO `json:"following,omitempty"`
+}
+
+// NewPetDTO maps the provided Pet (including its eager-loaded edges) to its
+// representation in responses. Returns nil if the provided Pet is nil.
+func NewPetDTO(e *ent.Pet) *PetDTO {
+	if e == nil {
+		return nil
+	}
+
+	dto := &PetDTO{
+		ID:        e.ID,
+		Name:      e.Name,
+		Nicknames: e.Nicknames,
+		Age:       e.Age,
+		Type:      e.Type,
+	}
+	dto.Edges.Categories = NewCategoryDTOs(e.Edges.Categories)
+	dto.Edges.Owner = NewUserDTO(e.Edges.Owner)
+	dto.Edges.Friends = NewPetDTOs(e.Edges.Friends)
+	dto.Edges.FollowedBy = NewUserDTOs(e.Edges.FollowedBy)
+	dto.Edges.Following = NewFollowsDTOs(e.Edges.Following)
+	return dto
+}
+
+// NewPetDTOs maps the provided Pets to their representation in responses
+// (see [NewPetDTO]). Returns nil if the provided slice is nil.
+func NewPetDTOs(v []*ent.Pet) []*PetDTO {
+	if v == nil {
+		return nil
+	}
+
+	dtos := make([]*PetDTO, len(v))
+	for i := range v {
+		dtos[i] = NewPetDTO(v[i])
+	}
+	return dtos
+}
+
+// SettingsDTO is the representation of a Settings in responses, which is decoupled from
+// [ent.Settings], so the wire format isn't affected by changes to the ent schema which
+// aren't reflected in the REST API.
+type SettingsDTO struct {
+	// ID of the Settings entity.
+	ID int `json:"id,omitempty"`
+	// Time in which the resource was initially created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time that the resource was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Global banner text to apply to the frontend.
+	GlobalBanner *string `json:"globalBanner"`
+	// Edges holds the eager-loaded edges of the Settings.
+	Edges SettingsEdgesDTO `json:"edges"`
+}
+
+// SettingsEdgesDTO holds the eager-loaded edges of a SettingsDTO.
+type SettingsEdgesDTO struct {
+	// Administrators for the platform.
+	Admins []*UserDTO `json:"admins,omitempty"`
+}
+
+// NewSettingsDTO maps the provided Settings (including its eager-loaded edges) to its
+// representation in responses. Returns nil if the provided Settings is nil.
+func NewSettingsDTO(e *ent.Settings) *SettingsDTO {
+	if e == nil {
+		return nil
+	}
+
+	dto := &SettingsDTO{
+		ID:           e.ID,
+		CreatedAt:    e.CreatedAt,
+		UpdatedAt:    e.UpdatedAt,
+		GlobalBanner: e.GlobalBanner,
+	}
+	dto.Edges.Admins = NewUserDTOs(e.Edges.Admins)
+	return dto
+}
+
+// NewSettingsDTOs maps the provided Settings to their representation in responses
+// (see [NewSettingsDTO]). Returns nil if the provided slice is nil.
+func NewSettingsDTOs(v []*ent.Settings) []*SettingsDTO {
+	if v == nil {
+		return nil
+	}
+
+	dtos := make([]*SettingsDTO, len(v))
+	for i := range v {
+		dtos[i] = NewSettingsDTO(v[i])
+	}
+	return dtos
+}
+
+// UserDTO is the representation of a User in responses, which is decoupled from
+// [ent.User], so the wire format isn't affected by changes to the ent schema which
+// aren't reflected in the REST API.
+type UserDTO struct {
+	// ID of the User entity.
+	ID int `json:"id,omitempty"`
+	// Time in which the resource was initially created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time that the resource was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Name of the user.
+	Name string `json:"name"`
+	// Type of object being defined (user or system which is for internal usecases).
+	Type user.Type `json:"type"`
+	// Full name if USER, otherwise null.
+	Description *string `json:"description"`
+	// If the user is still in the source system.
+	Enabled bool `json:"enabled"`
+	// Email associated with the user. Note that not all users have an associated email address.
+	Email *string `json:"email"`
+	// The github user raw JSON data.
+	GithubData *github.User          `json:"githubData"`
+	ProfileURL *schema.ExampleValuer `json:"profileURL"`
+	// Edges holds the eager-loaded edges of the User.
+	Edges UserEdgesDTO `json:"edges"`
+}
+
+// UserEdgesDTO holds the eager-loaded edges of a UserDTO.
+type UserEdgesDTO struct {
+	// Pets owned by the user.
+	Pets []*PetDTO `json:"pets,omitempty"`
+	// Pets that the user is following.
+	FollowedPets []*PetDTO `json:"followedPets,omitempty"`
+	// Friends of the user.
+	Friends     []*UserDTO       `json:"friends,omitempty"`
+	Following   []*FollowsDTO    `json:"following,omitempty"`
+	Friendships []*FriendshipDTO `json:"friendships,omitempty"`
+}
+
+// NewUserDTO maps the provided User (including its eager-loaded edges) to its
+// representation in responses. Returns nil if the provided User is nil.
+func NewUserDTO(e *ent.User) *UserDTO {
+	if e == nil {
+		return nil
+	}
+
+	dto := &UserDTO{
+		ID:          e.ID,
+		CreatedAt:   e.CreatedAt,
+		UpdatedAt:   e.UpdatedAt,
+		Name:        e.Name,
+		Type:        e.Type,
+		Description: e.Description,
+		Enabled:     e.Enabled,
+		Email:       e.Email,
+		GithubData:  e.GithubData,
+		ProfileURL:  e.ProfileURL,
+	}
+	dto.Edges.Pets = NewPetDTOs(e.Edges.Pets)
+	dto.Edges.FollowedPets = NewPetDTOs(e.Edges.FollowedPets)
+	dto.Edges.Friends = NewUserDTOs(e.Edges.Friends)
+	dto.Edges.Following = NewFollowsDTOs(e.Edges.Following)
+	dto.Edges.Friendships = NewFriendshipDTOs(e.Edges.Friendships)
+	return dto
+}
+
+// NewUserDTOs maps the provided Users to their representation in responses
+// (see [NewUserDTO]). Returns nil if the provided slice is nil.
+func NewUserDTOs(v []*ent.User) []*UserDTO {
+	if v == nil {
+		return nil
+	}
+
+	dtos := make([]*UserDTO, len(v))
+	for i := range v {
+		dtos[i] = NewUserDTO(v[i])
+	}
+	return dtos
+}
+
+// toResponseDTO maps the entities of the provided response to their representation in
+// responses (e.g. [ent.Pet] to [PetDTO]). Responses which don't contain entities are
+// returned as-is.
+func toResponseDTO(resp any) any {
+	if v, ok := resp.(interface{ toDTO() any }); ok {
+		return v.toDTO()
+	}
+
+	switch v := resp.(type) {
+	case *ent.Category:
+		return NewCategoryDTO(v)
+	case *[]*ent.Category:
+		dtos := NewCategoryDTOs(*v)
+		return &dtos
+	case *PagedResponse[ent.Category]:
+		return &PagedResponse[CategoryDTO]{
+			Page:         v.Page,
+			TotalCount:   v.TotalCount,
+			LastPage:     v.LastPage,
+			IsFirstPage:  v.IsFirstPage,
+			IsLastPage:   v.IsLastPage,
+			PerPage:      v.PerPage,
+			NextPage:     v.NextPage,
+			PreviousPage: v.PreviousPage,
+			Content:      NewCategoryDTOs(v.Content),
+		}
+	case *ent.Follows:
+		return NewFollowsDTO(v)
+	case *[]*ent.Follows:
+		dtos := NewFollowsDTOs(*v)
+		return &dtos
+	case *PagedResponse[ent.Follows]:
+		return &PagedResponse[FollowsDTO]{
+			Page:         v.Page,
+			TotalCount:   v.TotalCount,
+			LastPage:     v.LastPage,
+			IsFirstPage:  v.IsFirstPage,
+			IsLastPage:   v.IsLastPage,
+			PerPage:      v.PerPage,
+			NextPage:     v.NextPage,
+			PreviousPage: v.PreviousPage,
+			Content:      NewFollowsDTOs(v.Content),
+		}
+	case *ent.Friendship:
+		return NewFriendshipDTO(v)
+	case *[]*ent.Friendship:
+		dtos := NewFriendshipDTOs(*v)
+		return &dtos
+	case *PagedResponse[ent.Friendship]:
+		return &PagedResponse[FriendshipDTO]{
+			Page:         v.Page,
+			TotalCount:   v.TotalCount,
+			LastPage:     v.LastPage,
+			IsFirstPage:  v.IsFirstPage,
+			IsLastPage:   v.IsLastPage,
+			PerPage:      v.PerPage,
+			NextPage:     v.NextPage,
+			PreviousPage: v.PreviousPage,
+			Content:      NewFriendshipDTOs(v.Content),
+		}
+	case *ent.Pet:
+		return NewPetDTO(v)
+	case *[]*ent.Pet:
+		dtos := NewPetDTOs(*v)
+		return &dtos
+	case *PagedResponse[ent.Pet]:
+		return &PagedResponse[PetDTO]{
+			Page:         v.Page,
+			TotalCount:   v.TotalCount,
+			LastPage:     v.LastPage,
+			IsFirstPage:  v.IsFirstPage,
+			IsLastPage:   v.IsLastPage,
+			PerPage:      v.PerPage,
+			NextPage:     v.NextPage,
+			PreviousPage: v.PreviousPage,
+			Content:      NewPetDTOs(v.Content),
+		}
+	case *ent.Settings:
+		return NewSettingsDTO(v)
+	case *[]*ent.Settings:
+		dtos := NewSettingsDTOs(*v)
+		return &dtos
+	case *PagedResponse[ent.Settings]:
+		return &PagedResponse[SettingsDTO]{
+			Page:         v.Page,
+			TotalCount:   v.TotalCount,
+			LastPage:     v.LastPage,
+			IsFirstPage:  v.IsFirstPage,
+			IsLastPage:   v.IsLastPage,
+			PerPage:      v.PerPage,
+			NextPage:     v.NextPage,
+			PreviousPage: v.PreviousPage,
+			Content:      NewSettingsDTOs(v.Content),
+		}
+	case *ent.User:
+		return NewUserDTO(v)
+	case *[]*ent.User:
+		dtos := NewUserDTOs(*v)
+		return &dtos
+	case *PagedResponse[ent.User]:
+		return &PagedResponse[UserDTO]{
+			Page:         v.Page,
+			TotalCount:   v.TotalCount,
+			LastPage:     v.LastPage,
+			IsFirstPage:  v.IsFirstPage,
+			IsLastPage:   v.IsLastPage,
+			PerPage:      v.PerPage,
+			NextPage:     v.NextPage,
+			PreviousPage: v.PreviousPage,
+			Content:      NewUserDTOs(v.Content),
+		}
+	}
+	return resp
+}
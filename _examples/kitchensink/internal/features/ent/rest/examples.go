@@ -0,0 +1,106 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// exampleFixture decodes an example payload into a fresh map, so callers are free
+// to modify the returned value.
+func exampleFixture(payload string) map[string]any {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		panic(fmt.Sprintf("failed to decode example payload: %v", err))
+	}
+	return v
+}
+
+// ExampleCategoryCreate returns a deterministic example payload for creating
+// a Category entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleCategoryCreate() map[string]any {
+	return exampleFixture("{\"ints\":[1],\"name\":\"Example Name\",\"nillable\":\"test\",\"pets\":[1],\"strings\":[\"FOO\"]}")
+}
+
+// ExampleCategoryUpdate returns a deterministic example payload for updating
+// a Category entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleCategoryUpdate() map[string]any {
+	return exampleFixture("{\"addPets\":[1],\"ints\":[1],\"name\":\"Example Name\",\"nillable\":\"test\",\"removePets\":[1],\"strings\":[\"FOO\"]}")
+}
+
+// ExampleFollowCreate returns a deterministic example payload for creating
+// a Follow entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFollowCreate() map[string]any {
+	return exampleFixture("{\"petID\":1,\"userID\":1}")
+}
+
+// ExampleFollowUpdate returns a deterministic example payload for updating
+// a Follow entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFollowUpdate() map[string]any {
+	return exampleFixture("{\"petID\":1,\"userID\":1}")
+}
+
+// ExampleFriendshipCreate returns a deterministic example payload for creating
+// a Friendship entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFriendshipCreate() map[string]any {
+	return exampleFixture("{\"createdAt\":\"2024-01-01T00:00:00Z\",\"friendID\":1,\"userID\":1}")
+}
+
+// ExampleFriendshipUpdate returns a deterministic example payload for updating
+// a Friendship entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleFriendshipUpdate() map[string]any {
+	return exampleFixture("{\"createdAt\":\"2024-01-01T00:00:00Z\",\"friendID\":1,\"userID\":1}")
+}
+
+// ExamplePetCreate returns a deterministic example payload for creating
+// a Pet entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExamplePetCreate() map[string]any {
+	return exampleFixture("{\"age\":2,\"categories\":[1],\"followedBy\":[1],\"friends\":[1],\"name\":\"Kuro\",\"nicknames\":[\"Example Name\"],\"owner\":1,\"type\":\"DOG\"}")
+}
+
+// ExamplePetUpdate returns a deterministic example payload for updating
+// a Pet entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExamplePetUpdate() map[string]any {
+	return exampleFixture("{\"addCategories\":[1],\"addFollowedBy\":[1],\"addFriends\":[1],\"age\":2,\"categories\":[1],\"name\":\"Kuro\",\"nicknames\":[\"Example Name\"],\"owner\":1,\"removeCategories\":[1],\"removeFollowedBy\":[1],\"removeFriends\":[1],\"type\":\"DOG\"}")
+}
+
+// ExampleSettingUpdate returns a deterministic example payload for updating
+// a Setting entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleSettingUpdate() map[string]any {
+	return exampleFixture("{\"addAdmins\":[1],\"globalBanner\":\"example\",\"removeAdmins\":[1]}")
+}
+
+// ExampleUserCreate returns a deterministic example payload for creating
+// a User entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleUserCreate() map[string]any {
+	return exampleFixture("{\"description\":\"Jon Smith\",\"email\":\"John.Smith@example.com\",\"enabled\":true,\"followedPets\":[1],\"friends\":[1],\"friendships\":[1],\"githubData\":{},\"name\":\"Example Name\",\"passwordHashed\":\"example\",\"pets\":[1],\"profileURL\":\"http://127.0.0.1/\",\"type\":\"USER\"}")
+}
+
+// ExampleUserUpdate returns a deterministic example payload for updating
+// a User entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleUserUpdate() map[string]any {
+	return exampleFixture("{\"addFollowedPets\":[1],\"addFriends\":[1],\"addFriendships\":[1],\"addPets\":[1],\"description\":\"Jon Smith\",\"email\":\"John.Smith@example.com\",\"enabled\":true,\"githubData\":{},\"name\":\"Example Name\",\"passwordHashed\":\"example\",\"profileURL\":\"http://127.0.0.1/\",\"removeFollowedPets\":[1],\"removeFriends\":[1],\"removeFriendships\":[1],\"removePets\":[1],\"type\":\"USER\"}")
+}
@@ -0,0 +1,163 @@
+// Code generated by entrest, DO NOT EDIT.
+import http from "k6/http";
+import { check } from "k6";
+
+const BASE_URL = __ENV.BASE_URL || "http://localhost:8080";
+
+export const options = {
+    vus: Number(__ENV.VUS || 10),
+    duration: __ENV.DURATION || "30s",
+    thresholds: {
+        http_req_failed: ["rate<0.01"],
+    },
+};
+
+const requests = [
+    {
+        "name": "listCategories",
+        "method": "GET",
+        "path": "/categories"
+    },
+    {
+        "name": "getCategory",
+        "method": "GET",
+        "path": "/categories/1"
+    },
+    {
+        "name": "listCategoryPets",
+        "method": "GET",
+        "path": "/categories/1/pets"
+    },
+    {
+        "name": "listFollows",
+        "method": "GET",
+        "path": "/follows"
+    },
+    {
+        "name": "getFollow",
+        "method": "GET",
+        "path": "/follows/1/1"
+    },
+    {
+        "name": "listFriendships",
+        "method": "GET",
+        "path": "/friendships"
+    },
+    {
+        "name": "getFriendship",
+        "method": "GET",
+        "path": "/friendships/1"
+    },
+    {
+        "name": "getFriendshipFriend",
+        "method": "GET",
+        "path": "/friendships/1/friend"
+    },
+    {
+        "name": "getFriendshipUser",
+        "method": "GET",
+        "path": "/friendships/1/user"
+    },
+    {
+        "name": "getOpenAPI",
+        "method": "GET",
+        "path": "/openapi.json"
+    },
+    {
+        "name": "listPets",
+        "method": "GET",
+        "path": "/pets"
+    },
+    {
+        "name": "getPet",
+        "method": "GET",
+        "path": "/pets/1"
+    },
+    {
+        "name": "listPetCategories",
+        "method": "GET",
+        "path": "/pets/1/categories"
+    },
+    {
+        "name": "listPetFollowedBys",
+        "method": "GET",
+        "path": "/pets/1/followed-by"
+    },
+    {
+        "name": "listPetFollowings",
+        "method": "GET",
+        "path": "/pets/1/following"
+    },
+    {
+        "name": "listPetFriends",
+        "method": "GET",
+        "path": "/pets/1/friends"
+    },
+    {
+        "name": "getPetOwner",
+        "method": "GET",
+        "path": "/pets/1/owner"
+    },
+    {
+        "name": "listSettings",
+        "method": "GET",
+        "path": "/settings"
+    },
+    {
+        "name": "getSetting",
+        "method": "GET",
+        "path": "/settings/1"
+    },
+    {
+        "name": "listSettingAdmins",
+        "method": "GET",
+        "path": "/settings/1/admins"
+    },
+    {
+        "name": "listUsers",
+        "method": "GET",
+        "path": "/users"
+    },
+    {
+        "name": "getUser",
+        "method": "GET",
+        "path": "/users/1"
+    },
+    {
+        "name": "getUserAvatar",
+        "method": "GET",
+        "path": "/users/1/avatar"
+    },
+    {
+        "name": "listUserFollowedPets",
+        "method": "GET",
+        "path": "/users/1/followed-pets"
+    },
+    {
+        "name": "listUserFollowings",
+        "method": "GET",
+        "path": "/users/1/following"
+    },
+    {
+        "name": "listUserFriends",
+        "method": "GET",
+        "path": "/users/1/friends"
+    },
+    {
+        "name": "listUserFriendships",
+        "method": "GET",
+        "path": "/users/1/friendships"
+    },
+    {
+        "name": "listUserPets",
+        "method": "GET",
+        "path": "/users/1/pets"
+    }
+];
+
+export default function () {
+    for (const r of requests) {
+        const res = http.request(r.method, BASE_URL + r.path, null, { tags: { name: r.name } });
+        check(res, { [r.name + " is not a server error"]: (res) => res.status < 500 });
+    }
+}
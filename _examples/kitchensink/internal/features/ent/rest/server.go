@@ -0,0 +1,2895 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	mathrand "math/rand/v2"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-chi/chi/v5"
+	"github.com/go-chi/chi/v5/middleware"
+	"github.com/go-playground/form/v4"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/follows"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/friendship"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/outbox"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/privacy"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/settings"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/user"
+)
+
+//go:embed openapi.json
+var OpenAPI []byte // OpenAPI contains the JSON schema of the API.
+
+// Operation represents the CRUD operation(s).
+type Operation string
+
+const (
+	// OperationCreate represents the create operation (method: POST).
+	OperationCreate Operation = "create"
+	// OperationRead represents the read operation (method: GET).
+	OperationRead Operation = "read"
+	// OperationUpdate represents the update operation (method: PATCH).
+	OperationUpdate Operation = "update"
+	// OperationDelete represents the delete operation (method: DELETE).
+	OperationDelete Operation = "delete"
+	// OperationList represents the list operation (method: GET).
+	OperationList Operation = "list"
+)
+
+// ErrorResponse is the response structure for errors.
+type ErrorResponse struct {
+	Error     string `json:"error"`                // The underlying error, which may be masked when debugging is disabled.
+	Type      string `json:"type"`                 // A summary of the error code based off the HTTP status code or application error code.
+	Code      int    `json:"code"`                 // The HTTP status code or other internal application error code.
+	RequestID string `json:"request_id,omitempty"` // The unique request ID for this error.
+	Timestamp string `json:"timestamp,omitempty"`  // The timestamp of the error, in RFC3339 format.
+}
+
+type ErrBadRequest struct {
+	Err error
+}
+
+func (e ErrBadRequest) Error() string {
+	return fmt.Sprintf("bad request: %s", e.Err)
+}
+
+func (e ErrBadRequest) Unwrap() error {
+	return e.Err
+}
+
+// IsBadRequest returns true if the unwrapped/underlying error is of type ErrBadRequest.
+func IsBadRequest(err error) bool {
+	var target *ErrBadRequest
+	return errors.As(err, &target)
+}
+
+// ErrUnprocessable is returned when a request is well-formed, but fails validation
+// (e.g. JSON values of the wrong type).
+type ErrUnprocessable struct {
+	Err error
+}
+
+func (e ErrUnprocessable) Error() string {
+	return fmt.Sprintf("unprocessable request: %s", e.Err)
+}
+
+func (e ErrUnprocessable) Unwrap() error {
+	return e.Err
+}
+
+// IsUnprocessable returns true if the unwrapped/underlying error is of type ErrUnprocessable.
+func IsUnprocessable(err error) bool {
+	var target *ErrUnprocessable
+	return errors.As(err, &target)
+}
+
+// ErrUnknownField is returned when a create/update request includes a field which
+// doesn't exist on the entity.
+type ErrUnknownField struct {
+	Err error
+}
+
+func (e ErrUnknownField) Error() string {
+	return fmt.Sprintf("unknown field: %s", e.Err)
+}
+
+func (e ErrUnknownField) Unwrap() error {
+	return e.Err
+}
+
+// IsUnknownField returns true if the unwrapped/underlying error is of type ErrUnknownField.
+func IsUnknownField(err error) bool {
+	var target *ErrUnknownField
+	return errors.As(err, &target)
+}
+
+type ErrConflict struct {
+	Err error
+}
+
+func (e ErrConflict) Error() string {
+	return fmt.Sprintf("conflict: %s", e.Err)
+}
+
+func (e ErrConflict) Unwrap() error {
+	return e.Err
+}
+
+// IsConflict returns true if the unwrapped/underlying error is of type ErrConflict.
+func IsConflict(err error) bool {
+	var target *ErrConflict
+	return errors.As(err, &target)
+}
+
+var ErrEndpointNotFound = errors.New("endpoint not found")
+
+// IsEndpointNotFound returns true if the unwrapped/underlying error is of type ErrEndpointNotFound.
+func IsEndpointNotFound(err error) bool {
+	return errors.Is(err, ErrEndpointNotFound)
+}
+
+var ErrMethodNotAllowed = errors.New("method not allowed")
+
+// IsMethodNotAllowed returns true if the unwrapped/underlying error is of type ErrMethodNotAllowed.
+func IsMethodNotAllowed(err error) bool {
+	return errors.Is(err, ErrMethodNotAllowed)
+}
+
+// JSON marshals 'v' to JSON, and setting the Content-Type as application/json.
+// Note that this does NOT auto-escape HTML. If 'v' cannot be marshalled to JSON,
+// this will panic.
+//
+// JSON also supports prettification when the origin request has a query parameter
+// of "pretty" set to true.
+func JSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+
+	if pretty, _ := strconv.ParseBool(r.FormValue("pretty")); pretty {
+		enc.SetIndent("", "    ")
+	}
+
+	if err := enc.Encode(v); err != nil && err != io.EOF {
+		panic(fmt.Sprintf("failed to marshal response: %v", err))
+	}
+}
+
+// M is an alias for map[string]any, which makes it easier to respond with generic JSON data structures.
+type M map[string]any
+
+var (
+	// DefaultDecoder is the default decoder used by Bind. You can either override
+	// this, or provide your own. Make sure it is set before Bind is called.
+	DefaultDecoder = form.NewDecoder()
+
+	// DefaultDecodeMaxMemory is the maximum amount of memory in bytes that will be
+	// used for decoding multipart/form-data requests.
+	DefaultDecodeMaxMemory int64 = 8 << 20
+)
+
+func init() {
+	RegisterPageDecoders(DefaultDecoder)
+}
+
+// RegisterPageDecoders registers decoders for the PageNumber type with the provided
+// decoder, which also accept "first" and "last". This is done automatically for
+// DefaultDecoder, but must be called when providing your own.
+func RegisterPageDecoders(d *form.Decoder) {
+	d.RegisterCustomTypeFunc(func(vals []string) (any, error) {
+		var v PageNumber
+		if err := v.UnmarshalText([]byte(vals[0])); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}, PageNumber(0))
+}
+
+// Bind decodes the request body to the given struct. At this time the only supported
+// content-types are application/json, application/x-www-form-urlencoded, as well as
+// GET parameters.
+func Bind(r *http.Request, v any) (err error) {
+	defer func() {
+		// The form decoder panics on some malformed parameter names (e.g. "]").
+		if rerr := recover(); rerr != nil {
+			err = &ErrBadRequest{Err: fmt.Errorf("error decoding %s request into required format (%T): %v", r.Method, v, rerr)}
+		}
+	}()
+
+	err = r.ParseForm()
+	if err != nil {
+		return &ErrBadRequest{Err: fmt.Errorf("parsing form parameters: %w", err)}
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		err = DefaultDecoder.Decode(v, r.Form)
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		switch {
+		case strings.HasPrefix(r.Header.Get("Content-Type"), "application/json"):
+			dec := json.NewDecoder(r.Body)
+			dec.DisallowUnknownFields()
+			defer r.Body.Close()
+			if err = dec.Decode(v); err != nil {
+				return jsonDecodeError(r, v, err)
+			}
+		case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"):
+			err = r.ParseMultipartForm(DefaultDecodeMaxMemory)
+			if err == nil {
+				err = DefaultDecoder.Decode(v, r.MultipartForm.Value)
+			}
+		default:
+			err = DefaultDecoder.Decode(v, r.PostForm)
+		}
+	default:
+		return &ErrBadRequest{Err: fmt.Errorf("unsupported method %s", r.Method)}
+	}
+
+	if err != nil {
+		return &ErrBadRequest{Err: fmt.Errorf("error decoding %s request into required format (%T): %w", r.Method, v, err)}
+	}
+	return nil
+}
+
+// jsonDecodeError wraps the provided JSON decoding error, distinguishing malformed
+// JSON from well-formed JSON which doesn't match the expected structure (or
+// contains unknown fields).
+func jsonDecodeError(r *http.Request, v any, err error) error {
+	err = fmt.Errorf("error decoding %s request into required format (%T): %w", r.Method, v, err)
+
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &syntaxErr), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return &ErrBadRequest{Err: err}
+	case strings.Contains(err.Error(), "json: unknown field "):
+		return &ErrUnknownField{Err: err}
+	default:
+		return &ErrUnprocessable{Err: err}
+	}
+}
+
+// Req simplifies making an HTTP handler that returns a single result, and an error.
+// The result, if not nil, must be JSON-marshalable. If result is nil, [http.StatusNoContent]
+// will be returned.
+func Req[Resp any](s *Server, op Operation, fn func(*http.Request) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		if s.rejectMaintenance(op) {
+			handleResponse[Resp](s, w, r, op, nil, ErrMaintenanceMode)
+			return
+		}
+		results, err := fn(r)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqID is similar to Req, but also processes an "id" path parameter and provides it to the
+// handler function.
+func ReqID[Resp any](s *Server, op Operation, fn func(*http.Request, int) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		if s.rejectMaintenance(op) {
+			handleResponse[Resp](s, w, r, op, nil, ErrMaintenanceMode)
+			return
+		}
+		id, err := strconv.Atoi(r.PathValue("id"))
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqParam is similar to Req, but also processes a request body/query params and provides it
+// to the handler function.
+func ReqParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request, *Params) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		if s.rejectMaintenance(op) {
+			handleResponse[Resp](s, w, r, op, nil, ErrMaintenanceMode)
+			return
+		}
+		params := new(Params)
+		if err := Bind(r, params); err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, params)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqIDParam is similar to ReqParam, but also processes an "id" path parameter and request
+// body/query params, and provides it to the handler function.
+func ReqIDParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request, int, *Params) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		if s.rejectMaintenance(op) {
+			handleResponse[Resp](s, w, r, op, nil, ErrMaintenanceMode)
+			return
+		}
+		id, err := strconv.Atoi(r.PathValue("id"))
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		params := new(Params)
+		err = Bind(r, params)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id, params)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqCompositeID is similar to ReqID, but for entities with a composite ID, which is
+// parsed from multiple path parameters using the provided parse function.
+func ReqCompositeID[ID, Resp any](s *Server, op Operation, parse func(*http.Request) (ID, error), fn func(*http.Request, ID) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		if s.rejectMaintenance(op) {
+			handleResponse[Resp](s, w, r, op, nil, ErrMaintenanceMode)
+			return
+		}
+		id, err := parse(r)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqCompositeIDParam is similar to ReqIDParam, but for entities with a composite ID,
+// which is parsed from multiple path parameters using the provided parse function.
+func ReqCompositeIDParam[ID, Params, Resp any](s *Server, op Operation, parse func(*http.Request) (ID, error), fn func(*http.Request, ID, *Params) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		if s.rejectMaintenance(op) {
+			handleResponse[Resp](s, w, r, op, nil, ErrMaintenanceMode)
+			return
+		}
+		id, err := parse(r)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		params := new(Params)
+		err = Bind(r, params)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id, params)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// RetryPolicy configures the retries of mutations (which are executed within a
+// transaction) which fail with a transient error (see [IsTransientError]). The zero
+// value uses the defaults of each field.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a transaction, including the
+	// first attempt. Defaults to 3. Set to 1 to disable retries.
+	MaxAttempts int
+
+	// Backoff returns the delay before the provided retry (starting at 1). Defaults
+	// to an exponential backoff starting at 10ms, with jitter.
+	Backoff func(retry int) time.Duration
+
+	// RetryAfter is the delay returned through the "Retry-After" header of responses
+	// to transient errors (rounded up to whole seconds). Defaults to 1 second.
+	RetryAfter time.Duration
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 3
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	if p != nil && p.Backoff != nil {
+		return p.Backoff(retry)
+	}
+	d := 10 * time.Millisecond << min(retry-1, 10)
+	return d/2 + mathrand.N(d/2+1)
+}
+
+func (p *RetryPolicy) retryAfter() time.Duration {
+	if p == nil || p.RetryAfter <= 0 {
+		return time.Second
+	}
+	return p.RetryAfter
+}
+
+// transientErrorStates holds the SQLSTATE codes of transient errors (serialization
+// failures and deadlocks), and the status code of responses to them.
+var transientErrorStates = map[string]int{
+	"40001": http.StatusConflict,           // serialization_failure
+	"40P01": http.StatusServiceUnavailable, // deadlock_detected
+}
+
+// transientErrorMessages holds the messages of transient errors of drivers which
+// don't expose a SQLSTATE code, and the status code of responses to them.
+var transientErrorMessages = map[string]int{
+	"Error 1213":         http.StatusServiceUnavailable, // MySQL: deadlock found.
+	"Error 1205":         http.StatusServiceUnavailable, // MySQL: lock wait timeout exceeded.
+	"database is locked": http.StatusServiceUnavailable, // SQLite: SQLITE_BUSY.
+	"SQLITE_BUSY":        http.StatusServiceUnavailable,
+}
+
+// transientErrorStatus returns the status code of responses to the provided error if
+// it's a transient error (see [IsTransientError]), i.e. 409 for serialization
+// failures, and 503 for deadlocks and lock timeouts, or 0 otherwise.
+func transientErrorStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) {
+		if status, ok := transientErrorStates[state.SQLState()]; ok {
+			return status
+		}
+	}
+
+	msg := err.Error()
+	for text, status := range transientErrorMessages {
+		if strings.Contains(msg, text) {
+			return status
+		}
+	}
+	return 0
+}
+
+// IsTransientError returns true if the provided error is a transient database error,
+// i.e. a serialization failure, deadlock or lock timeout, which may succeed when
+// retried. Transactions of mutations are retried when they fail with a transient
+// error (see [ServerConfig.Retry]), and responses to transient errors include a
+// "Retry-After" header.
+func IsTransientError(err error) bool {
+	return transientErrorStatus(err) != 0
+}
+
+type requestTxKey struct{}
+
+// requestTx returns the client of the transaction which the request of the provided
+// context is executed within, if any (i.e. a transactional batch, or a dry run),
+// which is committed or rolled back once the request completes, rather than by its
+// handler.
+func requestTx(ctx context.Context) (*ent.Client, bool) {
+	db, ok := ctx.Value(requestTxKey{}).(*ent.Client)
+	return db, ok
+}
+
+// withTx runs the provided function within a transaction of the client of the
+// provided context, committing if no error is returned, and rolling back otherwise.
+// Transactions which fail with a transient error are retried (see
+// [ServerConfig.Retry]). Requests which are already executed within a
+// transaction (see [requestTx]) use it instead.
+func withTx[T any](s *Server, ctx context.Context, fn func(tx *ent.Client) (*T, error)) (*T, error) {
+	if db, ok := requestTx(ctx); ok {
+		return fn(db)
+	}
+	for retry := 1; ; retry++ {
+		result, err := runTx(ctx, s.client(ctx), fn)
+		if err == nil || !IsTransientError(err) || retry >= s.config.Retry.maxAttempts() {
+			return result, err
+		}
+
+		timer := time.NewTimer(s.config.Retry.backoff(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// runTx runs the provided function within a transaction, committing if no error is
+// returned, and rolling back otherwise. If the provided client is already
+// transactional (e.g. provided through [UseEntContext]), the function is run within
+// its transaction, which is left to its owner.
+func runTx[T any](ctx context.Context, db *ent.Client, fn func(tx *ent.Client) (*T, error)) (*T, error) {
+	tx, err := db.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return fn(db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := fn(tx.Client())
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %w", err, rerr)
+		}
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+var (
+	// ErrFileTooLarge is returned when an uploaded file (or attachment) exceeds the
+	// maximum size of the file field (or attachments).
+	ErrFileTooLarge = errors.New("file too large")
+
+	// ErrUnsupportedMediaType is returned when an uploaded file (or attachment) has a
+	// media type which isn't accepted by the file field (or attachments), or the
+	// request isn't a multipart/form-data request.
+	ErrUnsupportedMediaType = errors.New("unsupported media type")
+)
+
+// matchContentType returns true if the provided media type matches any of the
+// provided media types, which may contain wildcard subtypes (e.g. "image/*").
+func matchContentType(contentType string, contentTypes []string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	for _, v := range contentTypes {
+		if v == mediaType || (strings.HasSuffix(v, "/*") && strings.HasPrefix(mediaType, strings.TrimSuffix(v, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// ErrFileNotFound is returned when downloading a file field which has no file.
+var ErrFileNotFound = errors.New("file not found")
+
+// File is a file which is uploaded to, or downloaded from, a file field. Handlers
+// returning a File write its contents directly, rather than encoding it as JSON.
+type File struct {
+	Data        []byte // The contents of the file.
+	ContentType string // The media type of the file.
+	Name        string // The original name of the file, if known.
+}
+
+// ReadFile reads the file uploaded through the provided part of a multipart/form-data
+// request, which must not be larger than maxSize bytes, and must match one of the
+// provided media types (if any, e.g. "image/png" or "image/*"). The media type is
+// detected from the contents of the file, if not provided by the client.
+func ReadFile(r *http.Request, part string, maxSize int64, contentTypes ...string) (*File, error) {
+	if !strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data") {
+		return nil, fmt.Errorf("%w: expected a multipart/form-data request", ErrUnsupportedMediaType)
+	}
+
+	// Leave some room for the rest of the multipart body.
+	r.Body = http.MaxBytesReader(nil, r.Body, maxSize+DefaultDecodeMaxMemory)
+
+	f, header, err := r.FormFile(part)
+	if err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			return nil, ErrFileTooLarge
+		}
+		return nil, &ErrBadRequest{Err: fmt.Errorf("reading file %q: %w", part, err)}
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil {
+		return nil, &ErrBadRequest{Err: fmt.Errorf("reading file %q: %w", part, err)}
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("%w: must be at most %d bytes", ErrFileTooLarge, maxSize)
+	}
+
+	contentType := header.Header.Get("Content-Type")
+	if contentType == "" || contentType == "application/octet-stream" {
+		contentType = http.DetectContentType(data)
+	}
+
+	if len(contentTypes) > 0 && !matchContentType(contentType, contentTypes) {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMediaType, contentType)
+	}
+
+	return &File{Data: data, ContentType: contentType, Name: header.Filename}, nil
+}
+
+// writeFile writes the provided file to the response, including support for range
+// and conditional requests.
+func writeFile(w http.ResponseWriter, r *http.Request, f *File) {
+	if f.ContentType == "" {
+		f.ContentType = "application/octet-stream"
+	}
+
+	w.Header().Set("Content-Type", f.ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+
+	if f.Name != "" {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": f.Name}))
+	}
+
+	http.ServeContent(w, r, f.Name, time.Time{}, bytes.NewReader(f.Data))
+}
+
+// entityLocation returns the path of the read endpoint of the provided entity (used
+// for the "Location" header of created entities), or an empty string if the entity
+// can't be read through its own endpoint.
+func (s *Server) entityLocation(v any) string {
+	switch e := v.(type) {
+	case *ent.Category:
+		return s.config.BasePath + strings.Replace("/categories/{id}", "{id}", fmt.Sprint(e.ID), 1)
+	case *ent.Friendship:
+		return s.config.BasePath + strings.Replace("/friendships/{id}", "{id}", fmt.Sprint(e.ID), 1)
+	case *ent.Pet:
+		return s.config.BasePath + strings.Replace("/pets/{id}", "{id}", fmt.Sprint(e.ID), 1)
+	case *ent.Settings:
+		return s.config.BasePath + strings.Replace("/settings/{id}", "{id}", fmt.Sprint(e.ID), 1)
+	case *ent.User:
+		return s.config.BasePath + strings.Replace("/users/{id}", "{id}", fmt.Sprint(e.ID), 1)
+	}
+	return ""
+}
+
+// withTolerantReader removes the provided properties (which are part of the entity
+// as returned by read endpoints, but can't be written, e.g. "id" or "edges") from
+// JSON request bodies, so clients can send back an entity as it was returned
+// (read-modify-write), including properties which they don't know about, without
+// the request being rejected. Bodies which aren't JSON objects are left to the
+// handler.
+func withTolerantReader(s *Server, op Operation, fields []string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Body == nil || r.Body == http.NoBody || !strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+			next(w, r)
+			return
+		}
+
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			handleResponse[struct{}](s, w, r, op, nil, &ErrBadRequest{Err: fmt.Errorf("failed to read body: %w", err)})
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(b))
+
+		var data map[string]json.RawMessage
+		if json.Unmarshal(b, &data) != nil {
+			next(w, r)
+			return
+		}
+
+		var found bool
+		for _, name := range fields {
+			if _, ok := data[name]; ok {
+				delete(data, name)
+				found = true
+			}
+		}
+
+		if found {
+			b, err = json.Marshal(data)
+			if err != nil {
+				handleResponse[struct{}](s, w, r, op, nil, err)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewReader(b))
+			r.ContentLength = int64(len(b))
+		}
+		next(w, r)
+	}
+}
+
+var ErrMaintenanceMode = errors.New("the API is in maintenance mode, and is read-only")
+
+// IsMaintenanceMode returns true if the unwrapped/underlying error is of type ErrMaintenanceMode.
+func IsMaintenanceMode(err error) bool {
+	return errors.Is(err, ErrMaintenanceMode)
+}
+
+// SetMaintenanceMode enables or disables the maintenance mode of the server. While
+// enabled, all mutating operations (create, update and delete) are rejected with a
+// 503, while read and list operations are still served, e.g. to keep the API live
+// (read-only) while migrating the database. It's safe for concurrent use.
+func (s *Server) SetMaintenanceMode(enabled bool) {
+	s.maintenance.Store(enabled)
+}
+
+// MaintenanceMode returns true if the maintenance mode of the server is enabled (see
+// [Server.SetMaintenanceMode]).
+func (s *Server) MaintenanceMode() bool {
+	return s.maintenance.Load()
+}
+
+// rejectMaintenance returns true if the provided operation is rejected, as it
+// mutates entities while the server is in maintenance mode.
+func (s *Server) rejectMaintenance(op Operation) bool {
+	return op != OperationRead && op != OperationList && s.maintenance.Load()
+}
+
+var ErrFeatureDisabled = errors.New("the requested operation is not enabled")
+
+// IsFeatureDisabled returns true if the unwrapped/underlying error is of type ErrFeatureDisabled.
+func IsFeatureDisabled(err error) bool {
+	return errors.Is(err, ErrFeatureDisabled)
+}
+
+// FeatureGate decides whether operations are enabled.
+type FeatureGate interface {
+	// Enabled returns true if the provided operation of the provided schema (e.g.
+	// "Pet") is enabled for the request with the provided context. Edge endpoints use
+	// the schema the edge is defined on.
+	Enabled(ctx context.Context, op Operation, schema string) bool
+}
+
+// FeatureGateFunc is an adapter to allow the use of ordinary functions as a
+// [FeatureGate].
+type FeatureGateFunc func(ctx context.Context, op Operation, schema string) bool
+
+// Enabled calls fn(ctx, op, schema).
+func (fn FeatureGateFunc) Enabled(ctx context.Context, op Operation, schema string) bool {
+	return fn(ctx, op, schema)
+}
+
+// withFeatureGate wraps the provided handler, rejecting requests if the provided
+// operation of the provided schema is disabled (see [ServerConfig.FeatureGate]).
+func withFeatureGate(s *Server, op Operation, schema string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if s.config.FeatureGate != nil && !s.config.FeatureGate.Enabled(r.Context(), op, schema) {
+			handleResponse[struct{}](s, w, r, op, nil, ErrFeatureDisabled)
+			return
+		}
+		next(w, r)
+	}
+}
+
+// PayloadSizeBuckets are the upper bounds (in bytes) of the buckets of payload size
+// histograms (see [SizeHistogram]).
+var PayloadSizeBuckets = []int{1024, 4096, 16384, 65536, 262144, 1048576, 4194304, 16777216}
+
+// SizeHistogram is a histogram of payload sizes, in bytes.
+type SizeHistogram struct {
+	// Buckets are the cumulative number of payloads with a size lower than or equal to
+	// the matching upper bound of [PayloadSizeBuckets].
+	Buckets []int `json:"buckets"`
+	// Count is the total number of payloads.
+	Count int `json:"count"`
+	// Sum is the sum of the sizes of all payloads.
+	Sum int `json:"sum"`
+	// Max is the size of the largest payload.
+	Max int `json:"max"`
+}
+
+// observe records a payload of the provided size.
+func (h *SizeHistogram) observe(size int) {
+	if h.Buckets == nil {
+		h.Buckets = make([]int, len(PayloadSizeBuckets))
+	}
+
+	for i, bound := range PayloadSizeBuckets {
+		if size <= bound {
+			h.Buckets[i]++
+		}
+	}
+	h.Count++
+	h.Sum += size
+	h.Max = max(h.Max, size)
+}
+
+// OperationPayloadSizes are the payload size histograms of an operation.
+type OperationPayloadSizes struct {
+	// OperationID is the OpenAPI operation ID, e.g. "getPet".
+	OperationID string `json:"operation_id"`
+	// Request is the histogram of the sizes of request bodies.
+	Request SizeHistogram `json:"request"`
+	// Response is the histogram of the sizes of response bodies.
+	Response SizeHistogram `json:"response"`
+}
+
+// PayloadSizes returns the payload size histograms of each operation which has
+// served requests, ordered by operation ID.
+func (s *Server) PayloadSizes() []*OperationPayloadSizes {
+	s.payloadMu.Lock()
+	defer s.payloadMu.Unlock()
+
+	results := make([]*OperationPayloadSizes, 0, len(s.payloadSizes))
+	for _, v := range s.payloadSizes {
+		result := *v
+		result.Request.Buckets = slices.Clone(v.Request.Buckets)
+		result.Response.Buckets = slices.Clone(v.Response.Buckets)
+		results = append(results, &result)
+	}
+
+	slices.SortFunc(results, func(a, b *OperationPayloadSizes) int {
+		return strings.Compare(a.OperationID, b.OperationID)
+	})
+	return results
+}
+
+// observePayloadSize records the size of the request and response body of the
+// provided request (see [Server.PayloadSizes] and [ServerConfig.ObservePayloadSize]).
+func (s *Server) observePayloadSize(r *http.Request, operationID string, requestBytes, responseBytes int) {
+	s.payloadMu.Lock()
+	sizes, ok := s.payloadSizes[operationID]
+	if !ok {
+		sizes = &OperationPayloadSizes{OperationID: operationID}
+		s.payloadSizes[operationID] = sizes
+	}
+	sizes.Request.observe(requestBytes)
+	sizes.Response.observe(responseBytes)
+	s.payloadMu.Unlock()
+
+	if s.config.ObservePayloadSize != nil {
+		s.config.ObservePayloadSize(r, operationID, requestBytes, responseBytes)
+	}
+}
+
+// payloadReader counts the bytes read from a request body.
+type payloadReader struct {
+	io.ReadCloser
+	size int
+}
+
+func (r *payloadReader) Read(b []byte) (int, error) {
+	n, err := r.ReadCloser.Read(b)
+	r.size += n
+	return n, err
+}
+
+// payloadWriter counts the bytes written to a response body.
+type payloadWriter struct {
+	http.ResponseWriter
+	size int
+}
+
+func (w *payloadWriter) Write(b []byte) (int, error) {
+	n, err := w.ResponseWriter.Write(b)
+	w.size += n
+	return n, err
+}
+
+// withPayloadSize wraps the provided handler of the provided operation, recording the
+// size of the request and response body of each request (see [Server.PayloadSizes]).
+func withPayloadSize(s *Server, op Operation, operationID string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		body := &payloadReader{ReadCloser: r.Body}
+		if r.Body != nil {
+			r.Body = body
+		}
+
+		pw := &payloadWriter{ResponseWriter: w}
+		next(pw, r)
+
+		s.observePayloadSize(r, operationID, body.size, pw.size)
+	}
+}
+
+// lastModified returns the last modification time of the entity (or for lists, the
+// latest of the entities) which the provided response returns, and if it's known.
+func lastModified(resp any) (time.Time, bool) {
+	if p, ok := resp.(interface{ unwrapParent() any }); ok {
+		resp = p.unwrapParent()
+	}
+
+	switch v := resp.(type) {
+	case *ent.Category:
+		return latestModified([]*ent.Category{v}, lastModifiedCategory)
+	case *[]*ent.Category:
+		return latestModified(*v, lastModifiedCategory)
+	case *PagedResponse[ent.Category]:
+		return latestModified(v.Content, lastModifiedCategory)
+	case *ent.Settings:
+		return latestModified([]*ent.Settings{v}, lastModifiedSettings)
+	case *[]*ent.Settings:
+		return latestModified(*v, lastModifiedSettings)
+	case *PagedResponse[ent.Settings]:
+		return latestModified(v.Content, lastModifiedSettings)
+	case *ent.User:
+		return latestModified([]*ent.User{v}, lastModifiedUser)
+	case *[]*ent.User:
+		return latestModified(*v, lastModifiedUser)
+	case *PagedResponse[ent.User]:
+		return latestModified(v.Content, lastModifiedUser)
+	}
+	return time.Time{}, false
+}
+
+// pageETag returns a weak ETag of the page of entities which the provided (list)
+// response returns, and if it's known (see [pageETagOf]).
+func pageETag(resp any) (string, bool) {
+	if p, ok := resp.(interface{ unwrapParent() any }); ok {
+		resp = p.unwrapParent()
+	}
+
+	switch v := resp.(type) {
+	case *[]*ent.Category:
+		return pageETagOf(*v, len(*v), func(e *ent.Category) any { return e.ID }, lastModifiedCategory), true
+	case *PagedResponse[ent.Category]:
+		return pageETagOf(v.Content, v.TotalCount, func(e *ent.Category) any { return e.ID }, lastModifiedCategory), true
+	case *[]*ent.Settings:
+		return pageETagOf(*v, len(*v), func(e *ent.Settings) any { return e.ID }, lastModifiedSettings), true
+	case *PagedResponse[ent.Settings]:
+		return pageETagOf(v.Content, v.TotalCount, func(e *ent.Settings) any { return e.ID }, lastModifiedSettings), true
+	case *[]*ent.User:
+		return pageETagOf(*v, len(*v), func(e *ent.User) any { return e.ID }, lastModifiedUser), true
+	case *PagedResponse[ent.User]:
+		return pageETagOf(v.Content, v.TotalCount, func(e *ent.User) any { return e.ID }, lastModifiedUser), true
+	}
+	return "", false
+}
+
+// lastModifiedCategory returns the last modification time of the provided
+// entity, or the zero time if it's unknown.
+func lastModifiedCategory(e *ent.Category) time.Time {
+	return e.UpdatedAt
+}
+
+// lastModifiedSettings returns the last modification time of the provided
+// entity, or the zero time if it's unknown.
+func lastModifiedSettings(e *ent.Settings) time.Time {
+	return e.UpdatedAt
+}
+
+// lastModifiedUser returns the last modification time of the provided
+// entity, or the zero time if it's unknown.
+func lastModifiedUser(e *ent.User) time.Time {
+	return e.UpdatedAt
+}
+
+// latestModified returns the latest of the last modification times of the provided
+// entities, and false if none of them are known.
+func latestModified[T any](entities []*T, fn func(*T) time.Time) (latest time.Time, ok bool) {
+	for _, e := range entities {
+		if e == nil {
+			continue
+		}
+		if v := fn(e); !v.IsZero() && v.After(latest) {
+			latest = v
+		}
+	}
+	return latest, !latest.IsZero()
+}
+
+// pageETagOf returns a weak ETag of the provided page of entities, based on the total
+// number of entities, and the key and last modification time of each entity within
+// the page. Unlike the last modification time of the page, it changes when entities
+// are removed from (or added to) the page.
+func pageETagOf[T any](entities []*T, total int, key func(*T) any, fn func(*T) time.Time) string {
+	h := sha256.New()
+	_, _ = fmt.Fprintf(h, "%d\n", total)
+	for _, e := range entities {
+		if e == nil {
+			continue
+		}
+		_, _ = fmt.Fprintf(h, "%v %d\n", key(e), fn(e).UnixNano())
+	}
+	return `W/"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
+
+// notModifiedSince returns true if the request has an "If-Modified-Since" header, and
+// the provided last modification time isn't after it. As HTTP dates have a precision
+// of seconds, the last modification time is truncated to seconds.
+func notModifiedSince(r *http.Request, modified time.Time) bool {
+	if r.Header.Get("If-None-Match") != "" {
+		// If-None-Match takes precedence over If-Modified-Since (RFC 9110).
+		return false
+	}
+
+	since, err := http.ParseTime(r.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+	return !modified.Truncate(time.Second).After(since)
+}
+
+type readMaskKey struct{}
+
+// ReadMask is a parsed field mask (see Google AIP-161), as provided through the
+// "read_mask" query parameter of read and list operations. Each key is the name of a
+// field or eager-loaded edge, and the value of edges is the mask of the fields of the
+// edge, or nil if all fields of the edge are requested.
+type ReadMask map[string]ReadMask
+
+// ReadMaskFromContext returns the read mask of the request, or nil if all fields
+// are requested.
+func ReadMaskFromContext(ctx context.Context) ReadMask {
+	mask, _ := ctx.Value(readMaskKey{}).(ReadMask)
+	return mask
+}
+
+// readMaskFields are the fields of each entity which can be requested through read
+// masks, mapped to their database columns (or nil for computed fields).
+var readMaskFields = map[string]map[string][]string{
+	"Category": {
+		"id":        {category.FieldID},
+		"createdAt": {category.FieldCreatedAt},
+		"updatedAt": {category.FieldUpdatedAt},
+		"name":      {category.FieldName},
+		"readonly":  {category.FieldReadonly},
+		"nillable":  {category.FieldNillable},
+		"strings":   {category.FieldStrings},
+		"ints":      {category.FieldInts},
+	},
+	"Follows": {
+		"followedAt": {follows.FieldFollowedAt},
+		"userID":     {follows.FieldUserID},
+		"petID":      {follows.FieldPetID},
+	},
+	"Friendship": {
+		"id":        {friendship.FieldID},
+		"createdAt": {friendship.FieldCreatedAt},
+		"userID":    {friendship.FieldUserID},
+		"friendID":  {friendship.FieldFriendID},
+	},
+	"Pet": {
+		"id":        {pet.FieldID},
+		"name":      {pet.FieldName},
+		"nicknames": {pet.FieldNicknames},
+		"age":       {pet.FieldAge},
+		"type":      {pet.FieldType},
+	},
+	"Settings": {
+		"id":           {settings.FieldID},
+		"createdAt":    {settings.FieldCreatedAt},
+		"updatedAt":    {settings.FieldUpdatedAt},
+		"globalBanner": {settings.FieldGlobalBanner},
+	},
+	"User": {
+		"id":          {user.FieldID},
+		"createdAt":   {user.FieldCreatedAt},
+		"updatedAt":   {user.FieldUpdatedAt},
+		"name":        {user.FieldName},
+		"type":        {user.FieldType},
+		"description": {user.FieldDescription},
+		"enabled":     {user.FieldEnabled},
+		"email":       {user.FieldEmail},
+		"githubData":  {user.FieldGithubData},
+		"profileURL":  {user.FieldProfileURL},
+	},
+}
+
+// readMaskEdges are the eager-loaded edges of each entity which can be requested
+// through read masks, mapped to the entity they point to.
+var readMaskEdges = map[string]map[string]string{
+	"Follows": {
+		"user": "User",
+		"pet":  "Pet",
+	},
+	"Pet": {
+		"categories": "Category",
+		"owner":      "User",
+	},
+	"Settings": {
+		"admins": "User",
+	},
+	"User": {
+		"pets": "Pet",
+	},
+}
+
+// ParseReadMask parses the provided comma-separated read mask (e.g. "name,owner.name")
+// of the provided entity, returning an error if any of the paths are unknown, or nil
+// if all fields are requested (i.e. the mask is empty, or "*").
+func ParseReadMask(entity, mask string) (ReadMask, error) {
+	m := ReadMask{}
+	for _, path := range strings.Split(mask, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		if path == "*" {
+			return nil, nil
+		}
+
+		current, typ := m, entity
+		segments := strings.Split(path, ".")
+		for i, segment := range segments {
+			last := i == len(segments)-1
+
+			if _, ok := readMaskFields[typ][segment]; ok && last {
+				current[segment] = nil
+				break
+			}
+
+			next, ok := readMaskEdges[typ][segment]
+			if !ok {
+				return nil, &ErrBadRequest{Err: fmt.Errorf("unknown read mask path %q", path)}
+			}
+
+			sub, exists := current[segment]
+			if last {
+				current[segment] = nil
+				break
+			}
+			if exists && sub == nil {
+				// All fields of the edge are already requested.
+				break
+			}
+			if !exists {
+				sub = ReadMask{}
+				current[segment] = sub
+			}
+			current, typ = sub, next
+		}
+	}
+
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
+
+// withReadMask wraps the provided handler of a read or list operation which returns
+// the provided entity, parsing the read mask of the request, and storing it in the
+// request context.
+func withReadMask(s *Server, op Operation, entity string, next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		mask, err := ParseReadMask(entity, r.URL.Query().Get("read_mask"))
+		if err != nil {
+			handleResponse[struct{}](s, w, r, op, nil, err)
+			return
+		}
+		if mask != nil {
+			r = r.WithContext(context.WithValue(r.Context(), readMaskKey{}, mask))
+		}
+		next(w, r)
+	}
+}
+
+// readMaskColumns returns the database columns of the fields of the provided entity
+// which are requested through the read mask of the request, or nil if all columns
+// are needed (e.g. computed fields are requested, which may depend on any field).
+func readMaskColumns(ctx context.Context, entity string) []string {
+	mask := ReadMaskFromContext(ctx)
+	if mask == nil {
+		return nil
+	}
+
+	columns := []string{}
+	for name := range mask {
+		fields, ok := readMaskFields[entity][name]
+		if !ok {
+			continue // Edges are loaded separately.
+		}
+		if fields == nil {
+			return nil
+		}
+		columns = append(columns, fields...)
+	}
+	return columns
+}
+
+// readMaskCategoryQuery only selects the fields of the provided query which are
+// requested through the read mask of the request (see [ReadMaskFromContext]).
+func readMaskCategoryQuery(ctx context.Context, query *ent.CategoryQuery) *ent.CategoryQuery {
+	if columns := readMaskColumns(ctx, "Category"); columns != nil {
+		query.Select(append(columns, category.FieldID)...)
+	}
+	return query
+}
+
+// readMaskFriendshipQuery only selects the fields of the provided query which are
+// requested through the read mask of the request (see [ReadMaskFromContext]).
+func readMaskFriendshipQuery(ctx context.Context, query *ent.FriendshipQuery) *ent.FriendshipQuery {
+	if columns := readMaskColumns(ctx, "Friendship"); columns != nil {
+		query.Select(append(columns, friendship.FieldID)...)
+	}
+	return query
+}
+
+// readMaskPetQuery only selects the fields of the provided query which are
+// requested through the read mask of the request (see [ReadMaskFromContext]).
+func readMaskPetQuery(ctx context.Context, query *ent.PetQuery) *ent.PetQuery {
+	if columns := readMaskColumns(ctx, "Pet"); columns != nil {
+		query.Select(append(columns, pet.FieldID)...)
+	}
+	return query
+}
+
+// readMaskSettingsQuery only selects the fields of the provided query which are
+// requested through the read mask of the request (see [ReadMaskFromContext]).
+func readMaskSettingsQuery(ctx context.Context, query *ent.SettingsQuery) *ent.SettingsQuery {
+	if columns := readMaskColumns(ctx, "Settings"); columns != nil {
+		query.Select(append(columns, settings.FieldID)...)
+	}
+	return query
+}
+
+// readMaskUserQuery only selects the fields of the provided query which are
+// requested through the read mask of the request (see [ReadMaskFromContext]).
+func readMaskUserQuery(ctx context.Context, query *ent.UserQuery) *ent.UserQuery {
+	if columns := readMaskColumns(ctx, "User"); columns != nil {
+		query.Select(append(columns, user.FieldID)...)
+	}
+	return query
+}
+
+// apply removes the fields and edges which aren't requested through the read mask
+// from the provided (decoded) entity, or list of entities.
+func (m ReadMask) apply(v any) {
+	switch v := v.(type) {
+	case []any:
+		for _, e := range v {
+			m.apply(e)
+		}
+	case map[string]any:
+		for name := range v {
+			if _, ok := m[name]; !ok && name != "edges" && !strings.HasPrefix(name, "_") {
+				delete(v, name)
+			}
+		}
+
+		edges, _ := v["edges"].(map[string]any)
+		for name, e := range edges {
+			sub, ok := m[name]
+			if !ok {
+				delete(edges, name)
+				continue
+			}
+			if sub != nil {
+				sub.apply(e)
+			}
+		}
+		if len(edges) == 0 {
+			delete(v, "edges")
+		}
+	}
+}
+
+// readMaskResponse returns the provided response of the provided operation, with
+// only the fields requested through the read mask of the request (see
+// [ReadMaskFromContext]).
+func readMaskResponse(r *http.Request, op Operation, resp any) any {
+	mask := ReadMaskFromContext(r.Context())
+	if mask == nil {
+		return resp
+	}
+
+	b, err := json.Marshal(resp)
+	if err != nil {
+		return resp
+	}
+
+	var data any
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	if err = dec.Decode(&data); err != nil {
+		return resp
+	}
+
+	if m, ok := data.(map[string]any); ok && op == OperationList {
+		mask.apply(m["content"])
+	} else {
+		mask.apply(data)
+	}
+	return data
+}
+
+// Links represents a set of linkable-relationsips that can be represented through
+// the "Link" header. Note that all urls must be url-encoded already.
+type Links map[string]string
+
+func (l Links) String() string {
+	var links []string
+	var keys []string
+	for k := range l {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		links = append(links, fmt.Sprintf(`<%s>; rel=%q`, l[k], k))
+	}
+	return strings.Join(links, ", ")
+}
+
+type linkablePagedResource interface {
+	GetPage() int
+	GetIsLastPage() bool
+}
+
+// Route describes an endpoint of an entity, as mounted by [Server.Handler].
+type Route struct {
+	Method      string    // The HTTP method, e.g. "GET".
+	Pattern     string    // The path pattern, relative to [ServerConfig.BasePath], e.g. "/pets/{id}".
+	Operation   Operation // The operation of the endpoint.
+	OperationID string    // The OpenAPI operation ID, e.g. "getPet".
+	Entity      string    // The name of the entity, e.g. "Pet".
+}
+
+// URL returns the path of the route (relative to [ServerConfig.BasePath]), with its
+// path parameters replaced by the provided params (in order), e.g. "/pets/1".
+func (r Route) URL(params ...any) (string, error) {
+	var b strings.Builder
+	pattern := r.Pattern
+
+	var i int
+	for {
+		start := strings.IndexByte(pattern, '{')
+		if start == -1 {
+			b.WriteString(pattern)
+			break
+		}
+
+		end := strings.IndexByte(pattern[start:], '}')
+		if end == -1 {
+			return "", fmt.Errorf("invalid pattern %q for operation %q", r.Pattern, r.OperationID)
+		}
+		end += start
+
+		if i >= len(params) {
+			return "", fmt.Errorf("missing path parameter %s for operation %q", pattern[start:end+1], r.OperationID)
+		}
+
+		b.WriteString(pattern[:start])
+		b.WriteString(url.PathEscape(fmt.Sprint(params[i])))
+		pattern = pattern[end+1:]
+		i++
+	}
+
+	if i != len(params) {
+		return "", fmt.Errorf("operation %q expects %d path parameters, got %d", r.OperationID, i, len(params))
+	}
+	return b.String(), nil
+}
+
+// routes are the endpoints of all entities, see [Routes].
+var routes = []Route{
+	{Method: "GET", Pattern: "/categories", Operation: OperationList, OperationID: "listCategories", Entity: "Category"},
+	{Method: "GET", Pattern: "/categories/{id}", Operation: OperationRead, OperationID: "getCategory", Entity: "Category"},
+	{Method: "GET", Pattern: "/categories/{id}/pets", Operation: OperationList, OperationID: "listCategoryPets", Entity: "Category"},
+	{Method: "POST", Pattern: "/categories", Operation: OperationCreate, OperationID: "createCategory", Entity: "Category"},
+	{Method: "PATCH", Pattern: "/categories/{id}", Operation: OperationUpdate, OperationID: "updateCategory", Entity: "Category"},
+	{Method: "DELETE", Pattern: "/categories/{id}", Operation: OperationDelete, OperationID: "deleteCategory", Entity: "Category"},
+	{Method: "GET", Pattern: "/follows", Operation: OperationList, OperationID: "listFollows", Entity: "Follows"},
+	{Method: "GET", Pattern: "/follows/{userID}/{petID}", Operation: OperationRead, OperationID: "getFollow", Entity: "Follows"},
+	{Method: "POST", Pattern: "/follows", Operation: OperationCreate, OperationID: "createFollow", Entity: "Follows"},
+	{Method: "PATCH", Pattern: "/follows/{userID}/{petID}", Operation: OperationUpdate, OperationID: "updateFollow", Entity: "Follows"},
+	{Method: "DELETE", Pattern: "/follows/{userID}/{petID}", Operation: OperationDelete, OperationID: "deleteFollow", Entity: "Follows"},
+	{Method: "GET", Pattern: "/friendships", Operation: OperationList, OperationID: "listFriendships", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/friendships/{id}", Operation: OperationRead, OperationID: "getFriendship", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/friendships/{id}/user", Operation: OperationRead, OperationID: "getFriendshipUser", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/friendships/{id}/friend", Operation: OperationRead, OperationID: "getFriendshipFriend", Entity: "Friendship"},
+	{Method: "POST", Pattern: "/friendships", Operation: OperationCreate, OperationID: "createFriendship", Entity: "Friendship"},
+	{Method: "PATCH", Pattern: "/friendships/{id}", Operation: OperationUpdate, OperationID: "updateFriendship", Entity: "Friendship"},
+	{Method: "DELETE", Pattern: "/friendships/{id}", Operation: OperationDelete, OperationID: "deleteFriendship", Entity: "Friendship"},
+	{Method: "GET", Pattern: "/pets", Operation: OperationList, OperationID: "listPets", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}", Operation: OperationRead, OperationID: "getPet", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/categories", Operation: OperationList, OperationID: "listPetCategories", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/owner", Operation: OperationRead, OperationID: "getPetOwner", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/friends", Operation: OperationList, OperationID: "listPetFriends", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/followed-by", Operation: OperationList, OperationID: "listPetFollowedBys", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/following", Operation: OperationList, OperationID: "listPetFollowings", Entity: "Pet"},
+	{Method: "POST", Pattern: "/pets/{id}/following", Operation: OperationCreate, OperationID: "createPetFollowing", Entity: "Pet"},
+	{Method: "POST", Pattern: "/pets", Operation: OperationCreate, OperationID: "createPet", Entity: "Pet"},
+	{Method: "PATCH", Pattern: "/pets/{id}", Operation: OperationUpdate, OperationID: "updatePet", Entity: "Pet"},
+	{Method: "DELETE", Pattern: "/pets/{id}", Operation: OperationDelete, OperationID: "deletePet", Entity: "Pet"},
+	{Method: "GET", Pattern: "/settings", Operation: OperationList, OperationID: "listSettings", Entity: "Settings"},
+	{Method: "GET", Pattern: "/settings/{id}", Operation: OperationRead, OperationID: "getSetting", Entity: "Settings"},
+	{Method: "GET", Pattern: "/settings/{id}/admins", Operation: OperationList, OperationID: "listSettingAdmins", Entity: "Settings"},
+	{Method: "PATCH", Pattern: "/settings/{id}", Operation: OperationUpdate, OperationID: "updateSetting", Entity: "Settings"},
+	{Method: "GET", Pattern: "/users", Operation: OperationList, OperationID: "listUsers", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}", Operation: OperationRead, OperationID: "getUser", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/avatar", Operation: OperationRead, OperationID: "getUserAvatar", Entity: "User"},
+	{Method: "PUT", Pattern: "/users/{id}/avatar", Operation: OperationUpdate, OperationID: "uploadUserAvatar", Entity: "User"},
+	{Method: "DELETE", Pattern: "/users/{id}/avatar", Operation: OperationUpdate, OperationID: "deleteUserAvatar", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/pets", Operation: OperationList, OperationID: "listUserPets", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/followed-pets", Operation: OperationList, OperationID: "listUserFollowedPets", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/friends", Operation: OperationList, OperationID: "listUserFriends", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/following", Operation: OperationList, OperationID: "listUserFollowings", Entity: "User"},
+	{Method: "POST", Pattern: "/users/{id}/following", Operation: OperationCreate, OperationID: "createUserFollowing", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/friendships", Operation: OperationList, OperationID: "listUserFriendships", Entity: "User"},
+	{Method: "POST", Pattern: "/users/{id}/friendships", Operation: OperationCreate, OperationID: "createUserFriendship", Entity: "User"},
+	{Method: "POST", Pattern: "/users", Operation: OperationCreate, OperationID: "createUser", Entity: "User"},
+	{Method: "PATCH", Pattern: "/users/{id}", Operation: OperationUpdate, OperationID: "updateUser", Entity: "User"},
+	{Method: "DELETE", Pattern: "/users/{id}", Operation: OperationDelete, OperationID: "deleteUser", Entity: "User"},
+}
+
+// Routes returns the endpoints of all entities, as mounted by [Server.Handler]. This
+// can be used to build links to endpoints, or to sync routes with an API gateway.
+func Routes() []Route {
+	return slices.Clone(routes)
+}
+
+// URLFor returns the path (relative to [ServerConfig.BasePath]) of the endpoint with
+// the provided operation ID (see [Routes]), with its path parameters replaced by the
+// provided params (in order). For example, URLFor("getPet", 1) returns "/pets/1".
+func URLFor(operationID string, params ...any) (string, error) {
+	for _, r := range routes {
+		if r.OperationID == operationID {
+			return r.URL(params...)
+		}
+	}
+	return "", fmt.Errorf("unknown operation %q", operationID)
+}
+
+// MaxBatchRequests is the maximum number of requests which can be included in a
+// single batch.
+const MaxBatchRequests = 20
+
+// batchMethods are the methods of requests which can be included in a batch.
+var batchMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// ErrBatchRolledBack is the error of the responses of all requests of a transactional
+// batch, other than the request which failed, as the transaction was rolled back.
+var ErrBatchRolledBack = errors.New("batch transaction was rolled back, as another request failed")
+
+// BatchRequest defines parameters for executing a batch of requests via
+// "POST /batch".
+type BatchRequest struct {
+	// The requests to execute, in order.
+	Requests []*BatchRequestItem `json:"requests"`
+	// Execute all requests within a single transaction. Execution stops at the first
+	// failed request, and the transaction is rolled back.
+	Transactional bool `json:"transactional,omitempty"`
+}
+
+// BatchRequestItem is a single request within a batch.
+type BatchRequestItem struct {
+	// An optional ID of the request, which is included in its response.
+	ID string `json:"id,omitempty"`
+	// The method of the request.
+	Method string `json:"method"`
+	// The path of the request, including the query string (e.g. "/pets?page=2").
+	Path string `json:"path"`
+	// Headers of the request, which override those of the batch request.
+	Headers map[string]string `json:"headers,omitempty"`
+	// The JSON body of the request.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// BatchResponse is the response of a batch, with the responses of all requests, in
+// the same order.
+type BatchResponse struct {
+	Responses []*BatchResponseItem `json:"responses"`
+}
+
+// BatchResponseItem is the response of a single request within a batch.
+type BatchResponseItem struct {
+	// The ID of the request, if provided.
+	ID string `json:"id,omitempty"`
+	// The HTTP status code of the response.
+	Status int `json:"status"`
+	// Headers of the response.
+	Headers map[string]string `json:"headers,omitempty"`
+	// The body of the response, if any. JSON bodies are included as-is, and other
+	// bodies as a string.
+	Body json.RawMessage `json:"body,omitempty"`
+}
+
+// Validate checks that the number of requests is within bounds, and that all requests
+// use a supported method, and a path relative to the base path of the API.
+func (b *BatchRequest) Validate() error {
+	if len(b.Requests) == 0 {
+		return &ErrUnprocessable{Err: errors.New("at least one request must be provided")}
+	}
+	if len(b.Requests) > MaxBatchRequests {
+		return &ErrUnprocessable{Err: fmt.Errorf("at most %d requests can be provided", MaxBatchRequests)}
+	}
+	for i, item := range b.Requests {
+		if item == nil {
+			return &ErrUnprocessable{Err: fmt.Errorf("request %d: request must be provided", i)}
+		}
+		if !slices.Contains(batchMethods, item.Method) {
+			return &ErrUnprocessable{Err: fmt.Errorf("request %d: unsupported method %q", i, item.Method)}
+		}
+		uri, err := url.Parse(item.Path)
+		if err != nil || !strings.HasPrefix(item.Path, "/") || strings.HasPrefix(item.Path, "//") {
+			return &ErrUnprocessable{Err: fmt.Errorf("request %d: invalid path %q, which must be relative to the base path of the API", i, item.Path)}
+		}
+		if path.Clean(uri.Path) == "/batch" {
+			return &ErrUnprocessable{Err: fmt.Errorf("request %d: batches can't be nested", i)}
+		}
+	}
+	return nil
+}
+
+// batch maps to "POST /batch".
+func (s *Server) batch(w http.ResponseWriter, r *http.Request) {
+	params := &BatchRequest{}
+	if err := Bind(r, params); err != nil {
+		handleResponse[BatchResponse](s, w, r, OperationCreate, nil, err)
+		return
+	}
+	if err := params.Validate(); err != nil {
+		handleResponse[BatchResponse](s, w, r, OperationCreate, nil, err)
+		return
+	}
+
+	ctx := r.Context()
+
+	var tx *ent.Tx
+	if params.Transactional {
+		var err error
+		tx, err = s.db.Tx(ctx)
+		if err != nil {
+			handleResponse[BatchResponse](s, w, r, OperationCreate, nil, err)
+			return
+		}
+		defer func() {
+			if v := recover(); v != nil {
+				_ = tx.Rollback()
+				panic(v)
+			}
+		}()
+		ctx = context.WithValue(ctx, requestTxKey{}, tx.Client())
+	}
+
+	resp := &BatchResponse{Responses: make([]*BatchResponseItem, len(params.Requests))}
+	failed := -1
+
+	for i, item := range params.Requests {
+		resp.Responses[i] = s.serveBatchItem(ctx, r, item)
+		if tx != nil && resp.Responses[i].Status >= http.StatusBadRequest {
+			failed = i
+			break
+		}
+	}
+
+	if tx != nil {
+		if failed < 0 {
+			if err := tx.Commit(); err != nil {
+				handleResponse[BatchResponse](s, w, r, OperationCreate, nil, err)
+				return
+			}
+		} else {
+			if err := tx.Rollback(); err != nil {
+				handleResponse[BatchResponse](s, w, r, OperationCreate, nil, err)
+				return
+			}
+			for i, item := range params.Requests {
+				if i == failed {
+					continue
+				}
+				rec := newBatchResponseWriter()
+				handleResponse[BatchResponseItem](s, rec, r, OperationCreate, nil, ErrBatchRolledBack)
+				resp.Responses[i] = rec.response(item.ID)
+			}
+		}
+	}
+
+	JSON(w, r, http.StatusOK, resp)
+}
+
+// serveBatchItem executes the provided request of a batch against all endpoints of
+// the server, with the context and headers of the batch request, returning its
+// response.
+func (s *Server) serveBatchItem(ctx context.Context, r *http.Request, item *BatchRequestItem) *BatchResponseItem {
+	rec := newBatchResponseWriter()
+
+	var body io.Reader = http.NoBody
+	if len(item.Body) > 0 && string(item.Body) != "null" {
+		body = bytes.NewReader(item.Body)
+	}
+	// Requests are routed from scratch, rather than continuing the routing of the
+	// batch request.
+	ctx = context.WithValue(ctx, chi.RouteCtxKey, chi.NewRouteContext())
+	req, err := http.NewRequestWithContext(ctx, item.Method, item.Path, body)
+	if err != nil {
+		handleResponse[BatchResponseItem](s, rec, r, OperationCreate, nil, &ErrBadRequest{Err: err})
+		return rec.response(item.ID)
+	}
+
+	req.Header = r.Header.Clone()
+	req.Header.Del("Content-Length")
+	req.Header.Del("Content-Type")
+	if body != http.NoBody {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	for k, v := range item.Headers {
+		req.Header.Set(k, v)
+	}
+	req.Host = r.Host
+	req.RemoteAddr = r.RemoteAddr
+	req.TLS = r.TLS
+
+	s.batchHandler().ServeHTTP(rec, req)
+	return rec.response(item.ID)
+}
+
+// batchHandler returns the handler which requests of batches are executed against,
+// with all endpoints of the server.
+func (s *Server) batchHandler() http.Handler {
+	s.batchOnce.Do(func() {
+		r := chi.NewRouter()
+		s.Handler(r)
+		s.batchMux = r
+	})
+	return s.batchMux
+}
+
+// batchResponseWriter records the response of a request within a batch.
+type batchResponseWriter struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func newBatchResponseWriter() *batchResponseWriter {
+	return &batchResponseWriter{header: http.Header{}}
+}
+
+func (w *batchResponseWriter) Header() http.Header {
+	return w.header
+}
+
+func (w *batchResponseWriter) WriteHeader(status int) {
+	if w.status == 0 {
+		w.status = status
+	}
+}
+
+func (w *batchResponseWriter) Write(b []byte) (int, error) {
+	w.WriteHeader(http.StatusOK)
+	return w.body.Write(b)
+}
+
+// response returns the recorded response, with the provided request ID.
+func (w *batchResponseWriter) response(id string) *BatchResponseItem {
+	resp := &BatchResponseItem{ID: id, Status: w.status}
+	if resp.Status == 0 {
+		resp.Status = http.StatusOK
+	}
+
+	if len(w.header) > 0 {
+		resp.Headers = make(map[string]string, len(w.header))
+		for k, v := range w.header {
+			resp.Headers[k] = strings.Join(v, ", ")
+		}
+	}
+
+	if body := bytes.TrimSpace(w.body.Bytes()); len(body) > 0 {
+		if json.Valid(body) {
+			resp.Body = body
+		} else {
+			resp.Body, _ = json.Marshal(string(body))
+		}
+	}
+	return resp
+}
+
+// OutboxDeleted is the payload of outbox entries for deleted entities.
+type OutboxDeleted struct {
+	ID int `json:"id"`
+}
+
+// writeOutbox inserts an outbox entry for the provided event, using the provided
+// (transactional) client, so the entry is only stored if the mutation is committed.
+func writeOutbox(ctx context.Context, tx *ent.Client, event, entity string, id int, data any) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return fmt.Errorf("failed to marshal outbox payload: %w", err)
+	}
+	return tx.Outbox.Create().
+		SetEvent(event).
+		SetEntity(entity).
+		SetEntityID(id).
+		SetPayload(payload).
+		SetCreatedAt(time.Now().UTC()).
+		Exec(ctx)
+}
+
+// DecodeOutboxPayload decodes the payload of the provided outbox entry into the
+// type of the entity (e.g. *ent.Pet), matching its REST schema, or [OutboxDeleted]
+// for deleted entities.
+func DecodeOutboxPayload(entry *ent.Outbox) (any, error) {
+	var v any
+	switch entry.Event {
+	case "category.created":
+		v = &ent.Category{}
+	case "category.updated":
+		v = &ent.Category{}
+	case "category.deleted":
+		v = &OutboxDeleted{}
+	case "friendship.created":
+		v = &ent.Friendship{}
+	case "friendship.updated":
+		v = &ent.Friendship{}
+	case "friendship.deleted":
+		v = &OutboxDeleted{}
+	case "pet.created":
+		v = &ent.Pet{}
+	case "pet.updated":
+		v = &ent.Pet{}
+	case "pet.deleted":
+		v = &OutboxDeleted{}
+	case "settings.updated":
+		v = &ent.Settings{}
+	case "user.created":
+		v = &ent.User{}
+	case "user.updated":
+		v = &ent.User{}
+	case "user.deleted":
+		v = &OutboxDeleted{}
+	default:
+		return nil, fmt.Errorf("unknown outbox event %q", entry.Event)
+	}
+
+	if err := json.Unmarshal(entry.Payload, v); err != nil {
+		return nil, fmt.Errorf("failed to decode outbox payload of event %q: %w", entry.Event, err)
+	}
+	return v, nil
+}
+
+// ProcessOutbox invokes fn for up to limit (or all, if limit is <= 0) unprocessed
+// outbox entries, in the order they were inserted, marking each entry as processed
+// once fn returns successfully. Processing stops at the first error, which is
+// returned, so the entry is retried on the next invocation. fn should be idempotent,
+// as an entry may be processed more than once (e.g. if marking it as processed fails),
+// and invocations must not run concurrently. Returns the number of processed entries.
+func ProcessOutbox(ctx context.Context, db *ent.Client, limit int, fn func(ctx context.Context, entry *ent.Outbox) error) (int, error) {
+	query := db.Outbox.Query().
+		Where(outbox.ProcessedAtIsNil()).
+		Order(outbox.ByID())
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+
+	entries, err := query.All(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	for i, entry := range entries {
+		if err = fn(ctx, entry); err != nil {
+			return i, err
+		}
+
+		err = db.Outbox.UpdateOne(entry).SetProcessedAt(time.Now().UTC()).Exec(ctx)
+		if err != nil {
+			return i, err
+		}
+	}
+	return len(entries), nil
+}
+
+// specVariant is a pre-rendered variant of the OpenAPI spec, as served by
+// [Server.Spec].
+type specVariant struct {
+	data        []byte // The encoded spec.
+	gzip        []byte // The gzip compressed version of data.
+	etag        string // A strong ETag of data.
+	contentType string // The content type of data.
+}
+
+// newSpecVariant pre-renders the provided OpenAPI spec (JSON or YAML encoded),
+// injecting the server URL (see [ServerConfig.DisableSpecInjectServer]).
+func (s *Server) newSpecVariant(data []byte, isYAML bool) (*specVariant, error) {
+	if !s.config.DisableSpecInjectServer && s.config.BaseURL != "" {
+		if isYAML {
+			// The YAML spec is generated with top-level keys at the start of a
+			// line, so a servers key can be detected, and prepended, without
+			// having to decode the spec.
+			if !bytes.HasPrefix(data, []byte("servers:")) && !bytes.Contains(data, []byte("\nservers:")) {
+				uri, err := json.Marshal(s.config.BaseURL)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal server URL: %w", err)
+				}
+				data = append([]byte("servers:\n    - url: "+string(uri)+"\n"), data...)
+			}
+		} else {
+			spec := map[string]any{}
+			err := json.Unmarshal(data, &spec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal spec: %w", err)
+			}
+
+			type Server struct {
+				URL string `json:"url"`
+			}
+
+			if _, ok := spec["servers"]; !ok {
+				spec["servers"] = []Server{{URL: s.config.BaseURL}}
+				data, err = json.Marshal(spec)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal spec: %w", err)
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	gw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = gw.Write(data); err != nil {
+		return nil, err
+	}
+	if err = gw.Close(); err != nil {
+		return nil, err
+	}
+
+	contentType := "application/json"
+	if isYAML {
+		contentType = "application/yaml"
+	}
+
+	sum := sha256.Sum256(data)
+	return &specVariant{
+		data:        data,
+		gzip:        buf.Bytes(),
+		etag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		contentType: contentType,
+	}, nil
+}
+
+// Spec returns the OpenAPI spec for the server implementation, as JSON. Supports
+// conditional requests (through the ETag of the spec), gzip compression, and
+// cross-origin requests. The specs of additional generation targets can be selected
+// through the "version" query parameter (e.g. "?version=public").
+func (s *Server) Spec(w http.ResponseWriter, r *http.Request) {
+	s.serveSpec(w, r, s.specs)
+}
+
+// serveSpec serves the requested variant of the provided specs (see [Server.Spec]).
+func (s *Server) serveSpec(w http.ResponseWriter, r *http.Request, specs map[string]*specVariant) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "*")
+		w.Header().Set("Access-Control-Max-Age", "86400")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	spec, ok := specs[r.URL.Query().Get("version")]
+	if !ok {
+		handleResponse[struct{}](s, w, r, "", nil, &ErrBadRequest{
+			Err: fmt.Errorf("unknown spec version %q", r.URL.Query().Get("version")),
+		})
+		return
+	}
+
+	w.Header().Set("Access-Control-Expose-Headers", "ETag")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("ETag", spec.etag)
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	if etagMatches(r.Header.Get("If-None-Match"), spec.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", spec.contentType)
+	if acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(spec.gzip)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(spec.data)
+}
+
+// etagMatches returns true if the provided If-None-Match header matches the
+// provided ETag (using weak comparison).
+func etagMatches(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip returns true if the client accepts gzip compressed responses.
+func acceptsGzip(r *http.Request) bool {
+	for _, v := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(v), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+var scalarTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>API Reference</title>
+    <meta charset="utf-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1" />
+    <meta name="darkreader-lock">
+    <link rel="icon" type="image/svg+xml"
+      href="data:image/svg+xml,%3Csvg xmlns='http://www.w3.org/2000/svg' width='32' height='32' viewBox='0 0 1024 1024'%3E%3Cpath fill='currentColor' d='m917.7 148.8l-42.4-42.4c-1.6-1.6-3.6-2.3-5.7-2.3s-4.1.8-5.7 2.3l-76.1 76.1a199.27 199.27 0 0 0-112.1-34.3c-51.2 0-102.4 19.5-141.5 58.6L432.3 308.7a8.03 8.03 0 0 0 0 11.3L704 591.7c1.6 1.6 3.6 2.3 5.7 2.3c2 0 4.1-.8 5.7-2.3l101.9-101.9c68.9-69 77-175.7 24.3-253.5l76.1-76.1c3.1-3.2 3.1-8.3 0-11.4M769.1 441.7l-59.4 59.4l-186.8-186.8l59.4-59.4c24.9-24.9 58.1-38.7 93.4-38.7s68.4 13.7 93.4 38.7c24.9 24.9 38.7 58.1 38.7 93.4s-13.8 68.4-38.7 93.4m-190.2 105a8.03 8.03 0 0 0-11.3 0L501 613.3L410.7 523l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3L441 408.6a8.03 8.03 0 0 0-11.3 0L363 475.3l-43-43a7.85 7.85 0 0 0-5.7-2.3c-2 0-4.1.8-5.7 2.3L206.8 534.2c-68.9 69-77 175.7-24.3 253.5l-76.1 76.1a8.03 8.03 0 0 0 0 11.3l42.4 42.4c1.6 1.6 3.6 2.3 5.7 2.3s4.1-.8 5.7-2.3l76.1-76.1c33.7 22.9 72.9 34.3 112.1 34.3c51.2 0 102.4-19.5 141.5-58.6l101.9-101.9c3.1-3.1 3.1-8.2 0-11.3l-43-43l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3zM441.7 769.1a131.32 131.32 0 0 1-93.4 38.7c-35.3 0-68.4-13.7-93.4-38.7a131.32 131.32 0 0 1-38.7-93.4c0-35.3 13.7-68.4 38.7-93.4l59.4-59.4l186.8 186.8z'/%3E%3C/svg%3E" />
+  </head>
+  <body>
+    <script id="api-reference"></script>
+    <script>
+      document.getElementById("api-reference").dataset.configuration = JSON.stringify({
+        spec: {
+          url: "{{ $.SpecPath }}",
+        },
+        {{- if $.DisableSpecInjectServer }}
+        servers: [
+            {url: window.location.origin + window.location.pathname.replace(/\/docs$/g, "")}
+        ],
+        {{- end }}
+        theme: "kepler",
+        isEditable: false,
+        hideDownloadButton: true,
+        customCss: ".darklight-reference-promo { visibility: hidden !important; height: 0 !important; }",
+      });
+    </script>
+    <script
+      src="https://cdn.jsdelivr.net/npm/@scalar/api-reference@1.25.3"
+      integrity="sha256-NKouGW+1wM6fSI5l+lWltgWmD++6ircOAcyI3Sr8gR4="
+      crossorigin="anonymous"
+    ></script>
+  </body>
+</html>`))
+
+func (s *Server) Docs(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	err := scalarTemplate.Execute(&buf, map[string]any{
+		"SpecPath":                s.config.BasePath + "/openapi.json",
+		"DisableSpecInjectServer": s.config.DisableSpecInjectServer,
+	})
+	if err != nil {
+		handleResponse[struct{}](s, w, r, "", nil, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	w.Header().Set("Content-Security-Policy", "default-src 'self' cdn.jsdelivr.net fonts.scalar.com 'unsafe-inline' 'unsafe-eval' data: blob:")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Referrer-Policy", "no-referrer-when-downgrade")
+	w.Header().Set("Permissions-Policy", "clipboard-write=(self)")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+type ServerConfig struct {
+	// BaseURL is similar to [ServerConfig.BasePath], however, only the path of the URL is used
+	// to prefill BasePath. This is not required if BasePath is provided.
+	BaseURL string
+
+	// BasePath if provided, and the spec endpoints are enabled, will allow annotating
+	// API responses with "Link" headers. See [ServerConfig.EnableLinks] for more information.
+	BasePath string
+
+	// DisableSpecHandler if set to true, will disable the spec endpoints (e.g. /openapi.json).
+	// This will also disable the embedded API reference documentation, see
+	// [ServerConfig.DisableDocs] for more information.
+	DisableSpecHandler bool
+
+	// DisableSpecInjectServer if set to true, will disable the automatic injection of the
+	// server URL into the spec. This only applies if [ServerConfig.BaseURL] is provided.
+	DisableSpecInjectServer bool
+
+	// DisableDocsHandler if set to true, will disable the embedded API reference documentation
+	// endpoint at /docs. Use this if you want to provide your own documentation functionality.
+	// This is disabled by default if [ServerConfig.DisableSpecHandler] is true.
+	DisableDocsHandler bool
+
+	// EnableLinks if set to true, will enable the "Link" response header, which can be used to hint
+	// to clients about the location of the OpenAPI spec, API documentation, how to auto-paginate
+	// through results, and more.
+	EnableLinks bool
+
+	// MaintenanceMode is the initial state of the maintenance mode of the server (see
+	// [Server.SetMaintenanceMode]).
+	MaintenanceMode bool
+
+	// FeatureGate is consulted for each request to an entity endpoint, and rejects the
+	// request if the operation is disabled (e.g. to ship new endpoints dark, and enable
+	// them per environment or per tenant). If not provided, all operations are enabled.
+	FeatureGate FeatureGate
+
+	// FeatureGateForbidden if set to true, will cause requests to operations disabled
+	// by the [ServerConfig.FeatureGate] to be rejected with a 403 "Forbidden", rather
+	// than a 404 "Not Found".
+	FeatureGateForbidden bool
+
+	// ObservePayloadSize is invoked with the size (in bytes) of the request and response
+	// body of each request to an entity endpoint, e.g. to record them in external
+	// metrics. They're also recorded in the histograms of [Server.PayloadSizes].
+	ObservePayloadSize func(r *http.Request, operationID string, requestBytes, responseBytes int)
+
+	// MaskErrors if set to true, will mask the error message returned to the client,
+	// returning a generic error message based on the HTTP status code.
+	MaskErrors bool
+
+	// ErrorHandler is invoked when an error occurs. If not provided, the default
+	// error handling logic will be used. If you want to run logic on errors, but
+	// not actually handle the error yourself, you can still call [Server.DefaultErrorHandler]
+	// after your logic.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, op Operation, err error)
+
+	// GetReqID returns the request ID for the given request. If not provided, the
+	// default implementation will use the X-Request-Id header, otherwise an empty
+	// string will be returned. If using go-chi, middleware.GetReqID will be used.
+	GetReqID func(r *http.Request) string
+
+	// ResponseHeaders provides the values of response headers (e.g. those documented
+	// through GlobalResponseHeaders, like X-Ratelimit-Limit), which are set before the
+	// response is written.
+	ResponseHeaders ResponseHeaderProvider
+
+	// ClientSelector selects the ent client used by each request (e.g. to route read
+	// operations to a read-replica, and mutations to the primary). If not provided, the
+	// client provided to [NewServer] is used for all requests.
+	ClientSelector ClientSelector
+
+	// Interceptors returns the ent interceptors of the provided request, which apply to
+	// all queries executed by the handler of the request (e.g. to scope queries to the
+	// tenant of the request, or filter out soft-deleted entities), rather than being
+	// registered globally on the client. See [RequestInterceptor].
+	Interceptors func(r *http.Request, op Operation) []ent.Interceptor
+
+	// Hooks returns the ent hooks of the provided request, which apply to all mutations
+	// executed by the handler of the request (e.g. to set the tenant or author of created
+	// entities). See [RequestHook].
+	Hooks func(r *http.Request, op Operation) []ent.Hook
+
+	// Retry configures the retries of transactions of mutations which fail with a
+	// transient error, e.g. a serialization failure or deadlock (see [IsTransientError]).
+	// If not provided, the defaults of [RetryPolicy] are used.
+	Retry *RetryPolicy
+}
+
+// ResponseHeaderProvider provides the values of response headers. The entity is the
+// result of the operation (e.g. *ent.Pet, or a paged response for lists), or nil for
+// errors and responses without a body.
+type ResponseHeaderProvider interface {
+	ResponseHeaders(ctx context.Context, op Operation, entity any) map[string]string
+}
+
+// ResponseHeadersFunc is an adapter to allow the use of ordinary functions as a
+// [ResponseHeaderProvider].
+type ResponseHeadersFunc func(ctx context.Context, op Operation, entity any) map[string]string
+
+// ResponseHeaders calls fn(ctx, op, entity).
+func (fn ResponseHeadersFunc) ResponseHeaders(ctx context.Context, op Operation, entity any) map[string]string {
+	return fn(ctx, op, entity)
+}
+
+// ClientSelector selects the ent client used by each request.
+type ClientSelector interface {
+	// SelectClient returns the client to use for the provided request of the provided
+	// operation, where primary is the client provided to [NewServer]. Returning nil
+	// uses the primary client. Note that mutations which re-fetch the entity (see
+	// Config.ReadYourWrites in entrest) use the same client for both.
+	SelectClient(r *http.Request, op Operation, primary *ent.Client) *ent.Client
+}
+
+// ClientSelectorFunc is an adapter to allow the use of ordinary functions as a
+// [ClientSelector].
+type ClientSelectorFunc func(r *http.Request, op Operation, primary *ent.Client) *ent.Client
+
+// SelectClient calls fn(r, op, primary).
+func (fn ClientSelectorFunc) SelectClient(r *http.Request, op Operation, primary *ent.Client) *ent.Client {
+	return fn(r, op, primary)
+}
+
+type Server struct {
+	db           *ent.Client
+	config       *ServerConfig
+	maintenance  atomic.Bool
+	batchOnce    sync.Once
+	batchMux     http.Handler
+	payloadMu    sync.Mutex
+	payloadSizes map[string]*OperationPayloadSizes
+	specs        map[string]*specVariant
+}
+
+// NewServer returns a new auto-generated server implementation for your ent schema.
+// [Server.Handler] returns a ready-to-use http.Handler that mounts all of the
+// necessary endpoints.
+func NewServer(db *ent.Client, config *ServerConfig) (*Server, error) {
+	s := &Server{
+		db:     db,
+		config: config,
+	}
+	if s.config == nil {
+		s.config = &ServerConfig{}
+	}
+	s.payloadSizes = map[string]*OperationPayloadSizes{}
+	s.maintenance.Store(s.config.MaintenanceMode)
+	if s.config.Interceptors != nil {
+		db.Intercept(RequestInterceptor)
+	}
+	if s.config.Hooks != nil {
+		db.Use(RequestHook)
+	}
+	if s.config.BaseURL != "" && s.config.BasePath == "" {
+		uri, err := url.Parse(s.config.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse BaseURL: %w", err)
+		}
+		s.config.BasePath = uri.Path
+	}
+	if s.config.BaseURL == "" {
+		s.config.DisableSpecInjectServer = true
+	}
+	if s.config.BasePath != "" {
+		if !strings.HasPrefix(s.config.BasePath, "/") {
+			s.config.BasePath = "/" + s.config.BasePath
+		}
+		s.config.BasePath = strings.TrimRight(s.config.BasePath, "/")
+	}
+	if !s.config.DisableSpecHandler {
+		variants := map[string][]byte{"": OpenAPI}
+
+		s.specs = make(map[string]*specVariant, len(variants))
+		for version, data := range variants {
+			variant, err := s.newSpecVariant(data, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to prepare %q spec: %w", version, err)
+			}
+			s.specs[version] = variant
+		}
+	}
+	return s, nil
+}
+
+type clientKey struct{}
+
+// withClient returns the provided request, with the client selected for the provided
+// operation (see [ServerConfig.ClientSelector]), and the interceptors and hooks of the
+// operation, stored in its context.
+func (s *Server) withClient(r *http.Request, op Operation) *http.Request {
+	r = s.withInterceptors(r, op)
+	if db, ok := requestTx(r.Context()); ok {
+		// Requests which are executed within a transaction (e.g. dry runs) use its client.
+		return r.WithContext(ent.NewContext(context.WithValue(r.Context(), clientKey{}, db), db))
+	}
+	if s.config.ClientSelector == nil {
+		return r
+	}
+
+	db := s.config.ClientSelector.SelectClient(r, op, s.db)
+	if db == nil || db == s.db {
+		return r
+	}
+	return r.WithContext(ent.NewContext(context.WithValue(r.Context(), clientKey{}, db), db))
+}
+
+// client returns the client selected for the request of the provided context (see
+// [ServerConfig.ClientSelector]), or the primary client.
+func (s *Server) client(ctx context.Context) *ent.Client {
+	if db, ok := ctx.Value(clientKey{}).(*ent.Client); ok {
+		return db
+	}
+	return s.db
+}
+
+type (
+	requestInterceptorsKey struct{}
+	requestHooksKey        struct{}
+)
+
+// withInterceptors returns the provided request, with the ent interceptors and hooks
+// of the provided operation (see [ServerConfig.Interceptors] and [ServerConfig.Hooks])
+// stored in its context.
+func (s *Server) withInterceptors(r *http.Request, op Operation) *http.Request {
+	ctx := r.Context()
+
+	if s.config.Interceptors != nil {
+		if inters := s.config.Interceptors(r, op); len(inters) > 0 {
+			ctx = context.WithValue(ctx, requestInterceptorsKey{}, inters)
+		}
+	}
+
+	if s.config.Hooks != nil {
+		if hooks := s.config.Hooks(r, op); len(hooks) > 0 {
+			ctx = context.WithValue(ctx, requestHooksKey{}, hooks)
+		}
+	}
+
+	if ctx == r.Context() {
+		return r
+	}
+	return r.WithContext(ctx)
+}
+
+// RequestInterceptor is an ent interceptor which applies the interceptors of the
+// request (see [ServerConfig.Interceptors]) to all queries executed with the request
+// context, including traversal interceptors (e.g. tenant scoping, or soft-delete
+// filters). It's registered on the client provided to [NewServer] when
+// [ServerConfig.Interceptors] is provided, and must be registered on any other client
+// returned by [ServerConfig.ClientSelector].
+var RequestInterceptor ent.Interceptor = requestInterceptor{}
+
+type requestInterceptor struct{}
+
+// Intercept implements [ent.Interceptor].
+func (requestInterceptor) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		inters, _ := ctx.Value(requestInterceptorsKey{}).([]ent.Interceptor)
+
+		querier := next
+		for i := len(inters) - 1; i >= 0; i-- {
+			querier = inters[i].Intercept(querier)
+		}
+		return querier.Query(ctx, q)
+	})
+}
+
+// Traverse implements [ent.Traverser].
+func (requestInterceptor) Traverse(ctx context.Context, q ent.Query) error {
+	inters, _ := ctx.Value(requestInterceptorsKey{}).([]ent.Interceptor)
+
+	for _, inter := range inters {
+		if trv, ok := inter.(ent.Traverser); ok {
+			if err := trv.Traverse(ctx, q); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RequestHook is an ent hook which applies the hooks of the request (see
+// [ServerConfig.Hooks]) to all mutations executed with the request context. It's
+// registered on the client provided to [NewServer] when [ServerConfig.Hooks] is
+// provided, and must be registered on any other client returned by
+// [ServerConfig.ClientSelector].
+func RequestHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		hooks, _ := ctx.Value(requestHooksKey{}).([]ent.Hook)
+
+		mutator := next
+		for i := len(hooks) - 1; i >= 0; i-- {
+			mutator = hooks[i](mutator)
+		}
+		return mutator.Mutate(ctx, m)
+	})
+}
+
+// DefaultErrorHandler is the default error handler for the Server.
+func (s *Server) DefaultErrorHandler(w http.ResponseWriter, r *http.Request, op Operation, err error) {
+	ts := time.Now().UTC().Format(time.RFC3339)
+
+	resp := ErrorResponse{
+		Error:     err.Error(),
+		Timestamp: ts,
+	}
+
+	var numErr *strconv.NumError
+
+	switch {
+	case IsEndpointNotFound(err):
+		resp.Code = http.StatusNotFound
+	case IsMethodNotAllowed(err):
+		resp.Code = http.StatusMethodNotAllowed
+	case IsBadRequest(err):
+		resp.Code = http.StatusBadRequest
+	case IsUnknownField(err):
+		resp.Code = 400
+	case IsUnprocessable(err):
+		resp.Code = 422
+	case IsConflict(err):
+		resp.Code = http.StatusConflict
+	case IsTransientError(err):
+		// Serialization failures, deadlocks and lock timeouts, which remain after all
+		// retries of the transaction are exhausted.
+		resp.Code = transientErrorStatus(err)
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(s.config.Retry.retryAfter().Seconds()))))
+	case errors.Is(err, ErrFileNotFound):
+		resp.Code = http.StatusNotFound
+	case errors.Is(err, ErrFileTooLarge):
+		resp.Code = http.StatusRequestEntityTooLarge
+	case errors.Is(err, ErrUnsupportedMediaType):
+		resp.Code = http.StatusUnsupportedMediaType
+	case errors.Is(err, privacy.Deny):
+		resp.Code = http.StatusForbidden
+	case errors.Is(err, ErrBatchRolledBack):
+		resp.Code = http.StatusFailedDependency
+	case IsMaintenanceMode(err):
+		resp.Code = http.StatusServiceUnavailable
+	case IsFeatureDisabled(err) && s.config.FeatureGateForbidden:
+		resp.Code = http.StatusForbidden
+	case IsFeatureDisabled(err):
+		resp.Code = http.StatusNotFound
+	case ent.IsNotFound(err):
+		resp.Code = http.StatusNotFound
+	case sqlgraph.IsForeignKeyConstraintError(err) && (op == OperationCreate || op == OperationUpdate):
+		// The entity references another entity (e.g. through an edge field) which
+		// doesn't exist. Deleting an entity which is still referenced is a conflict.
+		resp.Code = http.StatusNotFound
+	case ent.IsConstraintError(err), ent.IsNotSingular(err):
+		resp.Code = http.StatusConflict
+	case ent.IsValidationError(err):
+		resp.Code = 422
+	case errors.As(err, &numErr):
+		resp.Code = http.StatusBadRequest
+		resp.Error = fmt.Sprintf("invalid ID provided: %v", err)
+	default:
+		resp.Code = http.StatusInternalServerError
+	}
+
+	if resp.Type == "" {
+		resp.Type = http.StatusText(resp.Code)
+	}
+	if s.config.MaskErrors {
+		resp.Error = http.StatusText(resp.Code)
+	}
+	if s.config.GetReqID != nil {
+		resp.RequestID = s.config.GetReqID(r)
+	} else {
+		resp.RequestID = middleware.GetReqID(r.Context())
+	}
+	JSON(w, r, resp.Code, resp)
+}
+
+func handleResponse[Resp any](s *Server, w http.ResponseWriter, r *http.Request, op Operation, resp *Resp, err error) {
+	if s.config.EnableLinks {
+		links := Links{}
+		if !s.config.DisableSpecHandler {
+			links["service-desc"] = s.config.BasePath + "/openapi.json"
+			links["describedby"] = s.config.BasePath + "/openapi.json"
+		}
+
+		if err == nil && resp != nil && op == OperationList {
+			if lr, ok := any(resp).(linkablePagedResource); ok {
+				query := r.URL.Query()
+				if page := lr.GetPage(); page > 1 {
+					query.Set("page", strconv.Itoa(page-1))
+					r.URL.RawQuery = query.Encode()
+					links["prev"] = r.URL.String()
+					if !strings.HasPrefix(links["prev"], s.config.BasePath) {
+						links["prev"] = s.config.BasePath + links["prev"]
+					}
+				}
+				if !lr.GetIsLastPage() {
+					query.Set("page", strconv.Itoa(lr.GetPage()+1))
+					r.URL.RawQuery = query.Encode()
+					links["next"] = r.URL.String()
+					if !strings.HasPrefix(links["next"], s.config.BasePath) {
+						links["next"] = s.config.BasePath + links["next"]
+					}
+				}
+			}
+		}
+
+		if v := links.String(); v != "" {
+			w.Header().Set("Link", v)
+		}
+	}
+	if s.config.ResponseHeaders != nil {
+		var entity any
+		if err == nil && resp != nil {
+			entity = resp
+		}
+		for k, v := range s.config.ResponseHeaders.ResponseHeaders(r.Context(), op, entity) {
+			w.Header().Set(k, v)
+		}
+	}
+
+	if err != nil {
+		if s.config.ErrorHandler != nil {
+			s.config.ErrorHandler(w, r, op, err)
+			return
+		}
+		s.DefaultErrorHandler(w, r, op, err)
+		return
+	}
+	if resp != nil {
+		if f, ok := any(resp).(*File); ok {
+			writeFile(w, r, f)
+			return
+		}
+		if op == OperationCreate {
+			if loc := s.entityLocation(resp); loc != "" {
+				w.Header().Set("Location", loc)
+			}
+		}
+		if r.Method == http.MethodGet || r.Method == http.MethodHead {
+			switch op {
+			case OperationRead:
+				if modified, ok := lastModified(resp); ok {
+					w.Header().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+					if notModifiedSince(r, modified) {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+				}
+			case OperationList:
+				if modified, ok := lastModified(resp); ok {
+					w.Header().Set("Last-Modified", modified.UTC().Format(http.TimeFormat))
+				}
+				if etag, ok := pageETag(resp); ok {
+					w.Header().Set("ETag", etag)
+					if etagMatches(r.Header.Get("If-None-Match"), etag) {
+						w.WriteHeader(http.StatusNotModified)
+						return
+					}
+				}
+			}
+		}
+		type pagedResp interface {
+			GetTotalCount() int
+		}
+		status := http.StatusOK
+		if op == OperationCreate {
+			status = http.StatusCreated
+		}
+		JSON(w, r, status, readMaskResponse(r, op, toResponseDTO(resp)))
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UseEntContext can be used to inject an [ent.Client] into the context for use
+// by other middleware, or ent privacy layers. Note that the server will do this
+// by default, so you don't need to do this manually, unless it's a context that's
+// not being passed to the server and is being consumed elsewhere.
+func UseEntContext(db *ent.Client) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(ent.NewContext(r.Context(), db)))
+		})
+	}
+}
+
+// Handler mounts all of the necessary endpoints onto the provided chi.Router.
+func (s *Server) Handler(r chi.Router) {
+	r.Use(UseEntContext(s.db))
+	r.Get("/categories", withFeatureGate(s, OperationList, "Category", withPayloadSize(s, OperationList, "listCategories", withReadMask(s, OperationList, "Category", ReqParam(s, OperationList, s.ListCategories)))))
+	r.Get("/categories/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationRead, "Category", withPayloadSize(s, OperationRead, "getCategory", withReadMask(s, OperationRead, "Category", ReqID(s, OperationRead, s.GetCategory)))))
+	r.Get("/categories/{id:^[0-9]{1,50}$}/pets", withFeatureGate(s, OperationList, "Category", withPayloadSize(s, OperationList, "listCategoryPets", withReadMask(s, OperationList, "Pet", ReqIDParam(s, OperationList, s.ListCategoryPets)))))
+	r.Post("/categories", withFeatureGate(s, OperationCreate, "Category", withPayloadSize(s, OperationCreate, "createCategory", withTolerantReader(s, OperationCreate, []string{"id", "createdAt", "updatedAt", "readonly", "edges"}, ReqParam(s, OperationCreate, s.CreateCategory)))))
+	r.Patch("/categories/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationUpdate, "Category", withPayloadSize(s, OperationUpdate, "updateCategory", withTolerantReader(s, OperationUpdate, []string{"id", "createdAt", "updatedAt", "readonly", "edges"}, ReqIDParam(s, OperationUpdate, s.UpdateCategory)))))
+	r.Delete("/categories/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationDelete, "Category", withPayloadSize(s, OperationDelete, "deleteCategory", ReqID(s, OperationDelete, s.DeleteCategory))))
+	r.Get("/follows", withFeatureGate(s, OperationList, "Follows", withPayloadSize(s, OperationList, "listFollows", withReadMask(s, OperationList, "Follows", ReqParam(s, OperationList, s.ListFollows)))))
+	r.Get("/follows/{userID}/{petID}", withFeatureGate(s, OperationRead, "Follows", withPayloadSize(s, OperationRead, "getFollow", withReadMask(s, OperationRead, "Follows", ReqCompositeID(s, OperationRead, parseFollowID, s.GetFollow)))))
+	r.Post("/follows", withFeatureGate(s, OperationCreate, "Follows", withPayloadSize(s, OperationCreate, "createFollow", withTolerantReader(s, OperationCreate, []string{"followedAt", "edges"}, ReqParam(s, OperationCreate, s.CreateFollow)))))
+	r.Patch("/follows/{userID}/{petID}", withFeatureGate(s, OperationUpdate, "Follows", withPayloadSize(s, OperationUpdate, "updateFollow", withTolerantReader(s, OperationUpdate, []string{"followedAt", "edges"}, ReqCompositeIDParam(s, OperationUpdate, parseFollowID, s.UpdateFollow)))))
+	r.Delete("/follows/{userID}/{petID}", withFeatureGate(s, OperationDelete, "Follows", withPayloadSize(s, OperationDelete, "deleteFollow", ReqCompositeID(s, OperationDelete, parseFollowID, s.DeleteFollow))))
+	r.Get("/friendships", withFeatureGate(s, OperationList, "Friendship", withPayloadSize(s, OperationList, "listFriendships", withReadMask(s, OperationList, "Friendship", ReqParam(s, OperationList, s.ListFriendships)))))
+	r.Get("/friendships/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationRead, "Friendship", withPayloadSize(s, OperationRead, "getFriendship", withReadMask(s, OperationRead, "Friendship", ReqID(s, OperationRead, s.GetFriendship)))))
+	r.Get("/friendships/{id:^[0-9]{1,50}$}/user", withFeatureGate(s, OperationRead, "Friendship", withPayloadSize(s, OperationRead, "getFriendshipUser", withReadMask(s, OperationRead, "User", ReqID(s, OperationRead, s.GetFriendshipUser)))))
+	r.Get("/friendships/{id:^[0-9]{1,50}$}/friend", withFeatureGate(s, OperationRead, "Friendship", withPayloadSize(s, OperationRead, "getFriendshipFriend", withReadMask(s, OperationRead, "User", ReqID(s, OperationRead, s.GetFriendshipFriend)))))
+	r.Post("/friendships", withFeatureGate(s, OperationCreate, "Friendship", withPayloadSize(s, OperationCreate, "createFriendship", withTolerantReader(s, OperationCreate, []string{"id", "edges"}, ReqParam(s, OperationCreate, s.CreateFriendship)))))
+	r.Patch("/friendships/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationUpdate, "Friendship", withPayloadSize(s, OperationUpdate, "updateFriendship", withTolerantReader(s, OperationUpdate, []string{"id", "edges"}, ReqIDParam(s, OperationUpdate, s.UpdateFriendship)))))
+	r.Delete("/friendships/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationDelete, "Friendship", withPayloadSize(s, OperationDelete, "deleteFriendship", ReqID(s, OperationDelete, s.DeleteFriendship))))
+	r.Get("/pets", withFeatureGate(s, OperationList, "Pet", withPayloadSize(s, OperationList, "listPets", withReadMask(s, OperationList, "Pet", ReqParam(s, OperationList, s.ListPets)))))
+	r.Get("/pets/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationRead, "Pet", withPayloadSize(s, OperationRead, "getPet", withReadMask(s, OperationRead, "Pet", ReqID(s, OperationRead, s.GetPet)))))
+	r.Get("/pets/{id:^[0-9]{1,50}$}/categories", withFeatureGate(s, OperationList, "Pet", withPayloadSize(s, OperationList, "listPetCategories", withReadMask(s, OperationList, "Category", ReqIDParam(s, OperationList, s.ListPetCategories)))))
+	r.Get("/pets/{id:^[0-9]{1,50}$}/owner", withFeatureGate(s, OperationRead, "Pet", withPayloadSize(s, OperationRead, "getPetOwner", withReadMask(s, OperationRead, "User", ReqID(s, OperationRead, s.GetPetOwner)))))
+	r.Get("/pets/{id:^[0-9]{1,50}$}/friends", withFeatureGate(s, OperationList, "Pet", withPayloadSize(s, OperationList, "listPetFriends", withReadMask(s, OperationList, "Pet", ReqIDParam(s, OperationList, s.ListPetFriends)))))
+	r.Get("/pets/{id:^[0-9]{1,50}$}/followed-by", withFeatureGate(s, OperationList, "Pet", withPayloadSize(s, OperationList, "listPetFollowedBys", withReadMask(s, OperationList, "User", ReqIDParam(s, OperationList, s.ListPetFollowedBys)))))
+	r.Get("/pets/{id:^[0-9]{1,50}$}/following", withFeatureGate(s, OperationList, "Pet", withPayloadSize(s, OperationList, "listPetFollowings", withReadMask(s, OperationList, "Follows", ReqIDParam(s, OperationList, s.ListPetFollowings)))))
+	r.Post("/pets/{id:^[0-9]{1,50}$}/following", withFeatureGate(s, OperationCreate, "Pet", withPayloadSize(s, OperationCreate, "createPetFollowing", ReqIDParam(s, OperationCreate, s.CreatePetFollowing))))
+	r.Post("/pets", withFeatureGate(s, OperationCreate, "Pet", withPayloadSize(s, OperationCreate, "createPet", withTolerantReader(s, OperationCreate, []string{"id", "edges"}, ReqParam(s, OperationCreate, s.CreatePet)))))
+	r.Patch("/pets/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationUpdate, "Pet", withPayloadSize(s, OperationUpdate, "updatePet", withTolerantReader(s, OperationUpdate, []string{"id", "edges"}, ReqIDParam(s, OperationUpdate, s.UpdatePet)))))
+	r.Delete("/pets/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationDelete, "Pet", withPayloadSize(s, OperationDelete, "deletePet", ReqID(s, OperationDelete, s.DeletePet))))
+	r.Get("/settings", withFeatureGate(s, OperationList, "Settings", withPayloadSize(s, OperationList, "listSettings", withReadMask(s, OperationList, "Settings", ReqParam(s, OperationList, s.ListSettings)))))
+	r.Get("/settings/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationRead, "Settings", withPayloadSize(s, OperationRead, "getSetting", withReadMask(s, OperationRead, "Settings", ReqID(s, OperationRead, s.GetSetting)))))
+	r.Get("/settings/{id:^[0-9]{1,50}$}/admins", withFeatureGate(s, OperationList, "Settings", withPayloadSize(s, OperationList, "listSettingAdmins", withReadMask(s, OperationList, "User", ReqIDParam(s, OperationList, s.ListSettingAdmins)))))
+	r.Patch("/settings/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationUpdate, "Settings", withPayloadSize(s, OperationUpdate, "updateSetting", withTolerantReader(s, OperationUpdate, []string{"id", "createdAt", "updatedAt", "edges"}, ReqIDParam(s, OperationUpdate, s.UpdateSetting)))))
+	r.Get("/users", withFeatureGate(s, OperationList, "User", withPayloadSize(s, OperationList, "listUsers", withReadMask(s, OperationList, "User", ReqParam(s, OperationList, s.ListUsers)))))
+	r.Get("/users/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationRead, "User", withPayloadSize(s, OperationRead, "getUser", withReadMask(s, OperationRead, "User", ReqID(s, OperationRead, s.GetUser)))))
+	r.Get("/users/{id:^[0-9]{1,50}$}/avatar", withFeatureGate(s, OperationRead, "User", ReqID(s, OperationRead, s.GetUserAvatar)))
+	r.Put("/users/{id:^[0-9]{1,50}$}/avatar", withFeatureGate(s, OperationUpdate, "User", withPayloadSize(s, OperationUpdate, "uploadUserAvatar", ReqID(s, OperationUpdate, s.UploadUserAvatar))))
+	r.Delete("/users/{id:^[0-9]{1,50}$}/avatar", withFeatureGate(s, OperationUpdate, "User", withPayloadSize(s, OperationUpdate, "deleteUserAvatar", ReqID(s, OperationUpdate, s.DeleteUserAvatar))))
+	r.Get("/users/{id:^[0-9]{1,50}$}/pets", withFeatureGate(s, OperationList, "User", withPayloadSize(s, OperationList, "listUserPets", withReadMask(s, OperationList, "Pet", ReqIDParam(s, OperationList, s.ListUserPets)))))
+	r.Get("/users/{id:^[0-9]{1,50}$}/followed-pets", withFeatureGate(s, OperationList, "User", withPayloadSize(s, OperationList, "listUserFollowedPets", withReadMask(s, OperationList, "Pet", ReqIDParam(s, OperationList, s.ListUserFollowedPets)))))
+	r.Get("/users/{id:^[0-9]{1,50}$}/friends", withFeatureGate(s, OperationList, "User", withPayloadSize(s, OperationList, "listUserFriends", withReadMask(s, OperationList, "User", ReqIDParam(s, OperationList, s.ListUserFriends)))))
+	r.Get("/users/{id:^[0-9]{1,50}$}/following", withFeatureGate(s, OperationList, "User", withPayloadSize(s, OperationList, "listUserFollowings", withReadMask(s, OperationList, "Follows", ReqIDParam(s, OperationList, s.ListUserFollowings)))))
+	r.Post("/users/{id:^[0-9]{1,50}$}/following", withFeatureGate(s, OperationCreate, "User", withPayloadSize(s, OperationCreate, "createUserFollowing", ReqIDParam(s, OperationCreate, s.CreateUserFollowing))))
+	r.Get("/users/{id:^[0-9]{1,50}$}/friendships", withFeatureGate(s, OperationList, "User", withPayloadSize(s, OperationList, "listUserFriendships", withReadMask(s, OperationList, "Friendship", ReqIDParam(s, OperationList, s.ListUserFriendships)))))
+	r.Post("/users/{id:^[0-9]{1,50}$}/friendships", withFeatureGate(s, OperationCreate, "User", withPayloadSize(s, OperationCreate, "createUserFriendship", ReqIDParam(s, OperationCreate, s.CreateUserFriendship))))
+	r.Post("/users", withFeatureGate(s, OperationCreate, "User", withPayloadSize(s, OperationCreate, "createUser", withTolerantReader(s, OperationCreate, []string{"id", "createdAt", "updatedAt", "edges"}, ReqParam(s, OperationCreate, s.CreateUser)))))
+	r.Patch("/users/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationUpdate, "User", withPayloadSize(s, OperationUpdate, "updateUser", withTolerantReader(s, OperationUpdate, []string{"id", "createdAt", "updatedAt", "edges"}, ReqIDParam(s, OperationUpdate, s.UpdateUser)))))
+	r.Delete("/users/{id:^[0-9]{1,50}$}", withFeatureGate(s, OperationDelete, "User", withPayloadSize(s, OperationDelete, "deleteUser", ReqID(s, OperationDelete, s.DeleteUser))))
+
+	r.Post("/batch", s.batch)
+
+	if !s.config.DisableSpecHandler {
+		r.Get("/openapi.json", s.Spec)
+		r.Options("/openapi.json", s.Spec)
+	}
+
+	if !s.config.DisableSpecHandler && !s.config.DisableDocsHandler {
+		// If specs are enabled, it's safe to provide documentation, and if they don't override the
+		// root endpoint, we can redirect to the docs.
+		r.Get("/", http.RedirectHandler(s.config.BasePath+"/docs", http.StatusTemporaryRedirect).ServeHTTP)
+		r.Get("/docs", s.Docs)
+	}
+
+	r.NotFound(func(w http.ResponseWriter, r *http.Request) {
+		handleResponse[struct{}](s, w, r, "", nil, ErrEndpointNotFound)
+	})
+	r.MethodNotAllowed(func(w http.ResponseWriter, r *http.Request) {
+		handleResponse[struct{}](s, w, r, "", nil, ErrMethodNotAllowed)
+	})
+}
+
+// ListCategories maps to "GET /categories".
+func (s *Server) ListCategories(r *http.Request, p *ListCategoryParams) (*PagedResponse[ent.Category], error) {
+	return p.Exec(r.Context(), readMaskCategoryQuery(r.Context(), s.client(r.Context()).Category.Query()))
+}
+
+// GetCategory maps to "GET /categories/{id}".
+func (s *Server) GetCategory(r *http.Request, categoryID int) (*ent.Category, error) {
+	return EagerLoadCategory(readMaskCategoryQuery(r.Context(), s.client(r.Context()).Category.Query()).Where(category.ID(categoryID))).Only(r.Context())
+}
+
+// ListCategoryPets maps to "GET /categories/{id}/pets".
+func (s *Server) ListCategoryPets(r *http.Request, categoryID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Category.Query().Where(category.ID(categoryID)).QueryPets())
+}
+
+// CreateCategory maps to "POST /categories".
+func (s *Server) CreateCategory(r *http.Request, p *CreateCategoryParams) (*ent.Category, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Category, error) {
+		result, err := p.Exec(r.Context(), tx.Category.Create(), tx.Category.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "category.created", "Category", result.ID, result)
+	})
+}
+
+// UpdateCategory maps to "PATCH /categories/{id}".
+func (s *Server) UpdateCategory(r *http.Request, categoryID int, p *UpdateCategoryParams) (*ent.Category, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Category, error) {
+		result, err := p.Exec(r.Context(), tx.Category.UpdateOneID(categoryID), tx.Category.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "category.updated", "Category", result.ID, result)
+	})
+}
+
+// DeleteCategory maps to "DELETE /categories/{id}".
+func (s *Server) DeleteCategory(r *http.Request, categoryID int) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		err := tx.Category.DeleteOneID(categoryID).Exec(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		return nil, writeOutbox(r.Context(), tx, "category.deleted", "Category", categoryID, OutboxDeleted{ID: categoryID})
+	})
+}
+
+// ListFollows maps to "GET /follows".
+func (s *Server) ListFollows(r *http.Request, p *ListFollowParams) (*PagedResponse[ent.Follows], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Follows.Query())
+}
+
+// GetFollow maps to "GET /follows/{userID}/{petID}".
+func (s *Server) GetFollow(r *http.Request, id FollowID) (*ent.Follows, error) {
+	return EagerLoadFollow(s.client(r.Context()).Follows.Query().Where(id.Predicate())).Only(r.Context())
+}
+
+// CreateFollow maps to "POST /follows".
+func (s *Server) CreateFollow(r *http.Request, p *CreateFollowParams) (*ent.Follows, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Follows, error) {
+		return p.Exec(r.Context(), tx.Follows.Create(), tx.Follows.Query())
+	})
+}
+
+// UpdateFollow maps to "PATCH /follows/{userID}/{petID}".
+func (s *Server) UpdateFollow(r *http.Request, id FollowID, p *UpdateFollowParams) (*ent.Follows, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Follows, error) {
+		entity, err := tx.Follows.Query().Where(id.Predicate()).Only(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		return p.Exec(r.Context(), tx.Follows.UpdateOne(entity), tx.Follows.Query())
+	})
+}
+
+// DeleteFollow maps to "DELETE /follows/{userID}/{petID}".
+func (s *Server) DeleteFollow(r *http.Request, id FollowID) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		// Ensure the entity exists first, so a not found error is returned otherwise.
+		_, err := tx.Follows.Query().Where(id.Predicate()).Only(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		_, err = tx.Follows.Delete().Where(id.Predicate()).Exec(r.Context())
+		return nil, err
+	})
+}
+
+// ListFriendships maps to "GET /friendships".
+func (s *Server) ListFriendships(r *http.Request, p *ListFriendshipParams) (*PagedResponse[ent.Friendship], error) {
+	return p.Exec(r.Context(), readMaskFriendshipQuery(r.Context(), s.client(r.Context()).Friendship.Query()))
+}
+
+// GetFriendship maps to "GET /friendships/{id}".
+func (s *Server) GetFriendship(r *http.Request, friendshipID int) (*ent.Friendship, error) {
+	return EagerLoadFriendship(readMaskFriendshipQuery(r.Context(), s.client(r.Context()).Friendship.Query()).Where(friendship.ID(friendshipID))).Only(r.Context())
+}
+
+// GetFriendshipUser maps to "GET /friendships/{id}/user".
+func (s *Server) GetFriendshipUser(r *http.Request, friendshipID int) (*ent.User, error) {
+	return EagerLoadUser(s.client(r.Context()).Friendship.Query().Where(friendship.ID(friendshipID)).QueryUser()).Only(r.Context())
+}
+
+// GetFriendshipFriend maps to "GET /friendships/{id}/friend".
+func (s *Server) GetFriendshipFriend(r *http.Request, friendshipID int) (*ent.User, error) {
+	return EagerLoadUser(s.client(r.Context()).Friendship.Query().Where(friendship.ID(friendshipID)).QueryFriend()).Only(r.Context())
+}
+
+// CreateFriendship maps to "POST /friendships".
+func (s *Server) CreateFriendship(r *http.Request, p *CreateFriendshipParams) (*ent.Friendship, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Friendship, error) {
+		result, err := p.Exec(r.Context(), tx.Friendship.Create(), tx.Friendship.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "friendship.created", "Friendship", result.ID, result)
+	})
+}
+
+// UpdateFriendship maps to "PATCH /friendships/{id}".
+func (s *Server) UpdateFriendship(r *http.Request, friendshipID int, p *UpdateFriendshipParams) (*ent.Friendship, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Friendship, error) {
+		result, err := p.Exec(r.Context(), tx.Friendship.UpdateOneID(friendshipID), tx.Friendship.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "friendship.updated", "Friendship", result.ID, result)
+	})
+}
+
+// DeleteFriendship maps to "DELETE /friendships/{id}".
+func (s *Server) DeleteFriendship(r *http.Request, friendshipID int) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		err := tx.Friendship.DeleteOneID(friendshipID).Exec(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		return nil, writeOutbox(r.Context(), tx, "friendship.deleted", "Friendship", friendshipID, OutboxDeleted{ID: friendshipID})
+	})
+}
+
+// ListPets maps to "GET /pets".
+func (s *Server) ListPets(r *http.Request, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), readMaskPetQuery(r.Context(), s.client(r.Context()).Pet.Query()))
+}
+
+// GetPet maps to "GET /pets/{id}".
+func (s *Server) GetPet(r *http.Request, petID int) (*ent.Pet, error) {
+	return EagerLoadPet(readMaskPetQuery(r.Context(), s.client(r.Context()).Pet.Query()).Where(pet.ID(petID))).Only(r.Context())
+}
+
+// ListPetCategories maps to "GET /pets/{id}/categories".
+func (s *Server) ListPetCategories(r *http.Request, petID int, p *ListCategoryParams) (*PagedResponse[ent.Category], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryCategories())
+}
+
+// GetPetOwner maps to "GET /pets/{id}/owner".
+func (s *Server) GetPetOwner(r *http.Request, petID int) (*ent.User, error) {
+	return EagerLoadUser(s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryOwner()).Only(r.Context())
+}
+
+// ListPetFriends maps to "GET /pets/{id}/friends".
+func (s *Server) ListPetFriends(r *http.Request, petID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryFriends())
+}
+
+// ListPetFollowedBys maps to "GET /pets/{id}/followed-by".
+func (s *Server) ListPetFollowedBys(r *http.Request, petID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryFollowedBy())
+}
+
+// ListPetFollowings maps to "GET /pets/{id}/following".
+func (s *Server) ListPetFollowings(r *http.Request, petID int, p *ListFollowParams) (*PagedResponse[ent.Follows], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryFollowing())
+}
+
+// CreatePetFollowing maps to "POST /pets/{id}/following".
+func (s *Server) CreatePetFollowing(r *http.Request, petID int, p *CreateFollowParams) (*ent.Follows, error) {
+	p.PetID = petID
+	return p.Exec(r.Context(), s.client(r.Context()).Follows.Create(), s.client(r.Context()).Follows.Query())
+}
+
+// CreatePet maps to "POST /pets".
+func (s *Server) CreatePet(r *http.Request, p *CreatePetParams) (*ent.Pet, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Pet, error) {
+		result, err := p.Exec(r.Context(), tx.Pet.Create(), tx.Pet.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "pet.created", "Pet", result.ID, result)
+	})
+}
+
+// UpdatePet maps to "PATCH /pets/{id}".
+func (s *Server) UpdatePet(r *http.Request, petID int, p *UpdatePetParams) (*ent.Pet, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Pet, error) {
+		result, err := p.Exec(r.Context(), tx.Pet.UpdateOneID(petID), tx.Pet.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "pet.updated", "Pet", result.ID, result)
+	})
+}
+
+// DeletePet maps to "DELETE /pets/{id}".
+func (s *Server) DeletePet(r *http.Request, petID int) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		err := tx.Pet.DeleteOneID(petID).Exec(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		return nil, writeOutbox(r.Context(), tx, "pet.deleted", "Pet", petID, OutboxDeleted{ID: petID})
+	})
+}
+
+// ListSettings maps to "GET /settings".
+func (s *Server) ListSettings(r *http.Request, p *ListSettingParams) (*PagedResponse[ent.Settings], error) {
+	return p.Exec(r.Context(), readMaskSettingsQuery(r.Context(), s.client(r.Context()).Settings.Query()))
+}
+
+// GetSetting maps to "GET /settings/{id}".
+func (s *Server) GetSetting(r *http.Request, settingID int) (*ent.Settings, error) {
+	return EagerLoadSetting(readMaskSettingsQuery(r.Context(), s.client(r.Context()).Settings.Query()).Where(settings.ID(settingID))).Only(r.Context())
+}
+
+// ListSettingAdmins maps to "GET /settings/{id}/admins".
+func (s *Server) ListSettingAdmins(r *http.Request, settingID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Settings.Query().Where(settings.ID(settingID)).QueryAdmins())
+}
+
+// UpdateSetting maps to "PATCH /settings/{id}".
+func (s *Server) UpdateSetting(r *http.Request, settingID int, p *UpdateSettingParams) (*ent.Settings, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Settings, error) {
+		result, err := p.Exec(r.Context(), tx.Settings.UpdateOneID(settingID), tx.Settings.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "settings.updated", "Settings", result.ID, result)
+	})
+}
+
+// ListUsers maps to "GET /users".
+func (s *Server) ListUsers(r *http.Request, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	return p.Exec(r.Context(), readMaskUserQuery(r.Context(), s.client(r.Context()).User.Query()))
+}
+
+// GetUser maps to "GET /users/{id}".
+func (s *Server) GetUser(r *http.Request, userID int) (*ent.User, error) {
+	return EagerLoadUser(readMaskUserQuery(r.Context(), s.client(r.Context()).User.Query()).Where(user.ID(userID))).Only(r.Context())
+}
+
+// GetUserAvatar maps to "GET /users/{id}/avatar".
+func (s *Server) GetUserAvatar(r *http.Request, userID int) (*File, error) {
+	entity, err := s.client(r.Context()).User.Query().
+		Where(user.ID(userID)).
+		Select(user.FieldAvatar).
+		Only(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	if entity.Avatar == nil || len(*entity.Avatar) == 0 {
+		return nil, ErrFileNotFound
+	}
+	file := &File{Data: *entity.Avatar}
+	return file, nil
+}
+
+// UploadUserAvatar maps to "PUT /users/{id}/avatar".
+func (s *Server) UploadUserAvatar(r *http.Request, userID int) (*ent.User, error) {
+	file, err := ReadFile(r, "file", 10485760)
+	if err != nil {
+		return nil, err
+	}
+	err = s.client(r.Context()).User.UpdateOneID(userID).
+		SetAvatar(file.Data).
+		Exec(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadUser(s.client(r.Context()).User.Query().Where(user.ID(userID))).Only(r.Context())
+}
+
+// DeleteUserAvatar maps to "DELETE /users/{id}/avatar".
+func (s *Server) DeleteUserAvatar(r *http.Request, userID int) (*struct{}, error) {
+	return nil, s.client(r.Context()).User.UpdateOneID(userID).
+		ClearAvatar().
+		Exec(r.Context())
+}
+
+// ListUserPets maps to "GET /users/{id}/pets".
+func (s *Server) ListUserPets(r *http.Request, userID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryPets())
+}
+
+// ListUserFollowedPets maps to "GET /users/{id}/followed-pets".
+func (s *Server) ListUserFollowedPets(r *http.Request, userID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFollowedPets())
+}
+
+// ListUserFriends maps to "GET /users/{id}/friends".
+func (s *Server) ListUserFriends(r *http.Request, userID int, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFriends())
+}
+
+// ListUserFollowings maps to "GET /users/{id}/following".
+func (s *Server) ListUserFollowings(r *http.Request, userID int, p *ListFollowParams) (*PagedResponse[ent.Follows], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFollowing())
+}
+
+// CreateUserFollowing maps to "POST /users/{id}/following".
+func (s *Server) CreateUserFollowing(r *http.Request, userID int, p *CreateFollowParams) (*ent.Follows, error) {
+	p.UserID = userID
+	return p.Exec(r.Context(), s.client(r.Context()).Follows.Create(), s.client(r.Context()).Follows.Query())
+}
+
+// ListUserFriendships maps to "GET /users/{id}/friendships".
+func (s *Server) ListUserFriendships(r *http.Request, userID int, p *ListFriendshipParams) (*PagedResponse[ent.Friendship], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryFriendships())
+}
+
+// CreateUserFriendship maps to "POST /users/{id}/friendships".
+func (s *Server) CreateUserFriendship(r *http.Request, userID int, p *CreateFriendshipParams) (*ent.Friendship, error) {
+	p.UserID = userID
+	return p.Exec(r.Context(), s.client(r.Context()).Friendship.Create(), s.client(r.Context()).Friendship.Query())
+}
+
+// CreateUser maps to "POST /users".
+func (s *Server) CreateUser(r *http.Request, p *CreateUserParams) (*ent.User, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.User, error) {
+		result, err := p.Exec(r.Context(), tx.User.Create(), tx.User.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "user.created", "User", result.ID, result)
+	})
+}
+
+// UpdateUser maps to "PATCH /users/{id}".
+func (s *Server) UpdateUser(r *http.Request, userID int, p *UpdateUserParams) (*ent.User, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.User, error) {
+		result, err := p.Exec(r.Context(), tx.User.UpdateOneID(userID), tx.User.Query())
+		if err != nil {
+			return nil, err
+		}
+		return result, writeOutbox(r.Context(), tx, "user.updated", "User", result.ID, result)
+	})
+}
+
+// DeleteUser maps to "DELETE /users/{id}".
+func (s *Server) DeleteUser(r *http.Request, userID int) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		err := tx.User.DeleteOneID(userID).Exec(r.Context())
+		if err != nil {
+			return nil, err
+		}
+		return nil, writeOutbox(r.Context(), tx, "user.deleted", "User", userID, OutboxDeleted{ID: userID})
+	})
+}
@@ -0,0 +1,565 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/category"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/follows"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/friendship"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/pet"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/settings"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/user"
+)
+
+type Sorted struct {
+	// Field is the field to sort by. Can be a standard field name (e.g. "name"), or a custom field
+	// name (e.g. "pets.age.sum"). If no field is provided, the default field will be used.
+	Field *string `json:"sort" form:"sort,omitempty"`
+
+	// Order is the order to sort by. Can be either "asc" or "desc". If no order is provided, the
+	// default order will be used.
+	Order *orderDirection `json:"order" form:"order,omitempty"`
+}
+
+// Validate validates the sorting fields and applies any necessary defaults.
+func (s *Sorted) Validate(cfg *SortConfig) error {
+	if s.Field == nil {
+		if cfg.DefaultField == "" {
+			return nil
+		}
+		s.Field = &cfg.DefaultField
+	}
+	if s.Order == nil {
+		s.Order = &cfg.DefaultOrder
+	}
+
+	if !slices.Contains(cfg.Fields, *s.Field) {
+		return &ErrBadRequest{Err: fmt.Errorf("invalid sort field: %s", *s.Field)}
+	}
+
+	if !slices.Contains(OrderDirections, *s.Order) {
+		return &ErrBadRequest{Err: fmt.Errorf("invalid order: %s", *s.Order)}
+	}
+
+	return nil
+}
+
+// withOrderTerm returns the OrderTermOption (asc/desc) based on the provided order string.
+func withOrderTerm(order orderDirection) sql.OrderTermOption {
+	if order == orderAsc {
+		return sql.OrderAsc()
+	}
+	return sql.OrderDesc()
+}
+
+func withFieldSelector(field string, order orderDirection) func(*sql.Selector) {
+	if order == orderAsc {
+		return ent.Asc(field)
+	}
+	return ent.Desc(field)
+}
+
+type SortConfig struct {
+	Fields       []string
+	DefaultField string
+	DefaultOrder orderDirection
+
+	// Columns maps sort fields to their internal field names, for those which differ
+	// (e.g. "createdAt" to "created_at").
+	Columns map[string]string
+}
+
+// column returns the internal field name of the provided sort field.
+func (c *SortConfig) column(field string) string {
+	if v, ok := c.Columns[field]; ok {
+		return v
+	}
+	return field
+}
+
+type orderDirection string
+
+var (
+	orderAsc  orderDirection = "asc"
+	orderDesc orderDirection = "desc"
+
+	// OrderDirections are the allowed order directions that can be provided.
+	OrderDirections = []orderDirection{orderAsc, orderDesc}
+	// CategorySortConfig defines the default sort configuration for Category.
+	CategorySortConfig = &SortConfig{
+		Fields: []string{
+			"createdAt",
+			"id",
+			"pets.age.sum",
+			"pets.count",
+			"random",
+			"updatedAt",
+		},
+		DefaultField: "id",
+		DefaultOrder: "asc",
+		Columns: map[string]string{
+			"createdAt": "created_at",
+			"updatedAt": "updated_at",
+		},
+	}
+	// FollowSortConfig defines the default sort configuration for Follow.
+	FollowSortConfig = &SortConfig{
+		Fields: []string{
+			"followedAt",
+			"pet.age",
+			"pet.name",
+			"random",
+			"user.createdAt",
+			"user.email",
+			"user.name",
+			"user.updatedAt",
+		},
+		DefaultOrder: "asc",
+		Columns: map[string]string{
+			"followedAt":     "followed_at",
+			"user.createdAt": "user.created_at",
+			"user.updatedAt": "user.updated_at",
+		},
+	}
+	// FriendshipSortConfig defines the default sort configuration for Friendship.
+	FriendshipSortConfig = &SortConfig{
+		Fields: []string{
+			"friend.createdAt",
+			"friend.email",
+			"friend.name",
+			"friend.updatedAt",
+			"friendID",
+			"id",
+			"random",
+			"user.createdAt",
+			"user.email",
+			"user.name",
+			"user.updatedAt",
+			"userID",
+		},
+		DefaultField: "id",
+		DefaultOrder: "asc",
+		Columns: map[string]string{
+			"friend.createdAt": "friend.created_at",
+			"friend.updatedAt": "friend.updated_at",
+			"friendID":         "friend_id",
+			"user.createdAt":   "user.created_at",
+			"user.updatedAt":   "user.updated_at",
+			"userID":           "user_id",
+		},
+	}
+	// PetSortConfig defines the default sort configuration for Pet.
+	PetSortConfig = &SortConfig{
+		Fields: []string{
+			"age",
+			"categories.count",
+			"followedBy.count",
+			"following.count",
+			"friends.age.sum",
+			"friends.count",
+			"id",
+			"name",
+			"owner.createdAt",
+			"owner.email",
+			"owner.id",
+			"owner.name",
+			"owner.updatedAt",
+			"random",
+		},
+		DefaultField: "name",
+		DefaultOrder: "asc",
+		Columns: map[string]string{
+			"followedBy.count": "followed_by.count",
+			"owner.createdAt":  "owner.created_at",
+			"owner.updatedAt":  "owner.updated_at",
+		},
+	}
+	// SettingSortConfig defines the default sort configuration for Setting.
+	SettingSortConfig = &SortConfig{
+		Fields: []string{
+			"admins.count",
+			"createdAt",
+			"id",
+			"random",
+			"updatedAt",
+		},
+		DefaultField: "id",
+		DefaultOrder: "asc",
+		Columns: map[string]string{
+			"createdAt": "created_at",
+			"updatedAt": "updated_at",
+		},
+	}
+	// UserSortConfig defines the default sort configuration for User.
+	UserSortConfig = &SortConfig{
+		Fields: []string{
+			"createdAt",
+			"email",
+			"followedPets.age.sum",
+			"followedPets.count",
+			"following.count",
+			"friends.count",
+			"friendships.count",
+			"friendships.friendID.sum",
+			"friendships.userID.sum",
+			"id",
+			"name",
+			"pets.age.sum",
+			"pets.count",
+			"random",
+			"updatedAt",
+		},
+		DefaultField: "name",
+		DefaultOrder: "asc",
+		Columns: map[string]string{
+			"createdAt":                "created_at",
+			"followedPets.age.sum":     "followed_pets.age.sum",
+			"followedPets.count":       "followed_pets.count",
+			"friendships.friendID.sum": "friendships.friend_id.sum",
+			"friendships.userID.sum":   "friendships.user_id.sum",
+			"updatedAt":                "updated_at",
+		},
+	}
+)
+
+// applySortingCategory applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingCategory(query *ent.CategoryQuery, field string, order orderDirection) *ent.CategoryQuery {
+	switch field {
+	case "random", category.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakCategory(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		var isCount, isSum bool
+		if len(parts) > 2 {
+			switch parts[2] {
+			case "count":
+				isCount = true
+			case "sum":
+				isSum = true
+			}
+		}
+
+		switch parts[0] {
+		case category.EdgePets:
+			switch {
+			case isCount:
+				return query.Order(category.ByPetsCount(dir))
+			case isSum:
+				return query.Order(category.ByPets(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(category.ByPets(sql.OrderByField(parts[1], dir)))
+			}
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakCategory orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakCategory(query *ent.CategoryQuery, order orderDirection) *ent.CategoryQuery {
+	return query.Order(
+		withFieldSelector(category.FieldID, order),
+	)
+}
+
+// applySortingFollow applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingFollow(query *ent.FollowsQuery, field string, order orderDirection) *ent.FollowsQuery {
+	switch field {
+	case "random":
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakFollow(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		switch parts[0] {
+		case follows.EdgeUser:
+			return query.Order(follows.ByUserField(parts[1], dir))
+		case follows.EdgePet:
+			return query.Order(follows.ByPetField(parts[1], dir))
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakFollow orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakFollow(query *ent.FollowsQuery, order orderDirection) *ent.FollowsQuery {
+	return query.Order(
+		withFieldSelector(follows.FieldUserID, order),
+		withFieldSelector(follows.FieldPetID, order),
+	)
+}
+
+// applySortingFriendship applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingFriendship(query *ent.FriendshipQuery, field string, order orderDirection) *ent.FriendshipQuery {
+	switch field {
+	case "random", friendship.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakFriendship(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		switch parts[0] {
+		case friendship.EdgeUser:
+			return query.Order(friendship.ByUserField(parts[1], dir))
+		case friendship.EdgeFriend:
+			return query.Order(friendship.ByFriendField(parts[1], dir))
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakFriendship orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakFriendship(query *ent.FriendshipQuery, order orderDirection) *ent.FriendshipQuery {
+	return query.Order(
+		withFieldSelector(friendship.FieldID, order),
+	)
+}
+
+// applySortingPet applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingPet(query *ent.PetQuery, field string, order orderDirection) *ent.PetQuery {
+	switch field {
+	case "random", pet.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakPet(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		var isCount, isSum bool
+		if len(parts) > 2 {
+			switch parts[2] {
+			case "count":
+				isCount = true
+			case "sum":
+				isSum = true
+			}
+		}
+
+		switch parts[0] {
+		case pet.EdgeCategories:
+			switch {
+			case isCount:
+				return query.Order(pet.ByCategoriesCount(dir))
+			case isSum:
+				return query.Order(pet.ByCategories(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(pet.ByCategories(sql.OrderByField(parts[1], dir)))
+			}
+		case pet.EdgeOwner:
+			return query.Order(pet.ByOwnerField(parts[1], dir))
+		case pet.EdgeFriends:
+			switch {
+			case isCount:
+				return query.Order(pet.ByFriendsCount(dir))
+			case isSum:
+				return query.Order(pet.ByFriends(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(pet.ByFriends(sql.OrderByField(parts[1], dir)))
+			}
+		case pet.EdgeFollowedBy:
+			switch {
+			case isCount:
+				return query.Order(pet.ByFollowedByCount(dir))
+			case isSum:
+				return query.Order(pet.ByFollowedBy(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(pet.ByFollowedBy(sql.OrderByField(parts[1], dir)))
+			}
+		case pet.EdgeFollowing:
+			switch {
+			case isCount:
+				return query.Order(pet.ByFollowingCount(dir))
+			case isSum:
+				return query.Order(pet.ByFollowing(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(pet.ByFollowing(sql.OrderByField(parts[1], dir)))
+			}
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakPet orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakPet(query *ent.PetQuery, order orderDirection) *ent.PetQuery {
+	return query.Order(
+		withFieldSelector(pet.FieldID, order),
+	)
+}
+
+// applySortingSetting applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingSetting(query *ent.SettingsQuery, field string, order orderDirection) *ent.SettingsQuery {
+	switch field {
+	case "random", settings.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakSetting(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		var isCount, isSum bool
+		if len(parts) > 2 {
+			switch parts[2] {
+			case "count":
+				isCount = true
+			case "sum":
+				isSum = true
+			}
+		}
+
+		switch parts[0] {
+		case settings.EdgeAdmins:
+			switch {
+			case isCount:
+				return query.Order(settings.ByAdminsCount(dir))
+			case isSum:
+				return query.Order(settings.ByAdmins(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(settings.ByAdmins(sql.OrderByField(parts[1], dir)))
+			}
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakSetting orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakSetting(query *ent.SettingsQuery, order orderDirection) *ent.SettingsQuery {
+	return query.Order(
+		withFieldSelector(settings.FieldID, order),
+	)
+}
+
+// applySortingUser applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingUser(query *ent.UserQuery, field string, order orderDirection) *ent.UserQuery {
+	switch field {
+	case "random", user.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakUser(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		var isCount, isSum bool
+		if len(parts) > 2 {
+			switch parts[2] {
+			case "count":
+				isCount = true
+			case "sum":
+				isSum = true
+			}
+		}
+
+		switch parts[0] {
+		case user.EdgePets:
+			switch {
+			case isCount:
+				return query.Order(user.ByPetsCount(dir))
+			case isSum:
+				return query.Order(user.ByPets(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(user.ByPets(sql.OrderByField(parts[1], dir)))
+			}
+		case user.EdgeFollowedPets:
+			switch {
+			case isCount:
+				return query.Order(user.ByFollowedPetsCount(dir))
+			case isSum:
+				return query.Order(user.ByFollowedPets(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(user.ByFollowedPets(sql.OrderByField(parts[1], dir)))
+			}
+		case user.EdgeFriends:
+			switch {
+			case isCount:
+				return query.Order(user.ByFriendsCount(dir))
+			case isSum:
+				return query.Order(user.ByFriends(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(user.ByFriends(sql.OrderByField(parts[1], dir)))
+			}
+		case user.EdgeFollowing:
+			switch {
+			case isCount:
+				return query.Order(user.ByFollowingCount(dir))
+			case isSum:
+				return query.Order(user.ByFollowing(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(user.ByFollowing(sql.OrderByField(parts[1], dir)))
+			}
+		case user.EdgeFriendships:
+			switch {
+			case isCount:
+				return query.Order(user.ByFriendshipsCount(dir))
+			case isSum:
+				return query.Order(user.ByFriendships(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(user.ByFriendships(sql.OrderByField(parts[1], dir)))
+			}
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakUser orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakUser(query *ent.UserQuery, order orderDirection) *ent.UserQuery {
+	return query.Order(
+		withFieldSelector(user.FieldID, order),
+	)
+}
@@ -0,0 +1,157 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/settings"
+)
+
+// Settings is the model entity for the Settings schema.
+type Settings struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Time in which the resource was initially created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time that the resource was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Global banner text to apply to the frontend.
+	GlobalBanner *string `json:"globalBanner"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SettingsQuery when eager-loading is set.
+	Edges        SettingsEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SettingsEdges holds the relations/edges for other nodes in the graph.
+type SettingsEdges struct {
+	// Administrators for the platform.
+	Admins []*User `json:"admins,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AdminsOrErr returns the Admins value or an error if the edge
+// was not loaded in eager-loading.
+func (e SettingsEdges) AdminsOrErr() ([]*User, error) {
+	if e.loadedTypes[0] {
+		return e.Admins, nil
+	}
+	return nil, &NotLoadedError{edge: "admins"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Settings) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case settings.FieldID:
+			values[i] = new(sql.NullInt64)
+		case settings.FieldGlobalBanner:
+			values[i] = new(sql.NullString)
+		case settings.FieldCreatedAt, settings.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Settings fields.
+func (s *Settings) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case settings.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case settings.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		case settings.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				s.UpdatedAt = value.Time
+			}
+		case settings.FieldGlobalBanner:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field global_banner", values[i])
+			} else if value.Valid {
+				s.GlobalBanner = new(string)
+				*s.GlobalBanner = value.String
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Settings.
+// This includes values selected through modifiers, order, etc.
+func (s *Settings) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryAdmins queries the "admins" edge of the Settings entity.
+func (s *Settings) QueryAdmins() *UserQuery {
+	return NewSettingsClient(s.config).QueryAdmins(s)
+}
+
+// Update returns a builder for updating this Settings.
+// Note that you need to call Settings.Unwrap() before calling this method if this Settings
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Settings) Update() *SettingsUpdateOne {
+	return NewSettingsClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Settings entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Settings) Unwrap() *Settings {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Settings is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Settings) String() string {
+	var builder strings.Builder
+	builder.WriteString("Settings(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(s.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := s.GlobalBanner; v != nil {
+		builder.WriteString("global_banner=")
+		builder.WriteString(*v)
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// SettingsSlice is a parsable slice of Settings.
+type SettingsSlice []*Settings
@@ -0,0 +1,282 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/settings"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/user"
+)
+
+// SettingsCreate is the builder for creating a Settings entity.
+type SettingsCreate struct {
+	config
+	mutation *SettingsMutation
+	hooks    []Hook
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SettingsCreate) SetCreatedAt(t time.Time) *SettingsCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableCreatedAt(t *time.Time) *SettingsCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (sc *SettingsCreate) SetUpdatedAt(t time.Time) *SettingsCreate {
+	sc.mutation.SetUpdatedAt(t)
+	return sc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableUpdatedAt(t *time.Time) *SettingsCreate {
+	if t != nil {
+		sc.SetUpdatedAt(*t)
+	}
+	return sc
+}
+
+// SetGlobalBanner sets the "global_banner" field.
+func (sc *SettingsCreate) SetGlobalBanner(s string) *SettingsCreate {
+	sc.mutation.SetGlobalBanner(s)
+	return sc
+}
+
+// SetNillableGlobalBanner sets the "global_banner" field if the given value is not nil.
+func (sc *SettingsCreate) SetNillableGlobalBanner(s *string) *SettingsCreate {
+	if s != nil {
+		sc.SetGlobalBanner(*s)
+	}
+	return sc
+}
+
+// AddAdminIDs adds the "admins" edge to the User entity by IDs.
+func (sc *SettingsCreate) AddAdminIDs(ids ...int) *SettingsCreate {
+	sc.mutation.AddAdminIDs(ids...)
+	return sc
+}
+
+// AddAdmins adds the "admins" edges to the User entity.
+func (sc *SettingsCreate) AddAdmins(u ...*User) *SettingsCreate {
+	ids := make([]int, len(u))
+	for i := range u {
+		ids[i] = u[i].ID
+	}
+	return sc.AddAdminIDs(ids...)
+}
+
+// Mutation returns the SettingsMutation object of the builder.
+func (sc *SettingsCreate) Mutation() *SettingsMutation {
+	return sc.mutation
+}
+
+// Save creates the Settings in the database.
+func (sc *SettingsCreate) Save(ctx context.Context) (*Settings, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SettingsCreate) SaveX(ctx context.Context) *Settings {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SettingsCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SettingsCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SettingsCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := settings.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		v := settings.DefaultUpdatedAt()
+		sc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SettingsCreate) check() error {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Settings.created_at"`)}
+	}
+	if _, ok := sc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Settings.updated_at"`)}
+	}
+	if v, ok := sc.mutation.GlobalBanner(); ok {
+		if err := settings.GlobalBannerValidator(v); err != nil {
+			return &ValidationError{Name: "global_banner", err: fmt.Errorf(`ent: validator failed for field "Settings.global_banner": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (sc *SettingsCreate) sqlSave(ctx context.Context) (*Settings, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SettingsCreate) createSpec() (*Settings, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Settings{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(settings.Table, sqlgraph.NewFieldSpec(settings.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(settings.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := sc.mutation.UpdatedAt(); ok {
+		_spec.SetField(settings.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if value, ok := sc.mutation.GlobalBanner(); ok {
+		_spec.SetField(settings.FieldGlobalBanner, field.TypeString, value)
+		_node.GlobalBanner = &value
+	}
+	if nodes := sc.mutation.AdminsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   settings.AdminsTable,
+			Columns: []string{settings.AdminsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SettingsCreateBulk is the builder for creating many Settings entities in bulk.
+type SettingsCreateBulk struct {
+	config
+	err      error
+	builders []*SettingsCreate
+}
+
+// Save creates the Settings entities in the database.
+func (scb *SettingsCreateBulk) Save(ctx context.Context) ([]*Settings, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Settings, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SettingsMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SettingsCreateBulk) SaveX(ctx context.Context) []*Settings {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SettingsCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SettingsCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
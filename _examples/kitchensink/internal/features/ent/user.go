@@ -0,0 +1,334 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/go-github/v63/github"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/database/schema"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/user"
+)
+
+// User is the model entity for the User schema.
+type User struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// Time in which the resource was initially created.
+	CreatedAt time.Time `json:"createdAt"`
+	// Time that the resource was last updated.
+	UpdatedAt time.Time `json:"updatedAt"`
+	// Name of the user.
+	Name string `json:"name"`
+	// Type of object being defined (user or system which is for internal usecases).
+	Type user.Type `json:"type"`
+	// Full name if USER, otherwise null.
+	Description *string `json:"description"`
+	// If the user is still in the source system.
+	Enabled bool `json:"enabled"`
+	// Email associated with the user. Note that not all users have an associated email address.
+	Email *string `json:"email"`
+	// Avatar data for the user. This should generally only apply to the USER user type.
+	Avatar *[]byte `json:"-"`
+	// Hashed password for the user, this shouldn't be readable in the spec anywhere.
+	PasswordHashed string `json:"-"`
+	// The github user raw JSON data.
+	GithubData *github.User `json:"githubData"`
+	// ProfileURL holds the value of the "profile_url" field.
+	ProfileURL *schema.ExampleValuer `json:"profileURL"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the UserQuery when eager-loading is set.
+	Edges           UserEdges `json:"edges"`
+	settings_admins *int
+	selectValues    sql.SelectValues
+}
+
+// UserEdges holds the relations/edges for other nodes in the graph.
+type UserEdges struct {
+	// Pets owned by the user.
+	Pets []*Pet `json:"pets,omitempty"`
+	// Pets that the user is following.
+	FollowedPets []*Pet `json:"followedPets,omitempty"`
+	// Friends of the user.
+	Friends []*User `json:"friends,omitempty"`
+	// Following holds the value of the following edge.
+	Following []*Follows `json:"following,omitempty"`
+	// Friendships holds the value of the friendships edge.
+	Friendships []*Friendship `json:"friendships,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [5]bool
+}
+
+// PetsOrErr returns the Pets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PetsOrErr() ([]*Pet, error) {
+	if e.loadedTypes[0] {
+		return e.Pets, nil
+	}
+	return nil, &NotLoadedError{edge: "pets"}
+}
+
+// FollowedPetsOrErr returns the FollowedPets value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowedPetsOrErr() ([]*Pet, error) {
+	if e.loadedTypes[1] {
+		return e.FollowedPets, nil
+	}
+	return nil, &NotLoadedError{edge: "followed_pets"}
+}
+
+// FriendsOrErr returns the Friends value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FriendsOrErr() ([]*User, error) {
+	if e.loadedTypes[2] {
+		return e.Friends, nil
+	}
+	return nil, &NotLoadedError{edge: "friends"}
+}
+
+// FollowingOrErr returns the Following value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FollowingOrErr() ([]*Follows, error) {
+	if e.loadedTypes[3] {
+		return e.Following, nil
+	}
+	return nil, &NotLoadedError{edge: "following"}
+}
+
+// FriendshipsOrErr returns the Friendships value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FriendshipsOrErr() ([]*Friendship, error) {
+	if e.loadedTypes[4] {
+		return e.Friendships, nil
+	}
+	return nil, &NotLoadedError{edge: "friendships"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*User) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldAvatar, user.FieldGithubData:
+			values[i] = new([]byte)
+		case user.FieldProfileURL:
+			values[i] = new(schema.ExampleValuer)
+		case user.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case user.FieldID:
+			values[i] = new(sql.NullInt64)
+		case user.FieldName, user.FieldType, user.FieldDescription, user.FieldEmail, user.FieldPasswordHashed:
+			values[i] = new(sql.NullString)
+		case user.FieldCreatedAt, user.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case user.ForeignKeys[0]: // settings_admins
+			values[i] = new(sql.NullInt64)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the User fields.
+func (u *User) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case user.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			u.ID = int(value.Int64)
+		case user.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				u.CreatedAt = value.Time
+			}
+		case user.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				u.UpdatedAt = value.Time
+			}
+		case user.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				u.Name = value.String
+			}
+		case user.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				u.Type = user.Type(value.String)
+			}
+		case user.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				u.Description = new(string)
+				*u.Description = value.String
+			}
+		case user.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				u.Enabled = value.Bool
+			}
+		case user.FieldEmail:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field email", values[i])
+			} else if value.Valid {
+				u.Email = new(string)
+				*u.Email = value.String
+			}
+		case user.FieldAvatar:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field avatar", values[i])
+			} else if value != nil {
+				u.Avatar = value
+			}
+		case user.FieldPasswordHashed:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field password_hashed", values[i])
+			} else if value.Valid {
+				u.PasswordHashed = value.String
+			}
+		case user.FieldGithubData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field github_data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &u.GithubData); err != nil {
+					return fmt.Errorf("unmarshal field github_data: %w", err)
+				}
+			}
+		case user.FieldProfileURL:
+			if value, ok := values[i].(*schema.ExampleValuer); !ok {
+				return fmt.Errorf("unexpected type %T for field profile_url", values[i])
+			} else if value != nil {
+				u.ProfileURL = value
+			}
+		case user.ForeignKeys[0]:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for edge-field settings_admins", value)
+			} else if value.Valid {
+				u.settings_admins = new(int)
+				*u.settings_admins = int(value.Int64)
+			}
+		default:
+			u.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the User.
+// This includes values selected through modifiers, order, etc.
+func (u *User) Value(name string) (ent.Value, error) {
+	return u.selectValues.Get(name)
+}
+
+// QueryPets queries the "pets" edge of the User entity.
+func (u *User) QueryPets() *PetQuery {
+	return NewUserClient(u.config).QueryPets(u)
+}
+
+// QueryFollowedPets queries the "followed_pets" edge of the User entity.
+func (u *User) QueryFollowedPets() *PetQuery {
+	return NewUserClient(u.config).QueryFollowedPets(u)
+}
+
+// QueryFriends queries the "friends" edge of the User entity.
+func (u *User) QueryFriends() *UserQuery {
+	return NewUserClient(u.config).QueryFriends(u)
+}
+
+// QueryFollowing queries the "following" edge of the User entity.
+func (u *User) QueryFollowing() *FollowsQuery {
+	return NewUserClient(u.config).QueryFollowing(u)
+}
+
+// QueryFriendships queries the "friendships" edge of the User entity.
+func (u *User) QueryFriendships() *FriendshipQuery {
+	return NewUserClient(u.config).QueryFriendships(u)
+}
+
+// Update returns a builder for updating this User.
+// Note that you need to call User.Unwrap() before calling this method if this User
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (u *User) Update() *UserUpdateOne {
+	return NewUserClient(u.config).UpdateOne(u)
+}
+
+// Unwrap unwraps the User entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (u *User) Unwrap() *User {
+	_tx, ok := u.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: User is not a transactional entity")
+	}
+	u.config.driver = _tx.drv
+	return u
+}
+
+// String implements the fmt.Stringer.
+func (u *User) String() string {
+	var builder strings.Builder
+	builder.WriteString("User(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", u.ID))
+	builder.WriteString("created_at=")
+	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(u.UpdatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(u.Name)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", u.Type))
+	builder.WriteString(", ")
+	if v := u.Description; v != nil {
+		builder.WriteString("description=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", u.Enabled))
+	builder.WriteString(", ")
+	if v := u.Email; v != nil {
+		builder.WriteString("email=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := u.Avatar; v != nil {
+		builder.WriteString("avatar=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("password_hashed=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("github_data=")
+	builder.WriteString(fmt.Sprintf("%v", u.GithubData))
+	builder.WriteString(", ")
+	builder.WriteString("profile_url=")
+	builder.WriteString(fmt.Sprintf("%v", u.ProfileURL))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Users is a parsable slice of User.
+type Users []*User
@@ -0,0 +1,60 @@
+//go:build ignore
+
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package main
+
+import (
+	"log"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"github.com/lrstanley/entrest"
+)
+
+// A variant of the kitchensink, generated from the same schema with most optional
+// features enabled, so the templates of all features are compiled and tested together.
+func main() {
+	ex, err := entrest.NewExtension(&entrest.Config{
+		Handler:            entrest.HandlerChi,
+		WithTesting:        true,
+		WithBenchmarks:     true,
+		WithExamples:       true,
+		WithDTOs:           true,
+		WithOutbox:         true,
+		StrictMutate:       true,
+		TolerantReader:     true,
+		ReadYourWrites:     true,
+		MaintenanceMode:    true,
+		FeatureGates:       true,
+		EnableLastModified: true,
+		ReadMask:           true,
+		BytesAsFiles:       true,
+		DeduplicateSchemas: true,
+		FieldNameStyle:     entrest.FieldNameStyleCamel,
+		Batch:              &entrest.Batch{},
+		PayloadSizes:       &entrest.PayloadSizes{},
+		LoadTest:           entrest.LoadTestK6,
+	})
+	if err != nil {
+		log.Fatalf("creating entrest extension: %v", err)
+	}
+
+	err = entc.Generate(
+		"./database/schema",
+		&gen.Config{
+			Target:  "./features/ent",
+			Schema:  "github.com/lrstanley/entrest/_examples/kitchensink/internal/database/schema",
+			Package: "github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent",
+			Features: []gen.Feature{
+				gen.FeaturePrivacy,
+			},
+		},
+		entc.Extensions(ex),
+	)
+	if err != nil {
+		log.Fatalf("failed to run ent codegen: %v", err)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package features_test
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"slices"
+	"strconv"
+	"sync"
+	"testing"
+
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/enttest"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/migrate"
+	"github.com/lrstanley/entrest/_examples/kitchensink/internal/features/ent/rest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"modernc.org/sqlite"
+)
+
+var sqlRegister sync.Once
+
+func newClient(t testing.TB) *ent.Client {
+	t.Helper()
+
+	sqlRegister.Do(func() {
+		if !slices.Contains(sql.Drivers(), "sqlite3") {
+			sql.Register("sqlite3", &sqlite.Driver{})
+		}
+	})
+
+	opts := []enttest.Option{
+		enttest.WithOptions(ent.Log(t.Log)),
+		enttest.WithMigrateOptions(
+			migrate.WithDropColumn(true),
+			migrate.WithDropIndex(true),
+			migrate.WithGlobalUniqueID(true),
+			migrate.WithForeignKeys(true),
+		),
+	}
+
+	return enttest.Open(t, "sqlite3", "file:ent?mode=memory&_pragma=foreign_keys(1)", opts...)
+}
+
+func newRestServer(t *testing.T, cfg *rest.ServerConfig) (ctx context.Context, db *ent.Client, s *enttest.TestServer) {
+	t.Helper()
+	ctx = context.Background()
+	db = newClient(t)
+	t.Cleanup(func() { db.Close() })
+	s = enttest.NewServer(t, db, cfg)
+	return ctx, db, s
+}
+
+// newPetPayload returns the example create payload of pets, without edges, as the
+// example IDs don't reference existing entities.
+func newPetPayload() map[string]any {
+	payload := rest.ExamplePetCreate()
+	for _, key := range []string{"categories", "owner", "friends", "followedBy"} {
+		delete(payload, key)
+	}
+	return payload
+}
+
+func TestFeatures_CRUD(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+
+	created := enttest.Request[map[string]any](ctx, s, http.MethodPost, "/pets", newPetPayload()).Must(t)
+	require.Equal(t, http.StatusCreated, created.Data.Code)
+	assert.Equal(t, "Kuro", (*created.Value)["name"])
+	id := strconv.Itoa(int((*created.Value)["id"].(float64)))
+	assert.Equal(t, "/pets/"+id, created.Data.Header().Get("Location"))
+
+	read := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/pets/"+id, http.NoBody).Must(t)
+	assert.Equal(t, http.StatusOK, read.Data.Code)
+	assert.Equal(t, "Kuro", (*read.Value)["name"])
+
+	list := enttest.Request[rest.PagedResponse[map[string]any]](ctx, s, http.MethodGet, "/pets", http.NoBody).Must(t)
+	assert.Equal(t, http.StatusOK, list.Data.Code)
+	assert.Equal(t, 1, list.Value.TotalCount)
+
+	updated := enttest.Request[map[string]any](ctx, s, http.MethodPatch, "/pets/"+id, map[string]any{"name": "Shiro"}).Must(t)
+	assert.Equal(t, http.StatusOK, updated.Data.Code)
+	assert.Equal(t, "Shiro", (*updated.Value)["name"])
+
+	deleted := enttest.Request[struct{}](ctx, s, http.MethodDelete, "/pets/"+id, http.NoBody).Must(t)
+	assert.Equal(t, http.StatusNoContent, deleted.Data.Code)
+
+	// All mutations should have been recorded in the outbox, within the same
+	// transaction.
+	var events []string
+	n, err := rest.ProcessOutbox(ctx, db, 0, func(_ context.Context, entry *ent.Outbox) error {
+		events = append(events, entry.Event)
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, []string{"pet.created", "pet.updated", "pet.deleted"}, events)
+}
+
+func TestFeatures_LastModified(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+
+	// Categories have a required field which can't be provided through the API.
+	id := strconv.Itoa(db.Category.Create().SetName("foo").SetReadonly("bar").SaveX(ctx).ID)
+
+	read := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/categories/"+id, http.NoBody).Must(t)
+	assert.NotEmpty(t, read.Data.Header().Get("Last-Modified"))
+
+	list := enttest.Request[rest.PagedResponse[map[string]any]](ctx, s, http.MethodGet, "/categories", http.NoBody).Must(t)
+	assert.NotEmpty(t, list.Data.Header().Get("Last-Modified"))
+	assert.NotEmpty(t, list.Data.Header().Get("ETag"))
+}
+
+func TestFeatures_StrictMutate(t *testing.T) {
+	t.Parallel()
+
+	ctx, _, s := newRestServer(t, nil)
+
+	payload := newPetPayload()
+	payload["foo"] = "bar"
+
+	resp := enttest.Request[map[string]any](ctx, s, http.MethodPost, "/pets", payload)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+}
+
+func TestFeatures_ReadMask(t *testing.T) {
+	t.Parallel()
+
+	ctx, _, s := newRestServer(t, nil)
+
+	created := enttest.Request[map[string]any](ctx, s, http.MethodPost, "/pets", newPetPayload()).Must(t)
+	id := strconv.Itoa(int((*created.Value)["id"].(float64)))
+
+	resp := enttest.Request[map[string]any](ctx, s, http.MethodGet, "/pets/"+id+"?read_mask=name", http.NoBody).Must(t)
+	assert.Equal(t, "Kuro", (*resp.Value)["name"])
+	assert.NotContains(t, *resp.Value, "age")
+
+	resp = enttest.Request[map[string]any](ctx, s, http.MethodGet, "/pets/"+id+"?read_mask=foo", http.NoBody)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, http.StatusBadRequest, resp.Data.Code)
+}
+
+func TestFeatures_MaintenanceMode(t *testing.T) {
+	t.Parallel()
+
+	ctx, _, s := newRestServer(t, &rest.ServerConfig{MaintenanceMode: true})
+
+	resp := enttest.Request[map[string]any](ctx, s, http.MethodPost, "/pets", newPetPayload())
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, http.StatusServiceUnavailable, resp.Data.Code)
+
+	// Reads should still be allowed.
+	list := enttest.Request[rest.PagedResponse[map[string]any]](ctx, s, http.MethodGet, "/pets", http.NoBody).Must(t)
+	assert.Equal(t, http.StatusOK, list.Data.Code)
+}
+
+func TestFeatures_FeatureGate(t *testing.T) {
+	t.Parallel()
+
+	ctx, _, s := newRestServer(t, &rest.ServerConfig{
+		FeatureGate: rest.FeatureGateFunc(func(_ context.Context, _ rest.Operation, schema string) bool {
+			return schema != "Pet"
+		}),
+	})
+
+	resp := enttest.Request[rest.PagedResponse[map[string]any]](ctx, s, http.MethodGet, "/pets", http.NoBody)
+	require.NotNil(t, resp.Error)
+	assert.Equal(t, http.StatusNotFound, resp.Data.Code)
+
+	enttest.Request[rest.PagedResponse[map[string]any]](ctx, s, http.MethodGet, "/users", http.NoBody).Must(t)
+}
+
+func TestFeatures_Batch(t *testing.T) {
+	t.Parallel()
+
+	ctx, db, s := newRestServer(t, nil)
+
+	body, err := json.Marshal(newPetPayload())
+	require.NoError(t, err)
+
+	resp := enttest.Request[rest.BatchResponse](ctx, s, http.MethodPost, "/batch", &rest.BatchRequest{
+		Transactional: true,
+		Requests: []*rest.BatchRequestItem{
+			{ID: "create", Method: http.MethodPost, Path: "/pets", Body: body},
+			{ID: "list", Method: http.MethodGet, Path: "/pets"},
+		},
+	}).Must(t)
+
+	require.Len(t, resp.Value.Responses, 2)
+	assert.Equal(t, "create", resp.Value.Responses[0].ID)
+	assert.Equal(t, http.StatusCreated, resp.Value.Responses[0].Status)
+	assert.Equal(t, http.StatusOK, resp.Value.Responses[1].Status)
+	assert.Equal(t, 1, db.Pet.Query().CountX(ctx))
+
+	// A failed request should roll back the whole batch.
+	resp = enttest.Request[rest.BatchResponse](ctx, s, http.MethodPost, "/batch", &rest.BatchRequest{
+		Transactional: true,
+		Requests: []*rest.BatchRequestItem{
+			{Method: http.MethodPost, Path: "/pets", Body: body},
+			{Method: http.MethodGet, Path: "/pets/999999"},
+		},
+	})
+	require.NotNil(t, resp.Value)
+	assert.Equal(t, 1, db.Pet.Query().CountX(ctx))
+}
+
+func TestFeatures_Benchmarks(t *testing.T) {
+	benchmarks := enttest.Benchmarks()
+	require.NotEmpty(t, benchmarks)
+
+	// A single iteration of each benchmark is enough to ensure they actually run,
+	// rather than being skipped or failing.
+	require.NoError(t, flag.Set("test.benchtime", "1x"))
+
+	for name, fn := range benchmarks {
+		t.Run(name, func(t *testing.T) {
+			result := testing.Benchmark(func(b *testing.B) {
+				fn(b, newClient(b))
+			})
+			assert.Positive(t, result.N, "benchmark failed or was skipped")
+		})
+	}
+}
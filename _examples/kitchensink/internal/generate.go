@@ -1,6 +1,7 @@
 package internal
 
 //go:generate go run -mod=readonly database/entc.go
+//go:generate go run -mod=readonly features/entc.go
 
 import (
 	// Import tools that are used in "go:build ignore" based files, which won't
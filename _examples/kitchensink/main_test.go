@@ -3,11 +3,15 @@ package main
 import (
 	"context"
 	"database/sql"
+	"flag"
+	"maps"
 	"net/http"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -25,7 +29,7 @@ import (
 
 var sqlRegister sync.Once
 
-func newClient(t *testing.T) *ent.Client {
+func newClient(t testing.TB) *ent.Client {
 	t.Helper()
 
 	sqlRegister.Do(func() {
@@ -662,3 +666,116 @@ func TestHandler_FilterGroups(t *testing.T) {
 		assert.Equal(t, user1.ID, resp.Value.Content[0].ID)
 	}
 }
+
+// serializationError is a serialization failure, as returned by PostgreSQL drivers.
+type serializationError struct{}
+
+func (serializationError) Error() string {
+	return "could not serialize access due to concurrent update"
+}
+func (serializationError) SQLState() string { return "40001" }
+
+func TestHandler_RetryTransient(t *testing.T) {
+	ctx, db, s := newRestServer(t, &rest.ServerConfig{
+		Retry: &rest.RetryPolicy{
+			MaxAttempts: 3,
+			Backoff:     func(int) time.Duration { return 0 },
+		},
+	})
+	t.Cleanup(func() { db.Close() })
+
+	user1 := newUser(db).SaveX(ctx)
+
+	// Fail the provided number of attempts of pet mutations with a serialization failure.
+	var attempts, failures atomic.Int32
+	db.Pet.Use(func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			attempts.Add(1)
+			if failures.Add(-1) >= 0 {
+				return nil, serializationError{}
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+
+	data := map[string]any{
+		"name":  gofakeit.PetName(),
+		"age":   gofakeit.Number(1, 20),
+		"type":  pet.TypeDog,
+		"owner": user1.ID,
+	}
+
+	// Retried until it succeeds.
+	failures.Store(2)
+	resp := enttest.Request[ent.Pet](ctx, s, http.MethodPost, "/pets", data).Must(t)
+	assert.Equal(t, http.StatusCreated, resp.Data.Code)
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Equal(t, 1, db.Pet.Query().CountX(ctx))
+	pet1 := resp.Value
+
+	// Retries are exhausted.
+	attempts.Store(0)
+	failures.Store(3)
+	resp = enttest.Request[ent.Pet](ctx, s, http.MethodPost, "/pets", data)
+	assert.Equal(t, http.StatusConflict, resp.Data.Code)
+	assert.Equal(t, "1", resp.Data.Header().Get("Retry-After"))
+	assert.Equal(t, int32(3), attempts.Load())
+	assert.Equal(t, 1, db.Pet.Query().CountX(ctx))
+
+	// Updates and deletes are retried as well.
+	attempts.Store(0)
+	failures.Store(1)
+	resp = enttest.Request[ent.Pet](
+		ctx, s,
+		http.MethodPatch,
+		"/pets/"+strconv.Itoa(pet1.ID),
+		map[string]any{"name": gofakeit.PetName()},
+	).Must(t)
+	assert.Equal(t, http.StatusOK, resp.Data.Code)
+	assert.Equal(t, int32(2), attempts.Load())
+
+	attempts.Store(0)
+	failures.Store(1)
+	resp = enttest.Request[ent.Pet](ctx, s, http.MethodDelete, "/pets/"+strconv.Itoa(pet1.ID), nil).Must(t)
+	assert.Equal(t, http.StatusNoContent, resp.Data.Code)
+	assert.Equal(t, int32(2), attempts.Load())
+	assert.Equal(t, 0, db.Pet.Query().CountX(ctx))
+}
+
+func TestBenchmarks(t *testing.T) {
+	benchmarks := enttest.Benchmarks()
+	require.NotEmpty(t, benchmarks)
+
+	// A single iteration of each benchmark is enough to ensure they actually run,
+	// rather than being skipped or failing.
+	require.NoError(t, flag.Set("test.benchtime", "1x"))
+
+	for _, name := range slices.Sorted(maps.Keys(benchmarks)) {
+		t.Run(name, func(t *testing.T) {
+			result := testing.Benchmark(func(b *testing.B) {
+				benchmarks[name](b, newClient(b))
+			})
+			assert.Positive(t, result.N, "benchmark was skipped or failed")
+		})
+	}
+}
+
+func FuzzListUsers(f *testing.F) {
+	db := newClient(f)
+	f.Cleanup(func() { db.Close() })
+
+	// Malformed parameter names, which the form decoder panics on.
+	f.Add("]")
+	f.Add("name[=1")
+
+	enttest.FuzzListUsers(f, db)
+}
+
+func FuzzListPets(f *testing.F) {
+	db := newClient(f)
+	f.Cleanup(func() { db.Close() })
+
+	f.Add("]=1")
+
+	enttest.FuzzListPets(f, db)
+}
@@ -0,0 +1,75 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"context"
+
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/user"
+)
+
+// CreatePetParams defines parameters for creating a Pet via a POST request.
+type CreatePetParams struct {
+	Name string   `json:"name"`
+	Age  int      `json:"age"`
+	Type pet.Type `json:"type"`
+	// The user that owns the pet.
+	Owner *int `json:"owner,omitempty"`
+	// Pets that this pet is friends with.
+	Friends []int `json:"friends,omitempty"`
+}
+
+func (c *CreatePetParams) ApplyInputs(builder *ent.PetCreate) *ent.PetCreate {
+	builder.SetName(c.Name)
+	builder.SetAge(c.Age)
+	builder.SetType(c.Type)
+	if c.Owner != nil {
+		builder.SetOwnerID(*c.Owner)
+	}
+	builder.AddFriendIDs(c.Friends...)
+	return builder
+}
+
+// Exec wraps all logic (mapping all provided values to the builder), creates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges.
+func (c *CreatePetParams) Exec(ctx context.Context, builder *ent.PetCreate, query *ent.PetQuery) (*ent.Pet, error) {
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadPet(query.Where(pet.ID(result.ID))).Only(ctx)
+}
+
+// CreateUserParams defines parameters for creating a User via a POST request.
+type CreateUserParams struct {
+	// Username of the user.
+	Username string `json:"username"`
+	// full name/display name of the user.
+	DisplayName string `json:"display_name"`
+	// Email associated with the user.
+	Email string `json:"email"`
+	// Pets owned by the user.
+	Pets []int `json:"pets,omitempty"`
+}
+
+func (c *CreateUserParams) ApplyInputs(builder *ent.UserCreate) *ent.UserCreate {
+	builder.SetUsername(c.Username)
+	builder.SetDisplayName(c.DisplayName)
+	builder.SetEmail(c.Email)
+	builder.AddPetIDs(c.Pets...)
+	return builder
+}
+
+// Exec wraps all logic (mapping all provided values to the builder), creates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges.
+func (c *CreateUserParams) Exec(ctx context.Context, builder *ent.UserCreate, query *ent.UserQuery) (*ent.User, error) {
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadUser(query.Where(user.ID(result.ID))).Only(ctx)
+}
@@ -0,0 +1,28 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent"
+)
+
+// EagerLoadPet eager-loads the edges of a Pet entity, if any edges
+// were requested to be eager-loaded, based off associated annotations.
+func EagerLoadPet(query *ent.PetQuery) *ent.PetQuery {
+	return query.WithOwner(
+		func(e *ent.UserQuery) {
+			applySortingUser(e, "id", "asc")
+		},
+	)
+}
+
+// EagerLoadUser eager-loads the edges of a User entity, if any edges
+// were requested to be eager-loaded, based off associated annotations.
+func EagerLoadUser(query *ent.UserQuery) *ent.UserQuery {
+	return query.WithPets(
+		func(e *ent.PetQuery) {
+			applySortingPet(e, "id", "asc")
+			e.Limit(1000)
+		},
+	)
+}
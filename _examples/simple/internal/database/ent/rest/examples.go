@@ -0,0 +1,50 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// exampleFixture decodes an example payload into a fresh map, so callers are free
+// to modify the returned value.
+func exampleFixture(payload string) map[string]any {
+	var v map[string]any
+	if err := json.Unmarshal([]byte(payload), &v); err != nil {
+		panic(fmt.Sprintf("failed to decode example payload: %v", err))
+	}
+	return v
+}
+
+// ExamplePetCreate returns a deterministic example payload for creating
+// a Pet entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExamplePetCreate() map[string]any {
+	return exampleFixture("{\"age\":2,\"friends\":[1],\"name\":\"Kuro\",\"owner\":1,\"type\":\"DOG\"}")
+}
+
+// ExamplePetUpdate returns a deterministic example payload for updating
+// a Pet entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExamplePetUpdate() map[string]any {
+	return exampleFixture("{\"add_friends\":[1],\"age\":2,\"name\":\"Kuro\",\"owner\":1,\"remove_friends\":[1],\"type\":\"DOG\"}")
+}
+
+// ExampleUserCreate returns a deterministic example payload for creating
+// a User entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleUserCreate() map[string]any {
+	return exampleFixture("{\"display_name\":\"Example Name\",\"email\":\"John.Smith@example.com\",\"pets\":[1],\"username\":\"Example Name\"}")
+}
+
+// ExampleUserUpdate returns a deterministic example payload for updating
+// a User entity. This is the same example data used within the OpenAPI
+// spec (and thus by mock servers built from it). Edge IDs may need to be
+// replaced with IDs of existing entities.
+func ExampleUserUpdate() map[string]any {
+	return exampleFixture("{\"add_pets\":[1],\"display_name\":\"Example Name\",\"email\":\"John.Smith@example.com\",\"remove_pets\":[1]}")
+}
@@ -0,0 +1,1078 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/predicate"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/user"
+)
+
+type PageConfig struct {
+	MinItemsPerPage int `json:"min_items_per_page"`
+	ItemsPerPage    int `json:"items_per_page"`
+	MaxItemsPerPage int `json:"max_items_per_page"`
+}
+
+var (
+	firstPage PageNumber = 1
+	// DefaultPageConfig defines the page configuration for LIST-related endpoints
+	// for all entities by default. If the configuration is not overridden for a
+	// specific entity, this will be used.
+	DefaultPageConfig = &PageConfig{
+		MinItemsPerPage: 1,
+		ItemsPerPage:    10,
+		MaxItemsPerPage: 100,
+	}
+	// PetPageConfig defines the page configuration for LIST-related endpoints
+	// for Pet.
+	PetPageConfig = &PageConfig{
+		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
+		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
+		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+	}
+	// UserPageConfig defines the page configuration for LIST-related endpoints
+	// for User.
+	UserPageConfig = &PageConfig{
+		MinItemsPerPage: DefaultPageConfig.MinItemsPerPage,
+		ItemsPerPage:    DefaultPageConfig.ItemsPerPage,
+		MaxItemsPerPage: DefaultPageConfig.MaxItemsPerPage,
+	}
+)
+
+// PagableQuery is an interface for ent queries which support providing limit/offset.
+type PagableQuery[P any, T any] interface {
+	Limit(int) P
+	Offset(int) P
+	Count(ctx context.Context) (int, error)
+	All(ctx context.Context) ([]*T, error)
+}
+
+// PagedResponse is the JSON response structure for paged queries.
+type PagedResponse[T any] struct {
+	Page         int  `json:"page"`          // Current page number.
+	TotalCount   int  `json:"total_count"`   // Total number of items.
+	LastPage     int  `json:"last_page"`     // Last page number.
+	IsFirstPage  bool `json:"is_first_page"` // Whether this is the first page.
+	IsLastPage   bool `json:"is_last_page"`  // Whether this is the last page.
+	PerPage      int  `json:"-"`             // Number of items per page.
+	NextPage     *int `json:"-"`             // Next page number, if any.
+	PreviousPage *int `json:"-"`             // Previous page number, if any.
+	Content      []*T `json:"content"`       // Paged data.
+}
+
+// GetPage returns the current page number.
+func (p *PagedResponse[T]) GetPage() int {
+	return p.Page
+}
+
+// GetTotalCount returns the total number of items.
+func (p *PagedResponse[T]) GetTotalCount() int {
+	return p.TotalCount
+}
+
+// GetLastPage returns the last page number.
+func (p *PagedResponse[T]) GetLastPage() int {
+	return p.LastPage
+}
+
+// GetIsFirstPage returns whether this is the first page.
+func (p *PagedResponse[T]) GetIsFirstPage() bool {
+	return p.IsFirstPage
+}
+
+// GetIsLastPage returns whether this is the last page.
+func (p *PagedResponse[T]) GetIsLastPage() bool {
+	return p.IsLastPage
+}
+
+// PageNumber is the page number of paginated queries. Besides page numbers, "first" and
+// "last" are accepted, where the last page is resolved once the total number of results
+// is known (see [PageLast]).
+type PageNumber int
+
+// PageLast is the page number which is resolved to the last page of results by
+// ApplyPagination.
+const PageLast PageNumber = -1
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+func (p *PageNumber) UnmarshalText(b []byte) error {
+	switch string(b) {
+	case "first":
+		*p = firstPage
+	case "last":
+		*p = PageLast
+	default:
+		v, err := strconv.Atoi(string(b))
+		if err != nil || v < 1 {
+			return fmt.Errorf("invalid page %q, must be a page number (>= 1), \"first\" or \"last\"", b)
+		}
+		*p = PageNumber(v)
+	}
+	return nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, accepting both numbers and
+// strings.
+func (p *PageNumber) UnmarshalJSON(b []byte) error {
+	if len(b) > 0 && b[0] == '"' {
+		var s string
+		if err := json.Unmarshal(b, &s); err != nil {
+			return err
+		}
+		b = []byte(s)
+	}
+	return p.UnmarshalText(b)
+}
+
+type Paginated[P PagableQuery[P, T], T any] struct {
+	Page         *PageNumber `json:"page"     form:"page,omitempty"`
+	ItemsPerPage *int        `json:"per_page" form:"per_page,omitempty"`
+	ResultCount  int         `json:"-"        form:"-"` // ResultCount is populated by the query execution inside of ApplyPagination.
+	LastPage     int         `json:"-"        form:"-"` // LastPage is populated by the query execution inside of ApplyPagination.
+
+	hasApplied bool `json:"-" form:"-"`
+}
+
+// ApplyPagination applies offsets and limits, and also runs a count query on the
+// provided query to calculate total results and what the last page number is.
+func (p *Paginated[P, T]) ApplyPagination(ctx context.Context, query P, pageConfig *PageConfig) (P, error) {
+	if pageConfig == nil {
+		pageConfig = DefaultPageConfig
+	}
+
+	if p.Page == nil {
+		p.Page = &firstPage
+	}
+
+	if p.ItemsPerPage == nil {
+		p.ItemsPerPage = &pageConfig.ItemsPerPage
+	}
+
+	if *p.ItemsPerPage < pageConfig.MinItemsPerPage {
+		return query, &ErrBadRequest{Err: fmt.Errorf("per_page %d is out of bounds, must be >= %d", *p.ItemsPerPage, pageConfig.MinItemsPerPage)}
+	}
+
+	if *p.ItemsPerPage > pageConfig.MaxItemsPerPage {
+		return query, &ErrBadRequest{Err: fmt.Errorf("per_page %d is out of bounds, must be <= %d", *p.ItemsPerPage, pageConfig.MaxItemsPerPage)}
+	}
+
+	if *p.Page < 1 && *p.Page != PageLast {
+		return query, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, must be >= 1", *p.Page)}
+	}
+
+	var err error
+
+	p.ResultCount, err = query.Count(ctx)
+	if err != nil {
+		return query, err
+	}
+
+	// TODO: how to calculate this without knowing the total count?
+	p.LastPage = int(math.Ceil(float64(p.ResultCount) / float64(*p.ItemsPerPage)))
+
+	if p.LastPage < 1 {
+		p.LastPage = 1
+	}
+
+	if *p.Page == PageLast {
+		page := PageNumber(p.LastPage)
+		p.Page = &page
+	}
+
+	if int(*p.Page) > p.LastPage {
+		return query, &ErrBadRequest{Err: fmt.Errorf("page %d is out of bounds, last page is %d", *p.Page, p.LastPage)}
+	}
+
+	p.hasApplied = true
+	return query.Limit(*p.ItemsPerPage).Offset((int(*p.Page) - 1) * *p.ItemsPerPage), nil
+}
+
+// ExecutePaginated executes the query and returns a paged response. If ApplyPagination
+// was not called before, it will be called here.
+func (p *Paginated[P, T]) ExecutePaginated(ctx context.Context, query P, pageConfig *PageConfig) (*PagedResponse[T], error) {
+	if !p.hasApplied {
+		var err error
+		query, err = p.ApplyPagination(ctx, query, pageConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := query.All(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	page := int(*p.Page)
+
+	resp := &PagedResponse[T]{
+		Page:        page,
+		TotalCount:  p.ResultCount,
+		LastPage:    p.LastPage,
+		IsFirstPage: page == 1,
+		IsLastPage:  page == p.LastPage,
+		PerPage:     *p.ItemsPerPage,
+		Content:     data,
+	}
+
+	if !resp.IsLastPage {
+		next := page + 1
+		resp.NextPage = &next
+	}
+
+	if page > 1 {
+		prev := page - 1
+		resp.PreviousPage = &prev
+	}
+	return resp, nil
+}
+
+// listResult wraps the results of a non-paginated list query, so they can be returned
+// from handlers which expect a pointer result.
+func listResult[T any](results []*T, err error) (*[]*T, error) {
+	if err != nil {
+		return nil, err
+	}
+	return &results, nil
+}
+
+// FilterOperation represents if all or any (one or more) filters should be applied.
+type FilterOperation string
+
+const (
+	FilterOperationAnd FilterOperation = "and" // Represents the "and" predicate, applying against all filters combined.
+	FilterOperationOr  FilterOperation = "or"  // Represents the "or" predicate, applying against any number of filters.
+)
+
+var (
+	// FilterOperations defines the available filter methods.
+	FilterOperations = []FilterOperation{FilterOperationAnd, FilterOperationOr}
+)
+
+// validateFilter validates the provided filter values, each of which must pass at least
+// one of the provided validators (e.g. the enum or field validators of the filtered
+// fields), so filters which can never match return an error rather than no results.
+func validateFilter[T any](param string, values []T, validators ...func(T) error) error {
+	for _, v := range values {
+		var err error
+		for _, validate := range validators {
+			if validate == nil {
+				err = nil
+				break
+			}
+			if err = validate(v); err == nil {
+				break
+			}
+		}
+		if err != nil {
+			return &ErrBadRequest{Err: fmt.Errorf("invalid value provided for filter %q: %w", param, err)}
+		}
+	}
+	return nil
+}
+
+type Filtered[P ~func(*sql.Selector)] struct {
+	// FilterOperation controls how multiple predicates are applied together.
+	FilterOperation *FilterOperation `json:"filter_op,omitempty" form:"filter_op,omitempty"`
+}
+
+// ApplyFilterOperation applies the requested filter operation (if provided) to the
+// provided predicates. If no filter operation is provided, the predicates are
+// returned with AND.
+func (f *Filtered[P]) ApplyFilterOperation(predicates ...P) (P, error) {
+	if f.FilterOperation == nil || *f.FilterOperation == FilterOperationAnd {
+		return sql.AndPredicates(predicates...), nil
+	}
+	if !slices.Contains(FilterOperations, *f.FilterOperation) {
+		return nil, &ErrBadRequest{Err: fmt.Errorf("invalid filter method: %s", *f.FilterOperation)}
+	}
+	return sql.OrPredicates(predicates...), nil
+}
+
+// ListPetParams defines parameters for listing Pets via a GET request.
+type ListPetParams struct {
+	Sorted
+	Paginated[*ent.PetQuery, ent.Pet]
+	Filtered[predicate.Pet]
+
+	// Filters field "name" to be equal to the provided value.
+	PetNameEQ *string `form:"name.eq,omitempty" json:"pet_name_eq,omitempty"`
+	// Filters field "name" to be not equal to the provided value.
+	PetNameNEQ *string `form:"name.neq,omitempty" json:"pet_name_neq,omitempty"`
+	// Filters field "name" to be within the provided values.
+	PetNameIn []string `form:"name.in,omitempty" json:"pet_name_in,omitempty"`
+	// Filters field "name" to be not within the provided values.
+	PetNameNotIn []string `form:"name.notIn,omitempty" json:"pet_name_not_in,omitempty"`
+	// Filters field "name" to be equal to the provided value, case-insensitive.
+	PetNameEqualFold *string `form:"name.ieq,omitempty" json:"pet_name_equal_fold,omitempty"`
+	// Filters field "name" to contain the provided value.
+	PetNameContains *string `form:"name.has,omitempty" json:"pet_name_contains,omitempty"`
+	// Filters field "name" to contain the provided value, case-insensitive.
+	PetNameContainsFold *string `form:"name.ihas,omitempty" json:"pet_name_contains_fold,omitempty"`
+	// Filters field "name" to start with the provided value.
+	PetNameHasPrefix *string `form:"name.prefix,omitempty" json:"pet_name_has_prefix,omitempty"`
+	// Filters field "name" to end with the provided value.
+	PetNameHasSuffix *string `form:"name.suffix,omitempty" json:"pet_name_has_suffix,omitempty"`
+	// Filters field "age" to be equal to the provided value.
+	PetAgeEQ *int `form:"age.eq,omitempty" json:"pet_age_eq,omitempty"`
+	// Filters field "age" to be not equal to the provided value.
+	PetAgeNEQ *int `form:"age.neq,omitempty" json:"pet_age_neq,omitempty"`
+	// Filters field "age" to be greater than the provided value.
+	PetAgeGT *int `form:"age.gt,omitempty" json:"pet_age_gt,omitempty"`
+	// Filters field "age" to be less than the provided value.
+	PetAgeLT *int `form:"age.lt,omitempty" json:"pet_age_lt,omitempty"`
+	// Filters field "age" to be within the provided values.
+	PetAgeIn []int `form:"age.in,omitempty" json:"pet_age_in,omitempty"`
+	// Filters field "age" to be not within the provided values.
+	PetAgeNotIn []int `form:"age.notIn,omitempty" json:"pet_age_not_in,omitempty"`
+	// Filters field "type" to be equal to the provided value.
+	PetTypeEQ *pet.Type `form:"type.eq,omitempty" json:"pet_type_eq,omitempty"`
+	// Filters field "type" to be not equal to the provided value.
+	PetTypeNEQ *pet.Type `form:"type.neq,omitempty" json:"pet_type_neq,omitempty"`
+	// Filters field "type" to be within the provided values.
+	PetTypeIn []pet.Type `form:"type.in,omitempty" json:"pet_type_in,omitempty"`
+	// Filters field "type" to be not within the provided values.
+	PetTypeNotIn []pet.Type `form:"type.notIn,omitempty" json:"pet_type_not_in,omitempty"`
+	// If true, only return entities that have a owner edge.
+	EdgeHasOwner *bool `form:"has.owner,omitempty" json:"edge_has_owner,omitempty"`
+	// Filters field "username" to be equal to the provided value.
+	EdgeOwnerUsernameEQ *string `form:"owner.username.eq,omitempty" json:"edge_owner_username_eq,omitempty"`
+	// Filters field "username" to be not equal to the provided value.
+	EdgeOwnerUsernameNEQ *string `form:"owner.username.neq,omitempty" json:"edge_owner_username_neq,omitempty"`
+	// Filters field "username" to be within the provided values.
+	EdgeOwnerUsernameIn []string `form:"owner.username.in,omitempty" json:"edge_owner_username_in,omitempty"`
+	// Filters field "username" to be not within the provided values.
+	EdgeOwnerUsernameNotIn []string `form:"owner.username.notIn,omitempty" json:"edge_owner_username_not_in,omitempty"`
+	// Filters field "username" to be equal to the provided value, case-insensitive.
+	EdgeOwnerUsernameEqualFold *string `form:"owner.username.ieq,omitempty" json:"edge_owner_username_equal_fold,omitempty"`
+	// Filters field "username" to contain the provided value.
+	EdgeOwnerUsernameContains *string `form:"owner.username.has,omitempty" json:"edge_owner_username_contains,omitempty"`
+	// Filters field "username" to contain the provided value, case-insensitive.
+	EdgeOwnerUsernameContainsFold *string `form:"owner.username.ihas,omitempty" json:"edge_owner_username_contains_fold,omitempty"`
+	// Filters field "username" to start with the provided value.
+	EdgeOwnerUsernameHasPrefix *string `form:"owner.username.prefix,omitempty" json:"edge_owner_username_has_prefix,omitempty"`
+	// Filters field "username" to end with the provided value.
+	EdgeOwnerUsernameHasSuffix *string `form:"owner.username.suffix,omitempty" json:"edge_owner_username_has_suffix,omitempty"`
+	// Filters field "display_name" to be equal to the provided value.
+	EdgeOwnerDisplayNameEQ *string `form:"owner.displayName.eq,omitempty" json:"edge_owner_display_name_eq,omitempty"`
+	// Filters field "display_name" to be not equal to the provided value.
+	EdgeOwnerDisplayNameNEQ *string `form:"owner.displayName.neq,omitempty" json:"edge_owner_display_name_neq,omitempty"`
+	// Filters field "display_name" to be within the provided values.
+	EdgeOwnerDisplayNameIn []string `form:"owner.displayName.in,omitempty" json:"edge_owner_display_name_in,omitempty"`
+	// Filters field "display_name" to be not within the provided values.
+	EdgeOwnerDisplayNameNotIn []string `form:"owner.displayName.notIn,omitempty" json:"edge_owner_display_name_not_in,omitempty"`
+	// Filters field "display_name" to be equal to the provided value, case-insensitive.
+	EdgeOwnerDisplayNameEqualFold *string `form:"owner.displayName.ieq,omitempty" json:"edge_owner_display_name_equal_fold,omitempty"`
+	// Filters field "display_name" to contain the provided value.
+	EdgeOwnerDisplayNameContains *string `form:"owner.displayName.has,omitempty" json:"edge_owner_display_name_contains,omitempty"`
+	// Filters field "display_name" to contain the provided value, case-insensitive.
+	EdgeOwnerDisplayNameContainsFold *string `form:"owner.displayName.ihas,omitempty" json:"edge_owner_display_name_contains_fold,omitempty"`
+	// Filters field "display_name" to start with the provided value.
+	EdgeOwnerDisplayNameHasPrefix *string `form:"owner.displayName.prefix,omitempty" json:"edge_owner_display_name_has_prefix,omitempty"`
+	// Filters field "display_name" to end with the provided value.
+	EdgeOwnerDisplayNameHasSuffix *string `form:"owner.displayName.suffix,omitempty" json:"edge_owner_display_name_has_suffix,omitempty"`
+	// Filters field "email" to be equal to the provided value.
+	EdgeOwnerEmailEQ *string `form:"owner.email.eq,omitempty" json:"edge_owner_email_eq,omitempty"`
+	// Filters field "email" to be not equal to the provided value.
+	EdgeOwnerEmailNEQ *string `form:"owner.email.neq,omitempty" json:"edge_owner_email_neq,omitempty"`
+	// Filters field "email" to be within the provided values.
+	EdgeOwnerEmailIn []string `form:"owner.email.in,omitempty" json:"edge_owner_email_in,omitempty"`
+	// Filters field "email" to be not within the provided values.
+	EdgeOwnerEmailNotIn []string `form:"owner.email.notIn,omitempty" json:"edge_owner_email_not_in,omitempty"`
+	// Filters field "email" to be equal to the provided value, case-insensitive.
+	EdgeOwnerEmailEqualFold *string `form:"owner.email.ieq,omitempty" json:"edge_owner_email_equal_fold,omitempty"`
+	// Filters field "email" to contain the provided value.
+	EdgeOwnerEmailContains *string `form:"owner.email.has,omitempty" json:"edge_owner_email_contains,omitempty"`
+	// Filters field "email" to contain the provided value, case-insensitive.
+	EdgeOwnerEmailContainsFold *string `form:"owner.email.ihas,omitempty" json:"edge_owner_email_contains_fold,omitempty"`
+	// Filters field "email" to start with the provided value.
+	EdgeOwnerEmailHasPrefix *string `form:"owner.email.prefix,omitempty" json:"edge_owner_email_has_prefix,omitempty"`
+	// Filters field "email" to end with the provided value.
+	EdgeOwnerEmailHasSuffix *string `form:"owner.email.suffix,omitempty" json:"edge_owner_email_has_suffix,omitempty"`
+	// If true, only return entities that have a friend edge.
+	EdgeHasFriend *bool `form:"has.friend,omitempty" json:"edge_has_friend,omitempty"`
+	// Filters field "name" to be equal to the provided value.
+	EdgeFriendNameEQ *string `form:"friend.name.eq,omitempty" json:"edge_friend_name_eq,omitempty"`
+	// Filters field "name" to be not equal to the provided value.
+	EdgeFriendNameNEQ *string `form:"friend.name.neq,omitempty" json:"edge_friend_name_neq,omitempty"`
+	// Filters field "name" to be within the provided values.
+	EdgeFriendNameIn []string `form:"friend.name.in,omitempty" json:"edge_friend_name_in,omitempty"`
+	// Filters field "name" to be not within the provided values.
+	EdgeFriendNameNotIn []string `form:"friend.name.notIn,omitempty" json:"edge_friend_name_not_in,omitempty"`
+	// Filters field "name" to be equal to the provided value, case-insensitive.
+	EdgeFriendNameEqualFold *string `form:"friend.name.ieq,omitempty" json:"edge_friend_name_equal_fold,omitempty"`
+	// Filters field "name" to contain the provided value.
+	EdgeFriendNameContains *string `form:"friend.name.has,omitempty" json:"edge_friend_name_contains,omitempty"`
+	// Filters field "name" to contain the provided value, case-insensitive.
+	EdgeFriendNameContainsFold *string `form:"friend.name.ihas,omitempty" json:"edge_friend_name_contains_fold,omitempty"`
+	// Filters field "name" to start with the provided value.
+	EdgeFriendNameHasPrefix *string `form:"friend.name.prefix,omitempty" json:"edge_friend_name_has_prefix,omitempty"`
+	// Filters field "name" to end with the provided value.
+	EdgeFriendNameHasSuffix *string `form:"friend.name.suffix,omitempty" json:"edge_friend_name_has_suffix,omitempty"`
+	// Filters field "age" to be equal to the provided value.
+	EdgeFriendAgeEQ *int `form:"friend.age.eq,omitempty" json:"edge_friend_age_eq,omitempty"`
+	// Filters field "age" to be not equal to the provided value.
+	EdgeFriendAgeNEQ *int `form:"friend.age.neq,omitempty" json:"edge_friend_age_neq,omitempty"`
+	// Filters field "age" to be greater than the provided value.
+	EdgeFriendAgeGT *int `form:"friend.age.gt,omitempty" json:"edge_friend_age_gt,omitempty"`
+	// Filters field "age" to be less than the provided value.
+	EdgeFriendAgeLT *int `form:"friend.age.lt,omitempty" json:"edge_friend_age_lt,omitempty"`
+	// Filters field "age" to be within the provided values.
+	EdgeFriendAgeIn []int `form:"friend.age.in,omitempty" json:"edge_friend_age_in,omitempty"`
+	// Filters field "age" to be not within the provided values.
+	EdgeFriendAgeNotIn []int `form:"friend.age.notIn,omitempty" json:"edge_friend_age_not_in,omitempty"`
+	// Filters field "type" to be equal to the provided value.
+	EdgeFriendTypeEQ *pet.Type `form:"friend.type.eq,omitempty" json:"edge_friend_type_eq,omitempty"`
+	// Filters field "type" to be not equal to the provided value.
+	EdgeFriendTypeNEQ *pet.Type `form:"friend.type.neq,omitempty" json:"edge_friend_type_neq,omitempty"`
+	// Filters field "type" to be within the provided values.
+	EdgeFriendTypeIn []pet.Type `form:"friend.type.in,omitempty" json:"edge_friend_type_in,omitempty"`
+	// Filters field "type" to be not within the provided values.
+	EdgeFriendTypeNotIn []pet.Type `form:"friend.type.notIn,omitempty" json:"edge_friend_type_not_in,omitempty"`
+}
+
+// FilterPredicates returns the predicates for filter-related parameters in Pet.
+func (l *ListPetParams) FilterPredicates() (predicate.Pet, error) {
+	var predicates []predicate.Pet
+
+	if l.PetNameEQ != nil {
+		predicates = append(predicates, pet.NameEQ(*l.PetNameEQ))
+	}
+	if l.PetNameNEQ != nil {
+		predicates = append(predicates, pet.NameNEQ(*l.PetNameNEQ))
+	}
+	if l.PetNameIn != nil {
+		predicates = append(predicates, pet.NameIn(l.PetNameIn...))
+	}
+	if l.PetNameNotIn != nil {
+		predicates = append(predicates, pet.NameNotIn(l.PetNameNotIn...))
+	}
+	if l.PetNameEqualFold != nil {
+		predicates = append(predicates, pet.NameEqualFold(*l.PetNameEqualFold))
+	}
+	if l.PetNameContains != nil {
+		predicates = append(predicates, pet.NameContains(*l.PetNameContains))
+	}
+	if l.PetNameContainsFold != nil {
+		predicates = append(predicates, pet.NameContainsFold(*l.PetNameContainsFold))
+	}
+	if l.PetNameHasPrefix != nil {
+		predicates = append(predicates, pet.NameHasPrefix(*l.PetNameHasPrefix))
+	}
+	if l.PetNameHasSuffix != nil {
+		predicates = append(predicates, pet.NameHasSuffix(*l.PetNameHasSuffix))
+	}
+	if l.PetAgeEQ != nil {
+		if err := validateFilter("age.eq", []int{*l.PetAgeEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.AgeEQ(*l.PetAgeEQ))
+	}
+	if l.PetAgeNEQ != nil {
+		if err := validateFilter("age.neq", []int{*l.PetAgeNEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.AgeNEQ(*l.PetAgeNEQ))
+	}
+	if l.PetAgeGT != nil {
+		predicates = append(predicates, pet.AgeGT(*l.PetAgeGT))
+	}
+	if l.PetAgeLT != nil {
+		predicates = append(predicates, pet.AgeLT(*l.PetAgeLT))
+	}
+	if l.PetAgeIn != nil {
+		if err := validateFilter("age.in", l.PetAgeIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.AgeIn(l.PetAgeIn...))
+	}
+	if l.PetAgeNotIn != nil {
+		if err := validateFilter("age.notIn", l.PetAgeNotIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.AgeNotIn(l.PetAgeNotIn...))
+	}
+	if l.PetTypeEQ != nil {
+		if err := validateFilter("type.eq", []pet.Type{*l.PetTypeEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.TypeEQ(*l.PetTypeEQ))
+	}
+	if l.PetTypeNEQ != nil {
+		if err := validateFilter("type.neq", []pet.Type{*l.PetTypeNEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.TypeNEQ(*l.PetTypeNEQ))
+	}
+	if l.PetTypeIn != nil {
+		if err := validateFilter("type.in", l.PetTypeIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.TypeIn(l.PetTypeIn...))
+	}
+	if l.PetTypeNotIn != nil {
+		if err := validateFilter("type.notIn", l.PetTypeNotIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.TypeNotIn(l.PetTypeNotIn...))
+	}
+	if l.EdgeHasOwner != nil {
+		if *l.EdgeHasOwner {
+			predicates = append(predicates, pet.HasOwner())
+		} else {
+			predicates = append(predicates, pet.Not(pet.HasOwner()))
+		}
+	}
+	if l.EdgeOwnerUsernameEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameEQ(*l.EdgeOwnerUsernameEQ)))
+	}
+	if l.EdgeOwnerUsernameNEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameNEQ(*l.EdgeOwnerUsernameNEQ)))
+	}
+	if l.EdgeOwnerUsernameIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameIn(l.EdgeOwnerUsernameIn...)))
+	}
+	if l.EdgeOwnerUsernameNotIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameNotIn(l.EdgeOwnerUsernameNotIn...)))
+	}
+	if l.EdgeOwnerUsernameEqualFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameEqualFold(*l.EdgeOwnerUsernameEqualFold)))
+	}
+	if l.EdgeOwnerUsernameContains != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameContains(*l.EdgeOwnerUsernameContains)))
+	}
+	if l.EdgeOwnerUsernameContainsFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameContainsFold(*l.EdgeOwnerUsernameContainsFold)))
+	}
+	if l.EdgeOwnerUsernameHasPrefix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameHasPrefix(*l.EdgeOwnerUsernameHasPrefix)))
+	}
+	if l.EdgeOwnerUsernameHasSuffix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.UsernameHasSuffix(*l.EdgeOwnerUsernameHasSuffix)))
+	}
+	if l.EdgeOwnerDisplayNameEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameEQ(*l.EdgeOwnerDisplayNameEQ)))
+	}
+	if l.EdgeOwnerDisplayNameNEQ != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameNEQ(*l.EdgeOwnerDisplayNameNEQ)))
+	}
+	if l.EdgeOwnerDisplayNameIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameIn(l.EdgeOwnerDisplayNameIn...)))
+	}
+	if l.EdgeOwnerDisplayNameNotIn != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameNotIn(l.EdgeOwnerDisplayNameNotIn...)))
+	}
+	if l.EdgeOwnerDisplayNameEqualFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameEqualFold(*l.EdgeOwnerDisplayNameEqualFold)))
+	}
+	if l.EdgeOwnerDisplayNameContains != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameContains(*l.EdgeOwnerDisplayNameContains)))
+	}
+	if l.EdgeOwnerDisplayNameContainsFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameContainsFold(*l.EdgeOwnerDisplayNameContainsFold)))
+	}
+	if l.EdgeOwnerDisplayNameHasPrefix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameHasPrefix(*l.EdgeOwnerDisplayNameHasPrefix)))
+	}
+	if l.EdgeOwnerDisplayNameHasSuffix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.DisplayNameHasSuffix(*l.EdgeOwnerDisplayNameHasSuffix)))
+	}
+	if l.EdgeOwnerEmailEQ != nil {
+		if err := validateFilter("owner.email.eq", []string{*l.EdgeOwnerEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailEQ(*l.EdgeOwnerEmailEQ)))
+	}
+	if l.EdgeOwnerEmailNEQ != nil {
+		if err := validateFilter("owner.email.neq", []string{*l.EdgeOwnerEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailNEQ(*l.EdgeOwnerEmailNEQ)))
+	}
+	if l.EdgeOwnerEmailIn != nil {
+		if err := validateFilter("owner.email.in", l.EdgeOwnerEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailIn(l.EdgeOwnerEmailIn...)))
+	}
+	if l.EdgeOwnerEmailNotIn != nil {
+		if err := validateFilter("owner.email.notIn", l.EdgeOwnerEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailNotIn(l.EdgeOwnerEmailNotIn...)))
+	}
+	if l.EdgeOwnerEmailEqualFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailEqualFold(*l.EdgeOwnerEmailEqualFold)))
+	}
+	if l.EdgeOwnerEmailContains != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailContains(*l.EdgeOwnerEmailContains)))
+	}
+	if l.EdgeOwnerEmailContainsFold != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailContainsFold(*l.EdgeOwnerEmailContainsFold)))
+	}
+	if l.EdgeOwnerEmailHasPrefix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailHasPrefix(*l.EdgeOwnerEmailHasPrefix)))
+	}
+	if l.EdgeOwnerEmailHasSuffix != nil {
+		predicates = append(predicates, pet.HasOwnerWith(user.EmailHasSuffix(*l.EdgeOwnerEmailHasSuffix)))
+	}
+	if l.EdgeHasFriend != nil {
+		if *l.EdgeHasFriend {
+			predicates = append(predicates, pet.HasFriends())
+		} else {
+			predicates = append(predicates, pet.Not(pet.HasFriends()))
+		}
+	}
+	if l.EdgeFriendNameEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameEQ(*l.EdgeFriendNameEQ)))
+	}
+	if l.EdgeFriendNameNEQ != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameNEQ(*l.EdgeFriendNameNEQ)))
+	}
+	if l.EdgeFriendNameIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameIn(l.EdgeFriendNameIn...)))
+	}
+	if l.EdgeFriendNameNotIn != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameNotIn(l.EdgeFriendNameNotIn...)))
+	}
+	if l.EdgeFriendNameEqualFold != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameEqualFold(*l.EdgeFriendNameEqualFold)))
+	}
+	if l.EdgeFriendNameContains != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameContains(*l.EdgeFriendNameContains)))
+	}
+	if l.EdgeFriendNameContainsFold != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameContainsFold(*l.EdgeFriendNameContainsFold)))
+	}
+	if l.EdgeFriendNameHasPrefix != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameHasPrefix(*l.EdgeFriendNameHasPrefix)))
+	}
+	if l.EdgeFriendNameHasSuffix != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.NameHasSuffix(*l.EdgeFriendNameHasSuffix)))
+	}
+	if l.EdgeFriendAgeEQ != nil {
+		if err := validateFilter("friend.age.eq", []int{*l.EdgeFriendAgeEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeEQ(*l.EdgeFriendAgeEQ)))
+	}
+	if l.EdgeFriendAgeNEQ != nil {
+		if err := validateFilter("friend.age.neq", []int{*l.EdgeFriendAgeNEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNEQ(*l.EdgeFriendAgeNEQ)))
+	}
+	if l.EdgeFriendAgeGT != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeGT(*l.EdgeFriendAgeGT)))
+	}
+	if l.EdgeFriendAgeLT != nil {
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeLT(*l.EdgeFriendAgeLT)))
+	}
+	if l.EdgeFriendAgeIn != nil {
+		if err := validateFilter("friend.age.in", l.EdgeFriendAgeIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeIn(l.EdgeFriendAgeIn...)))
+	}
+	if l.EdgeFriendAgeNotIn != nil {
+		if err := validateFilter("friend.age.notIn", l.EdgeFriendAgeNotIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.AgeNotIn(l.EdgeFriendAgeNotIn...)))
+	}
+	if l.EdgeFriendTypeEQ != nil {
+		if err := validateFilter("friend.type.eq", []pet.Type{*l.EdgeFriendTypeEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeEQ(*l.EdgeFriendTypeEQ)))
+	}
+	if l.EdgeFriendTypeNEQ != nil {
+		if err := validateFilter("friend.type.neq", []pet.Type{*l.EdgeFriendTypeNEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNEQ(*l.EdgeFriendTypeNEQ)))
+	}
+	if l.EdgeFriendTypeIn != nil {
+		if err := validateFilter("friend.type.in", l.EdgeFriendTypeIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeIn(l.EdgeFriendTypeIn...)))
+	}
+	if l.EdgeFriendTypeNotIn != nil {
+		if err := validateFilter("friend.type.notIn", l.EdgeFriendTypeNotIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, pet.HasFriendsWith(pet.TypeNotIn(l.EdgeFriendTypeNotIn...)))
+	}
+
+	return l.ApplyFilterOperation(predicates...)
+}
+
+// ApplySorting applies sorting to the query based on the provided sort and order fields.
+func (l *ListPetParams) ApplySorting(query *ent.PetQuery) error {
+	if err := l.Sorted.Validate(PetSortConfig); err != nil {
+		return err
+	}
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakPet(query, orderAsc)
+		return nil
+	}
+	applySortingPet(query, PetSortConfig.column(*l.Field), *l.Order)
+	return nil
+}
+
+// Exec wraps all logic (filtering, sorting, pagination, eager loading) and
+// executes all necessary queries, returning the results.
+func (l *ListPetParams) Exec(ctx context.Context, query *ent.PetQuery) (results *PagedResponse[ent.Pet], err error) {
+	return l.ExecWithPageConfig(ctx, query, PetPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListPetParams) ExecWithPageConfig(ctx context.Context, query *ent.PetQuery, pageConfig *PageConfig) (results *PagedResponse[ent.Pet], err error) {
+	predicates, err := l.FilterPredicates()
+	if err != nil {
+		return nil, err
+	}
+	query.Where(predicates)
+	err = l.ApplySorting(EagerLoadPet(query))
+	if err != nil {
+		return nil, err
+	}
+	return l.ExecutePaginated(ctx, query, pageConfig)
+}
+
+// ListUserParams defines parameters for listing Users via a GET request.
+type ListUserParams struct {
+	Sorted
+	Paginated[*ent.UserQuery, ent.User]
+	Filtered[predicate.User]
+
+	// Filters field "username" to be equal to the provided value.
+	UserUsernameEQ *string `form:"username.eq,omitempty" json:"user_username_eq,omitempty"`
+	// Filters field "username" to be not equal to the provided value.
+	UserUsernameNEQ *string `form:"username.neq,omitempty" json:"user_username_neq,omitempty"`
+	// Filters field "username" to be within the provided values.
+	UserUsernameIn []string `form:"username.in,omitempty" json:"user_username_in,omitempty"`
+	// Filters field "username" to be not within the provided values.
+	UserUsernameNotIn []string `form:"username.notIn,omitempty" json:"user_username_not_in,omitempty"`
+	// Filters field "username" to be equal to the provided value, case-insensitive.
+	UserUsernameEqualFold *string `form:"username.ieq,omitempty" json:"user_username_equal_fold,omitempty"`
+	// Filters field "username" to contain the provided value.
+	UserUsernameContains *string `form:"username.has,omitempty" json:"user_username_contains,omitempty"`
+	// Filters field "username" to contain the provided value, case-insensitive.
+	UserUsernameContainsFold *string `form:"username.ihas,omitempty" json:"user_username_contains_fold,omitempty"`
+	// Filters field "username" to start with the provided value.
+	UserUsernameHasPrefix *string `form:"username.prefix,omitempty" json:"user_username_has_prefix,omitempty"`
+	// Filters field "username" to end with the provided value.
+	UserUsernameHasSuffix *string `form:"username.suffix,omitempty" json:"user_username_has_suffix,omitempty"`
+	// Filters field "display_name" to be equal to the provided value.
+	UserDisplayNameEQ *string `form:"displayName.eq,omitempty" json:"user_display_name_eq,omitempty"`
+	// Filters field "display_name" to be not equal to the provided value.
+	UserDisplayNameNEQ *string `form:"displayName.neq,omitempty" json:"user_display_name_neq,omitempty"`
+	// Filters field "display_name" to be within the provided values.
+	UserDisplayNameIn []string `form:"displayName.in,omitempty" json:"user_display_name_in,omitempty"`
+	// Filters field "display_name" to be not within the provided values.
+	UserDisplayNameNotIn []string `form:"displayName.notIn,omitempty" json:"user_display_name_not_in,omitempty"`
+	// Filters field "display_name" to be equal to the provided value, case-insensitive.
+	UserDisplayNameEqualFold *string `form:"displayName.ieq,omitempty" json:"user_display_name_equal_fold,omitempty"`
+	// Filters field "display_name" to contain the provided value.
+	UserDisplayNameContains *string `form:"displayName.has,omitempty" json:"user_display_name_contains,omitempty"`
+	// Filters field "display_name" to contain the provided value, case-insensitive.
+	UserDisplayNameContainsFold *string `form:"displayName.ihas,omitempty" json:"user_display_name_contains_fold,omitempty"`
+	// Filters field "display_name" to start with the provided value.
+	UserDisplayNameHasPrefix *string `form:"displayName.prefix,omitempty" json:"user_display_name_has_prefix,omitempty"`
+	// Filters field "display_name" to end with the provided value.
+	UserDisplayNameHasSuffix *string `form:"displayName.suffix,omitempty" json:"user_display_name_has_suffix,omitempty"`
+	// Filters field "email" to be equal to the provided value.
+	UserEmailEQ *string `form:"email.eq,omitempty" json:"user_email_eq,omitempty"`
+	// Filters field "email" to be not equal to the provided value.
+	UserEmailNEQ *string `form:"email.neq,omitempty" json:"user_email_neq,omitempty"`
+	// Filters field "email" to be within the provided values.
+	UserEmailIn []string `form:"email.in,omitempty" json:"user_email_in,omitempty"`
+	// Filters field "email" to be not within the provided values.
+	UserEmailNotIn []string `form:"email.notIn,omitempty" json:"user_email_not_in,omitempty"`
+	// Filters field "email" to be equal to the provided value, case-insensitive.
+	UserEmailEqualFold *string `form:"email.ieq,omitempty" json:"user_email_equal_fold,omitempty"`
+	// Filters field "email" to contain the provided value.
+	UserEmailContains *string `form:"email.has,omitempty" json:"user_email_contains,omitempty"`
+	// Filters field "email" to contain the provided value, case-insensitive.
+	UserEmailContainsFold *string `form:"email.ihas,omitempty" json:"user_email_contains_fold,omitempty"`
+	// Filters field "email" to start with the provided value.
+	UserEmailHasPrefix *string `form:"email.prefix,omitempty" json:"user_email_has_prefix,omitempty"`
+	// Filters field "email" to end with the provided value.
+	UserEmailHasSuffix *string `form:"email.suffix,omitempty" json:"user_email_has_suffix,omitempty"`
+	// If true, only return entities that have a pet edge.
+	EdgeHasPet *bool `form:"has.pet,omitempty" json:"edge_has_pet,omitempty"`
+	// Filters field "name" to be equal to the provided value.
+	EdgePetNameEQ *string `form:"pet.name.eq,omitempty" json:"edge_pet_name_eq,omitempty"`
+	// Filters field "name" to be not equal to the provided value.
+	EdgePetNameNEQ *string `form:"pet.name.neq,omitempty" json:"edge_pet_name_neq,omitempty"`
+	// Filters field "name" to be within the provided values.
+	EdgePetNameIn []string `form:"pet.name.in,omitempty" json:"edge_pet_name_in,omitempty"`
+	// Filters field "name" to be not within the provided values.
+	EdgePetNameNotIn []string `form:"pet.name.notIn,omitempty" json:"edge_pet_name_not_in,omitempty"`
+	// Filters field "name" to be equal to the provided value, case-insensitive.
+	EdgePetNameEqualFold *string `form:"pet.name.ieq,omitempty" json:"edge_pet_name_equal_fold,omitempty"`
+	// Filters field "name" to contain the provided value.
+	EdgePetNameContains *string `form:"pet.name.has,omitempty" json:"edge_pet_name_contains,omitempty"`
+	// Filters field "name" to contain the provided value, case-insensitive.
+	EdgePetNameContainsFold *string `form:"pet.name.ihas,omitempty" json:"edge_pet_name_contains_fold,omitempty"`
+	// Filters field "name" to start with the provided value.
+	EdgePetNameHasPrefix *string `form:"pet.name.prefix,omitempty" json:"edge_pet_name_has_prefix,omitempty"`
+	// Filters field "name" to end with the provided value.
+	EdgePetNameHasSuffix *string `form:"pet.name.suffix,omitempty" json:"edge_pet_name_has_suffix,omitempty"`
+	// Filters field "age" to be equal to the provided value.
+	EdgePetAgeEQ *int `form:"pet.age.eq,omitempty" json:"edge_pet_age_eq,omitempty"`
+	// Filters field "age" to be not equal to the provided value.
+	EdgePetAgeNEQ *int `form:"pet.age.neq,omitempty" json:"edge_pet_age_neq,omitempty"`
+	// Filters field "age" to be greater than the provided value.
+	EdgePetAgeGT *int `form:"pet.age.gt,omitempty" json:"edge_pet_age_gt,omitempty"`
+	// Filters field "age" to be less than the provided value.
+	EdgePetAgeLT *int `form:"pet.age.lt,omitempty" json:"edge_pet_age_lt,omitempty"`
+	// Filters field "age" to be within the provided values.
+	EdgePetAgeIn []int `form:"pet.age.in,omitempty" json:"edge_pet_age_in,omitempty"`
+	// Filters field "age" to be not within the provided values.
+	EdgePetAgeNotIn []int `form:"pet.age.notIn,omitempty" json:"edge_pet_age_not_in,omitempty"`
+	// Filters field "type" to be equal to the provided value.
+	EdgePetTypeEQ *pet.Type `form:"pet.type.eq,omitempty" json:"edge_pet_type_eq,omitempty"`
+	// Filters field "type" to be not equal to the provided value.
+	EdgePetTypeNEQ *pet.Type `form:"pet.type.neq,omitempty" json:"edge_pet_type_neq,omitempty"`
+	// Filters field "type" to be within the provided values.
+	EdgePetTypeIn []pet.Type `form:"pet.type.in,omitempty" json:"edge_pet_type_in,omitempty"`
+	// Filters field "type" to be not within the provided values.
+	EdgePetTypeNotIn []pet.Type `form:"pet.type.notIn,omitempty" json:"edge_pet_type_not_in,omitempty"`
+}
+
+// FilterPredicates returns the predicates for filter-related parameters in User.
+func (l *ListUserParams) FilterPredicates() (predicate.User, error) {
+	var predicates []predicate.User
+
+	if l.UserUsernameEQ != nil {
+		predicates = append(predicates, user.UsernameEQ(*l.UserUsernameEQ))
+	}
+	if l.UserUsernameNEQ != nil {
+		predicates = append(predicates, user.UsernameNEQ(*l.UserUsernameNEQ))
+	}
+	if l.UserUsernameIn != nil {
+		predicates = append(predicates, user.UsernameIn(l.UserUsernameIn...))
+	}
+	if l.UserUsernameNotIn != nil {
+		predicates = append(predicates, user.UsernameNotIn(l.UserUsernameNotIn...))
+	}
+	if l.UserUsernameEqualFold != nil {
+		predicates = append(predicates, user.UsernameEqualFold(*l.UserUsernameEqualFold))
+	}
+	if l.UserUsernameContains != nil {
+		predicates = append(predicates, user.UsernameContains(*l.UserUsernameContains))
+	}
+	if l.UserUsernameContainsFold != nil {
+		predicates = append(predicates, user.UsernameContainsFold(*l.UserUsernameContainsFold))
+	}
+	if l.UserUsernameHasPrefix != nil {
+		predicates = append(predicates, user.UsernameHasPrefix(*l.UserUsernameHasPrefix))
+	}
+	if l.UserUsernameHasSuffix != nil {
+		predicates = append(predicates, user.UsernameHasSuffix(*l.UserUsernameHasSuffix))
+	}
+	if l.UserDisplayNameEQ != nil {
+		predicates = append(predicates, user.DisplayNameEQ(*l.UserDisplayNameEQ))
+	}
+	if l.UserDisplayNameNEQ != nil {
+		predicates = append(predicates, user.DisplayNameNEQ(*l.UserDisplayNameNEQ))
+	}
+	if l.UserDisplayNameIn != nil {
+		predicates = append(predicates, user.DisplayNameIn(l.UserDisplayNameIn...))
+	}
+	if l.UserDisplayNameNotIn != nil {
+		predicates = append(predicates, user.DisplayNameNotIn(l.UserDisplayNameNotIn...))
+	}
+	if l.UserDisplayNameEqualFold != nil {
+		predicates = append(predicates, user.DisplayNameEqualFold(*l.UserDisplayNameEqualFold))
+	}
+	if l.UserDisplayNameContains != nil {
+		predicates = append(predicates, user.DisplayNameContains(*l.UserDisplayNameContains))
+	}
+	if l.UserDisplayNameContainsFold != nil {
+		predicates = append(predicates, user.DisplayNameContainsFold(*l.UserDisplayNameContainsFold))
+	}
+	if l.UserDisplayNameHasPrefix != nil {
+		predicates = append(predicates, user.DisplayNameHasPrefix(*l.UserDisplayNameHasPrefix))
+	}
+	if l.UserDisplayNameHasSuffix != nil {
+		predicates = append(predicates, user.DisplayNameHasSuffix(*l.UserDisplayNameHasSuffix))
+	}
+	if l.UserEmailEQ != nil {
+		if err := validateFilter("email.eq", []string{*l.UserEmailEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.EmailEQ(*l.UserEmailEQ))
+	}
+	if l.UserEmailNEQ != nil {
+		if err := validateFilter("email.neq", []string{*l.UserEmailNEQ}, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.EmailNEQ(*l.UserEmailNEQ))
+	}
+	if l.UserEmailIn != nil {
+		if err := validateFilter("email.in", l.UserEmailIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.EmailIn(l.UserEmailIn...))
+	}
+	if l.UserEmailNotIn != nil {
+		if err := validateFilter("email.notIn", l.UserEmailNotIn, user.EmailValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.EmailNotIn(l.UserEmailNotIn...))
+	}
+	if l.UserEmailEqualFold != nil {
+		predicates = append(predicates, user.EmailEqualFold(*l.UserEmailEqualFold))
+	}
+	if l.UserEmailContains != nil {
+		predicates = append(predicates, user.EmailContains(*l.UserEmailContains))
+	}
+	if l.UserEmailContainsFold != nil {
+		predicates = append(predicates, user.EmailContainsFold(*l.UserEmailContainsFold))
+	}
+	if l.UserEmailHasPrefix != nil {
+		predicates = append(predicates, user.EmailHasPrefix(*l.UserEmailHasPrefix))
+	}
+	if l.UserEmailHasSuffix != nil {
+		predicates = append(predicates, user.EmailHasSuffix(*l.UserEmailHasSuffix))
+	}
+	if l.EdgeHasPet != nil {
+		if *l.EdgeHasPet {
+			predicates = append(predicates, user.HasPets())
+		} else {
+			predicates = append(predicates, user.Not(user.HasPets()))
+		}
+	}
+	if l.EdgePetNameEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameEQ(*l.EdgePetNameEQ)))
+	}
+	if l.EdgePetNameNEQ != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameNEQ(*l.EdgePetNameNEQ)))
+	}
+	if l.EdgePetNameIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameIn(l.EdgePetNameIn...)))
+	}
+	if l.EdgePetNameNotIn != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameNotIn(l.EdgePetNameNotIn...)))
+	}
+	if l.EdgePetNameEqualFold != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameEqualFold(*l.EdgePetNameEqualFold)))
+	}
+	if l.EdgePetNameContains != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameContains(*l.EdgePetNameContains)))
+	}
+	if l.EdgePetNameContainsFold != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameContainsFold(*l.EdgePetNameContainsFold)))
+	}
+	if l.EdgePetNameHasPrefix != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameHasPrefix(*l.EdgePetNameHasPrefix)))
+	}
+	if l.EdgePetNameHasSuffix != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.NameHasSuffix(*l.EdgePetNameHasSuffix)))
+	}
+	if l.EdgePetAgeEQ != nil {
+		if err := validateFilter("pet.age.eq", []int{*l.EdgePetAgeEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.AgeEQ(*l.EdgePetAgeEQ)))
+	}
+	if l.EdgePetAgeNEQ != nil {
+		if err := validateFilter("pet.age.neq", []int{*l.EdgePetAgeNEQ}, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.AgeNEQ(*l.EdgePetAgeNEQ)))
+	}
+	if l.EdgePetAgeGT != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeGT(*l.EdgePetAgeGT)))
+	}
+	if l.EdgePetAgeLT != nil {
+		predicates = append(predicates, user.HasPetsWith(pet.AgeLT(*l.EdgePetAgeLT)))
+	}
+	if l.EdgePetAgeIn != nil {
+		if err := validateFilter("pet.age.in", l.EdgePetAgeIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.AgeIn(l.EdgePetAgeIn...)))
+	}
+	if l.EdgePetAgeNotIn != nil {
+		if err := validateFilter("pet.age.notIn", l.EdgePetAgeNotIn, pet.AgeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.AgeNotIn(l.EdgePetAgeNotIn...)))
+	}
+	if l.EdgePetTypeEQ != nil {
+		if err := validateFilter("pet.type.eq", []pet.Type{*l.EdgePetTypeEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.TypeEQ(*l.EdgePetTypeEQ)))
+	}
+	if l.EdgePetTypeNEQ != nil {
+		if err := validateFilter("pet.type.neq", []pet.Type{*l.EdgePetTypeNEQ}, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.TypeNEQ(*l.EdgePetTypeNEQ)))
+	}
+	if l.EdgePetTypeIn != nil {
+		if err := validateFilter("pet.type.in", l.EdgePetTypeIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.TypeIn(l.EdgePetTypeIn...)))
+	}
+	if l.EdgePetTypeNotIn != nil {
+		if err := validateFilter("pet.type.notIn", l.EdgePetTypeNotIn, pet.TypeValidator); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, user.HasPetsWith(pet.TypeNotIn(l.EdgePetTypeNotIn...)))
+	}
+
+	return l.ApplyFilterOperation(predicates...)
+}
+
+// ApplySorting applies sorting to the query based on the provided sort and order fields.
+func (l *ListUserParams) ApplySorting(query *ent.UserQuery) error {
+	if err := l.Sorted.Validate(UserSortConfig); err != nil {
+		return err
+	}
+	if l.Field == nil { // No custom sort field provided and no defaults.
+		applySortingTiebreakUser(query, orderAsc)
+		return nil
+	}
+	applySortingUser(query, UserSortConfig.column(*l.Field), *l.Order)
+	return nil
+}
+
+// Exec wraps all logic (filtering, sorting, pagination, eager loading) and
+// executes all necessary queries, returning the results.
+func (l *ListUserParams) Exec(ctx context.Context, query *ent.UserQuery) (results *PagedResponse[ent.User], err error) {
+	return l.ExecWithPageConfig(ctx, query, UserPageConfig)
+}
+
+// ExecWithPageConfig is the same as Exec, but uses the provided page configuration
+// (e.g. for edge endpoints which have their own page configuration).
+func (l *ListUserParams) ExecWithPageConfig(ctx context.Context, query *ent.UserQuery, pageConfig *PageConfig) (results *PagedResponse[ent.User], err error) {
+	predicates, err := l.FilterPredicates()
+	if err != nil {
+		return nil, err
+	}
+	query.Where(predicates)
+	err = l.ApplySorting(EagerLoadUser(query))
+	if err != nil {
+		return nil, err
+	}
+	return l.ExecutePaginated(ctx, query, pageConfig)
+}
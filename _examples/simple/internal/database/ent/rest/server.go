@@ -0,0 +1,1322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"io"
+	"math"
+	mathrand "math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/go-playground/form/v4"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/user"
+)
+
+//go:embed openapi.json
+var OpenAPI []byte // OpenAPI contains the JSON schema of the API.
+
+// Operation represents the CRUD operation(s).
+type Operation string
+
+const (
+	// OperationCreate represents the create operation (method: POST).
+	OperationCreate Operation = "create"
+	// OperationRead represents the read operation (method: GET).
+	OperationRead Operation = "read"
+	// OperationUpdate represents the update operation (method: PATCH).
+	OperationUpdate Operation = "update"
+	// OperationDelete represents the delete operation (method: DELETE).
+	OperationDelete Operation = "delete"
+	// OperationList represents the list operation (method: GET).
+	OperationList Operation = "list"
+)
+
+// ErrorResponse is the response structure for errors.
+type ErrorResponse struct {
+	Error     string `json:"error"`                // The underlying error, which may be masked when debugging is disabled.
+	Type      string `json:"type"`                 // A summary of the error code based off the HTTP status code or application error code.
+	Code      int    `json:"code"`                 // The HTTP status code or other internal application error code.
+	RequestID string `json:"request_id,omitempty"` // The unique request ID for this error.
+	Timestamp string `json:"timestamp,omitempty"`  // The timestamp of the error, in RFC3339 format.
+}
+
+type ErrBadRequest struct {
+	Err error
+}
+
+func (e ErrBadRequest) Error() string {
+	return fmt.Sprintf("bad request: %s", e.Err)
+}
+
+func (e ErrBadRequest) Unwrap() error {
+	return e.Err
+}
+
+// IsBadRequest returns true if the unwrapped/underlying error is of type ErrBadRequest.
+func IsBadRequest(err error) bool {
+	var target *ErrBadRequest
+	return errors.As(err, &target)
+}
+
+// ErrUnprocessable is returned when a request is well-formed, but fails validation
+// (e.g. JSON values of the wrong type).
+type ErrUnprocessable struct {
+	Err error
+}
+
+func (e ErrUnprocessable) Error() string {
+	return fmt.Sprintf("unprocessable request: %s", e.Err)
+}
+
+func (e ErrUnprocessable) Unwrap() error {
+	return e.Err
+}
+
+// IsUnprocessable returns true if the unwrapped/underlying error is of type ErrUnprocessable.
+func IsUnprocessable(err error) bool {
+	var target *ErrUnprocessable
+	return errors.As(err, &target)
+}
+
+// ErrUnknownField is returned when a create/update request includes a field which
+// doesn't exist on the entity.
+type ErrUnknownField struct {
+	Err error
+}
+
+func (e ErrUnknownField) Error() string {
+	return fmt.Sprintf("unknown field: %s", e.Err)
+}
+
+func (e ErrUnknownField) Unwrap() error {
+	return e.Err
+}
+
+// IsUnknownField returns true if the unwrapped/underlying error is of type ErrUnknownField.
+func IsUnknownField(err error) bool {
+	var target *ErrUnknownField
+	return errors.As(err, &target)
+}
+
+type ErrConflict struct {
+	Err error
+}
+
+func (e ErrConflict) Error() string {
+	return fmt.Sprintf("conflict: %s", e.Err)
+}
+
+func (e ErrConflict) Unwrap() error {
+	return e.Err
+}
+
+// IsConflict returns true if the unwrapped/underlying error is of type ErrConflict.
+func IsConflict(err error) bool {
+	var target *ErrConflict
+	return errors.As(err, &target)
+}
+
+var ErrEndpointNotFound = errors.New("endpoint not found")
+
+// IsEndpointNotFound returns true if the unwrapped/underlying error is of type ErrEndpointNotFound.
+func IsEndpointNotFound(err error) bool {
+	return errors.Is(err, ErrEndpointNotFound)
+}
+
+var ErrMethodNotAllowed = errors.New("method not allowed")
+
+// IsMethodNotAllowed returns true if the unwrapped/underlying error is of type ErrMethodNotAllowed.
+func IsMethodNotAllowed(err error) bool {
+	return errors.Is(err, ErrMethodNotAllowed)
+}
+
+// JSON marshals 'v' to JSON, and setting the Content-Type as application/json.
+// Note that this does NOT auto-escape HTML. If 'v' cannot be marshalled to JSON,
+// this will panic.
+//
+// JSON also supports prettification when the origin request has a query parameter
+// of "pretty" set to true.
+func JSON(w http.ResponseWriter, r *http.Request, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	enc := json.NewEncoder(w)
+
+	if pretty, _ := strconv.ParseBool(r.FormValue("pretty")); pretty {
+		enc.SetIndent("", "    ")
+	}
+
+	if err := enc.Encode(v); err != nil && err != io.EOF {
+		panic(fmt.Sprintf("failed to marshal response: %v", err))
+	}
+}
+
+// M is an alias for map[string]any, which makes it easier to respond with generic JSON data structures.
+type M map[string]any
+
+var (
+	// DefaultDecoder is the default decoder used by Bind. You can either override
+	// this, or provide your own. Make sure it is set before Bind is called.
+	DefaultDecoder = form.NewDecoder()
+
+	// DefaultDecodeMaxMemory is the maximum amount of memory in bytes that will be
+	// used for decoding multipart/form-data requests.
+	DefaultDecodeMaxMemory int64 = 8 << 20
+)
+
+func init() {
+	RegisterPageDecoders(DefaultDecoder)
+}
+
+// RegisterPageDecoders registers decoders for the PageNumber type with the provided
+// decoder, which also accept "first" and "last". This is done automatically for
+// DefaultDecoder, but must be called when providing your own.
+func RegisterPageDecoders(d *form.Decoder) {
+	d.RegisterCustomTypeFunc(func(vals []string) (any, error) {
+		var v PageNumber
+		if err := v.UnmarshalText([]byte(vals[0])); err != nil {
+			return nil, err
+		}
+		return v, nil
+	}, PageNumber(0))
+}
+
+// Bind decodes the request body to the given struct. At this time the only supported
+// content-types are application/json, application/x-www-form-urlencoded, as well as
+// GET parameters.
+func Bind(r *http.Request, v any) (err error) {
+	defer func() {
+		// The form decoder panics on some malformed parameter names (e.g. "]").
+		if rerr := recover(); rerr != nil {
+			err = &ErrBadRequest{Err: fmt.Errorf("error decoding %s request into required format (%T): %v", r.Method, v, rerr)}
+		}
+	}()
+
+	err = r.ParseForm()
+	if err != nil {
+		return &ErrBadRequest{Err: fmt.Errorf("parsing form parameters: %w", err)}
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+		err = DefaultDecoder.Decode(v, r.Form)
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		switch {
+		case strings.HasPrefix(r.Header.Get("Content-Type"), "application/json"):
+			dec := json.NewDecoder(r.Body)
+			dec.DisallowUnknownFields()
+			defer r.Body.Close()
+			if err = dec.Decode(v); err != nil {
+				return jsonDecodeError(r, v, err)
+			}
+		case strings.HasPrefix(r.Header.Get("Content-Type"), "multipart/form-data"):
+			err = r.ParseMultipartForm(DefaultDecodeMaxMemory)
+			if err == nil {
+				err = DefaultDecoder.Decode(v, r.MultipartForm.Value)
+			}
+		default:
+			err = DefaultDecoder.Decode(v, r.PostForm)
+		}
+	default:
+		return &ErrBadRequest{Err: fmt.Errorf("unsupported method %s", r.Method)}
+	}
+
+	if err != nil {
+		return &ErrBadRequest{Err: fmt.Errorf("error decoding %s request into required format (%T): %w", r.Method, v, err)}
+	}
+	return nil
+}
+
+// jsonDecodeError wraps the provided JSON decoding error, distinguishing malformed
+// JSON from well-formed JSON which doesn't match the expected structure (or
+// contains unknown fields).
+func jsonDecodeError(r *http.Request, v any, err error) error {
+	err = fmt.Errorf("error decoding %s request into required format (%T): %w", r.Method, v, err)
+
+	var syntaxErr *json.SyntaxError
+	switch {
+	case errors.As(err, &syntaxErr), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return &ErrBadRequest{Err: err}
+	case strings.Contains(err.Error(), "json: unknown field "):
+		return &ErrUnknownField{Err: err}
+	default:
+		return &ErrUnprocessable{Err: err}
+	}
+}
+
+// Req simplifies making an HTTP handler that returns a single result, and an error.
+// The result, if not nil, must be JSON-marshalable. If result is nil, [http.StatusNoContent]
+// will be returned.
+func Req[Resp any](s *Server, op Operation, fn func(*http.Request) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		results, err := fn(r)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqID is similar to Req, but also processes an "id" path parameter and provides it to the
+// handler function.
+func ReqID[Resp any](s *Server, op Operation, fn func(*http.Request, int) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		id, err := strconv.Atoi(r.PathValue("id"))
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqParam is similar to Req, but also processes a request body/query params and provides it
+// to the handler function.
+func ReqParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request, *Params) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		params := new(Params)
+		if err := Bind(r, params); err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, params)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqIDParam is similar to ReqParam, but also processes an "id" path parameter and request
+// body/query params, and provides it to the handler function.
+func ReqIDParam[Params, Resp any](s *Server, op Operation, fn func(*http.Request, int, *Params) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		id, err := strconv.Atoi(r.PathValue("id"))
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		params := new(Params)
+		err = Bind(r, params)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id, params)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqCompositeID is similar to ReqID, but for entities with a composite ID, which is
+// parsed from multiple path parameters using the provided parse function.
+func ReqCompositeID[ID, Resp any](s *Server, op Operation, parse func(*http.Request) (ID, error), fn func(*http.Request, ID) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		id, err := parse(r)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// ReqCompositeIDParam is similar to ReqIDParam, but for entities with a composite ID,
+// which is parsed from multiple path parameters using the provided parse function.
+func ReqCompositeIDParam[ID, Params, Resp any](s *Server, op Operation, parse func(*http.Request) (ID, error), fn func(*http.Request, ID, *Params) (*Resp, error)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		r = s.withClient(r, op)
+		id, err := parse(r)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		params := new(Params)
+		err = Bind(r, params)
+		if err != nil {
+			handleResponse[Resp](s, w, r, op, nil, err)
+			return
+		}
+		results, err := fn(r, id, params)
+		handleResponse(s, w, r, op, results, err)
+	}
+}
+
+// RetryPolicy configures the retries of mutations (which are executed within a
+// transaction) which fail with a transient error (see [IsTransientError]). The zero
+// value uses the defaults of each field.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts of a transaction, including the
+	// first attempt. Defaults to 3. Set to 1 to disable retries.
+	MaxAttempts int
+
+	// Backoff returns the delay before the provided retry (starting at 1). Defaults
+	// to an exponential backoff starting at 10ms, with jitter.
+	Backoff func(retry int) time.Duration
+
+	// RetryAfter is the delay returned through the "Retry-After" header of responses
+	// to transient errors (rounded up to whole seconds). Defaults to 1 second.
+	RetryAfter time.Duration
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts < 1 {
+		return 3
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) backoff(retry int) time.Duration {
+	if p != nil && p.Backoff != nil {
+		return p.Backoff(retry)
+	}
+	d := 10 * time.Millisecond << min(retry-1, 10)
+	return d/2 + mathrand.N(d/2+1)
+}
+
+func (p *RetryPolicy) retryAfter() time.Duration {
+	if p == nil || p.RetryAfter <= 0 {
+		return time.Second
+	}
+	return p.RetryAfter
+}
+
+// transientErrorStates holds the SQLSTATE codes of transient errors (serialization
+// failures and deadlocks), and the status code of responses to them.
+var transientErrorStates = map[string]int{
+	"40001": http.StatusConflict,           // serialization_failure
+	"40P01": http.StatusServiceUnavailable, // deadlock_detected
+}
+
+// transientErrorMessages holds the messages of transient errors of drivers which
+// don't expose a SQLSTATE code, and the status code of responses to them.
+var transientErrorMessages = map[string]int{
+	"Error 1213":         http.StatusServiceUnavailable, // MySQL: deadlock found.
+	"Error 1205":         http.StatusServiceUnavailable, // MySQL: lock wait timeout exceeded.
+	"database is locked": http.StatusServiceUnavailable, // SQLite: SQLITE_BUSY.
+	"SQLITE_BUSY":        http.StatusServiceUnavailable,
+}
+
+// transientErrorStatus returns the status code of responses to the provided error if
+// it's a transient error (see [IsTransientError]), i.e. 409 for serialization
+// failures, and 503 for deadlocks and lock timeouts, or 0 otherwise.
+func transientErrorStatus(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var state interface{ SQLState() string }
+	if errors.As(err, &state) {
+		if status, ok := transientErrorStates[state.SQLState()]; ok {
+			return status
+		}
+	}
+
+	msg := err.Error()
+	for text, status := range transientErrorMessages {
+		if strings.Contains(msg, text) {
+			return status
+		}
+	}
+	return 0
+}
+
+// IsTransientError returns true if the provided error is a transient database error,
+// i.e. a serialization failure, deadlock or lock timeout, which may succeed when
+// retried. Transactions of mutations are retried when they fail with a transient
+// error (see [ServerConfig.Retry]), and responses to transient errors include a
+// "Retry-After" header.
+func IsTransientError(err error) bool {
+	return transientErrorStatus(err) != 0
+}
+
+// withTx runs the provided function within a transaction of the client of the
+// provided context, committing if no error is returned, and rolling back otherwise.
+// Transactions which fail with a transient error are retried (see
+// [ServerConfig.Retry]).
+func withTx[T any](s *Server, ctx context.Context, fn func(tx *ent.Client) (*T, error)) (*T, error) {
+	for retry := 1; ; retry++ {
+		result, err := runTx(ctx, s.client(ctx), fn)
+		if err == nil || !IsTransientError(err) || retry >= s.config.Retry.maxAttempts() {
+			return result, err
+		}
+
+		timer := time.NewTimer(s.config.Retry.backoff(retry))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, err
+		case <-timer.C:
+		}
+	}
+}
+
+// runTx runs the provided function within a transaction, committing if no error is
+// returned, and rolling back otherwise. If the provided client is already
+// transactional (e.g. provided through [UseEntContext]), the function is run within
+// its transaction, which is left to its owner.
+func runTx[T any](ctx context.Context, db *ent.Client, fn func(tx *ent.Client) (*T, error)) (*T, error) {
+	tx, err := db.Tx(ctx)
+	if errors.Is(err, ent.ErrTxStarted) {
+		return fn(db)
+	}
+	if err != nil {
+		return nil, err
+	}
+	result, err := fn(tx.Client())
+	if err != nil {
+		if rerr := tx.Rollback(); rerr != nil {
+			err = fmt.Errorf("%w: rolling back transaction: %w", err, rerr)
+		}
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Links represents a set of linkable-relationsips that can be represented through
+// the "Link" header. Note that all urls must be url-encoded already.
+type Links map[string]string
+
+func (l Links) String() string {
+	var links []string
+	var keys []string
+	for k := range l {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		links = append(links, fmt.Sprintf(`<%s>; rel=%q`, l[k], k))
+	}
+	return strings.Join(links, ", ")
+}
+
+type linkablePagedResource interface {
+	GetPage() int
+	GetIsLastPage() bool
+}
+
+// Route describes an endpoint of an entity, as mounted by [Server.Handler].
+type Route struct {
+	Method      string    // The HTTP method, e.g. "GET".
+	Pattern     string    // The path pattern, relative to [ServerConfig.BasePath], e.g. "/pets/{id}".
+	Operation   Operation // The operation of the endpoint.
+	OperationID string    // The OpenAPI operation ID, e.g. "getPet".
+	Entity      string    // The name of the entity, e.g. "Pet".
+}
+
+// URL returns the path of the route (relative to [ServerConfig.BasePath]), with its
+// path parameters replaced by the provided params (in order), e.g. "/pets/1".
+func (r Route) URL(params ...any) (string, error) {
+	var b strings.Builder
+	pattern := r.Pattern
+
+	var i int
+	for {
+		start := strings.IndexByte(pattern, '{')
+		if start == -1 {
+			b.WriteString(pattern)
+			break
+		}
+
+		end := strings.IndexByte(pattern[start:], '}')
+		if end == -1 {
+			return "", fmt.Errorf("invalid pattern %q for operation %q", r.Pattern, r.OperationID)
+		}
+		end += start
+
+		if i >= len(params) {
+			return "", fmt.Errorf("missing path parameter %s for operation %q", pattern[start:end+1], r.OperationID)
+		}
+
+		b.WriteString(pattern[:start])
+		b.WriteString(url.PathEscape(fmt.Sprint(params[i])))
+		pattern = pattern[end+1:]
+		i++
+	}
+
+	if i != len(params) {
+		return "", fmt.Errorf("operation %q expects %d path parameters, got %d", r.OperationID, i, len(params))
+	}
+	return b.String(), nil
+}
+
+// routes are the endpoints of all entities, see [Routes].
+var routes = []Route{
+	{Method: "GET", Pattern: "/pets", Operation: OperationList, OperationID: "listPets", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}", Operation: OperationRead, OperationID: "getPet", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/owner", Operation: OperationRead, OperationID: "getPetOwner", Entity: "Pet"},
+	{Method: "GET", Pattern: "/pets/{id}/friends", Operation: OperationList, OperationID: "listPetFriends", Entity: "Pet"},
+	{Method: "POST", Pattern: "/pets", Operation: OperationCreate, OperationID: "createPet", Entity: "Pet"},
+	{Method: "PATCH", Pattern: "/pets/{id}", Operation: OperationUpdate, OperationID: "updatePet", Entity: "Pet"},
+	{Method: "DELETE", Pattern: "/pets/{id}", Operation: OperationDelete, OperationID: "deletePet", Entity: "Pet"},
+	{Method: "GET", Pattern: "/users", Operation: OperationList, OperationID: "listUsers", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}", Operation: OperationRead, OperationID: "getUser", Entity: "User"},
+	{Method: "GET", Pattern: "/users/{id}/pets", Operation: OperationList, OperationID: "listUserPets", Entity: "User"},
+	{Method: "POST", Pattern: "/users", Operation: OperationCreate, OperationID: "createUser", Entity: "User"},
+	{Method: "PATCH", Pattern: "/users/{id}", Operation: OperationUpdate, OperationID: "updateUser", Entity: "User"},
+	{Method: "DELETE", Pattern: "/users/{id}", Operation: OperationDelete, OperationID: "deleteUser", Entity: "User"},
+}
+
+// Routes returns the endpoints of all entities, as mounted by [Server.Handler]. This
+// can be used to build links to endpoints, or to sync routes with an API gateway.
+func Routes() []Route {
+	return slices.Clone(routes)
+}
+
+// URLFor returns the path (relative to [ServerConfig.BasePath]) of the endpoint with
+// the provided operation ID (see [Routes]), with its path parameters replaced by the
+// provided params (in order). For example, URLFor("getPet", 1) returns "/pets/1".
+func URLFor(operationID string, params ...any) (string, error) {
+	for _, r := range routes {
+		if r.OperationID == operationID {
+			return r.URL(params...)
+		}
+	}
+	return "", fmt.Errorf("unknown operation %q", operationID)
+}
+
+// specVariant is a pre-rendered variant of the OpenAPI spec, as served by
+// [Server.Spec].
+type specVariant struct {
+	data        []byte // The encoded spec.
+	gzip        []byte // The gzip compressed version of data.
+	etag        string // A strong ETag of data.
+	contentType string // The content type of data.
+}
+
+// newSpecVariant pre-renders the provided OpenAPI spec (JSON or YAML encoded),
+// injecting the server URL (see [ServerConfig.DisableSpecInjectServer]).
+func (s *Server) newSpecVariant(data []byte, isYAML bool) (*specVariant, error) {
+	if !s.config.DisableSpecInjectServer && s.config.BaseURL != "" {
+		if isYAML {
+			// The YAML spec is generated with top-level keys at the start of a
+			// line, so a servers key can be detected, and prepended, without
+			// having to decode the spec.
+			if !bytes.HasPrefix(data, []byte("servers:")) && !bytes.Contains(data, []byte("\nservers:")) {
+				uri, err := json.Marshal(s.config.BaseURL)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal server URL: %w", err)
+				}
+				data = append([]byte("servers:\n    - url: "+string(uri)+"\n"), data...)
+			}
+		} else {
+			spec := map[string]any{}
+			err := json.Unmarshal(data, &spec)
+			if err != nil {
+				return nil, fmt.Errorf("failed to unmarshal spec: %w", err)
+			}
+
+			type Server struct {
+				URL string `json:"url"`
+			}
+
+			if _, ok := spec["servers"]; !ok {
+				spec["servers"] = []Server{{URL: s.config.BaseURL}}
+				data, err = json.Marshal(spec)
+				if err != nil {
+					return nil, fmt.Errorf("failed to marshal spec: %w", err)
+				}
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	gw, err := gzip.NewWriterLevel(&buf, gzip.BestCompression)
+	if err != nil {
+		return nil, err
+	}
+	if _, err = gw.Write(data); err != nil {
+		return nil, err
+	}
+	if err = gw.Close(); err != nil {
+		return nil, err
+	}
+
+	contentType := "application/json"
+	if isYAML {
+		contentType = "application/yaml"
+	}
+
+	sum := sha256.Sum256(data)
+	return &specVariant{
+		data:        data,
+		gzip:        buf.Bytes(),
+		etag:        `"` + hex.EncodeToString(sum[:16]) + `"`,
+		contentType: contentType,
+	}, nil
+}
+
+// Spec returns the OpenAPI spec for the server implementation, as JSON. Supports
+// conditional requests (through the ETag of the spec), gzip compression, and
+// cross-origin requests. The specs of additional generation targets can be selected
+// through the "version" query parameter (e.g. "?version=public").
+func (s *Server) Spec(w http.ResponseWriter, r *http.Request) {
+	s.serveSpec(w, r, s.specs)
+}
+
+// serveSpec serves the requested variant of the provided specs (see [Server.Spec]).
+func (s *Server) serveSpec(w http.ResponseWriter, r *http.Request, specs map[string]*specVariant) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	if r.Method == http.MethodOptions {
+		w.Header().Set("Access-Control-Allow-Methods", "GET, HEAD, OPTIONS")
+		w.Header().Set("Access-Control-Allow-Headers", "*")
+		w.Header().Set("Access-Control-Max-Age", "86400")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	spec, ok := specs[r.URL.Query().Get("version")]
+	if !ok {
+		handleResponse[struct{}](s, w, r, "", nil, &ErrBadRequest{
+			Err: fmt.Errorf("unknown spec version %q", r.URL.Query().Get("version")),
+		})
+		return
+	}
+
+	w.Header().Set("Access-Control-Expose-Headers", "ETag")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("ETag", spec.etag)
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	if etagMatches(r.Header.Get("If-None-Match"), spec.etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", spec.contentType)
+	if acceptsGzip(r) {
+		w.Header().Set("Content-Encoding", "gzip")
+		w.WriteHeader(http.StatusOK)
+		_, _ = w.Write(spec.gzip)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(spec.data)
+}
+
+// etagMatches returns true if the provided If-None-Match header matches the
+// provided ETag (using weak comparison).
+func etagMatches(header, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, v := range strings.Split(header, ",") {
+		v = strings.TrimPrefix(strings.TrimSpace(v), "W/")
+		if v == "*" || v == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip returns true if the client accepts gzip compressed responses.
+func acceptsGzip(r *http.Request) bool {
+	for _, v := range strings.Split(r.Header.Get("Accept-Encoding"), ",") {
+		coding, params, _ := strings.Cut(strings.TrimSpace(v), ";")
+		if !strings.EqualFold(strings.TrimSpace(coding), "gzip") {
+			continue
+		}
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			if weight, err := strconv.ParseFloat(q, 64); err == nil && weight == 0 {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+var scalarTemplate = template.Must(template.New("docs").Parse(`<!DOCTYPE html>
+<html>
+  <head>
+    <title>API Reference</title>
+    <meta charset="utf-8" />
+    <meta name="viewport" content="width=device-width, initial-scale=1" />
+    <meta name="darkreader-lock">
+    <link rel="icon" type="image/svg+xml"
+      href="data:image/svg+xml,%3Csvg xmlns='http://www.w3.org/2000/svg' width='32' height='32' viewBox='0 0 1024 1024'%3E%3Cpath fill='currentColor' d='m917.7 148.8l-42.4-42.4c-1.6-1.6-3.6-2.3-5.7-2.3s-4.1.8-5.7 2.3l-76.1 76.1a199.27 199.27 0 0 0-112.1-34.3c-51.2 0-102.4 19.5-141.5 58.6L432.3 308.7a8.03 8.03 0 0 0 0 11.3L704 591.7c1.6 1.6 3.6 2.3 5.7 2.3c2 0 4.1-.8 5.7-2.3l101.9-101.9c68.9-69 77-175.7 24.3-253.5l76.1-76.1c3.1-3.2 3.1-8.3 0-11.4M769.1 441.7l-59.4 59.4l-186.8-186.8l59.4-59.4c24.9-24.9 58.1-38.7 93.4-38.7s68.4 13.7 93.4 38.7c24.9 24.9 38.7 58.1 38.7 93.4s-13.8 68.4-38.7 93.4m-190.2 105a8.03 8.03 0 0 0-11.3 0L501 613.3L410.7 523l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3L441 408.6a8.03 8.03 0 0 0-11.3 0L363 475.3l-43-43a7.85 7.85 0 0 0-5.7-2.3c-2 0-4.1.8-5.7 2.3L206.8 534.2c-68.9 69-77 175.7-24.3 253.5l-76.1 76.1a8.03 8.03 0 0 0 0 11.3l42.4 42.4c1.6 1.6 3.6 2.3 5.7 2.3s4.1-.8 5.7-2.3l76.1-76.1c33.7 22.9 72.9 34.3 112.1 34.3c51.2 0 102.4-19.5 141.5-58.6l101.9-101.9c3.1-3.1 3.1-8.2 0-11.3l-43-43l66.7-66.7c3.1-3.1 3.1-8.2 0-11.3zM441.7 769.1a131.32 131.32 0 0 1-93.4 38.7c-35.3 0-68.4-13.7-93.4-38.7a131.32 131.32 0 0 1-38.7-93.4c0-35.3 13.7-68.4 38.7-93.4l59.4-59.4l186.8 186.8z'/%3E%3C/svg%3E" />
+  </head>
+  <body>
+    <script id="api-reference"></script>
+    <script>
+      document.getElementById("api-reference").dataset.configuration = JSON.stringify({
+        spec: {
+          url: "{{ $.SpecPath }}",
+        },
+        {{- if $.DisableSpecInjectServer }}
+        servers: [
+            {url: window.location.origin + window.location.pathname.replace(/\/docs$/g, "")}
+        ],
+        {{- end }}
+        theme: "kepler",
+        isEditable: false,
+        hideDownloadButton: true,
+        customCss: ".darklight-reference-promo { visibility: hidden !important; height: 0 !important; }",
+      });
+    </script>
+    <script
+      src="https://cdn.jsdelivr.net/npm/@scalar/api-reference@1.25.3"
+      integrity="sha256-NKouGW+1wM6fSI5l+lWltgWmD++6ircOAcyI3Sr8gR4="
+      crossorigin="anonymous"
+    ></script>
+  </body>
+</html>`))
+
+func (s *Server) Docs(w http.ResponseWriter, r *http.Request) {
+	var buf bytes.Buffer
+	err := scalarTemplate.Execute(&buf, map[string]any{
+		"SpecPath":                s.config.BasePath + "/openapi.json",
+		"DisableSpecInjectServer": s.config.DisableSpecInjectServer,
+	})
+	if err != nil {
+		handleResponse[struct{}](s, w, r, "", nil, err)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html")
+	w.Header().Set("Content-Security-Policy", "default-src 'self' cdn.jsdelivr.net fonts.scalar.com 'unsafe-inline' 'unsafe-eval' data: blob:")
+	w.Header().Set("X-Frame-Options", "DENY")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Referrer-Policy", "no-referrer-when-downgrade")
+	w.Header().Set("Permissions-Policy", "clipboard-write=(self)")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(buf.Bytes())
+}
+
+type ServerConfig struct {
+	// BaseURL is similar to [ServerConfig.BasePath], however, only the path of the URL is used
+	// to prefill BasePath. This is not required if BasePath is provided.
+	BaseURL string
+
+	// BasePath if provided, and the spec endpoints are enabled, will allow annotating
+	// API responses with "Link" headers. See [ServerConfig.EnableLinks] for more information.
+	BasePath string
+
+	// DisableSpecHandler if set to true, will disable the spec endpoints (e.g. /openapi.json).
+	// This will also disable the embedded API reference documentation, see
+	// [ServerConfig.DisableDocs] for more information.
+	DisableSpecHandler bool
+
+	// DisableSpecInjectServer if set to true, will disable the automatic injection of the
+	// server URL into the spec. This only applies if [ServerConfig.BaseURL] is provided.
+	DisableSpecInjectServer bool
+
+	// DisableDocsHandler if set to true, will disable the embedded API reference documentation
+	// endpoint at /docs. Use this if you want to provide your own documentation functionality.
+	// This is disabled by default if [ServerConfig.DisableSpecHandler] is true.
+	DisableDocsHandler bool
+
+	// EnableLinks if set to true, will enable the "Link" response header, which can be used to hint
+	// to clients about the location of the OpenAPI spec, API documentation, how to auto-paginate
+	// through results, and more.
+	EnableLinks bool
+
+	// MaskErrors if set to true, will mask the error message returned to the client,
+	// returning a generic error message based on the HTTP status code.
+	MaskErrors bool
+
+	// ErrorHandler is invoked when an error occurs. If not provided, the default
+	// error handling logic will be used. If you want to run logic on errors, but
+	// not actually handle the error yourself, you can still call [Server.DefaultErrorHandler]
+	// after your logic.
+	ErrorHandler func(w http.ResponseWriter, r *http.Request, op Operation, err error)
+
+	// GetReqID returns the request ID for the given request. If not provided, the
+	// default implementation will use the X-Request-Id header, otherwise an empty
+	// string will be returned. If using go-chi, middleware.GetReqID will be used.
+	GetReqID func(r *http.Request) string
+
+	// ResponseHeaders provides the values of response headers (e.g. those documented
+	// through GlobalResponseHeaders, like X-Ratelimit-Limit), which are set before the
+	// response is written.
+	ResponseHeaders ResponseHeaderProvider
+
+	// ClientSelector selects the ent client used by each request (e.g. to route read
+	// operations to a read-replica, and mutations to the primary). If not provided, the
+	// client provided to [NewServer] is used for all requests.
+	ClientSelector ClientSelector
+
+	// Interceptors returns the ent interceptors of the provided request, which apply to
+	// all queries executed by the handler of the request (e.g. to scope queries to the
+	// tenant of the request, or filter out soft-deleted entities), rather than being
+	// registered globally on the client. See [RequestInterceptor].
+	Interceptors func(r *http.Request, op Operation) []ent.Interceptor
+
+	// Hooks returns the ent hooks of the provided request, which apply to all mutations
+	// executed by the handler of the request (e.g. to set the tenant or author of created
+	// entities). See [RequestHook].
+	Hooks func(r *http.Request, op Operation) []ent.Hook
+
+	// Retry configures the retries of transactions of mutations which fail with a
+	// transient error, e.g. a serialization failure or deadlock (see [IsTransientError]).
+	// If not provided, the defaults of [RetryPolicy] are used.
+	Retry *RetryPolicy
+}
+
+// ResponseHeaderProvider provides the values of response headers. The entity is the
+// result of the operation (e.g. *ent.Pet, or a paged response for lists), or nil for
+// errors and responses without a body.
+type ResponseHeaderProvider interface {
+	ResponseHeaders(ctx context.Context, op Operation, entity any) map[string]string
+}
+
+// ResponseHeadersFunc is an adapter to allow the use of ordinary functions as a
+// [ResponseHeaderProvider].
+type ResponseHeadersFunc func(ctx context.Context, op Operation, entity any) map[string]string
+
+// ResponseHeaders calls fn(ctx, op, entity).
+func (fn ResponseHeadersFunc) ResponseHeaders(ctx context.Context, op Operation, entity any) map[string]string {
+	return fn(ctx, op, entity)
+}
+
+// ClientSelector selects the ent client used by each request.
+type ClientSelector interface {
+	// SelectClient returns the client to use for the provided request of the provided
+	// operation, where primary is the client provided to [NewServer]. Returning nil
+	// uses the primary client. Note that mutations which re-fetch the entity (see
+	// Config.ReadYourWrites in entrest) use the same client for both.
+	SelectClient(r *http.Request, op Operation, primary *ent.Client) *ent.Client
+}
+
+// ClientSelectorFunc is an adapter to allow the use of ordinary functions as a
+// [ClientSelector].
+type ClientSelectorFunc func(r *http.Request, op Operation, primary *ent.Client) *ent.Client
+
+// SelectClient calls fn(r, op, primary).
+func (fn ClientSelectorFunc) SelectClient(r *http.Request, op Operation, primary *ent.Client) *ent.Client {
+	return fn(r, op, primary)
+}
+
+type Server struct {
+	db     *ent.Client
+	config *ServerConfig
+	specs  map[string]*specVariant
+}
+
+// NewServer returns a new auto-generated server implementation for your ent schema.
+// [Server.Handler] returns a ready-to-use http.Handler that mounts all of the
+// necessary endpoints.
+func NewServer(db *ent.Client, config *ServerConfig) (*Server, error) {
+	s := &Server{
+		db:     db,
+		config: config,
+	}
+	if s.config == nil {
+		s.config = &ServerConfig{}
+	}
+	if s.config.Interceptors != nil {
+		db.Intercept(RequestInterceptor)
+	}
+	if s.config.Hooks != nil {
+		db.Use(RequestHook)
+	}
+	if s.config.BaseURL != "" && s.config.BasePath == "" {
+		uri, err := url.Parse(s.config.BaseURL)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse BaseURL: %w", err)
+		}
+		s.config.BasePath = uri.Path
+	}
+	if s.config.BaseURL == "" {
+		s.config.DisableSpecInjectServer = true
+	}
+	if s.config.BasePath != "" {
+		if !strings.HasPrefix(s.config.BasePath, "/") {
+			s.config.BasePath = "/" + s.config.BasePath
+		}
+		s.config.BasePath = strings.TrimRight(s.config.BasePath, "/")
+	}
+	if !s.config.DisableSpecHandler {
+		variants := map[string][]byte{"": OpenAPI}
+
+		s.specs = make(map[string]*specVariant, len(variants))
+		for version, data := range variants {
+			variant, err := s.newSpecVariant(data, false)
+			if err != nil {
+				return nil, fmt.Errorf("failed to prepare %q spec: %w", version, err)
+			}
+			s.specs[version] = variant
+		}
+	}
+	return s, nil
+}
+
+type clientKey struct{}
+
+// withClient returns the provided request, with the client selected for the provided
+// operation (see [ServerConfig.ClientSelector]), and the interceptors and hooks of the
+// operation, stored in its context.
+func (s *Server) withClient(r *http.Request, op Operation) *http.Request {
+	r = s.withInterceptors(r, op)
+	if s.config.ClientSelector == nil {
+		return r
+	}
+
+	db := s.config.ClientSelector.SelectClient(r, op, s.db)
+	if db == nil || db == s.db {
+		return r
+	}
+	return r.WithContext(ent.NewContext(context.WithValue(r.Context(), clientKey{}, db), db))
+}
+
+// client returns the client selected for the request of the provided context (see
+// [ServerConfig.ClientSelector]), or the primary client.
+func (s *Server) client(ctx context.Context) *ent.Client {
+	if db, ok := ctx.Value(clientKey{}).(*ent.Client); ok {
+		return db
+	}
+	return s.db
+}
+
+type (
+	requestInterceptorsKey struct{}
+	requestHooksKey        struct{}
+)
+
+// withInterceptors returns the provided request, with the ent interceptors and hooks
+// of the provided operation (see [ServerConfig.Interceptors] and [ServerConfig.Hooks])
+// stored in its context.
+func (s *Server) withInterceptors(r *http.Request, op Operation) *http.Request {
+	ctx := r.Context()
+
+	if s.config.Interceptors != nil {
+		if inters := s.config.Interceptors(r, op); len(inters) > 0 {
+			ctx = context.WithValue(ctx, requestInterceptorsKey{}, inters)
+		}
+	}
+
+	if s.config.Hooks != nil {
+		if hooks := s.config.Hooks(r, op); len(hooks) > 0 {
+			ctx = context.WithValue(ctx, requestHooksKey{}, hooks)
+		}
+	}
+
+	if ctx == r.Context() {
+		return r
+	}
+	return r.WithContext(ctx)
+}
+
+// RequestInterceptor is an ent interceptor which applies the interceptors of the
+// request (see [ServerConfig.Interceptors]) to all queries executed with the request
+// context, including traversal interceptors (e.g. tenant scoping, or soft-delete
+// filters). It's registered on the client provided to [NewServer] when
+// [ServerConfig.Interceptors] is provided, and must be registered on any other client
+// returned by [ServerConfig.ClientSelector].
+var RequestInterceptor ent.Interceptor = requestInterceptor{}
+
+type requestInterceptor struct{}
+
+// Intercept implements [ent.Interceptor].
+func (requestInterceptor) Intercept(next ent.Querier) ent.Querier {
+	return ent.QuerierFunc(func(ctx context.Context, q ent.Query) (ent.Value, error) {
+		inters, _ := ctx.Value(requestInterceptorsKey{}).([]ent.Interceptor)
+
+		querier := next
+		for i := len(inters) - 1; i >= 0; i-- {
+			querier = inters[i].Intercept(querier)
+		}
+		return querier.Query(ctx, q)
+	})
+}
+
+// Traverse implements [ent.Traverser].
+func (requestInterceptor) Traverse(ctx context.Context, q ent.Query) error {
+	inters, _ := ctx.Value(requestInterceptorsKey{}).([]ent.Interceptor)
+
+	for _, inter := range inters {
+		if trv, ok := inter.(ent.Traverser); ok {
+			if err := trv.Traverse(ctx, q); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RequestHook is an ent hook which applies the hooks of the request (see
+// [ServerConfig.Hooks]) to all mutations executed with the request context. It's
+// registered on the client provided to [NewServer] when [ServerConfig.Hooks] is
+// provided, and must be registered on any other client returned by
+// [ServerConfig.ClientSelector].
+func RequestHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		hooks, _ := ctx.Value(requestHooksKey{}).([]ent.Hook)
+
+		mutator := next
+		for i := len(hooks) - 1; i >= 0; i-- {
+			mutator = hooks[i](mutator)
+		}
+		return mutator.Mutate(ctx, m)
+	})
+}
+
+// DefaultErrorHandler is the default error handler for the Server.
+func (s *Server) DefaultErrorHandler(w http.ResponseWriter, r *http.Request, op Operation, err error) {
+	ts := time.Now().UTC().Format(time.RFC3339)
+
+	resp := ErrorResponse{
+		Error:     err.Error(),
+		Timestamp: ts,
+	}
+
+	var numErr *strconv.NumError
+
+	switch {
+	case IsEndpointNotFound(err):
+		resp.Code = http.StatusNotFound
+	case IsMethodNotAllowed(err):
+		resp.Code = http.StatusMethodNotAllowed
+	case IsBadRequest(err):
+		resp.Code = http.StatusBadRequest
+	case IsUnknownField(err):
+		resp.Code = 400
+	case IsUnprocessable(err):
+		resp.Code = 422
+	case IsConflict(err):
+		resp.Code = http.StatusConflict
+	case IsTransientError(err):
+		// Serialization failures, deadlocks and lock timeouts, which remain after all
+		// retries of the transaction are exhausted.
+		resp.Code = transientErrorStatus(err)
+		w.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(s.config.Retry.retryAfter().Seconds()))))
+	case ent.IsNotFound(err):
+		resp.Code = http.StatusNotFound
+	case sqlgraph.IsForeignKeyConstraintError(err) && (op == OperationCreate || op == OperationUpdate):
+		// The entity references another entity (e.g. through an edge field) which
+		// doesn't exist. Deleting an entity which is still referenced is a conflict.
+		resp.Code = http.StatusNotFound
+	case ent.IsConstraintError(err), ent.IsNotSingular(err):
+		resp.Code = http.StatusConflict
+	case ent.IsValidationError(err):
+		resp.Code = 422
+	case errors.As(err, &numErr):
+		resp.Code = http.StatusBadRequest
+		resp.Error = fmt.Sprintf("invalid ID provided: %v", err)
+	default:
+		resp.Code = http.StatusInternalServerError
+	}
+
+	if resp.Type == "" {
+		resp.Type = http.StatusText(resp.Code)
+	}
+	if s.config.MaskErrors {
+		resp.Error = http.StatusText(resp.Code)
+	}
+	if s.config.GetReqID != nil {
+		resp.RequestID = s.config.GetReqID(r)
+	} else {
+		resp.RequestID = r.Header.Get("X-Request-Id")
+	}
+	JSON(w, r, resp.Code, resp)
+}
+
+func handleResponse[Resp any](s *Server, w http.ResponseWriter, r *http.Request, op Operation, resp *Resp, err error) {
+	if s.config.EnableLinks {
+		links := Links{}
+		if !s.config.DisableSpecHandler {
+			links["service-desc"] = s.config.BasePath + "/openapi.json"
+			links["describedby"] = s.config.BasePath + "/openapi.json"
+		}
+
+		if err == nil && resp != nil && op == OperationList {
+			if lr, ok := any(resp).(linkablePagedResource); ok {
+				query := r.URL.Query()
+				if page := lr.GetPage(); page > 1 {
+					query.Set("page", strconv.Itoa(page-1))
+					r.URL.RawQuery = query.Encode()
+					links["prev"] = r.URL.String()
+					if !strings.HasPrefix(links["prev"], s.config.BasePath) {
+						links["prev"] = s.config.BasePath + links["prev"]
+					}
+				}
+				if !lr.GetIsLastPage() {
+					query.Set("page", strconv.Itoa(lr.GetPage()+1))
+					r.URL.RawQuery = query.Encode()
+					links["next"] = r.URL.String()
+					if !strings.HasPrefix(links["next"], s.config.BasePath) {
+						links["next"] = s.config.BasePath + links["next"]
+					}
+				}
+			}
+		}
+
+		if v := links.String(); v != "" {
+			w.Header().Set("Link", v)
+		}
+	}
+	if s.config.ResponseHeaders != nil {
+		var entity any
+		if err == nil && resp != nil {
+			entity = resp
+		}
+		for k, v := range s.config.ResponseHeaders.ResponseHeaders(r.Context(), op, entity) {
+			w.Header().Set(k, v)
+		}
+	}
+
+	if err != nil {
+		if s.config.ErrorHandler != nil {
+			s.config.ErrorHandler(w, r, op, err)
+			return
+		}
+		s.DefaultErrorHandler(w, r, op, err)
+		return
+	}
+	if resp != nil {
+		type pagedResp interface {
+			GetTotalCount() int
+		}
+		status := http.StatusOK
+		if op == OperationCreate {
+			status = http.StatusCreated
+		}
+		JSON(w, r, status, resp)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// UseEntContext can be used to inject an [ent.Client] into the context for use
+// by other middleware, or ent privacy layers. Note that the server will do this
+// by default, so you don't need to do this manually, unless it's a context that's
+// not being passed to the server and is being consumed elsewhere.
+func UseEntContext(db *ent.Client) func(next http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, r.WithContext(ent.NewContext(r.Context(), db)))
+		})
+	}
+}
+
+// Handler returns a ready-to-use http.Handler that mounts all of the necessary endpoints.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /pets", ReqParam(s, OperationList, s.ListPets))
+	mux.HandleFunc("GET /pets/{id}", ReqID(s, OperationRead, s.GetPet))
+	mux.HandleFunc("GET /pets/{id}/owner", ReqID(s, OperationRead, s.GetPetOwner))
+	mux.HandleFunc("GET /pets/{id}/friends", ReqIDParam(s, OperationList, s.ListPetFriends))
+	mux.HandleFunc("POST /pets", ReqParam(s, OperationCreate, s.CreatePet))
+	mux.HandleFunc("PATCH /pets/{id}", ReqIDParam(s, OperationUpdate, s.UpdatePet))
+	mux.HandleFunc("DELETE /pets/{id}", ReqID(s, OperationDelete, s.DeletePet))
+	mux.HandleFunc("GET /users", ReqParam(s, OperationList, s.ListUsers))
+	mux.HandleFunc("GET /users/{id}", ReqID(s, OperationRead, s.GetUser))
+	mux.HandleFunc("GET /users/{id}/pets", ReqIDParam(s, OperationList, s.ListUserPets))
+	mux.HandleFunc("POST /users", ReqParam(s, OperationCreate, s.CreateUser))
+	mux.HandleFunc("PATCH /users/{id}", ReqIDParam(s, OperationUpdate, s.UpdateUser))
+	mux.HandleFunc("DELETE /users/{id}", ReqID(s, OperationDelete, s.DeleteUser))
+
+	if !s.config.DisableSpecHandler {
+		mux.HandleFunc("GET /openapi.json", s.Spec)
+		mux.HandleFunc("OPTIONS /openapi.json", s.Spec)
+	}
+
+	if !s.config.DisableSpecHandler && !s.config.DisableDocsHandler {
+		// If specs are enabled, it's safe to provide documentation, and if they don't override the
+		// root endpoint, we can redirect to the docs.
+		mux.HandleFunc("GET /", http.RedirectHandler(s.config.BasePath+"/docs", http.StatusTemporaryRedirect).ServeHTTP)
+		mux.HandleFunc("GET /docs", s.Docs)
+	}
+
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		handleResponse[struct{}](s, w, r, "", nil, ErrEndpointNotFound)
+	})
+	var handler http.Handler = mux
+	return http.StripPrefix(s.config.BasePath, UseEntContext(s.db)(handler))
+}
+
+// ListPets maps to "GET /pets".
+func (s *Server) ListPets(r *http.Request, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query())
+}
+
+// GetPet maps to "GET /pets/{id}".
+func (s *Server) GetPet(r *http.Request, petID int) (*ent.Pet, error) {
+	return EagerLoadPet(s.client(r.Context()).Pet.Query().Where(pet.ID(petID))).Only(r.Context())
+}
+
+// GetPetOwner maps to "GET /pets/{id}/owner".
+func (s *Server) GetPetOwner(r *http.Request, petID int) (*ent.User, error) {
+	return EagerLoadUser(s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryOwner()).Only(r.Context())
+}
+
+// ListPetFriends maps to "GET /pets/{id}/friends".
+func (s *Server) ListPetFriends(r *http.Request, petID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).Pet.Query().Where(pet.ID(petID)).QueryFriends())
+}
+
+// CreatePet maps to "POST /pets".
+func (s *Server) CreatePet(r *http.Request, p *CreatePetParams) (*ent.Pet, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Pet, error) {
+		return p.Exec(r.Context(), tx.Pet.Create(), tx.Pet.Query())
+	})
+}
+
+// UpdatePet maps to "PATCH /pets/{id}".
+func (s *Server) UpdatePet(r *http.Request, petID int, p *UpdatePetParams) (*ent.Pet, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.Pet, error) {
+		return p.Exec(r.Context(), tx.Pet.UpdateOneID(petID), tx.Pet.Query())
+	})
+}
+
+// DeletePet maps to "DELETE /pets/{id}".
+func (s *Server) DeletePet(r *http.Request, petID int) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		return nil, tx.Pet.DeleteOneID(petID).Exec(r.Context())
+	})
+}
+
+// ListUsers maps to "GET /users".
+func (s *Server) ListUsers(r *http.Request, p *ListUserParams) (*PagedResponse[ent.User], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query())
+}
+
+// GetUser maps to "GET /users/{id}".
+func (s *Server) GetUser(r *http.Request, userID int) (*ent.User, error) {
+	return EagerLoadUser(s.client(r.Context()).User.Query().Where(user.ID(userID))).Only(r.Context())
+}
+
+// ListUserPets maps to "GET /users/{id}/pets".
+func (s *Server) ListUserPets(r *http.Request, userID int, p *ListPetParams) (*PagedResponse[ent.Pet], error) {
+	return p.Exec(r.Context(), s.client(r.Context()).User.Query().Where(user.ID(userID)).QueryPets())
+}
+
+// CreateUser maps to "POST /users".
+func (s *Server) CreateUser(r *http.Request, p *CreateUserParams) (*ent.User, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.User, error) {
+		return p.Exec(r.Context(), tx.User.Create(), tx.User.Query())
+	})
+}
+
+// UpdateUser maps to "PATCH /users/{id}".
+func (s *Server) UpdateUser(r *http.Request, userID int, p *UpdateUserParams) (*ent.User, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*ent.User, error) {
+		return p.Exec(r.Context(), tx.User.UpdateOneID(userID), tx.User.Query())
+	})
+}
+
+// DeleteUser maps to "DELETE /users/{id}".
+func (s *Server) DeleteUser(r *http.Request, userID int) (*struct{}, error) {
+	return withTx(s, r.Context(), func(tx *ent.Client) (*struct{}, error) {
+		return nil, tx.User.DeleteOneID(userID).Exec(r.Context())
+	})
+}
@@ -0,0 +1,223 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/user"
+)
+
+type Sorted struct {
+	// Field is the field to sort by. Can be a standard field name (e.g. "name"), or a custom field
+	// name (e.g. "pets.age.sum"). If no field is provided, the default field will be used.
+	Field *string `json:"sort" form:"sort,omitempty"`
+
+	// Order is the order to sort by. Can be either "asc" or "desc". If no order is provided, the
+	// default order will be used.
+	Order *orderDirection `json:"order" form:"order,omitempty"`
+}
+
+// Validate validates the sorting fields and applies any necessary defaults.
+func (s *Sorted) Validate(cfg *SortConfig) error {
+	if s.Field == nil {
+		if cfg.DefaultField == "" {
+			return nil
+		}
+		s.Field = &cfg.DefaultField
+	}
+	if s.Order == nil {
+		s.Order = &cfg.DefaultOrder
+	}
+
+	if !slices.Contains(cfg.Fields, *s.Field) {
+		return &ErrBadRequest{Err: fmt.Errorf("invalid sort field: %s", *s.Field)}
+	}
+
+	if !slices.Contains(OrderDirections, *s.Order) {
+		return &ErrBadRequest{Err: fmt.Errorf("invalid order: %s", *s.Order)}
+	}
+
+	return nil
+}
+
+// withOrderTerm returns the OrderTermOption (asc/desc) based on the provided order string.
+func withOrderTerm(order orderDirection) sql.OrderTermOption {
+	if order == orderAsc {
+		return sql.OrderAsc()
+	}
+	return sql.OrderDesc()
+}
+
+func withFieldSelector(field string, order orderDirection) func(*sql.Selector) {
+	if order == orderAsc {
+		return ent.Asc(field)
+	}
+	return ent.Desc(field)
+}
+
+type SortConfig struct {
+	Fields       []string
+	DefaultField string
+	DefaultOrder orderDirection
+
+	// Columns maps sort fields to their internal field names, for those which differ
+	// (e.g. "createdAt" to "created_at").
+	Columns map[string]string
+}
+
+// column returns the internal field name of the provided sort field.
+func (c *SortConfig) column(field string) string {
+	if v, ok := c.Columns[field]; ok {
+		return v
+	}
+	return field
+}
+
+type orderDirection string
+
+var (
+	orderAsc  orderDirection = "asc"
+	orderDesc orderDirection = "desc"
+
+	// OrderDirections are the allowed order directions that can be provided.
+	OrderDirections = []orderDirection{orderAsc, orderDesc}
+	// PetSortConfig defines the default sort configuration for Pet.
+	PetSortConfig = &SortConfig{
+		Fields: []string{
+			"age",
+			"friends.age.sum",
+			"friends.count",
+			"id",
+			"name",
+			"owner.display_name",
+			"owner.email",
+			"owner.id",
+			"owner.username",
+			"random",
+		},
+		DefaultField: "id",
+		DefaultOrder: "asc",
+	}
+	// UserSortConfig defines the default sort configuration for User.
+	UserSortConfig = &SortConfig{
+		Fields: []string{
+			"display_name",
+			"email",
+			"id",
+			"pets.age.sum",
+			"pets.count",
+			"random",
+			"username",
+		},
+		DefaultField: "id",
+		DefaultOrder: "asc",
+	}
+)
+
+// applySortingPet applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingPet(query *ent.PetQuery, field string, order orderDirection) *ent.PetQuery {
+	switch field {
+	case "random", pet.FieldID:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakPet(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		var isCount, isSum bool
+		if len(parts) > 2 {
+			switch parts[2] {
+			case "count":
+				isCount = true
+			case "sum":
+				isSum = true
+			}
+		}
+
+		switch parts[0] {
+		case pet.EdgeOwner:
+			return query.Order(pet.ByOwnerField(parts[1], dir))
+		case pet.EdgeFriends:
+			switch {
+			case isCount:
+				return query.Order(pet.ByFriendsCount(dir))
+			case isSum:
+				return query.Order(pet.ByFriends(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(pet.ByFriends(sql.OrderByField(parts[1], dir)))
+			}
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakPet orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakPet(query *ent.PetQuery, order orderDirection) *ent.PetQuery {
+	return query.Order(
+		withFieldSelector(pet.FieldID, order),
+	)
+}
+
+// applySortingUser applies sorting to the query based on the provided sort and
+// order fields. Note that all inputs provided MUST ALREADY BE VALIDATED.
+func applySortingUser(query *ent.UserQuery, field string, order orderDirection) *ent.UserQuery {
+	switch field {
+	case "random", user.FieldID, user.FieldUsername:
+		// Already a total ordering (or intentionally unstable).
+	default:
+		// Order by the unique identifier of the entity last, so results have a total
+		// ordering when sorting by non-unique fields, and pagination is stable.
+		defer applySortingTiebreakUser(query, order)
+	}
+	if parts := strings.Split(field, "."); len(parts) > 1 {
+		dir := withOrderTerm(order)
+
+		var isCount, isSum bool
+		if len(parts) > 2 {
+			switch parts[2] {
+			case "count":
+				isCount = true
+			case "sum":
+				isSum = true
+			}
+		}
+
+		switch parts[0] {
+		case user.EdgePets:
+			switch {
+			case isCount:
+				return query.Order(user.ByPetsCount(dir))
+			case isSum:
+				return query.Order(user.ByPets(sql.OrderBySum(parts[1], dir)))
+			default:
+				return query.Order(user.ByPets(sql.OrderByField(parts[1], dir)))
+			}
+		}
+	}
+	if field == "random" {
+		return query.Order(sql.OrderByRand())
+	}
+	return query.Order(withFieldSelector(field, order))
+}
+
+// applySortingTiebreakUser orders the query by the unique identifier of
+// the entity, which is used as a tiebreaker after all other ordering.
+func applySortingTiebreakUser(query *ent.UserQuery, order orderDirection) *ent.UserQuery {
+	return query.Order(
+		withFieldSelector(user.FieldID, order),
+	)
+}
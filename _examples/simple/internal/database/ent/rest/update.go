@@ -0,0 +1,102 @@
+// Code generated by ent, DO NOT EDIT.
+
+package rest
+
+import (
+	"context"
+
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/pet"
+	"github.com/lrstanley/entrest/_examples/simple/internal/database/ent/user"
+)
+
+// UpdatePetParams defines parameters for updating a Pet via a PATCH request.
+type UpdatePetParams struct {
+	Name Option[string]   `json:"name"`
+	Age  Option[int]      `json:"age"`
+	Type Option[pet.Type] `json:"type"`
+	// The user that owns the pet.
+	Owner Option[*int] `json:"owner,omitempty"`
+	// Pets that this pet is friends with.
+	AddFriends Option[[]int] `json:"add_friends,omitempty"`
+	// Pets that this pet is friends with.
+	RemoveFriends Option[[]int] `json:"remove_friends,omitempty"`
+}
+
+func (u *UpdatePetParams) ApplyInputs(builder *ent.PetUpdateOne) *ent.PetUpdateOne {
+	if v, ok := u.Name.Get(); ok {
+		builder.SetName(v)
+	}
+	if v, ok := u.Age.Get(); ok {
+		builder.SetAge(v)
+	}
+	if v, ok := u.Type.Get(); ok {
+		builder.SetType(v)
+	}
+
+	if v, ok := u.Owner.Get(); ok {
+		if v != nil {
+			builder.SetOwnerID(*v)
+		} else {
+			builder.ClearOwner()
+		}
+	}
+	if v, ok := u.AddFriends.Get(); ok && v != nil {
+		builder.AddFriendIDs(v...)
+	}
+	if v, ok := u.RemoveFriends.Get(); ok && v != nil {
+		builder.RemoveFriendIDs(v...)
+	}
+	return builder
+}
+
+// Exec wraps all logic (mapping all provided values to the build), updates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges.
+func (c *UpdatePetParams) Exec(ctx context.Context, builder *ent.PetUpdateOne, query *ent.PetQuery) (*ent.Pet, error) {
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadPet(query.Where(pet.ID(result.ID))).Only(ctx)
+}
+
+// UpdateUserParams defines parameters for updating a User via a PATCH request.
+type UpdateUserParams struct {
+	// full name/display name of the user.
+	DisplayName Option[string] `json:"display_name"`
+	// Email associated with the user.
+	Email Option[string] `json:"email"`
+	// Pets owned by the user.
+	AddPets Option[[]int] `json:"add_pets,omitempty"`
+	// Pets owned by the user.
+	RemovePets Option[[]int] `json:"remove_pets,omitempty"`
+}
+
+func (u *UpdateUserParams) ApplyInputs(builder *ent.UserUpdateOne) *ent.UserUpdateOne {
+	if v, ok := u.DisplayName.Get(); ok {
+		builder.SetDisplayName(v)
+	}
+	if v, ok := u.Email.Get(); ok {
+		builder.SetEmail(v)
+	}
+
+	if v, ok := u.AddPets.Get(); ok && v != nil {
+		builder.AddPetIDs(v...)
+	}
+	if v, ok := u.RemovePets.Get(); ok && v != nil {
+		builder.RemovePetIDs(v...)
+	}
+	return builder
+}
+
+// Exec wraps all logic (mapping all provided values to the build), updates the entity,
+// and does another query (using provided query as base) to get the entity, with all eager
+// loaded edges.
+func (c *UpdateUserParams) Exec(ctx context.Context, builder *ent.UserUpdateOne, query *ent.UserQuery) (*ent.User, error) {
+	result, err := c.ApplyInputs(builder).Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return EagerLoadUser(query.Where(user.ID(result.ID))).Only(ctx)
+}
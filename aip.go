@@ -0,0 +1,143 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// aipFilterOperators maps the filter operations which can be expressed through AIP-160
+// filter expressions (see [FlavorAIP]) to their operator (and argument, if specific).
+var aipFilterOperators = map[gen.Op]string{
+	gen.EQ:        "=",
+	gen.NEQ:       "!=",
+	gen.GT:        ">",
+	gen.GTE:       ">=",
+	gen.LT:        "<",
+	gen.LTE:       "<=",
+	gen.Contains:  ":",
+	gen.HasPrefix: `= "value*"`,
+	gen.HasSuffix: `= "*value"`,
+	gen.IsNil:     "= null",
+}
+
+// getAIPFilters returns the filters of list operations returning the provided type,
+// which can be used through AIP-160 filter expressions (see [FlavorAIP]).
+func getAIPFilters(t *gen.Type) []*flavorFilter {
+	return getFlavorFilters(t, aipFilterOperators, ":*")
+}
+
+// getAIPCollectionName returns the name of the response field which contains the
+// results of paginated list operations returning the provided type (see [FlavorAIP]),
+// e.g. "pets".
+func getAIPCollectionName(t *gen.Type) string {
+	style := getFieldNameStyle(t)
+	if style == FieldNameStyleDefault || style == FieldNameStyleAsIs {
+		style = FieldNameStyleSnake
+	}
+	return style.Format(Pluralize(t.Name))
+}
+
+// aipPagedSchema returns the schema of the pagination fields of paginated list
+// responses (see [FlavorAIP]).
+func aipPagedSchema() *ogen.Schema {
+	return &ogen.Schema{
+		Type: "object",
+		Properties: ogen.Properties{
+			{
+				Name: "next_page_token",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "A token which can be provided as the `page_token` parameter to retrieve the next page. Empty if there are no subsequent pages.",
+				},
+			},
+			{
+				Name: "total_size",
+				Schema: &ogen.Schema{
+					Type:        "integer",
+					Description: "The total number of results based on the provided query.",
+					Minimum:     ogen.Int().SetMinimum(ptr(int64(0))).Minimum,
+				},
+			},
+		},
+		Required: []string{"next_page_token", "total_size"},
+	}
+}
+
+// aipPaginationParameters returns the "page_size" and "page_token" parameters of
+// paginated list operations (see [FlavorAIP]).
+func aipPaginationParameters(minItems, maxItems, items int) []*ogen.Parameter {
+	return []*ogen.Parameter{
+		{
+			Name:        "page_size",
+			In:          "query",
+			Description: "The maximum number of entities to retrieve per page.",
+			Schema: ogen.Int().
+				SetMinimum(ptr(int64(minItems))).
+				SetMaximum(ptr(int64(maxItems))).
+				SetDefault(json.RawMessage(strconv.Itoa(items))),
+		},
+		{
+			Name:        "page_token",
+			In:          "query",
+			Description: "A page token, received through the `next_page_token` field of a previous call, to retrieve the subsequent page. All other list parameters must match the call which provided the page token.",
+			Schema:      ogen.String(),
+		},
+	}
+}
+
+// aipOrderByParameter returns the "order_by" parameter of list operations (see
+// [FlavorAIP]) returning the provided type, which can be sorted by the provided fields.
+func aipOrderByParameter(t *gen.Type, sortable []string, defaultSort string, defaultOrder SortOrder) *ogen.Parameter {
+	example := defaultSort
+	fields := make([]string, len(sortable))
+	for i, f := range sortable {
+		fields[i] = "`" + GetSortFieldName(t, f) + "`"
+		if example == "" && f != "random" {
+			example = f
+		}
+	}
+
+	param := &ogen.Parameter{
+		Name: "order_by",
+		In:   "query",
+		Description: fmt.Sprintf(
+			"Sort entity results by the given field (see AIP-132), optionally followed by `asc` (default) or `desc`, e.g. `%s desc`. Only a single field is supported. Supported fields: %s.",
+			GetSortFieldName(t, example),
+			strings.Join(fields, ", "),
+		),
+		Schema: ogen.String(),
+	}
+
+	if defaultSort != "" {
+		param.Schema = param.Schema.SetDefault(json.RawMessage(strconv.Quote(GetSortFieldName(t, defaultSort) + " " + string(defaultOrder))))
+	}
+	return param
+}
+
+// aipFilterParameter returns the "filter" parameter of list operations (see
+// [FlavorAIP]) returning the provided type, or nil if the type has no filters.
+func aipFilterParameter(t *gen.Type) *ogen.Parameter {
+	supported := getFlavorFilterSummary(getAIPFilters(t))
+	if supported == "" {
+		return nil
+	}
+
+	return &ogen.Parameter{
+		Name: "filter",
+		In:   "query",
+		Description: fmt.Sprintf(
+			"Filter entity results through a filter expression (see AIP-160), e.g. `name = \"foo\" AND age > 3`. Restrictions can be combined through either `AND` or `OR` (not both), and string and time values must be quoted. Supported fields and operators: %s.",
+			supported,
+		),
+		Schema: ogen.String(),
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"slices"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_FlavorAIP(t *testing.T) {
+	t.Parallel()
+
+	params := func(op *ogen.Operation) (names []string) {
+		for _, p := range op.Parameters {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+
+	t.Run("aip", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			Flavor: FlavorAIP,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.name", WithSortable(true), WithFilter(FilterGroupEqual))
+				injectAnnotations(t, g, "Pet.owner", WithFilter(FilterEdge))
+				return nil
+			},
+		})
+
+		for _, path := range []string{"/pets", "/users/{userID}/pets"} {
+			op := r.spec.Paths[path].Get
+			require.NotNil(t, op, path)
+
+			names := params(op)
+			assert.Subset(t, names, []string{"page_size", "page_token", "order_by", "filter"}, path)
+			for _, name := range []string{"page", "per_page", "sort", "order", "filter_op"} {
+				assert.NotContains(t, names, name, path)
+			}
+		}
+
+		op := r.spec.Paths["/pets"].Get
+		filter := op.Parameters[slices.IndexFunc(op.Parameters, func(p *ogen.Parameter) bool { return p.Name == "filter" })]
+		assert.Contains(t, filter.Description, "`name` (`=`, `!=`, `:`")
+		assert.Contains(t, filter.Description, "`owner` (`:*`)")
+
+		assert.NotNil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.pets`))
+		assert.Nil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.content`))
+		assert.NotNil(t, r.json(`$.components.schemas.PagedResponse.properties.next_page_token`))
+		assert.NotNil(t, r.json(`$.components.schemas.PagedResponse.properties.total_size`))
+		assert.Nil(t, r.json(`$.components.schemas.PagedResponse.properties.page`))
+		assert.Nil(t, r.json(`$.components.parameters.Page`))
+	})
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		names := params(r.spec.Paths["/pets"].Get)
+		assert.Contains(t, names, "per_page")
+		assert.NotContains(t, names, "page_size")
+		assert.NotNil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.content`))
+	})
+}
+
+func TestGetAIPCollectionName(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{Flavor: FlavorAIP})
+
+	for _, n := range r.graph.Nodes {
+		if n.Name == "Pet" {
+			assert.Equal(t, "pets", getAIPCollectionName(n))
+		}
+	}
+}
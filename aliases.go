@@ -0,0 +1,194 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// fieldAlias is a previous JSON property name of a field (see [WithAliases]).
+type fieldAlias struct {
+	// Alias is the previous property name.
+	Alias string
+	// Name is the current property name.
+	Name string
+}
+
+// getFieldAliases returns the aliases of the fields of the provided type (see
+// [WithAliases]) which can be set in the request body of the provided operation
+// (create or update), ordered by alias.
+func getFieldAliases(t *gen.Type, op Operation) (aliases []*fieldAlias) {
+	cfg := GetConfig(t.Config)
+
+	if GetAnnotation(t).GetSkip(cfg) || IsReadOnly(t) {
+		return nil
+	}
+
+	style := getFieldNameStyle(t)
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if len(fa.Aliases) == 0 || fa.GetSkip(cfg) || !fa.GetWritable(op) || (op == OperationUpdate && f.Immutable) {
+			continue
+		}
+
+		for _, alias := range fa.Aliases {
+			aliases = append(aliases, &fieldAlias{Alias: style.Format(alias), Name: GetFieldName(t, f)})
+		}
+	}
+
+	slices.SortFunc(aliases, func(a, b *fieldAlias) int {
+		return strings.Compare(a.Alias, b.Alias)
+	})
+	return aliases
+}
+
+// hasFieldAliases returns true if any of the provided types have field aliases which
+// can be used in request bodies.
+func hasFieldAliases(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return len(getFieldAliases(t, OperationCreate)) > 0 || len(getFieldAliases(t, OperationUpdate)) > 0
+	})
+}
+
+// getAliasPathSegment returns the path segment the provided type would have if it
+// was still named after the provided alias (see [WithAliases]), through
+// [Config.PathNameFunc] and [Namer.PathSegment].
+func getAliasPathSegment(t *gen.Type, alias string) string {
+	old := *t
+	old.Name = alias
+
+	if hasConfig(t.Config) {
+		if fn := GetConfig(t.Config).PathNameFunc; fn != nil {
+			if v := fn(&old); v != "" {
+				return v
+			}
+		}
+	}
+
+	return GetNamer(t).PathSegment(&old, nil)
+}
+
+// getAliasPaths returns the previous base paths of the provided type (e.g. "/animals"),
+// based on its aliases (see [WithAliases]), which are redirected to its current base
+// path.
+func getAliasPaths(t *gen.Type) (paths []string) {
+	ta := GetAnnotation(t)
+	if ta.GetSkip(GetConfig(t.Config)) || ta.DisableHandler {
+		return nil
+	}
+
+	for _, alias := range ta.Aliases {
+		path := "/" + getAliasPathSegment(t, alias)
+		if ta.Group != "" {
+			path = "/" + ta.Group + path
+		}
+
+		if path != GetPathName(OperationList, t, nil, false) && !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	return paths
+}
+
+// hasAliasPaths returns true if any of the provided types have previous base paths
+// which are redirected.
+func hasAliasPaths(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return len(getAliasPaths(t)) > 0
+	})
+}
+
+// validateFieldAliases checks that the aliases of the fields of the provided type (see
+// [WithAliases]) don't conflict with the names of its fields and edges, or with each
+// other.
+func validateFieldAliases(t *gen.Type) (errs []error) {
+	style := getFieldNameStyle(t)
+
+	names := map[string]bool{}
+	for _, f := range t.Fields {
+		names[GetFieldName(t, f)] = true
+	}
+	if t.ID != nil {
+		names[GetFieldName(t, t.ID)] = true
+	}
+	for _, e := range t.Edges {
+		names[GetEdgeName(t, e, "")] = true
+	}
+
+	seen := map[string]string{}
+
+	for _, f := range t.Fields {
+		for _, alias := range GetAnnotation(f).Aliases {
+			if alias == "" {
+				errs = append(errs, fmt.Errorf("field %q has an empty alias", f.Name))
+				continue
+			}
+
+			v := style.Format(alias)
+			if names[v] {
+				errs = append(errs, fmt.Errorf("alias %q of field %q conflicts with the name of a field or edge", alias, f.Name))
+				continue
+			}
+
+			if other, ok := seen[v]; ok && other != f.Name {
+				errs = append(errs, fmt.Errorf("alias %q of field %q is already used by field %q", alias, f.Name, other))
+				continue
+			}
+			seen[v] = f.Name
+		}
+	}
+	return errs
+}
+
+// validateSchemaAliases checks that the aliases of the provided schema (see
+// [WithAliases]) are valid schema names.
+func validateSchemaAliases(t *gen.Type, ta *Annotation) (errs []error) {
+	for _, alias := range ta.Aliases {
+		switch {
+		case alias == "":
+			errs = append(errs, errors.New("schema has an empty alias"))
+		case alias == t.Name:
+			errs = append(errs, fmt.Errorf("alias %q is the current name of the schema", alias))
+		default:
+			if err := validatePathName(getAliasPathSegment(t, alias)); err != nil {
+				errs = append(errs, fmt.Errorf("alias %q: %w", alias, err))
+			}
+		}
+	}
+	return errs
+}
+
+// validateAliasPaths checks that the previous base paths of all schemas (see
+// [WithAliases]), which are redirected, don't conflict with the base paths of other
+// schemas, or with each other.
+func validateAliasPaths(cfg *Config, nodes []*gen.Type) (errs []error) {
+	seen := map[string]string{}
+
+	for _, t := range nodes {
+		if !GetAnnotation(t).GetSkip(cfg) {
+			seen[GetPathName(OperationList, t, nil, false)] = t.Name
+		}
+	}
+
+	for _, t := range nodes {
+		for _, path := range getAliasPaths(t) {
+			if other, ok := seen[path]; ok {
+				errs = append(errs, &AnnotationError{
+					Schema: t.Name,
+					Err:    fmt.Errorf("alias path %q is already used by schema %s", path, other),
+				})
+				continue
+			}
+			seen[path] = t.Name
+		}
+	}
+	return errs
+}
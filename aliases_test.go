@@ -0,0 +1,50 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliases(t *testing.T) {
+	t.Parallel()
+
+	var (
+		create []*fieldAlias
+		update []*fieldAlias
+		paths  []string
+	)
+
+	mustBuildSpec(t, &Config{
+		FieldNameStyle: FieldNameStyleCamel,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithPathName("animals"), WithAliases("Animal", "Creature"))
+			injectAnnotations(t, g, "Pet.age", WithAliases("years", "age_in_years"))
+
+			for _, n := range g.Nodes {
+				if n.Name == "Pet" {
+					create = getFieldAliases(n, OperationCreate)
+					update = getFieldAliases(n, OperationUpdate)
+					paths = getAliasPaths(n)
+				}
+			}
+			return nil
+		},
+	})
+
+	expected := []*fieldAlias{
+		{Alias: "ageInYears", Name: "age"},
+		{Alias: "years", Name: "age"},
+	}
+	assert.Equal(t, expected, create)
+	assert.Equal(t, expected, update)
+
+	// "Animal" resolves to the current path of the schema, so isn't redirected.
+	assert.Equal(t, []string{"/creatures"}, paths)
+}
@@ -0,0 +1,156 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// GetAlternateKeyFields returns the fields of the provided type which are used as
+// alternate keys (see [WithAlternateKey]), each of which has a lookup endpoint. Returns
+// nil if the type is skipped, doesn't have a single ID field, or doesn't have the read
+// operation enabled.
+func GetAlternateKeyFields(t *gen.Type) []*gen.Field {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	if t.ID == nil || ta.GetSkip(cfg) || !ta.HasOperation(cfg, OperationRead) {
+		return nil
+	}
+
+	var fields []*gen.Field
+
+	for _, k := range ta.AlternateKeys {
+		idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == k })
+		if idx == -1 || GetAnnotation(t.Fields[idx]).GetSkip(cfg) {
+			continue
+		}
+		fields = append(fields, t.Fields[idx])
+	}
+	return fields
+}
+
+// GetAlternateKeyPathName returns the path of the lookup endpoint for the provided
+// alternate key field of the provided type, e.g. "/posts/by-slug/{slug}".
+func GetAlternateKeyPathName(t *gen.Type, f *gen.Field) string {
+	return GetPathName(OperationList, t, nil, false) + "/by-" + KebabCase(f.Name) + "/{" + GetFieldName(t, f) + "}"
+}
+
+// GetAlternateKeyOperationID returns the operation ID of the lookup endpoint for the
+// provided alternate key field of the provided type, e.g. "getPostBySlug".
+func GetAlternateKeyOperationID(t *gen.Type, f *gen.Field) string {
+	return "get" + GetSchemaName(t) + "By" + PascalCase(f.Name)
+}
+
+// getAlternateKeyComponentName returns the name of the parameter component for the
+// provided alternate key field, e.g. "PostSlug".
+func getAlternateKeyComponentName(t *gen.Type, f *gen.Field) string {
+	return GetSchemaName(t) + PascalCase(f.Name)
+}
+
+// hasAlternateKeyHandlers returns true if any of the provided types have alternate key
+// lookup endpoints which should have handlers mounted.
+func hasAlternateKeyHandlers(nodes []*gen.Type) bool {
+	for _, t := range nodes {
+		if !GetAnnotation(t).DisableHandler && len(GetAlternateKeyFields(t)) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSpecAlternateKey generates an independent spec for the lookup endpoint of the
+// provided alternate key field of the provided type (see [WithAlternateKey]).
+func GetSpecAlternateKey(t *gen.Type, f *gen.Field) (*ogen.Spec, error) {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	entityName := GetSchemaName(t)
+	name := GetFieldName(t, f)
+
+	spec := newBaseSpec(cfg)
+	spec.Tags = append(spec.Tags, ogen.Tag{Name: Pluralize(t.Name), Description: ta.Description})
+
+	keySchema, err := GetSchemaField(f)
+	if err != nil {
+		return nil, err
+	}
+
+	spec.Components.Parameters[getAlternateKeyComponentName(t, f)] = &ogen.Parameter{
+		Name:        name,
+		In:          "path",
+		Description: fmt.Sprintf("The %s of the %s to act upon.", name, entityName),
+		Required:    true,
+		Schema:      keySchema,
+	}
+
+	for k, v := range GetSchemaType(t, OperationRead, nil) {
+		spec.Components.Schemas[k] = v
+	}
+
+	oper := &ogen.Operation{
+		Tags:        []string{Pluralize(t.Name)},
+		Summary:     fmt.Sprintf("Find a %s by %s", CamelCase(entityName), name),
+		Description: fmt.Sprintf("Find a single %s by its unique %s.", CamelCase(entityName), name),
+		OperationID: GetAlternateKeyOperationID(t, f),
+		Deprecated:  ta.Deprecated,
+		Responses: ogen.Responses{
+			strconv.Itoa(http.StatusOK): ogen.NewResponse().
+				SetDescription(fmt.Sprintf("%s with the requested %s was found.", entityName, name)).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(t)}),
+		},
+	}
+
+	spec.Paths[GetAlternateKeyPathName(t, f)] = &ogen.PathItem{
+		Summary:     oper.Summary,
+		Description: oper.Description,
+		Get:         oper,
+		Parameters: []*ogen.Parameter{
+			{Ref: "#/components/parameters/PrettyResponse"},
+			{Ref: "#/components/parameters/" + getAlternateKeyComponentName(t, f)},
+		},
+	}
+
+	return spec, nil
+}
+
+// validateAlternateKeys checks that all alternate keys of the provided type reference
+// existing, unique, non-sensitive fields, of a type which can be provided as a path
+// parameter.
+func validateAlternateKeys(cfg *Config, t *gen.Type, ta *Annotation) (errs []error) {
+	if len(ta.AlternateKeys) == 0 || ta.GetSkip(cfg) {
+		return nil
+	}
+
+	if t.ID == nil {
+		return []error{errors.New("alternate keys are only supported on schemas with a single ID field")}
+	}
+
+	for _, k := range ta.AlternateKeys {
+		idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == k })
+		if idx == -1 {
+			errs = append(errs, fmt.Errorf("alternate key field %q does not exist", k))
+			continue
+		}
+
+		f := t.Fields[idx]
+
+		switch {
+		case !f.Unique:
+			errs = append(errs, fmt.Errorf("alternate key field %q must be unique", k))
+		case f.Sensitive() || GetAnnotation(f).GetSkip(cfg) || !GetAnnotation(f).GetReadable():
+			errs = append(errs, fmt.Errorf("alternate key field %q is sensitive, skipped or not readable", k))
+		case !f.IsString() && !f.Type.Numeric() && !f.IsUUID():
+			errs = append(errs, fmt.Errorf("alternate key field %q must be a string, numeric or UUID field", k))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+// withUniqueField marks the provided field of the provided type as unique, as none of
+// the test schemas have unique fields.
+func withUniqueField(t *testing.T, g *gen.Graph, typeName, fieldName string) {
+	t.Helper()
+
+	for _, n := range g.Nodes {
+		if n.Name != typeName {
+			continue
+		}
+		for _, f := range n.Fields {
+			if f.Name == fieldName {
+				f.Unique = true
+				return
+			}
+		}
+	}
+	t.Fatalf("failed to find field %q in type %q", fieldName, typeName)
+}
+
+func TestSpec_AlternateKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withUniqueField(t, g, "User", "email")
+				injectAnnotations(t, g, "User", WithAlternateKey("email"))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "getUserByEmail", r.json(`$.paths./users/by-email/{email}.get.operationId`))
+		assert.Equal(t, "email", r.json(`$.components.parameters.UserEmail.name`))
+		assert.Equal(t, "path", r.json(`$.components.parameters.UserEmail.in`))
+		assert.Equal(t, "string", r.json(`$.components.parameters.UserEmail.schema.type`))
+		assert.Contains(t, r.json(`$.paths./users/by-email/{email}.parameters[*].$ref`), "#/components/parameters/UserEmail")
+		assert.Contains(t, r.json(`$.paths./users/by-email/{email}.get.responses.200.content.application/json.schema.$ref`), "/UserRead")
+	})
+
+	t.Run("read-disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withUniqueField(t, g, "User", "email")
+				injectAnnotations(t, g, "User", WithAlternateKey("email"), WithExcludeOperations(OperationRead))
+				return nil
+			},
+		})
+
+		assert.Nil(t, r.json(`$.paths./users/by-email/{email}`))
+	})
+}
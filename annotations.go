@@ -6,17 +6,22 @@ package entrest
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"slices"
 	"strings"
+	"time"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/schema"
 	"github.com/ogen-go/ogen"
 )
 
-// GetAnnotation returns the annotation on the given graph item.
+// GetAnnotation returns the annotation on the given graph item. While generating, the
+// returned annotation shares its slices, maps and pointers with the decoded annotation
+// stored on the graph item (see [cacheAnnotations]), so these must not be modified.
 func GetAnnotation(v any) *Annotation {
 	switch v := v.(type) {
 	case *gen.Type:
@@ -31,36 +36,84 @@ func GetAnnotation(v any) *Annotation {
 }
 
 // decodeAnnotation decodes the entrest [Annotation] from the given gen.Annotations.
-// Panics if it's unable to decode the annotation.
+// Annotations which are already decoded (see [cacheAnnotations] and [withAnnotation])
+// are only (shallow) copied. Panics if it's unable to decode the annotation.
 func decodeAnnotation(as gen.Annotations) *Annotation {
 	ant := &Annotation{}
-	if as != nil && as[ant.Name()] != nil {
-		if err := ant.Decode(as[ant.Name()]); err != nil {
+
+	switch raw := as[ant.Name()].(type) {
+	case nil:
+	case *Annotation:
+		if raw != nil {
+			*ant = *raw
+		}
+	default:
+		if err := ant.Decode(raw); err != nil {
 			panic(fmt.Sprintf("failed to decode annotation: %v", err))
 		}
 	}
 	return ant
 }
 
+// cacheAnnotations decodes the entrest annotations of all types, fields and edges in the
+// given graph once, and stores the decoded annotations on them, as decoding them
+// through JSON for every call of [GetAnnotation] is expensive. The annotations are
+// replaced rather than modified, as they are shared with the loaded schema.
+func cacheAnnotations(g *gen.Graph) {
+	decode := func(as gen.Annotations) gen.Annotations {
+		switch as[(Annotation{}).Name()].(type) {
+		case nil, *Annotation:
+			return as
+		default:
+			return withAnnotation(as, func(_ *Annotation) {})
+		}
+	}
+
+	for _, t := range g.Nodes {
+		t.Annotations = decode(t.Annotations)
+		if t.ID != nil {
+			t.ID.Annotations = decode(t.ID.Annotations)
+		}
+		for _, f := range t.Fields {
+			f.Annotations = decode(f.Annotations)
+		}
+		for _, e := range t.Edges {
+			e.Annotations = decode(e.Annotations)
+		}
+	}
+}
+
 // ValidateAnnotations ensures that all annotations on the given graph are correctly
 // attached to the right types (e.g. a field-only annotation on a schema or edge type).
+// If the nodes are part of a graph with a [Config], it also checks all annotations for
+// conflicts (see [ValidateAnnotationConflicts]). All errors are returned (joined), each
+// as an [*AnnotationError] containing the location of the offending annotation.
 func ValidateAnnotations(nodes ...*gen.Type) error {
+	var errs []error
+
 	for _, t := range nodes {
 		if err := GetAnnotation(t).getSupportedType(t.Name, "schema"); err != nil {
-			return err
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
 		}
 		for _, f := range t.Fields {
 			if err := GetAnnotation(f).getSupportedType(f.Name, "field"); err != nil {
-				return err
+				errs = append(errs, &AnnotationError{Schema: t.Name, Field: f.Name, Err: err})
 			}
 		}
 		for _, e := range t.Edges {
 			if err := GetAnnotation(e).getSupportedType(e.Name, "edge"); err != nil {
-				return err
+				errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
 			}
 		}
 	}
-	return nil
+
+	// Only check for conflicts if the annotations are otherwise valid, as conflicts
+	// between invalid annotations are just noise.
+	if len(errs) == 0 && len(nodes) > 0 && hasConfig(nodes[0].Config) {
+		return ValidateAnnotationConflicts(GetConfig(nodes[0].Config), nodes...)
+	}
+
+	return errors.Join(errs...)
 }
 
 var ( // Ensure that Annotation implements necessary interfaces.
@@ -77,35 +130,92 @@ type Annotation struct {
 
 	// Fields that map directly to the OpenAPI schema.
 
-	AdditionalTags       []string             `json:",omitempty" ent:"schema,edge"`
-	Tags                 []string             `json:",omitempty" ent:"schema,edge"`
-	OperationSummary     map[Operation]string `json:",omitempty" ent:"schema,edge"`
-	OperationDescription map[Operation]string `json:",omitempty" ent:"schema,edge"`
-	OperationID          map[Operation]string `json:",omitempty" ent:"schema,edge"`
-	Description          string               `json:",omitempty" ent:"schema,edge,field"`
-	Example              any                  `json:",omitempty" ent:"field"`
-	Deprecated           bool                 `json:",omitempty" ent:"schema,edge,field"`
-	Schema               *ogen.Schema         `json:",omitempty" ent:"field"`
-	ReadOnly             bool                 `json:",omitempty" ent:"field"`
+	AdditionalTags       []string                     `json:",omitempty" ent:"schema,edge"`
+	Tags                 []string                     `json:",omitempty" ent:"schema,edge"`
+	OperationSummary     map[Operation]string         `json:",omitempty" ent:"schema,edge"`
+	OperationDescription map[Operation]string         `json:",omitempty" ent:"schema,edge"`
+	OperationID          map[Operation]string         `json:",omitempty" ent:"schema,edge"`
+	OperationMethod      map[Operation]string         `json:",omitempty" ent:"schema"`
+	CreateResponse       CreateResponse               `json:",omitempty" ent:"schema"`
+	ResponseStatus       map[Operation]int            `json:",omitempty" ent:"schema"`
+	RequestHeaders       map[Operation]RequestHeaders `json:",omitempty" ent:"schema"`
+	SQLModifiers         map[Operation]*SQLModifiers  `json:",omitempty" ent:"schema"`
+	PathName             string                       `json:",omitempty" ent:"schema,edge"`
+	Group                string                       `json:",omitempty" ent:"schema"`
+	Description          string                       `json:",omitempty" ent:"schema,edge,field"`
+	Example              any                          `json:",omitempty" ent:"field"`
+	Deprecated           bool                         `json:",omitempty" ent:"schema,edge,field"`
+	Deprecation          *Deprecation                 `json:",omitempty" ent:"schema,edge"`
+	Schema               *ogen.Schema                 `json:",omitempty" ent:"field"`
+	ReadOnly             bool                         `json:",omitempty" ent:"schema,field"`
+	EnumDescriptions     map[string]string            `json:",omitempty" ent:"field"`
+	DeprecatedEnumValues []string                     `json:",omitempty" ent:"field"`
+	IntEnumValues        map[string]int               `json:",omitempty" ent:"field"`
+	TimeFormat           TimeFormat                   `json:",omitempty" ent:"field"`
+	NumericFormat        *NumericFormat               `json:",omitempty" ent:"field"`
+	MoneyCurrencyField   string                       `json:",omitempty" ent:"field"`
+	Geo                  *GeoOptions                  `json:",omitempty" ent:"field"`
+	Translations         *TranslationOptions          `json:",omitempty" ent:"field"`
+	File                 *FileOptions                 `json:",omitempty" ent:"field"`
+	Hashed               bool                         `json:",omitempty" ent:"field"`
 
 	// All others.
 
-	Pagination      *bool       `json:",omitempty" ent:"schema,edge"`
-	MinItemsPerPage int         `json:",omitempty" ent:"schema,edge"`
-	MaxItemsPerPage int         `json:",omitempty" ent:"schema,edge"`
-	ItemsPerPage    int         `json:",omitempty" ent:"schema,edge"`
-	EagerLoad       *bool       `json:",omitempty" ent:"edge"`
-	EagerLoadLimit  *int        `json:",omitempty" ent:"edge"`
-	EdgeEndpoint    *bool       `json:",omitempty" ent:"edge"`
-	EdgeUpdateBulk  bool        `json:",omitempty" ent:"edge"`
-	Filter          Predicate   `json:",omitempty" ent:"schema,edge,field"`
-	FilterGroup     string      `json:",omitempty" ent:"edge,field"`
-	DisableHandler  bool        `json:",omitempty" ent:"schema,edge"`
-	Sortable        bool        `json:",omitempty" ent:"field"`
-	DefaultSort     *string     `json:",omitempty" ent:"schema"`
-	DefaultOrder    *SortOrder  `json:",omitempty" ent:"schema"`
-	Skip            bool        `json:",omitempty" ent:"schema,edge,field"`
-	Operations      []Operation `json:",omitempty" ent:"schema,edge"`
+	Pagination      *bool              `json:",omitempty" ent:"schema,edge"`
+	SeekPagination  bool               `json:",omitempty" ent:"schema"`
+	MinItemsPerPage int                `json:",omitempty" ent:"schema,edge"`
+	MaxItemsPerPage int                `json:",omitempty" ent:"schema,edge"`
+	ItemsPerPage    int                `json:",omitempty" ent:"schema,edge"`
+	EagerLoad       *bool              `json:",omitempty" ent:"edge"`
+	EagerLoadLimit  *int               `json:",omitempty" ent:"edge"`
+	EagerLoadDepth  int                `json:",omitempty" ent:"edge"`
+	EagerLoadEdges  []string           `json:",omitempty" ent:"edge"`
+	EagerLoadFields []string           `json:",omitempty" ent:"edge"`
+	EdgeEndpoint    *bool              `json:",omitempty" ent:"edge"`
+	EdgeUpdateBulk  bool               `json:",omitempty" ent:"edge"`
+	ParentFields    []string           `json:",omitempty" ent:"edge"`
+	TreeTraversal   *int               `json:",omitempty" ent:"edge"`
+	ClientID        *bool              `json:",omitempty" ent:"schema"`
+	IDFormat        *IDFormat          `json:",omitempty" ent:"schema"`
+	AlternateKeys   []string           `json:",omitempty" ent:"schema"`
+	Aliases         []string           `json:",omitempty" ent:"schema,field"`
+	Subscriptions   []Operation        `json:",omitempty" ent:"schema"`
+	ComputedFields  []*ComputedField   `json:",omitempty" ent:"schema"`
+	Filter          Predicate          `json:",omitempty" ent:"schema,edge,field"`
+	FilterGroup     string             `json:",omitempty" ent:"edge,field"`
+	DisableHandler  bool               `json:",omitempty" ent:"schema,edge"`
+	SelectForUpdate bool               `json:",omitempty" ent:"schema"`
+	DryRun          bool               `json:",omitempty" ent:"schema"`
+	ChangedFields   bool               `json:",omitempty" ent:"schema"`
+	History         bool               `json:",omitempty" ent:"schema"`
+	SoftDelete      bool               `json:",omitempty" ent:"schema"`
+	Attachments     *AttachmentOptions `json:",omitempty" ent:"schema"`
+	Sortable        bool               `json:",omitempty" ent:"field"`
+	DefaultSort     *string            `json:",omitempty" ent:"schema"`
+	DefaultOrder    *SortOrder         `json:",omitempty" ent:"schema"`
+	Skip            bool               `json:",omitempty" ent:"schema,edge,field"`
+	Operations      []Operation        `json:",omitempty" ent:"schema,edge"`
+
+	// Field toggles (see [WithFieldToggle]).
+
+	FieldToggles map[FieldToggle]bool `json:",omitempty" ent:"field"`
+
+	// Conditional requirements (see [WithConditionalRequirement]).
+
+	ConditionalRequirements []*ConditionalRequirement `json:",omitempty" ent:"schema"`
+
+	// Cross-field validation (see [WithCrossValidation]).
+
+	CrossValidations []*CrossValidation `json:",omitempty" ent:"schema"`
+
+	// Request deduplication (see [WithDeduplication]).
+
+	DeduplicationWindow time.Duration `json:",omitempty" ent:"schema"`
+
+	// Versioning (see [Config.Versions]).
+
+	Versions          *VersionRange               `json:",omitempty" ent:"schema,edge,field"`
+	OperationVersions map[Operation]*VersionRange `json:",omitempty" ent:"schema"`
 }
 
 // getSupportedType uses reflection to check if the annotation is supported on the
@@ -189,6 +299,47 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation { // nolint:goc
 			a.OperationID[k] = v
 		}
 	}
+	if len(am.OperationMethod) > 0 {
+		if a.OperationMethod == nil {
+			a.OperationMethod = make(map[Operation]string)
+		}
+		for k, v := range am.OperationMethod {
+			a.OperationMethod[k] = v
+		}
+	}
+	if am.CreateResponse != CreateResponseBody {
+		a.CreateResponse = am.CreateResponse
+	}
+	if len(am.ResponseStatus) > 0 {
+		if a.ResponseStatus == nil {
+			a.ResponseStatus = make(map[Operation]int)
+		}
+		for k, v := range am.ResponseStatus {
+			a.ResponseStatus[k] = v
+		}
+	}
+	if len(am.RequestHeaders) > 0 {
+		if a.RequestHeaders == nil {
+			a.RequestHeaders = make(map[Operation]RequestHeaders)
+		}
+		for k, v := range am.RequestHeaders {
+			a.RequestHeaders[k] = a.RequestHeaders[k].Append(v)
+		}
+	}
+	if len(am.SQLModifiers) > 0 {
+		if a.SQLModifiers == nil {
+			a.SQLModifiers = make(map[Operation]*SQLModifiers)
+		}
+		for k, v := range am.SQLModifiers {
+			a.SQLModifiers[k] = a.SQLModifiers[k].merge(v)
+		}
+	}
+	if am.PathName != "" {
+		a.PathName = am.PathName
+	}
+	if am.Group != "" {
+		a.Group = am.Group
+	}
 	if am.Description != "" {
 		a.Description = am.Description
 	}
@@ -196,13 +347,57 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation { // nolint:goc
 		a.Example = am.Example
 	}
 	a.Deprecated = a.Deprecated || am.Deprecated
+	if am.Deprecation != nil {
+		a.Deprecation = am.Deprecation
+	}
 	if am.Schema != nil {
 		a.Schema = am.Schema
 	}
+	if len(am.EnumDescriptions) > 0 {
+		if a.EnumDescriptions == nil {
+			a.EnumDescriptions = make(map[string]string)
+		}
+		for k, v := range am.EnumDescriptions {
+			a.EnumDescriptions[k] = v
+		}
+	}
+	for _, v := range am.DeprecatedEnumValues {
+		if !slices.Contains(a.DeprecatedEnumValues, v) {
+			a.DeprecatedEnumValues = append(a.DeprecatedEnumValues, v)
+		}
+	}
+	if len(am.IntEnumValues) > 0 {
+		if a.IntEnumValues == nil {
+			a.IntEnumValues = make(map[string]int)
+		}
+		for k, v := range am.IntEnumValues {
+			a.IntEnumValues[k] = v
+		}
+	}
+	if am.TimeFormat != TimeFormatDefault {
+		a.TimeFormat = am.TimeFormat
+	}
+	if am.NumericFormat != nil {
+		a.NumericFormat = am.NumericFormat
+	}
+	if am.MoneyCurrencyField != "" {
+		a.MoneyCurrencyField = am.MoneyCurrencyField
+	}
+	if am.Geo != nil {
+		a.Geo = am.Geo
+	}
+	if am.Translations != nil {
+		a.Translations = am.Translations
+	}
+	if am.File != nil {
+		a.File = am.File
+	}
+	a.Hashed = a.Hashed || am.Hashed
 
 	if am.Pagination != nil {
 		a.Pagination = am.Pagination
 	}
+	a.SeekPagination = a.SeekPagination || am.SeekPagination
 	if am.MinItemsPerPage != 0 {
 		a.MinItemsPerPage = am.MinItemsPerPage
 	}
@@ -218,10 +413,56 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation { // nolint:goc
 	if am.EagerLoadLimit != nil {
 		a.EagerLoadLimit = am.EagerLoadLimit
 	}
+	if am.EagerLoadDepth != 0 {
+		a.EagerLoadDepth = am.EagerLoadDepth
+	}
+	if am.EagerLoadEdges != nil {
+		a.EagerLoadEdges = am.EagerLoadEdges
+	}
+	if am.EagerLoadFields != nil {
+		a.EagerLoadFields = am.EagerLoadFields
+	}
 	if am.EdgeEndpoint != nil {
 		a.EdgeEndpoint = am.EdgeEndpoint
 	}
 	a.EdgeUpdateBulk = a.EdgeUpdateBulk || am.EdgeUpdateBulk
+	for _, name := range am.ParentFields {
+		if !slices.Contains(a.ParentFields, name) {
+			a.ParentFields = append(a.ParentFields, name)
+		}
+	}
+	if am.TreeTraversal != nil {
+		a.TreeTraversal = am.TreeTraversal
+	}
+	if am.ClientID != nil {
+		a.ClientID = am.ClientID
+	}
+	if am.IDFormat != nil {
+		a.IDFormat = am.IDFormat
+	}
+	for _, k := range am.AlternateKeys {
+		if !slices.Contains(a.AlternateKeys, k) {
+			a.AlternateKeys = append(a.AlternateKeys, k)
+		}
+	}
+	for _, v := range am.Aliases {
+		if !slices.Contains(a.Aliases, v) {
+			a.Aliases = append(a.Aliases, v)
+		}
+	}
+	for _, op := range am.Subscriptions {
+		if !slices.Contains(a.Subscriptions, op) {
+			a.Subscriptions = append(a.Subscriptions, op)
+		}
+	}
+	for _, cf := range am.ComputedFields {
+		idx := slices.IndexFunc(a.ComputedFields, func(v *ComputedField) bool { return v.Name == cf.Name })
+		if idx == -1 {
+			a.ComputedFields = append(a.ComputedFields, cf)
+		} else {
+			a.ComputedFields[idx] = cf
+		}
+	}
 	if am.Filter != 0 {
 		a.Filter = am.Filter.Add(a.Filter)
 	}
@@ -229,7 +470,39 @@ func (a Annotation) Merge(o schema.Annotation) schema.Annotation { // nolint:goc
 		a.FilterGroup = am.FilterGroup
 	}
 	a.DisableHandler = a.DisableHandler || am.DisableHandler
+	a.SelectForUpdate = a.SelectForUpdate || am.SelectForUpdate
+	a.DryRun = a.DryRun || am.DryRun
+	a.ChangedFields = a.ChangedFields || am.ChangedFields
+	a.History = a.History || am.History
+	a.SoftDelete = a.SoftDelete || am.SoftDelete
+	if am.Attachments != nil {
+		a.Attachments = am.Attachments
+	}
+	if am.Versions != nil {
+		a.Versions = am.Versions
+	}
+	if len(am.OperationVersions) > 0 {
+		if a.OperationVersions == nil {
+			a.OperationVersions = make(map[Operation]*VersionRange)
+		}
+		for k, v := range am.OperationVersions {
+			a.OperationVersions[k] = v
+		}
+	}
 	a.Sortable = a.Sortable || am.Sortable
+	if len(am.FieldToggles) > 0 {
+		if a.FieldToggles == nil {
+			a.FieldToggles = make(map[FieldToggle]bool)
+		}
+		for k, v := range am.FieldToggles {
+			a.FieldToggles[k] = v
+		}
+	}
+	a.ConditionalRequirements = append(a.ConditionalRequirements, am.ConditionalRequirements...)
+	a.CrossValidations = append(a.CrossValidations, am.CrossValidations...)
+	if am.DeduplicationWindow != 0 {
+		a.DeduplicationWindow = am.DeduplicationWindow
+	}
 	if am.DefaultSort != nil {
 		a.DefaultSort = am.DefaultSort
 	}
@@ -314,6 +587,12 @@ func (a *Annotation) GetEagerLoad(config *Config) bool {
 	return *a.EagerLoad
 }
 
+// GetEagerLoadDepth returns the depth to eager-load the edge to, which is always at
+// least 1.
+func (a *Annotation) GetEagerLoadDepth() int {
+	return max(a.EagerLoadDepth, 1)
+}
+
 // GetEagerLoadLimit returns the limit for the max number of entities to eager-load for the
 // edge (or defaults from [Config.EagerLoadLimit]).
 func (a *Annotation) GetEagerLoadLimit(config *Config) int {
@@ -323,6 +602,56 @@ func (a *Annotation) GetEagerLoadLimit(config *Config) int {
 	return *a.EagerLoadLimit
 }
 
+// GetFieldToggle returns the value of the provided toggle of the field (see
+// [WithFieldToggle]), or the provided default if the toggle isn't set.
+func (a *Annotation) GetFieldToggle(toggle FieldToggle, def bool) bool {
+	if v, ok := a.FieldToggles[toggle]; ok {
+		return v
+	}
+	return def
+}
+
+// GetReadable returns true if the field is included in responses (see [FieldReadable]).
+// Hashed fields (see [WithHashed]) are never readable.
+func (a *Annotation) GetReadable() bool {
+	return !a.Hashed && a.GetFieldToggle(FieldReadable, true)
+}
+
+// GetCreatable returns true if the field can be set when creating entities (see
+// [FieldCreatable] and [WithReadOnly]).
+func (a *Annotation) GetCreatable() bool {
+	return !a.ReadOnly && a.GetFieldToggle(FieldCreatable, true)
+}
+
+// GetUpdatable returns true if the field can be set when updating entities (see
+// [FieldUpdatable] and [WithReadOnly]). Note that immutable fields can never be
+// updated, regardless.
+func (a *Annotation) GetUpdatable() bool {
+	return !a.ReadOnly && a.GetFieldToggle(FieldUpdatable, true)
+}
+
+// GetWritable returns true if the field can be set in the request body of the provided
+// operation (create or update).
+func (a *Annotation) GetWritable(op Operation) bool {
+	if op == OperationCreate {
+		return a.GetCreatable()
+	}
+	return a.GetUpdatable()
+}
+
+// GetTreeTraversalDepth returns the max depth which can be requested through the tree
+// traversal endpoints of the edge, or 0 if tree traversal isn't enabled on the edge
+// (see [WithTreeTraversal]).
+func (a *Annotation) GetTreeTraversalDepth() int {
+	if a.TreeTraversal == nil {
+		return 0
+	}
+	if *a.TreeTraversal < 1 {
+		return defaultTreeTraversalDepth
+	}
+	return *a.TreeTraversal
+}
+
 // GetEdgeEndpoint returns if the edge should have an endpoint (or defaults from
 // [Config.DisableEagerLoadedEndpoints]).
 func (a *Annotation) GetEdgeEndpoint(config *Config) bool {
@@ -387,6 +716,40 @@ func (a *Annotation) GetOperationID(op Operation) string {
 	return a.OperationID[op]
 }
 
+// GetSQLModifiers returns the SQL modifiers of the provided operation (see
+// [WithSQLModifiers]), including the row-level lock of the update and delete operations
+// when [WithSelectForUpdate] is enabled, or nil if the operation has no SQL modifiers.
+func (a *Annotation) GetSQLModifiers(op Operation) *SQLModifiers {
+	m := a.SQLModifiers[op]
+	if !a.SelectForUpdate || (op != OperationUpdate && op != OperationDelete) {
+		return m
+	}
+	return (&SQLModifiers{Lock: SQLLockUpdate}).merge(m)
+}
+
+// GetResponseStatus returns the status code of successful responses of the provided
+// operation, through [WithResponseStatus], or the default status code of the operation
+// (e.g. 201 for creates, and 204 for deletes or creates with [CreateResponseNoContent]).
+func (a *Annotation) GetResponseStatus(op Operation) int {
+	if v := a.ResponseStatus[op]; v != 0 {
+		return v
+	}
+	if op == OperationCreate && a.CreateResponse == CreateResponseNoContent {
+		return http.StatusNoContent
+	}
+	return defaultResponseStatuses[op]
+}
+
+// GetOperationMethod returns the HTTP method for the provided operation, through
+// [WithOperationMethod], or the default method of the operation (e.g. "PATCH" for
+// updates).
+func (a *Annotation) GetOperationMethod(op Operation) string {
+	if v := a.OperationMethod[op]; v != "" {
+		return strings.ToUpper(v)
+	}
+	return defaultOperationMethods[op]
+}
+
 // GetDefaultSort returns the default sort field for the schema in the REST API.
 // If one was not previously specified, but the type has an ID field, it will default
 // to "id".
@@ -409,8 +772,11 @@ func (a *Annotation) GetDefaultOrder() SortOrder {
 	return *a.DefaultOrder
 }
 
+// GetSkip returns true if the schema, edge or field is skipped in the REST API. File
+// fields (see [WithFile]) are also skipped, as they are only exposed through their own
+// endpoints.
 func (a *Annotation) GetSkip(config *Config) bool {
-	return a.Skip || len(a.GetOperations(config)) == 0
+	return a.Skip || a.File != nil || len(a.GetOperations(config)) == 0
 }
 
 // WithOperationSummary provides a summary for the specified operation. This should be
@@ -445,6 +811,147 @@ func WithOperationID(op Operation, v string) Annotation {
 	return Annotation{OperationID: map[Operation]string{op: v}}
 }
 
+// WithOperationMethod overrides the HTTP method of the specified operation of the
+// schema, for example, "PUT" rather than "PATCH" for updates, or "POST" rather than
+// "GET" for lists, so sensitive filter values are sent in the request body rather than
+// the URL. The spec, routing and generated handlers are all updated accordingly. See
+// [AllowedOperationMethods] for the methods supported by each operation.
+func WithOperationMethod(op Operation, method string) Annotation {
+	return Annotation{OperationMethod: map[Operation]string{op: method}}
+}
+
+// WithCreateResponse sets the response of the create operation of the schema, for
+// example, responding with only a "Location" header containing the path of the created
+// entity, rather than the created entity itself. Both the spec and the generated
+// handlers are updated accordingly. See [CreateResponse] for the supported responses.
+func WithCreateResponse(v CreateResponse) Annotation {
+	return Annotation{CreateResponse: v}
+}
+
+// WithResponseStatus overrides the status code of successful responses of the specified
+// operation of the schema, for example, 200 rather than 204 for deletes, or 206 rather
+// than 200 for lists. Both the spec and the generated handlers are updated accordingly.
+// Must be a 2xx status code, and operations which respond with a body can't use 204 or
+// 205.
+func WithResponseStatus(op Operation, code int) Annotation {
+	return Annotation{ResponseStatus: map[Operation]int{op: code}}
+}
+
+// WithRequestHeader adds a header parameter to the provided operation of the schema,
+// in addition to [Config.GlobalRequestHeaders]. The generated handlers validate the
+// header (responding with a 400 if a required header is missing, or the header doesn't
+// match the schema), and store the parsed value in the request context, which can be
+// retrieved through the generated "RequestHeader<Name>" functions (e.g.
+// "RequestHeaderXTenantID"). The schema defaults to a string, and must be a string,
+// integer, number or boolean schema. Can be provided multiple times.
+func WithRequestHeader(op Operation, header string, schema *ogen.Schema, required bool) Annotation {
+	return Annotation{RequestHeaders: map[Operation]RequestHeaders{
+		op: {header: {Name: header, In: "header", Schema: schema, Required: required}},
+	}}
+}
+
+// WithSelectForUpdate configures the update and delete handlers of the schema to fetch
+// the row "FOR UPDATE" within the transaction of the mutation, before mutating it. This
+// serializes concurrent mutations of the same entity, preventing lost updates without
+// clients needing to use conditional requests (ETags). Row-level locks aren't added on
+// SQLite, which serializes write transactions instead. Requires the "sql/modifier" ent
+// feature.
+func WithSelectForUpdate(v bool) Annotation {
+	return Annotation{SelectForUpdate: v}
+}
+
+// WithDryRun adds a "dry_run" query parameter to the create, update and delete
+// operations of the schema. Dry runs execute the operation (including validation, hooks
+// and constraint checks) within a transaction which is always rolled back, and respond
+// with the would-be result, e.g. so UIs can pre-validate complex forms. Note that
+// deferred constraints, which are only checked on commit, aren't checked.
+func WithDryRun(v bool) Annotation {
+	return Annotation{DryRun: v}
+}
+
+// WithDeduplication suppresses duplicate create requests of the schema, for clients
+// which don't provide an Idempotency-Key header. Requests which are identical (by the
+// content hash of their method, URL, credentials and body) to a successful request
+// within the provided window aren't executed again, and instead return the original
+// response, e.g. for rapid retries after a timeout. Responses are stored by the
+// DeduplicationStore of the generated server (see ServerConfig.DeduplicationStore),
+// which defaults to an in-memory store.
+func WithDeduplication(window time.Duration) Annotation {
+	return Annotation{DeduplicationWindow: window}
+}
+
+// WithChangedFields adds a "_changed" property to the responses of the update operation
+// of the schema, listing the fields and edges which were actually modified by the update
+// (computed from the ent mutation, compared with the previous values), e.g. for audit
+// UIs or cache invalidation on the client. Note that this requires an additional query
+// to load the previous values of the entity.
+func WithChangedFields(v bool) Annotation {
+	return Annotation{ChangedFields: v}
+}
+
+// WithAttachments adds a "POST /<entities>/{id}/attachments" endpoint to the schema,
+// which accepts the metadata of an attachment (name, media type and size), and responds
+// with a presigned URL which the client uploads the attachment to, directly to the
+// storage backend. Presigned URLs are issued by ServerConfig.AttachmentStorage, which
+// is required when any schema has attachments.
+//
+// Example:
+//
+//	func (Pet) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithAttachments(entrest.AttachmentOptions{
+//				ContentTypes: []string{"image/*", "application/pdf"},
+//				MaxSize:      50 << 20,
+//			}),
+//		}
+//	}
+func WithAttachments(v AttachmentOptions) Annotation {
+	return Annotation{Attachments: &v}
+}
+
+// WithSQLModifiers attaches SQL modifiers to the queries of the provided operation of
+// the schema, through ent query modifiers: a timeout (the queries are canceled, and a
+// 504 is returned, once the timeout is reached), optimizer hints (prefixed to the
+// queries as a hint comment), and a row-level lock (e.g. "FOR UPDATE") of the queries
+// which read the entity within the update and delete operations. Hints and locks
+// require the "sql/modifier" ent feature. Hints are appended, and other values
+// override previous values, when provided multiple times.
+//
+// Example:
+//
+//	func (Pet) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithSQLModifiers(entrest.OperationList, &entrest.SQLModifiers{
+//				Timeout: 5 * time.Second,
+//				Hints:   []string{"IndexScan(pets pets_name_idx)"},
+//			}),
+//			entrest.WithSQLModifiers(entrest.OperationUpdate, &entrest.SQLModifiers{
+//				Lock: entrest.SQLLockUpdate,
+//			}),
+//		}
+//	}
+func WithSQLModifiers(op Operation, m *SQLModifiers) Annotation {
+	return Annotation{SQLModifiers: map[Operation]*SQLModifiers{op: m}}
+}
+
+// WithPathName sets the URL path segment for the schema (e.g. "people" rather than
+// "users"), or the edge (e.g. "best-buddy" rather than "best-friend"), overriding both
+// [Config.PathNameFunc] and [Namer.PathSegment]. All paths of the schema, including
+// edge, tree traversal, and alternate key paths, are updated accordingly. Must be a
+// single path segment.
+func WithPathName(v string) Annotation {
+	return Annotation{PathName: v}
+}
+
+// WithGroup sets the group (domain area) of the schema, e.g. "billing". All paths of
+// the schema are nested under the group (e.g. "/billing/invoices/{id}"), and the tags
+// of the schema are grouped under the group name, through the "x-tagGroups" spec
+// extension (supported by documentation tools like Redoc). Must be a single path
+// segment, and must not conflict with the path segment of another schema.
+func WithGroup(name string) Annotation {
+	return Annotation{Group: name}
+}
+
 // WithDescription sets the description for the schema/edge/field in the REST API. This will
 // otherwise default to the schema/edge/field's description according to Ent (e.g. the
 // comment). It's recommended to use the field comment rather than setting this annotation
@@ -459,17 +966,34 @@ func WithPagination(v bool) Annotation {
 	return Annotation{Pagination: &v}
 }
 
+// WithSeekPagination enables seek (keyset) pagination for the schema, rather than page
+// based pagination, which is intended for large, append-only schemas (e.g. events). List
+// operations returning the schema accept the "after_id" and "before_id" parameters, are
+// always ordered by ID (in the order provided by [WithDefaultOrder]), and don't count the
+// total number of results. Instead, responses only include if there are more results.
+// Requires a single, numeric or string ID field, and no other sortable fields. Only
+// supported with [FlavorDefault].
+func WithSeekPagination() Annotation {
+	return Annotation{SeekPagination: true}
+}
+
 // WithMinItemsPerPage sets an explicit minimum number of items per page for paginated calls.
+// When provided on an edge, it only applies to the edge endpoint, and takes precedence over
+// the value provided on the schema of the edge.
 func WithMinItemsPerPage(v int) Annotation {
 	return Annotation{MinItemsPerPage: v}
 }
 
 // WithMaxItemsPerPage sets an explicit maximum number of items per page for paginated calls.
+// When provided on an edge, it only applies to the edge endpoint, and takes precedence over
+// the value provided on the schema of the edge.
 func WithMaxItemsPerPage(v int) Annotation {
 	return Annotation{MaxItemsPerPage: v}
 }
 
 // WithItemsPerPage sets an explicit default number of items per page for paginated calls.
+// When provided on an edge, it only applies to the edge endpoint, and takes precedence over
+// the value provided on the schema of the edge.
 func WithItemsPerPage(v int) Annotation {
 	return Annotation{ItemsPerPage: v}
 }
@@ -477,7 +1001,8 @@ func WithItemsPerPage(v int) Annotation {
 // WithEagerLoad sets the edge to be eager-loaded in the REST API for each associated
 // entity. Note that edges are not eager-loaded by default. Eager-loading, when enabled,
 // means that the configured edge is always fetched when the parent entity is fetched
-// (only covering the first level, it does not recurse).
+// (only covering the first level, it does not recurse, see [WithEagerLoadDepth] and
+// [WithEagerLoadEdges]).
 func WithEagerLoad(v bool) Annotation {
 	return Annotation{EagerLoad: &v}
 }
@@ -493,6 +1018,34 @@ func WithEagerLoadLimit(v int) Annotation {
 	return Annotation{EagerLoadLimit: &v}
 }
 
+// WithEagerLoadDepth sets the depth to eager-load the edge to, where a depth of 1 (the
+// default) only loads the edge itself (entity -> edge), and a depth of 2 also loads the
+// eager-loaded edges of the edge (entity -> edge -> edge), and so on. Only edges which
+// are themselves eager-loaded are followed. Has no effect if [WithEagerLoadEdges] is
+// also provided.
+func WithEagerLoadDepth(v int) Annotation {
+	return Annotation{EagerLoadDepth: v}
+}
+
+// WithEagerLoadEdges sets the nested edges to eager-load along with the edge, using
+// dot-separated edge paths relative to the edge type. For example, on a "pets" edge,
+// providing "toys" and "toys.manufacturer" will eager-load the pets, their toys, and the
+// manufacturer of each toy, but not any other edges of pets (e.g. "vaccinations"), even
+// if they are eager-loaded by default. Nested edges don't need to be eager-loaded
+// themselves.
+func WithEagerLoadEdges(paths ...string) Annotation {
+	return Annotation{EagerLoadEdges: paths}
+}
+
+// WithEagerLoadFields restricts the fields of the edge type which are loaded and embedded
+// in the parent response when the edge is eager-loaded (e.g. only "name" of an "owner"
+// edge). The ID of the edge type is always included. A dedicated, slimmed, schema is
+// generated for the edge. This also applies to the edge when it's eager-loaded as a
+// nested edge (see [WithEagerLoadDepth] and [WithEagerLoadEdges]).
+func WithEagerLoadFields(fields ...string) Annotation {
+	return Annotation{EagerLoadFields: fields}
+}
+
 // WithEdgeEndpoint sets the edge to have an endpoint. If the edge is eager-loaded,
 // and the global config is set to disable endpoints for edges which are also
 // eager-loaded, this will default to false. Not required to be provided unless
@@ -513,6 +1066,147 @@ func WithEdgeUpdateBulk(v bool) Annotation {
 	return Annotation{EdgeUpdateBulk: v}
 }
 
+// WithParentFields includes the provided fields of the parent entity (the schema the edge
+// belongs to), along with its ID, in the responses of the endpoint of the edge, as the
+// "_parent" property. For example, providing "name" on the "owner" edge of a Pet adds
+// the ID and name of the pet to the response of "/pets/{petID}/owner", which can be used
+// for breadcrumbs without a second request. Only supported on unique and paginated edges.
+func WithParentFields(fields ...string) Annotation {
+	return Annotation{ParentFields: fields}
+}
+
+// WithVersions restricts the schema, field or edge to the provided range of API versions
+// (see [Config.Versions]), where introduced is the first version it's available in (or
+// empty for the first version), and removed is the first version it's no longer available
+// in (or empty if it's available in the latest version). For example, WithVersions("v2", "")
+// on a field only includes it in the spec and responses of "v2" and later. Edges are also
+// unavailable in versions which the schema they point to isn't available in.
+func WithVersions(introduced, removed string) Annotation {
+	return Annotation{Versions: &VersionRange{Introduced: introduced, Removed: removed}}
+}
+
+// WithOperationVersions restricts the provided operation of the schema to the provided
+// range of API versions (see [WithVersions]), e.g. WithOperationVersions(OperationDelete,
+// "", "v2") removes the delete endpoint from "v2" onwards.
+func WithOperationVersions(op Operation, introduced, removed string) Annotation {
+	return Annotation{OperationVersions: map[Operation]*VersionRange{
+		op: {Introduced: introduced, Removed: removed},
+	}}
+}
+
+// WithTreeTraversal enables tree traversal endpoints for a self-referential O2M edge
+// (e.g. the "children" edge, or its inverse "parent" edge, of a Category schema),
+// which generates the following endpoints (using recursive queries):
+//   - GET /categories/{id}/ancestors: returns all ancestors of the entity, from the
+//     parent through to the root.
+//   - GET /categories/{id}/descendants: returns all descendants of the entity,
+//     ordered by their depth relative to the entity.
+//
+// Both endpoints accept a "depth" query parameter, which limits the number of levels
+// to traverse, up to the provided max depth. A max depth of 0 or less defaults to 25.
+func WithTreeTraversal(maxDepth int) Annotation {
+	return Annotation{TreeTraversal: &maxDepth}
+}
+
+// WithClientProvidedID allows (or disallows) clients to provide the ID of new entities
+// of the schema when creating them, overriding [Config.ClientProvidedIDs]. Only schemas
+// with a single, user-defined ID field (e.g. field.String("id"), field.Int("id"),
+// field.UUID("id", ...), or a custom type like a ULID or KSUID) are supported. See
+// [HasClientProvidedID] for more information.
+func WithClientProvidedID(v bool) Annotation {
+	return Annotation{ClientID: &v}
+}
+
+// WithIDFormat declares the representation (format, pattern, example) of the ID of
+// the schema in the OpenAPI spec, overriding [Config.IDFormat]. This is used in ID
+// path parameters, as well as ID properties in request/response schemas, and edges
+// referencing the schema. See [IDFormatULID] and [IDFormatKSUID] for common formats.
+//
+// Example:
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithIDFormat(entrest.IDFormatULID),
+//		}
+//	}
+func WithIDFormat(v IDFormat) Annotation {
+	return Annotation{IDFormat: &v}
+}
+
+// WithAlternateKey adds a lookup endpoint for the schema, which reads a single entity
+// using the provided unique field (an alternate key) rather than the ID, e.g.
+// "GET /posts/by-slug/{slug}". Can be provided multiple times for multiple alternate
+// keys. The field must be unique, and be a string, numeric or UUID field. Requires
+// the read operation to be enabled on the schema.
+//
+// Example:
+//
+//	func (Post) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithAlternateKey("slug"),
+//		}
+//	}
+func WithAlternateKey(field string) Annotation {
+	return Annotation{AlternateKeys: []string{field}}
+}
+
+// WithAliases sets previous names of the field or schema, for backward-compatible
+// renames. The old names of a field are still accepted in create and update request
+// bodies, with a "Warning" response header pointing to the new name. Requests to the
+// old paths of a schema (derived from the old schema names, e.g. "Animal" for
+// "/animals") are permanently redirected (308) to the new paths. Aliases are meant
+// to be removed after a transition period.
+//
+// Example:
+//
+//	func (Pet) Fields() []ent.Field {
+//		return []ent.Field{
+//			field.String("nickname").Annotations(
+//				entrest.WithAliases("name"),
+//			),
+//		}
+//	}
+func WithAliases(aliases ...string) Annotation {
+	return Annotation{Aliases: aliases}
+}
+
+// WithSubscriptions allows clients to subscribe to webhooks for the provided operations
+// (create, update and/or delete) of the schema, e.g. the "pet.created" event. When any
+// schema has subscriptions, "/subscriptions" endpoints are generated to manage webhook
+// subscriptions, and the webhook payloads are documented as callbacks of the create
+// subscription operation. Webhooks are delivered through the WebhookDispatcher of the
+// generated server.
+//
+// Example:
+//
+//	func (Pet) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithSubscriptions(entrest.OperationCreate, entrest.OperationDelete),
+//		}
+//	}
+func WithSubscriptions(ops ...Operation) Annotation {
+	return Annotation{Subscriptions: ops}
+}
+
+// WithComputedField adds a read-only property to the responses of the schema, which is
+// derived from the entity (e.g. "full_name", or an "age" from a birth date) rather than
+// stored. The value is produced by the resolver method of the generated
+// ComputedFieldResolver interface (e.g. "ResolvePetFullName" if resolver is empty), which
+// is provided through the server config. The Go type of the value is derived from the
+// provided schema (string, integer, number and boolean types, otherwise any). Can be
+// provided multiple times for multiple computed fields.
+//
+// Example:
+//
+//	func (User) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithComputedField("full_name", &ogen.Schema{Type: "string"}, ""),
+//		}
+//	}
+func WithComputedField(name string, schema *ogen.Schema, resolver string) Annotation {
+	return Annotation{ComputedFields: []*ComputedField{{Name: name, Schema: schema, Resolver: resolver}}}
+}
+
 // WithFilter sets the field to be filterable with the provided predicate(s). When applied
 // on an edge with [FilterEdge], it will include the fields associated with the edge
 // that are also filterable.
@@ -553,6 +1247,115 @@ func WithSortable(v bool) Annotation {
 	return Annotation{Sortable: v}
 }
 
+// WithFieldToggle enables or disables a single behavior of the field in the REST API
+// (see [FieldToggle]), independently of all other behaviors. For example, a field can
+// be excluded from filters, while still being returned in responses, or be write-only.
+// Toggles which are set take precedence over [WithFilter] and [WithSortable], though
+// read-only fields (see [WithReadOnly]) can never be created or updated. See also
+// [WithFilterable], [WithReadable], [WithCreatable] and [WithUpdatable].
+//
+// Example:
+//
+//	entrest.WithFieldToggle(entrest.FieldFilterable, false) // Not filterable, but still readable.
+//	entrest.WithFieldToggle(entrest.FieldSortable, false)   // E.g. on the ID field.
+func WithFieldToggle(toggle FieldToggle, v bool) Annotation {
+	return Annotation{FieldToggles: map[FieldToggle]bool{toggle: v}}
+}
+
+// WithFilterable sets whether the field can be filtered by (see [FieldFilterable]).
+func WithFilterable(v bool) Annotation {
+	return WithFieldToggle(FieldFilterable, v)
+}
+
+// WithReadable sets whether the field is included in responses (see [FieldReadable]).
+// Fields which aren't readable are write-only.
+func WithReadable(v bool) Annotation {
+	return WithFieldToggle(FieldReadable, v)
+}
+
+// WithCreatable sets whether the field can be set when creating entities (see
+// [FieldCreatable]).
+func WithCreatable(v bool) Annotation {
+	return WithFieldToggle(FieldCreatable, v)
+}
+
+// WithUpdatable sets whether the field can be set when updating entities (see
+// [FieldUpdatable]).
+func WithUpdatable(v bool) Annotation {
+	return WithFieldToggle(FieldUpdatable, v)
+}
+
+// WithCreateOnly sets the field to only be settable when creating entities, e.g. an
+// initial password. It's never included in responses, and can't be updated.
+func WithCreateOnly() Annotation {
+	return Annotation{FieldToggles: map[FieldToggle]bool{
+		FieldReadable:  false,
+		FieldCreatable: true,
+		FieldUpdatable: false,
+	}}
+}
+
+// WithUpdateOnly sets the field to only be settable when updating entities, e.g. a
+// password reset token. It's never included in responses, and can't be set when
+// creating entities.
+func WithUpdateOnly() Annotation {
+	return Annotation{FieldToggles: map[FieldToggle]bool{
+		FieldReadable:  false,
+		FieldCreatable: false,
+		FieldUpdatable: true,
+	}}
+}
+
+// WithConditionalRequirement requires the provided optional fields in create requests,
+// only when another (enum or bool) field of the schema is provided with one of the
+// provided values, e.g. a "license_number" which is only required for pets of type
+// "DOG". The requirement is expressed as a "oneOf" in the create schema, and enforced
+// before the entity is created, returning a 422 if it isn't met. Can be provided
+// multiple times for multiple requirements.
+//
+// Example:
+//
+//	func (Pet) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithConditionalRequirement(entrest.ConditionalRequirement{
+//				Fields: []string{"license_number"},
+//				When:   "type",
+//				Values: []any{"DOG"},
+//			}),
+//		}
+//	}
+func WithConditionalRequirement(v ConditionalRequirement) Annotation {
+	return Annotation{ConditionalRequirements: []*ConditionalRequirement{&v}}
+}
+
+// WithCrossValidation registers a request-level validation rule of the schema, which
+// spans multiple fields (e.g. "start_date must be before end_date"). The rule is enforced
+// by the provided validator, a method of the generated CrossValidator interface (see
+// ServerConfig.CrossValidator), which is invoked with the create or update params once
+// the request is bound, before it's executed. Errors are returned as a 422, and the
+// description is appended to the description of the create and update operations.
+// Validators can be shared by multiple schemas, and the annotation can be provided
+// multiple times for multiple rules.
+//
+// Example:
+//
+//	func (Event) Annotations() []schema.Annotation {
+//		return []schema.Annotation{
+//			entrest.WithCrossValidation("start_date must be before end_date", "ValidateEventDates"),
+//		}
+//	}
+func WithCrossValidation(description, validator string) Annotation {
+	return Annotation{CrossValidations: []*CrossValidation{{Description: description, Validator: validator}}}
+}
+
+// WithHashed sets the string field to be hashed (e.g. a password) by the Hasher of the
+// generated server (see ServerConfig.Hasher), before it's persisted when creating or
+// updating entities, so the raw value never reaches ent. Hashed fields are write-only,
+// i.e. they're never included in responses, and can't be filtered or sorted by.
+func WithHashed(v bool) Annotation {
+	return Annotation{Hashed: v}
+}
+
 // WithDefaultSort sets the default sort field for the schema in the REST API. If not specified,
 // will default to the "id" field (if it exists on the schema/edge). The provided field must exist
 // on the schema, otherwise codegen will fail. You may provide any of the typical fields shown for
@@ -581,8 +1384,12 @@ func WithSkip(v bool) Annotation {
 	return Annotation{Skip: v}
 }
 
-// WithReadOnly sets the field to be read-only in the REST API. If you want to make
-// a schema or edge read-only, use the Operations annotation instead.
+// WithReadOnly sets the field to be read-only in the REST API. When used on a schema,
+// the schema is treated as a read-only projection (e.g. a reporting table), the same
+// as ent views: only read and list operations are generated, no create/update schemas
+// are generated, and the read schema uses a "View" suffix (e.g. "PetStatsView") instead
+// of "Read". See [IsReadOnly] for more information. If you want to make an edge
+// read-only, use the Operations annotation instead.
 func WithReadOnly(v bool) Annotation {
 	return Annotation{ReadOnly: v}
 }
@@ -599,6 +1406,16 @@ func WithDeprecated(v bool) Annotation {
 	return Annotation{Deprecated: v}
 }
 
+// WithDeprecation marks the schema or edge (and its operations) as deprecated (see
+// [WithDeprecated]) since the provided date, and to be removed at the provided sunset
+// date. Responses of the deprecated operations include the "Deprecation" (RFC 9745) and
+// "Sunset" (RFC 8594) headers, and requests to them can be recorded through the
+// DeprecatedUsage option of the generated ServerConfig (e.g. using the generated
+// DeprecatedUsageCounter), to know when it's safe to remove them.
+func WithDeprecation(since, sunset time.Time) Annotation {
+	return Annotation{Deprecated: true, Deprecation: &Deprecation{Since: since, Sunset: sunset}}
+}
+
 // WithSchema sets the OpenAPI schema for a field. This is required for any fields which
 // are JSON based, or don't have a pre-defined ent type for the field.
 //
@@ -607,6 +1424,95 @@ func WithSchema(v *ogen.Schema) Annotation {
 	return Annotation{Schema: v}
 }
 
+// WithEnumDescriptions sets the descriptions of the values of an enum field, keyed by
+// the enum value (e.g. "USER"). Descriptions are included in the description of the
+// field in the OpenAPI spec, as a list of all enum values.
+func WithEnumDescriptions(v map[string]string) Annotation {
+	return Annotation{EnumDescriptions: v}
+}
+
+// WithDeprecatedEnumValues marks specific values of an enum field as deprecated. As
+// OpenAPI doesn't support deprecating individual enum values, deprecated values are
+// marked as such in the description of the field. Deprecated values are still
+// accepted by the REST API.
+func WithDeprecatedEnumValues(v ...string) Annotation {
+	return Annotation{DeprecatedEnumValues: v}
+}
+
+// WithIntEnum declares the integer values of an integer-backed enum field (a
+// field.Enum with a GoType whose underlying type is an integer, which encoding/json
+// marshals as a number), keyed by the enum value (e.g. "LOW"). The field is exposed
+// as an integer enum in the OpenAPI spec, and only the provided integers are accepted
+// in query parameters.
+//
+// Integer-backed enums without this annotation are exposed as strings, which requires
+// the type to implement [encoding.TextMarshaler] and [encoding.TextUnmarshaler].
+func WithIntEnum(v map[string]int) Annotation {
+	return Annotation{IntEnumValues: v}
+}
+
+// WithTimeFormat sets the format of a time field in request and response bodies, and
+// in filter query parameters, overriding [Config.TimeFormat]. The OpenAPI type, format
+// and example of the field are adjusted accordingly (e.g. an int64 for
+// [TimeFormatUnixMilli]). See [TimeFormat] for the supported formats.
+func WithTimeFormat(v TimeFormat) Annotation {
+	return Annotation{TimeFormat: v}
+}
+
+// WithNumericFormat sets the format of a high-precision numeric field in request and
+// response bodies, and in filter query parameters, overriding [Config.NumericFormat].
+// Fields with a GoType of *big.Int or decimal.Decimal have a numeric format by default,
+// but any GoType which implements [encoding.TextMarshaler] and
+// [encoding.TextUnmarshaler] (with decimal numbers as text) is supported. The OpenAPI
+// type, format and example of the field are adjusted accordingly. See [NumericFormat]
+// for the supported options.
+func WithNumericFormat(v NumericFormat) Annotation {
+	return Annotation{NumericFormat: &v}
+}
+
+// WithMoney pairs the numeric (amount) field with the provided string or enum field
+// (the ISO 4217 currency code, e.g. "USD") of the same schema, which are exposed as a
+// single money object (e.g. {"amount": "12.50", "currency": "USD"}) in request and
+// response bodies, under the name of the amount field. The currency field is no longer
+// exposed on its own, and both fields must be provided together in create and update
+// requests. Filtering and sorting (if enabled) are still done on the amount field.
+func WithMoney(currencyField string) Annotation {
+	return Annotation{MoneyCurrencyField: currencyField}
+}
+
+// WithGeo exposes the field as a GeoJSON geometry (e.g. {"type": "Point", "coordinates":
+// [-122.4194, 37.7749]}) of the provided type in request and response bodies, and
+// optionally adds bounding box and radius filters to list operations, which are
+// compiled to the spatial functions of the database (PostGIS, MySQL or SpatiaLite).
+// The Go type of the field must be encoded as the GeoJSON coordinates (e.g. orb.Point
+// or [2]float64 for points), and its column type must be a spatial type (e.g.
+// "geometry(Point, 4326)"). See [GeoOptions] for the supported options.
+func WithGeo(v GeoOptions) Annotation {
+	return Annotation{Geo: &v}
+}
+
+// WithTranslations marks the JSON field (a map of locales to translations, e.g.
+// map[string]string{"en": "Hello", "fr": "Bonjour"}) as translated. Responses include
+// the translation for the locale preferred by the client (through the Accept-Language
+// header, falling back to less specific locales, e.g. "fr-CA" to "fr", and then to
+// [TranslationOptions.Fallback]), rather than all translations, unless the
+// "translations=true" query parameter is provided. Create and update requests always
+// accept all translations.
+func WithTranslations(v TranslationOptions) Annotation {
+	return Annotation{Translations: &v}
+}
+
+// WithFile exposes a bytes field as a file, which is excluded from JSON request and
+// response bodies (including the JSON encoding of the generated ent entity), and is
+// instead downloaded through "GET /<schema>/{id}/<field>" (with the stored media type),
+// uploaded through "PUT /<schema>/{id}/<field>" (as the "file" part of a
+// multipart/form-data request), and cleared through "DELETE /<schema>/{id}/<field>"
+// (optional fields only). See [FileOptions] for storing the media type and name of
+// uploaded files in companion fields.
+func WithFile(v FileOptions) Annotation {
+	return Annotation{File: &v}
+}
+
 // WithIncludeOperations includes the specified operations in the REST API for the
 // schema. If empty, all operations are generated (unless globally disabled).
 func WithIncludeOperations(v ...Operation) Annotation {
@@ -59,6 +59,32 @@ func TestGetAnnotation(t *testing.T) {
 	)
 }
 
+func TestCacheAnnotations(t *testing.T) {
+	t.Parallel()
+
+	raw := map[string]any{"Sortable": true}
+	loaded := gen.Annotations{Annotation{}.Name(): raw}
+	f := &gen.Field{Annotations: loaded}
+	g := &gen.Graph{Nodes: []*gen.Type{{Name: "Pet", Fields: []*gen.Field{f}}}}
+
+	cacheAnnotations(g)
+
+	// The loaded annotations are replaced, not modified.
+	assert.IsType(t, &Annotation{}, f.Annotations[Annotation{}.Name()])
+	assert.Equal(t, raw, loaded[Annotation{}.Name()])
+
+	a1 := GetAnnotation(f)
+	a1.Sortable = false
+
+	a2 := GetAnnotation(f)
+	assert.NotSame(t, a1, a2)
+	assert.True(t, a2.Sortable)
+
+	// Replaced annotations are picked up.
+	f.Annotations = withAnnotation(f.Annotations, func(a *Annotation) { a.Sortable = false })
+	assert.False(t, GetAnnotation(f).Sortable)
+}
+
 func TestValidateAnnotation(t *testing.T) {
 	tests := []struct {
 		name    string
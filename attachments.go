@@ -0,0 +1,258 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// DefaultAttachmentURLExpiry is the default duration for which presigned attachment
+// upload URLs are valid (see [AttachmentOptions.URLExpiry]).
+const DefaultAttachmentURLExpiry = 15 * time.Minute
+
+// AttachmentOptions configures the attachments of a schema (see [WithAttachments]).
+type AttachmentOptions struct {
+	// ContentTypes is an optional list of media types which are accepted for
+	// attachments, which may contain wildcard subtypes (e.g. "image/*"). Any media type
+	// is accepted if empty.
+	ContentTypes []string `json:",omitempty"`
+
+	// MaxSize is the maximum size (in bytes) of attachments. Defaults to
+	// [DefaultFileMaxSize].
+	MaxSize int64 `json:",omitempty"`
+
+	// URLExpiry is the duration for which presigned upload URLs are valid, which is
+	// provided to the storage backend. Defaults to [DefaultAttachmentURLExpiry].
+	URLExpiry time.Duration `json:",omitempty"`
+}
+
+// GetMaxSize returns the maximum size (in bytes) of attachments.
+func (o *AttachmentOptions) GetMaxSize() int64 {
+	if o.MaxSize > 0 {
+		return o.MaxSize
+	}
+	return DefaultFileMaxSize
+}
+
+// GetURLExpiry returns the duration for which presigned upload URLs are valid.
+func (o *AttachmentOptions) GetURLExpiry() time.Duration {
+	if o.URLExpiry > 0 {
+		return o.URLExpiry
+	}
+	return DefaultAttachmentURLExpiry
+}
+
+// hasAttachments returns true if the provided type has attachments (see
+// [WithAttachments]).
+func hasAttachments(t *gen.Type) bool {
+	ta := GetAnnotation(t)
+	return ta.Attachments != nil && t.ID != nil && !ta.GetSkip(GetConfig(t.Config))
+}
+
+// hasAnyAttachments returns true if any of the provided types have attachments (see
+// [WithAttachments]).
+func hasAnyAttachments(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, hasAttachments)
+}
+
+// GetAttachmentPathName returns the path of the attachment upload endpoint of the
+// provided type, e.g. "/pets/{id}/attachments". useUniqueID determines if the ID path
+// parameter should be "{id}" or "{type|camel}ID".
+func GetAttachmentPathName(t *gen.Type, useUniqueID bool) string {
+	return GetPathName(OperationRead, t, nil, useUniqueID) + "/attachments"
+}
+
+// GetAttachmentOperationID returns the operation ID of the attachment upload endpoint
+// of the provided type, e.g. "createPetAttachment".
+func GetAttachmentOperationID(t *gen.Type) string {
+	return "create" + GetSchemaName(t) + "Attachment"
+}
+
+// addAttachmentSchemas adds the shared attachment metadata schemas to the provided
+// spec.
+func addAttachmentSchemas(spec *ogen.Spec) {
+	metadata := func() ogen.Properties {
+		return ogen.Properties{
+			{Name: "name", Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "The original name of the file.",
+				MinLength:   ptr(uint64(1)),
+				MaxLength:   ptr(uint64(255)),
+				Example:     ogen.ExampleValue(`"report.pdf"`),
+			}},
+			{Name: "content_type", Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "The media type of the file.",
+				Example:     ogen.ExampleValue(`"application/pdf"`),
+			}},
+			{Name: "size", Schema: &ogen.Schema{
+				Type:        "integer",
+				Format:      "int64",
+				Description: "The size of the file, in bytes.",
+				Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
+			}},
+		}
+	}
+
+	spec.Components.Schemas["AttachmentCreate"] = &ogen.Schema{
+		Type:        "object",
+		Description: "The metadata of an attachment which is about to be uploaded.",
+		Properties:  metadata(),
+		Required:    []string{"name", "content_type", "size"},
+	}
+
+	spec.Components.Schemas["Attachment"] = &ogen.Schema{
+		Type:        "object",
+		Description: "The metadata of an attachment.",
+		Properties: append(ogen.Properties{
+			{Name: "key", Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "The key of the attachment in the storage backend.",
+			}},
+		}, metadata()...),
+		Required: []string{"key", "name", "content_type", "size"},
+	}
+
+	spec.Components.Schemas["AttachmentUpload"] = &ogen.Schema{
+		Description: "A presigned URL, which the attachment can be uploaded to directly.",
+		AllOf: []*ogen.Schema{
+			{Ref: "#/components/schemas/Attachment"},
+			{
+				Type: "object",
+				Properties: ogen.Properties{
+					{Name: "method", Schema: &ogen.Schema{
+						Type:        "string",
+						Description: "The HTTP method of the upload request.",
+						Example:     ogen.ExampleValue(`"PUT"`),
+					}},
+					{Name: "url", Schema: &ogen.Schema{
+						Type:        "string",
+						Format:      "uri",
+						Description: "The presigned URL which the file must be uploaded to.",
+					}},
+					{Name: "headers", Schema: &ogen.Schema{
+						Type:                 "object",
+						Description:          "The headers which must be included in the upload request.",
+						AdditionalProperties: &ogen.AdditionalProperties{Schema: ogen.Schema{Type: "string"}},
+					}},
+					{Name: "expires_at", Schema: &ogen.Schema{
+						Type:        "string",
+						Format:      "date-time",
+						Description: "When the presigned URL expires.",
+					}},
+				},
+				Required: []string{"method", "url", "expires_at"},
+			},
+		},
+	}
+}
+
+// GetSpecAttachments generates an independent spec for the attachment upload endpoint
+// of the provided type (see [WithAttachments]).
+func GetSpecAttachments(t *gen.Type) (*ogen.Spec, error) {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	opts := ta.Attachments
+	entityName := GetSchemaName(t)
+
+	spec := newBaseSpec(cfg)
+	spec.Tags = append(spec.Tags, ogen.Tag{Name: Pluralize(t.Name), Description: ta.Description})
+
+	if err := addIDParameters(spec, t); err != nil {
+		return nil, err
+	}
+	addAttachmentSchemas(spec)
+
+	description := fmt.Sprintf(
+		"Request a presigned URL to upload an attachment of a %s, directly to the storage backend. Attachments may be up to %d bytes",
+		CamelCase(entityName),
+		opts.GetMaxSize(),
+	)
+	if len(opts.ContentTypes) > 0 {
+		description += ", with one of the following media types: " + strings.Join(opts.ContentTypes, ", ")
+	}
+
+	oper := &ogen.Operation{
+		Tags:        []string{Pluralize(t.Name)},
+		Summary:     fmt.Sprintf("Upload an attachment of a %s", CamelCase(entityName)),
+		Description: description + ".",
+		OperationID: GetAttachmentOperationID(t),
+		Deprecated:  ta.Deprecated,
+		RequestBody: &ogen.RequestBody{
+			Required: true,
+			Content: map[string]ogen.Media{
+				"application/json": {Schema: &ogen.Schema{Ref: "#/components/schemas/AttachmentCreate"}},
+			},
+		},
+		Responses: ogen.Responses{
+			strconv.Itoa(http.StatusOK): ogen.NewResponse().
+				SetDescription("The presigned URL, which the attachment can be uploaded to.").
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/AttachmentUpload"}),
+			strconv.Itoa(http.StatusRequestEntityTooLarge): ogen.NewResponse().
+				SetDescription("The attachment is too large.").
+				SetJSONContent(ErrorResponseObject(http.StatusRequestEntityTooLarge)),
+			strconv.Itoa(http.StatusUnsupportedMediaType): ogen.NewResponse().
+				SetDescription("The media type of the attachment isn't supported.").
+				SetJSONContent(ErrorResponseObject(http.StatusUnsupportedMediaType)),
+		},
+	}
+	addMaintenanceResponse(cfg, oper)
+
+	spec.Paths[GetAttachmentPathName(t, true)] = &ogen.PathItem{
+		Parameters: getIDParameterRefs(t),
+		Post:       oper,
+	}
+	return spec, nil
+}
+
+// validateAttachments checks that a schema with attachments (see [WithAttachments]) has
+// a single ID field, valid options, and that the upload endpoint doesn't conflict with
+// the path of any edge or file field.
+func validateAttachments(cfg *Config, t *gen.Type, ta *Annotation) (errs []error) {
+	if ta.Attachments == nil || ta.GetSkip(cfg) {
+		return nil
+	}
+
+	if t.ID == nil {
+		return []error{errors.New("attachments are only supported on schemas with a single ID field")}
+	}
+
+	if ta.Attachments.MaxSize < 0 {
+		errs = append(errs, fmt.Errorf("invalid max attachment size %d", ta.Attachments.MaxSize))
+	}
+
+	if ta.Attachments.URLExpiry < 0 {
+		errs = append(errs, fmt.Errorf("invalid attachment URL expiry %s", ta.Attachments.URLExpiry))
+	}
+
+	for _, v := range ta.Attachments.ContentTypes {
+		if _, _, err := mime.ParseMediaType(v); err != nil {
+			errs = append(errs, fmt.Errorf("invalid attachment content type %q: %w", v, err))
+		}
+	}
+
+	for _, e := range t.Edges {
+		if GetPathSegment(t, e) == "attachments" {
+			errs = append(errs, fmt.Errorf("attachments endpoint path conflicts with the path of edge %q", e.Name))
+		}
+	}
+
+	for _, f := range GetFileFields(t) {
+		if KebabCase(f.Name) == "attachments" {
+			errs = append(errs, fmt.Errorf("attachments endpoint path conflicts with the path of file field %q", f.Name))
+		}
+	}
+	return errs
+}
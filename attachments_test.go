@@ -0,0 +1,69 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_Attachments(t *testing.T) {
+	t.Parallel()
+
+	t.Run("upload", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithAttachments(AttachmentOptions{
+					ContentTypes: []string{"image/*"},
+					MaxSize:      1 << 20,
+				}))
+				return nil
+			},
+		})
+
+		prefix := `$.paths./pets/{petID}/attachments.post`
+
+		assert.Equal(t, "createPetAttachment", r.json(prefix+`.operationId`))
+		assert.Equal(t, "#/components/schemas/AttachmentCreate", r.json(prefix+`.requestBody.content['application/json'].schema.$ref`))
+		assert.Equal(t, "#/components/schemas/AttachmentUpload", r.json(prefix+`.responses.200.content['application/json'].schema.$ref`))
+		assert.NotNil(t, r.json(prefix+`.responses.413`))
+		assert.NotNil(t, r.json(prefix+`.responses.415`))
+		assert.Contains(t, r.json(prefix+`.description`), "1048576 bytes")
+		assert.Contains(t, r.json(prefix+`.description`), "image/*")
+
+		assert.Equal(t, []any{"name", "content_type", "size"}, r.json(`$.components.schemas.AttachmentCreate.required`))
+		assert.Contains(t, r.json(`$.components.schemas.Attachment.required`), "key")
+		assert.Equal(t, "#/components/schemas/Attachment", r.json(`$.components.schemas.AttachmentUpload.allOf[0].$ref`))
+
+		assert.Nil(t, r.json(`$.paths./users/{userID}/attachments`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			opts     AttachmentOptions
+			contains string
+		}{
+			{AttachmentOptions{MaxSize: -1}, "invalid max attachment size -1"},
+			{AttachmentOptions{URLExpiry: -1}, "invalid attachment URL expiry"},
+			{AttachmentOptions{ContentTypes: []string{"image/"}}, `invalid attachment content type "image/"`},
+		} {
+			_, err := buildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, "Pet", WithAttachments(tt.opts))
+					return ValidateAnnotations(g.Nodes...)
+				},
+			})
+			require.ErrorContains(t, err, tt.contains)
+		}
+	})
+}
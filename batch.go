@@ -0,0 +1,183 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// defaultBatchPath is the default path of the batch endpoint (see [Config.Batch]).
+const defaultBatchPath = "/batch"
+
+// batchMethods are the methods of requests which can be included in a batch.
+var batchMethods = []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete}
+
+// Batch enables the batch endpoint of the generated server (see [Config.Batch]), which
+// executes multiple requests against the generated handlers in a single round trip.
+type Batch struct {
+	// Path is the path of the batch endpoint. Defaults to "/batch".
+	Path string `json:",omitempty"`
+
+	// MaxRequests is the maximum number of requests which can be included in a single
+	// batch. Defaults to 20.
+	MaxRequests int `json:",omitempty"`
+}
+
+// validate validates the batch endpoint config, and applies defaults.
+func (b *Batch) validate() error {
+	if b.Path == "" {
+		b.Path = defaultBatchPath
+	}
+
+	if !strings.HasPrefix(b.Path, "/") || strings.Count(b.Path, "/") != 1 || validatePathName(b.Path[1:]) != nil {
+		return fmt.Errorf("Batch.Path %q must be a single path segment, e.g. %q", b.Path, defaultBatchPath)
+	}
+
+	if b.MaxRequests < 0 {
+		return errors.New("Batch.MaxRequests must be >= 0")
+	}
+
+	if b.MaxRequests == 0 {
+		b.MaxRequests = 20
+	}
+	return nil
+}
+
+// GetSpecBatch returns the spec for the batch endpoint (see [Config.Batch]), or nil if
+// it isn't enabled.
+func GetSpecBatch(cfg *Config) *ogen.Spec {
+	if cfg.Batch == nil {
+		return nil
+	}
+
+	return &ogen.Spec{
+		Paths: ogen.Paths{
+			cfg.Batch.Path: &ogen.PathItem{
+				Post: &ogen.Operation{
+					Tags:    []string{"Batch"},
+					Summary: "Execute a batch of requests",
+					Description: "Execute multiple requests against the API in a single round trip, in order. " +
+						"Each request is handled as if it was sent on its own (with the headers of the batch " +
+						"request), and has its own response. If transactional, all requests are executed " +
+						"within a single transaction, which is rolled back if any request fails.",
+					OperationID: "batch",
+					RequestBody: ogen.NewRequestBody().
+						SetRequired(true).
+						SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/BatchRequest"}),
+					Responses: ogen.Responses{
+						strconv.Itoa(http.StatusOK): ogen.NewResponse().
+							SetDescription("The responses of the requests, in the same order.").
+							SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/BatchResponse"}),
+					},
+				},
+			},
+		},
+		Components: &ogen.Components{
+			Schemas: map[string]*ogen.Schema{
+				"BatchRequest": {
+					Type: "object",
+					Properties: []ogen.Property{
+						{Name: "requests", Schema: &ogen.Schema{
+							Type:        "array",
+							Description: "The requests to execute, in order.",
+							Items:       &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/BatchRequestItem"}},
+							MinItems:    ptr(uint64(1)),
+							MaxItems:    ptr(uint64(cfg.Batch.MaxRequests)), //nolint:gosec
+						}},
+						{Name: "transactional", Schema: &ogen.Schema{
+							Type: "boolean",
+							Description: "Execute all requests within a single transaction. Execution stops at the " +
+								"first failed request, and the transaction is rolled back, in which case all other " +
+								"requests respond with a 424 \"Failed Dependency\".",
+						}},
+					},
+					Required: []string{"requests"},
+				},
+				"BatchRequestItem": {
+					Type: "object",
+					Properties: []ogen.Property{
+						{Name: "id", Schema: &ogen.Schema{Type: "string", Description: "An optional ID of the request, which is included in its response."}},
+						{Name: "method", Schema: &ogen.Schema{Type: "string", Enum: sliceToRawMessage(batchMethods)}},
+						{Name: "path", Schema: &ogen.Schema{
+							Type:        "string",
+							Description: "The path of the request, including the query string (e.g. \"/pets?page=2\").",
+							Pattern:     "^/",
+						}},
+						{Name: "headers", Schema: batchHeadersSchema("Headers of the request, which override those of the batch request.")},
+						{Name: "body", Schema: &ogen.Schema{Description: "The JSON body of the request."}},
+					},
+					Required: []string{"method", "path"},
+				},
+				"BatchResponse": {
+					Type: "object",
+					Properties: []ogen.Property{
+						{Name: "responses", Schema: &ogen.Schema{
+							Type:  "array",
+							Items: &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/BatchResponseItem"}},
+						}},
+					},
+					Required: []string{"responses"},
+				},
+				"BatchResponseItem": {
+					Type: "object",
+					Properties: []ogen.Property{
+						{Name: "id", Schema: &ogen.Schema{Type: "string", Description: "The ID of the request, if provided."}},
+						{Name: "status", Schema: &ogen.Schema{Type: "integer", Description: "The HTTP status code of the response."}},
+						{Name: "headers", Schema: batchHeadersSchema("Headers of the response.")},
+						{Name: "body", Schema: &ogen.Schema{
+							Description: "The body of the response, if any. JSON bodies are included as-is, and other bodies as a string.",
+						}},
+					},
+					Required: []string{"status"},
+				},
+			},
+		},
+		Tags: []ogen.Tag{
+			{
+				Name:        "Batch",
+				Description: "Execute multiple requests in a single round trip.",
+			},
+		},
+	}
+}
+
+// batchHeadersSchema returns the schema of the headers of a request or response within
+// a batch, with the provided description.
+func batchHeadersSchema(description string) *ogen.Schema {
+	return &ogen.Schema{
+		Type:                 "object",
+		Description:          description,
+		AdditionalProperties: &ogen.AdditionalProperties{Schema: ogen.Schema{Type: "string"}},
+	}
+}
+
+// validateBatchPath checks that no schema uses the path of the batch endpoint, if it's
+// enabled (see [Config.Batch]).
+func validateBatchPath(cfg *Config, nodes []*gen.Type) (errs []error) {
+	if cfg.Batch == nil {
+		return nil
+	}
+
+	for _, t := range nodes {
+		if GetAnnotation(t).GetSkip(cfg) {
+			continue
+		}
+
+		if GetPathName(OperationList, t, nil, false) == cfg.Batch.Path {
+			errs = append(errs, &AnnotationError{
+				Schema: t.Name,
+				Err:    fmt.Errorf("path %q conflicts with the batch endpoint", cfg.Batch.Path),
+			})
+		}
+	}
+	return errs
+}
@@ -0,0 +1,61 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_Batch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{Handler: HandlerStdlib, Batch: &Batch{}})
+
+		assert.Equal(t, "batch", r.json(`$.paths./batch.post.operationId`))
+		assert.Equal(t, "#/components/schemas/BatchResponse", r.json(`$.paths./batch.post.responses.200.content['application/json'].schema.$ref`))
+		assert.InDelta(t, 20, r.json(`$.components.schemas.BatchRequest.properties.requests.maxItems`), 0)
+		assert.Equal(t, []any{"GET", "POST", "PUT", "PATCH", "DELETE"}, r.json(`$.components.schemas.BatchRequestItem.properties.method.enum`))
+		assert.Equal(t, "string", r.json(`$.components.schemas.BatchResponseItem.properties.headers.additionalProperties.type`))
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{Handler: HandlerStdlib, Batch: &Batch{Path: "/bulk", MaxRequests: 5}})
+
+		assert.Nil(t, r.json(`$.paths./batch`))
+		assert.Equal(t, "batch", r.json(`$.paths./bulk.post.operationId`))
+		assert.InDelta(t, 5, r.json(`$.components.schemas.BatchRequest.properties.requests.maxItems`), 0)
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Nil(t, r.json(`$.paths./batch`))
+		assert.Nil(t, r.json(`$.components.schemas.BatchRequest`))
+	})
+
+	t.Run("no-handler", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{Handler: HandlerNone, Batch: &Batch{}})
+
+		assert.Nil(t, r.json(`$.paths./batch`))
+	})
+
+	t.Run("invalid-path", func(t *testing.T) {
+		t.Parallel()
+
+		err := (&Config{Batch: &Batch{Path: "/a/b"}}).Validate()
+		assert.ErrorContains(t, err, "must be a single path segment")
+	})
+}
@@ -0,0 +1,165 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// benchmarkSeed describes how entities of a type are seeded by the generated
+// benchmarks (see [Config.WithBenchmarks]), using its example create payload (see
+// [GetExamplePayload]).
+type benchmarkSeed struct {
+	// Skip is the reason entities of the type can't be seeded, if any.
+	Skip string
+	// Omit are the properties which are removed from the example create payload, i.e.
+	// optional edges, as the example IDs don't reference existing entities.
+	Omit []string
+	// Edges are the required edges, which are seeded first.
+	Edges []*benchmarkSeedEdge
+	// Unique are the unique fields, which are made unique for each seeded entity.
+	Unique []*benchmarkSeedField
+	// UpdateOmit are the properties which are removed from the example update
+	// payload, i.e. all edges, as the example IDs don't reference existing entities.
+	UpdateOmit []string
+}
+
+// benchmarkSeedEdge is a required edge which is seeded before the entity itself.
+type benchmarkSeedEdge struct {
+	// Key is the property of the edge within the create payload.
+	Key string
+	// Type is the type the edge points to.
+	Type *gen.Type
+	// Unique is true if the edge accepts a single ID, rather than a list of IDs.
+	Unique bool
+	// Numeric is true if the ID of the edge type is numeric.
+	Numeric bool
+}
+
+// benchmarkSeedField is a unique field which is made unique for each seeded entity.
+type benchmarkSeedField struct {
+	// Key is the property of the field within the create payload.
+	Key string
+	// Numeric is true if the field is numeric (rather than a string).
+	Numeric bool
+}
+
+// getBenchmarkSeed returns how entities of the provided type are seeded by the
+// generated benchmarks.
+func getBenchmarkSeed(t *gen.Type) *benchmarkSeed {
+	return resolveBenchmarkSeed(t, map[string]bool{})
+}
+
+// resolveBenchmarkSeed is the underlying implementation of [getBenchmarkSeed], which
+// tracks the types which are already being resolved, to prevent cycles of required
+// edges.
+func resolveBenchmarkSeed(t *gen.Type, resolving map[string]bool) *benchmarkSeed { //nolint:gocyclo,cyclop
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	seed := &benchmarkSeed{}
+
+	switch {
+	case ta.GetSkip(cfg) || ta.DisableHandler:
+		seed.Skip = "schema is skipped"
+	case t.ID == nil || t.HasCompositeID():
+		seed.Skip = "schema doesn't have a single ID field"
+	case !ta.HasOperation(cfg, OperationCreate):
+		seed.Skip = "schema doesn't have a create operation"
+	case ta.CreateResponse == CreateResponseNoContent:
+		seed.Skip = "create operation doesn't return the ID of created entities"
+	case resolving[t.Name]:
+		seed.Skip = "schema has a cycle of required edges"
+	}
+
+	if seed.Skip != "" {
+		return seed
+	}
+
+	resolving[t.Name] = true
+	defer delete(resolving, t.Name)
+
+	if HasClientProvidedID(t) && (t.ID.IsString() || t.ID.Type.Numeric()) {
+		seed.Unique = append(seed.Unique, &benchmarkSeedField{Key: "id", Numeric: t.ID.Type.Numeric()})
+	}
+
+	for _, f := range t.Fields {
+		if f.IsEdgeField() {
+			continue
+		}
+
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || !fa.GetCreatable() {
+			if !f.Optional && !f.Default {
+				seed.Skip = fmt.Sprintf("required field %q can't be provided when creating", f.Name)
+				return seed
+			}
+			continue
+		}
+
+		if f.Unique && (f.IsString() || f.Type.Numeric()) {
+			seed.Unique = append(seed.Unique, &benchmarkSeedField{Key: GetFieldName(t, f), Numeric: f.Type.Numeric()})
+		}
+	}
+
+	for _, e := range t.Edges {
+		if GetAnnotation(e).GetSkip(cfg) || e.Type.ID == nil {
+			if !e.Optional {
+				seed.Skip = fmt.Sprintf("required edge %q can't be provided when creating", e.Name)
+				return seed
+			}
+			continue
+		}
+
+		key := GetEdgeName(t, e, "")
+		if f := e.Field(); f != nil {
+			key = GetFieldName(t, f)
+		}
+
+		seed.UpdateOmit = append(seed.UpdateOmit, key, GetEdgeName(t, e, "add"), GetEdgeName(t, e, "remove"))
+
+		if e.Optional {
+			seed.Omit = append(seed.Omit, key)
+			continue
+		}
+
+		if dep := resolveBenchmarkSeed(e.Type, resolving); dep.Skip != "" {
+			seed.Skip = fmt.Sprintf("required edge %q can't be seeded: %s", e.Name, dep.Skip)
+			return seed
+		}
+
+		seed.Edges = append(seed.Edges, &benchmarkSeedEdge{
+			Key:     key,
+			Type:    e.Type,
+			Unique:  e.Unique,
+			Numeric: e.Type.ID.Type.Numeric(),
+		})
+	}
+
+	return seed
+}
+
+// getBenchmarkOperations returns the operations of the provided type for which
+// benchmarks are generated. Only list benchmarks are generated for types which can't
+// be seeded (see [getBenchmarkSeed]).
+func getBenchmarkOperations(t *gen.Type) []Operation {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	if ta.GetSkip(cfg) || ta.DisableHandler {
+		return nil
+	}
+
+	seedable := getBenchmarkSeed(t).Skip == ""
+
+	var ops []Operation
+	for _, op := range []Operation{OperationList, OperationCreate, OperationRead, OperationUpdate, OperationDelete} {
+		if ta.HasOperation(cfg, op) && (op == OperationList || seedable) {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
@@ -0,0 +1,91 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// findType returns the type with the provided name from the graph.
+func findType(t *testing.T, g *gen.Graph, name string) *gen.Type {
+	t.Helper()
+
+	for _, n := range g.Nodes {
+		if n.Name == name {
+			return n
+		}
+	}
+
+	t.Fatalf("failed to find type %q", name)
+	return nil
+}
+
+func TestGetBenchmarkSeed(t *testing.T) {
+	t.Parallel()
+
+	t.Run("optional-edges", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		seed := getBenchmarkSeed(findType(t, r.graph, "Pet"))
+
+		assert.Empty(t, seed.Skip)
+		assert.Empty(t, seed.Edges)
+		assert.ElementsMatch(t, []string{"categories", "owner", "friends", "best_friend", "followed_by"}, seed.Omit)
+		assert.Contains(t, seed.UpdateOmit, "add_friends")
+		assert.Contains(t, seed.UpdateOmit, "remove_friends")
+	})
+
+	t.Run("required-edges", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		seed := getBenchmarkSeed(findType(t, r.graph, "Friendship"))
+
+		assert.Empty(t, seed.Skip)
+		require.Len(t, seed.Edges, 2)
+		assert.Equal(t, "user_id", seed.Edges[0].Key)
+		assert.Equal(t, "User", seed.Edges[0].Type.Name)
+		assert.True(t, seed.Edges[0].Unique)
+		assert.True(t, seed.Edges[0].Numeric)
+		assert.Equal(t, "friend_id", seed.Edges[1].Key)
+	})
+
+	t.Run("required-field-not-creatable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		injectAnnotations(t, r.graph, "Pet.name", WithReadOnly(true))
+
+		seed := getBenchmarkSeed(findType(t, r.graph, "Pet"))
+		assert.Equal(t, `required field "name" can't be provided when creating`, seed.Skip)
+		assert.Equal(t, []Operation{OperationList}, getBenchmarkOperations(findType(t, r.graph, "Pet")))
+	})
+
+	t.Run("required-edge-not-seedable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		injectAnnotations(t, r.graph, "User", WithExcludeOperations(OperationCreate))
+
+		seed := getBenchmarkSeed(findType(t, r.graph, "Friendship"))
+		assert.Equal(t, `required edge "user" can't be seeded: schema doesn't have a create operation`, seed.Skip)
+	})
+
+	t.Run("operations", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		assert.Equal(
+			t,
+			[]Operation{OperationList, OperationCreate, OperationRead, OperationUpdate, OperationDelete},
+			getBenchmarkOperations(findType(t, r.graph, "Pet")),
+		)
+	})
+}
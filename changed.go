@@ -0,0 +1,134 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// changedProperty is the name of the property of update responses which lists the
+// fields which were modified by the update (see [WithChangedFields]).
+const changedProperty = "_changed"
+
+// hasChangedFields returns true if the update responses of the provided type include
+// the fields which were modified (see [WithChangedFields]). The previous values can't be
+// loaded by ent for entities with a composite ID, so they aren't supported.
+func hasChangedFields(t *gen.Type) bool {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	return ta.ChangedFields &&
+		!ta.GetSkip(cfg) &&
+		!IsReadOnly(t) &&
+		t.ID != nil &&
+		ta.HasOperation(cfg, OperationUpdate)
+}
+
+// hasAnyChangedFields returns true if the update responses of any of the provided types
+// include the fields which were modified (see [WithChangedFields]).
+func hasAnyChangedFields(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, hasChangedFields)
+}
+
+// getChangedFieldNames returns the names of the fields and edges of the provided type
+// which are reported when modified (see [WithChangedFields]), as they're known by the
+// ent mutation, mapped to their names in the API. Edges with a field which is exposed
+// are reported through the field, and the currency fields of money objects (see
+// [WithMoney]) through their amount field.
+func getChangedFieldNames(t *gen.Type) map[string]string {
+	cfg := GetConfig(t.Config)
+	names := map[string]string{}
+
+	for _, f := range t.Fields {
+		if GetAnnotation(f).GetSkip(cfg) {
+			continue
+		}
+		names[f.Name] = GetFieldName(t, f)
+	}
+
+	// Currency fields of money objects are reported through their amount field.
+	for _, f := range GetMoneyFields(t) {
+		names[GetMoneyCurrencyField(t, f).Name] = GetFieldName(t, f)
+	}
+
+	for _, e := range t.Edges {
+		if GetAnnotation(e).GetSkip(cfg) || (e.Field() != nil && !GetAnnotation(e.Field()).GetSkip(cfg)) {
+			continue
+		}
+		names[e.Name] = GetEdgeName(t, e, "")
+	}
+	return names
+}
+
+// wrapChangedFields wraps the provided handler (Go expression) of the provided
+// operation, so the fields modified by updates are collected, if the schema reports
+// them (see [WithChangedFields]).
+func wrapChangedFields(t *gen.Type, op Operation, handler string) string {
+	if op != OperationUpdate || !hasChangedFields(t) {
+		return handler
+	}
+	return fmt.Sprintf("withChangedFields(%s)", handler)
+}
+
+// addChangedFields adds the fields which were modified (see [WithChangedFields]) to the
+// successful response of the provided update operation, as the "_changed" property.
+func addChangedFields(spec *ogen.Spec, t *gen.Type, op Operation, oper *ogen.Operation) error {
+	if oper == nil || op != OperationUpdate || !hasChangedFields(t) {
+		return nil
+	}
+
+	code := strconv.Itoa(http.StatusOK)
+	resp := oper.Responses[code]
+	if resp == nil || resp.Content["application/json"].Schema == nil {
+		return errors.New("update operation has no JSON response")
+	}
+
+	name := GetSchemaName(t) + "WithChanged"
+	spec.Components.Schemas[name] = &ogen.Schema{
+		Description: resp.Description,
+		AllOf: []*ogen.Schema{
+			resp.Content["application/json"].Schema,
+			{
+				Type: "object",
+				Properties: ogen.Properties{
+					{
+						Name: changedProperty,
+						Schema: &ogen.Schema{
+							Type:        "array",
+							Description: "The fields and edges which were modified by the update, sorted by name.",
+							Items:       &ogen.Items{Item: &ogen.Schema{Type: "string"}},
+						},
+					},
+				},
+				Required: []string{changedProperty},
+			},
+		},
+	}
+
+	oper.Responses[code] = ogen.NewResponse().
+		SetDescription(resp.Description).
+		SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + name})
+	return nil
+}
+
+// validateChangedFields checks that a schema which reports modified fields (see
+// [WithChangedFields]) has an ID, and an update operation.
+func validateChangedFields(cfg *Config, t *gen.Type, ta *Annotation) []error {
+	if !ta.ChangedFields || ta.GetSkip(cfg) {
+		return nil
+	}
+
+	if !hasChangedFields(t) {
+		return []error{errors.New("changed fields are only supported on schemas with an ID and an update operation")}
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_ChangedFields(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithChangedFields(true))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "#/components/schemas/PetWithChanged", r.json(`$.paths./pets/{petID}.patch.responses.200.content['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/PetRead", r.json(`$.components.schemas.PetWithChanged.allOf[0].$ref`))
+	assert.Equal(t, "array", r.json(`$.components.schemas.PetWithChanged.allOf[1].properties._changed.type`))
+	assert.Equal(t, "_changed", r.json(`$.components.schemas.PetWithChanged.allOf[1].required[0]`))
+
+	assert.Equal(t, "#/components/schemas/PetRead", r.json(`$.paths./pets/{petID}.get.responses.200.content['application/json'].schema.$ref`))
+	assert.Equal(t, "#/components/schemas/UserRead", r.json(`$.paths./users/{userID}.patch.responses.200.content['application/json'].schema.$ref`))
+	assert.Nil(t, r.json(`$.components.schemas.UserWithChanged`))
+}
@@ -0,0 +1,179 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ogen-go/ogen"
+)
+
+const (
+	// changelogManifestFile is the file (within "<ent>/rest") which contains the manifest
+	// of the previous generation (see [Config.Changelog]).
+	changelogManifestFile = "manifest.generated.json"
+
+	// changelogMarkdownHeader is the header of the markdown changelog, which entries are
+	// added below.
+	changelogMarkdownHeader = "# API Changelog\n\n"
+)
+
+// ChangelogEntry is a single entry of the generated changelog (see [Config.Changelog]).
+type ChangelogEntry struct {
+	// Version is the version of the spec (info.version) the entry was generated for.
+	Version string `json:"version"`
+
+	// Date is the date (YYYY-MM-DD, UTC) the entry was generated on.
+	Date string `json:"date"`
+
+	// Changes are the differences compared to the previous generation.
+	Changes *SpecDiff `json:"changes"`
+}
+
+// Markdown returns the markdown version of the entry.
+func (e *ChangelogEntry) Markdown() string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "## %s (%s)\n", e.Version, e.Date)
+
+	section := func(title string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		fmt.Fprintf(&sb, "\n### %s\n\n", title)
+		for _, v := range values {
+			fmt.Fprintf(&sb, "- `%s`\n", v)
+		}
+	}
+
+	section("Added endpoints", e.Changes.AddedRoutes)
+	section("Removed endpoints", e.Changes.RemovedRoutes)
+	section("Changed endpoints", e.Changes.ChangedRoutes)
+	section("Added schemas", e.Changes.AddedSchemas)
+	section("Removed schemas", e.Changes.RemovedSchemas)
+	section("Added fields", e.Changes.AddedFields)
+	section("Removed fields", e.Changes.RemovedFields)
+	section("Changed fields", e.Changes.ChangedFields)
+	return sb.String()
+}
+
+// readChangelogManifest reads the manifest of the previous generation from the provided
+// directory, returning nil if there is none.
+func readChangelogManifest(dir string) (*SpecManifest, error) {
+	b, err := os.ReadFile(filepath.Join(dir, changelogManifestFile))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil //nolint:nilnil
+		}
+		return nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+
+	m := &SpecManifest{}
+	if err = json.Unmarshal(b, m); err != nil {
+		return nil, fmt.Errorf("failed to decode manifest: %w", err)
+	}
+	return m, nil
+}
+
+// writeChangelog compares the manifest of the provided spec with the manifest of the
+// previous generation (if any) within the provided directory, adding an entry to the top
+// of the changelog (in the provided format) if there are any differences, and replaces
+// the manifest.
+func writeChangelog(dir string, spec *ogen.Spec, format ChangelogFormat, now time.Time) error {
+	if format == ChangelogNone {
+		return nil
+	}
+
+	previous, err := readChangelogManifest(dir)
+	if err != nil {
+		return err
+	}
+
+	manifest := NewSpecManifest(spec)
+
+	if previous != nil {
+		diff := DiffManifests(previous, manifest)
+		if !diff.IsEmpty() {
+			entry := &ChangelogEntry{
+				Version: spec.Info.Version,
+				Date:    now.UTC().Format(time.DateOnly),
+				Changes: diff,
+			}
+
+			switch format {
+			case ChangelogMarkdown:
+				err = addChangelogMarkdown(filepath.Join(dir, "CHANGELOG.generated.md"), entry)
+			case ChangelogJSON:
+				err = addChangelogJSON(filepath.Join(dir, "changelog.generated.json"), entry)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	b, err := json.MarshalIndent(manifest, "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal manifest: %w", err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, changelogManifestFile), append(b, '\n'), 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to write manifest: %w", err)
+	}
+	return nil
+}
+
+// addChangelogMarkdown adds the provided entry to the top of the markdown changelog.
+func addChangelogMarkdown(fn string, entry *ChangelogEntry) error {
+	b, err := os.ReadFile(fn)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to read changelog: %w", err)
+	}
+
+	previous := strings.TrimPrefix(string(b), changelogMarkdownHeader)
+	if previous != "" {
+		previous = "\n" + previous
+	}
+
+	err = os.WriteFile(fn, []byte(changelogMarkdownHeader+entry.Markdown()+previous), 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to write changelog: %w", err)
+	}
+	return nil
+}
+
+// addChangelogJSON adds the provided entry to the top of the JSON changelog, which is an
+// array of [ChangelogEntry].
+func addChangelogJSON(fn string, entry *ChangelogEntry) error {
+	var entries []*ChangelogEntry
+
+	b, err := os.ReadFile(fn)
+	switch {
+	case err == nil:
+		if err = json.Unmarshal(b, &entries); err != nil {
+			return fmt.Errorf("failed to decode changelog: %w", err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to read changelog: %w", err)
+	}
+
+	b, err = json.MarshalIndent(append([]*ChangelogEntry{entry}, entries...), "", "    ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal changelog: %w", err)
+	}
+
+	err = os.WriteFile(fn, append(b, '\n'), 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to write changelog: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteChangelog(t *testing.T) {
+	t.Parallel()
+
+	oldSpec := mustBuildSpec(t, &Config{}).spec
+	newSpec := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithExcludeOperations(OperationDelete))
+			injectAnnotations(t, g, "Pet.age", WithSkip(true))
+			injectAnnotations(t, g, "Pet.name", WithSchema(&ogen.Schema{Type: "string", MaxLength: ptr(uint64(10))}))
+			return nil
+		},
+	}).spec
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("markdown", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		fn := filepath.Join(dir, "CHANGELOG.generated.md")
+
+		// The first generation only writes the manifest.
+		require.NoError(t, writeChangelog(dir, oldSpec, ChangelogMarkdown, now))
+		assert.FileExists(t, filepath.Join(dir, changelogManifestFile))
+		assert.NoFileExists(t, fn)
+
+		require.NoError(t, writeChangelog(dir, newSpec, ChangelogMarkdown, now))
+
+		b, err := os.ReadFile(fn)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), "# API Changelog\n\n## 1.0.0 (2024-05-01)\n")
+		assert.Contains(t, string(b), "### Removed endpoints\n\n- `DELETE /pets/{petID}`\n")
+		assert.Contains(t, string(b), "- `Pet.age`")
+		assert.Contains(t, string(b), "### Changed fields\n\n- `Pet.name`\n")
+
+		// No changes, so no entry.
+		require.NoError(t, writeChangelog(dir, newSpec, ChangelogMarkdown, now))
+
+		b2, err := os.ReadFile(fn)
+		require.NoError(t, err)
+		assert.Equal(t, string(b), string(b2))
+
+		// New entries are added to the top.
+		require.NoError(t, writeChangelog(dir, oldSpec, ChangelogMarkdown, now.AddDate(0, 0, 1)))
+
+		b3, err := os.ReadFile(fn)
+		require.NoError(t, err)
+		assert.Contains(t, string(b3), "# API Changelog\n\n## 1.0.0 (2024-05-02)\n")
+		assert.Contains(t, string(b3), "\n## 1.0.0 (2024-05-01)\n")
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+
+		require.NoError(t, writeChangelog(dir, oldSpec, ChangelogJSON, now))
+		require.NoError(t, writeChangelog(dir, newSpec, ChangelogJSON, now))
+		require.NoError(t, writeChangelog(dir, oldSpec, ChangelogJSON, now.AddDate(0, 0, 1)))
+
+		b, err := os.ReadFile(filepath.Join(dir, "changelog.generated.json"))
+		require.NoError(t, err)
+
+		var entries []*ChangelogEntry
+		require.NoError(t, json.Unmarshal(b, &entries))
+		require.Len(t, entries, 2)
+		assert.Equal(t, "2024-05-02", entries[0].Date)
+		assert.Contains(t, entries[0].Changes.AddedRoutes, "DELETE /pets/{petID}")
+		assert.Equal(t, "2024-05-01", entries[1].Date)
+		assert.Contains(t, entries[1].Changes.RemovedRoutes, "DELETE /pets/{petID}")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewExtension(&Config{Changelog: "foo"})
+		require.ErrorContains(t, err, "unsupported changelog format")
+	})
+}
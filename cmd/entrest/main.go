@@ -0,0 +1,202 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+// Command entrest is a small companion CLI for entrest, which can run generation
+// standalone (without a custom entc.go file), print the resolved configuration,
+// list all generated routes/operations, and validate annotations.
+//
+// Usage:
+//
+//	entrest generate [flags] <schema-path>
+//	entrest generate -dry-run [flags] <schema-path>
+//	entrest config   [flags]
+//	entrest routes   [flags] <schema-path>
+//	entrest validate [flags] <schema-path>
+//
+// The configuration is read from a JSON file (see -config), using the same field
+// names as [entrest.Config].
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"text/tabwriter"
+
+	"entgo.io/ent/entc"
+	"entgo.io/ent/entc/gen"
+	"github.com/lrstanley/entrest"
+	"github.com/ogen-go/ogen"
+)
+
+const usage = `usage: entrest <command> [flags] [schema-path]
+
+commands:
+  generate   run code generation (ent + entrest) for the provided schema path
+  config     print the resolved entrest configuration as JSON
+  routes     list all generated routes/operations for the provided schema path
+  validate   validate all entrest annotations for the provided schema path
+
+run "entrest <command> -h" for command-specific flags.
+`
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "entrest: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, w io.Writer) error {
+	if len(args) == 0 {
+		fmt.Fprint(os.Stderr, usage)
+		return errors.New("no command provided")
+	}
+
+	cmd, args := args[0], args[1:]
+
+	fs := flag.NewFlagSet("entrest "+cmd, flag.ContinueOnError)
+	configPath := fs.String("config", "", "path to a JSON file containing the entrest configuration")
+	target := fs.String("target", "", "target directory for generated code (defaults to the parent of the schema path)")
+	pkg := fs.String("package", "", "Go package path of the target directory (defaults to the schema package parent)")
+	dryRun := fs.Bool("dry-run", false, "print a plan of the API changes instead of writing any files (generate only)")
+
+	switch cmd {
+	case "generate", "config", "routes", "validate":
+	case "help", "-h", "--help":
+		fmt.Fprint(w, usage)
+		return nil
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		return fmt.Errorf("unknown command %q", cmd)
+	}
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	cfg, err := loadConfig(*configPath)
+	if err != nil {
+		return err
+	}
+
+	if cmd == "config" {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "    ")
+		return enc.Encode(cfg)
+	}
+
+	if fs.NArg() != 1 {
+		return fmt.Errorf("%s: expected exactly one schema path argument", cmd)
+	}
+	schemaPath := fs.Arg(0)
+
+	if *dryRun {
+		cfg.DryRun = true
+	}
+	if cfg.DryRun && cfg.DryRunWriter == nil {
+		cfg.DryRunWriter = w
+	}
+
+	ext, err := entrest.NewExtension(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create extension: %w", err)
+	}
+
+	if cmd == "generate" {
+		return entc.Generate(schemaPath, &gen.Config{Target: *target, Package: *pkg}, entc.Extensions(ext))
+	}
+
+	g, err := loadGraph(schemaPath, ext, *target, *pkg)
+	if err != nil {
+		return err
+	}
+
+	switch cmd {
+	case "validate":
+		if err = entrest.ValidateAnnotations(g.Nodes...); err != nil {
+			return err
+		}
+		fmt.Fprintln(w, "ok")
+		return nil
+	case "routes":
+		var spec *ogen.Spec
+		spec, err = ext.Generate(g)
+		if err != nil {
+			return err
+		}
+		return printRoutes(w, spec)
+	}
+	return nil
+}
+
+// loadConfig loads the entrest configuration from the provided JSON file. If no
+// path is provided, the default configuration is used.
+func loadConfig(path string) (*entrest.Config, error) {
+	cfg := &entrest.Config{}
+
+	if path != "" {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read config: %w", err)
+		}
+
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+
+		if err = dec.Decode(cfg); err != nil {
+			return nil, fmt.Errorf("failed to decode config %q: %w", path, err)
+		}
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config: %w", err)
+	}
+	return cfg, nil
+}
+
+// loadGraph loads the ent graph for the provided schema path, with the entrest
+// annotations attached (which [entc.LoadGraph] doesn't do by itself).
+func loadGraph(schemaPath string, ext *entrest.Extension, target, pkg string) (*gen.Graph, error) {
+	gc := &gen.Config{Target: target, Package: pkg, Annotations: gen.Annotations{}}
+
+	for _, a := range ext.Annotations() {
+		gc.Annotations[a.Name()] = a
+	}
+
+	g, err := entc.LoadGraph(schemaPath, gc)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load schema graph: %w", err)
+	}
+	return g, nil
+}
+
+// printRoutes prints all routes/operations of the spec, sorted by path and method,
+// as a table.
+func printRoutes(w io.Writer, spec *ogen.Spec) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "METHOD\tPATH\tOPERATION\tSUMMARY")
+
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	for _, path := range paths {
+		entrest.PatchOperations(spec.Paths[path], func(method string, op *ogen.Operation) *ogen.Operation {
+			if op == nil {
+				return nil
+			}
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", method, path, op.OperationID, op.Summary)
+			return op
+		})
+	}
+	return tw.Flush()
+}
@@ -0,0 +1,116 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSchemaPath = "../../testdata/schema"
+
+// writeTestConfig writes the provided JSON configuration to a temporary file, and
+// returns its path.
+func writeTestConfig(t *testing.T, config string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "entrest.json")
+	require.NoError(t, os.WriteFile(path, []byte(config), 0o600))
+	return path
+}
+
+func TestRun_Flags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		args     []string
+		config   string
+		contains string
+	}{
+		{name: "no-command", args: nil, contains: "no command provided"},
+		{name: "unknown-command", args: []string{"foo"}, contains: `unknown command "foo"`},
+		{name: "unknown-flag", args: []string{"routes", "-foo"}, contains: "flag provided but not defined: -foo"},
+		{name: "missing-schema-path", args: []string{"validate"}, contains: "expected exactly one schema path argument"},
+		{name: "extra-schema-path", args: []string{"routes", "foo", "bar"}, contains: "expected exactly one schema path argument"},
+		{name: "missing-config", args: []string{"config", "-config", "does-not-exist.json"}, contains: "failed to read config"},
+		{name: "unknown-config-field", args: []string{"config"}, config: `{"Foo": true}`, contains: `unknown field "Foo"`},
+		{name: "invalid-config", args: []string{"config"}, config: `{"Handler": "foo"}`, contains: "invalid config"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			args := tt.args
+			if tt.config != "" {
+				args = append(args, "-config", writeTestConfig(t, tt.config))
+			}
+
+			err := run(args, &bytes.Buffer{})
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestRun_Help(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, run([]string{"help"}, buf))
+	assert.Contains(t, buf.String(), "usage: entrest <command>")
+}
+
+func TestRun_Config(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, run([]string{"config", "-config", writeTestConfig(t, `{"Handler": "chi", "Concurrency": 4}`)}, buf))
+
+	var cfg map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &cfg))
+	assert.Equal(t, "chi", cfg["Handler"])
+	assert.InDelta(t, 4, cfg["Concurrency"], 0)
+}
+
+func TestRun_Validate(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, run([]string{"validate", testSchemaPath}, buf))
+	assert.Equal(t, "ok\n", buf.String())
+}
+
+func TestRun_Routes(t *testing.T) {
+	t.Parallel()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, run([]string{"routes", testSchemaPath}, buf))
+	assert.Regexp(t, `(?m)^METHOD\s+PATH\s+OPERATION\s+SUMMARY$`, buf.String())
+	assert.Regexp(t, `(?m)^GET\s+/pets\s+listPets\s`, buf.String())
+	assert.Regexp(t, `(?m)^POST\s+/pets\s+createPet\s`, buf.String())
+}
+
+func TestRun_DryRun(t *testing.T) {
+	t.Parallel()
+
+	target := t.TempDir()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, run([]string{"generate", "-dry-run", "-target", target, testSchemaPath}, buf))
+	assert.Contains(t, buf.String(), "Routes added:")
+	assert.Contains(t, buf.String(), "+ GET /pets")
+
+	entries, err := os.ReadDir(target)
+	require.NoError(t, err)
+	assert.Empty(t, entries, "dry run should not write any files")
+}
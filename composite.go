@@ -0,0 +1,100 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// HasItemID returns true if entities of the provided type can be individually
+// addressed, either through a single ID field, or through a composite ID (see
+// [GetCompositeIDFields]). Only types with an item ID support read, update and
+// delete operations.
+func HasItemID(t *gen.Type) bool {
+	return t.ID != nil || t.HasCompositeID()
+}
+
+// GetCompositeIDFields returns the fields which make up the composite ID of the
+// provided type (e.g. an edge schema with field.ID("user_id", "pet_id")), or nil if
+// the type doesn't have a composite ID. Each field is provided as a separate path
+// parameter of item endpoints, e.g. "/follows/{user_id}/{pet_id}".
+func GetCompositeIDFields(t *gen.Type) []*gen.Field {
+	if !t.HasCompositeID() {
+		return nil
+	}
+	return t.EdgeSchema.ID
+}
+
+// getCompositeIDPath returns the path segments of the composite ID of the provided
+// type, e.g. "{user_id}/{pet_id}".
+func getCompositeIDPath(t *gen.Type) string {
+	segments := make([]string, 0, len(t.EdgeSchema.ID))
+	for _, f := range GetCompositeIDFields(t) {
+		segments = append(segments, "{"+GetFieldName(t, f)+"}")
+	}
+	return strings.Join(segments, "/")
+}
+
+// getCompositeIDComponentName returns the name of the parameter component for the
+// provided composite ID field, e.g. "FollowUserID".
+func getCompositeIDComponentName(t *gen.Type, f *gen.Field) string {
+	return GetSchemaName(t) + PascalCase(f.Name)
+}
+
+// addIDParameters adds the ID path parameter(s) of the provided type to the provided
+// spec. Types with a composite ID get one parameter per field of the ID.
+func addIDParameters(spec *ogen.Spec, t *gen.Type) error {
+	entityName := GetSchemaName(t)
+
+	if !t.HasCompositeID() {
+		idSchema, err := GetSchemaID(t)
+		if err != nil {
+			return err
+		}
+
+		spec.Components.Parameters[entityName+"ID"] = &ogen.Parameter{
+			Name:        GetParamName(t),
+			In:          "path",
+			Description: fmt.Sprintf("The ID of the %s to act upon.", entityName),
+			Required:    true,
+			Schema:      idSchema,
+		}
+		return nil
+	}
+
+	for _, f := range GetCompositeIDFields(t) {
+		fieldSchema, err := GetSchemaField(f)
+		if err != nil {
+			return err
+		}
+
+		spec.Components.Parameters[getCompositeIDComponentName(t, f)] = &ogen.Parameter{
+			Name:        GetFieldName(t, f),
+			In:          "path",
+			Description: fmt.Sprintf("The %s of the %s to act upon (part of its composite ID).", GetFieldName(t, f), entityName),
+			Required:    true,
+			Schema:      fieldSchema,
+		}
+	}
+	return nil
+}
+
+// getIDParameterRefs returns references to the ID path parameter(s) of the provided
+// type (see [addIDParameters]).
+func getIDParameterRefs(t *gen.Type) []*ogen.Parameter {
+	if !t.HasCompositeID() {
+		return []*ogen.Parameter{{Ref: "#/components/parameters/" + GetSchemaName(t) + "ID"}}
+	}
+
+	refs := make([]*ogen.Parameter, 0, len(t.EdgeSchema.ID))
+	for _, f := range GetCompositeIDFields(t) {
+		refs = append(refs, &ogen.Parameter{Ref: "#/components/parameters/" + getCompositeIDComponentName(t, f)})
+	}
+	return refs
+}
@@ -0,0 +1,52 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_CompositeID(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Equal(t, "getFollow", r.json(`$.paths./follows/{user_id}/{pet_id}.get.operationId`))
+		assert.Equal(t, "updateFollow", r.json(`$.paths./follows/{user_id}/{pet_id}.patch.operationId`))
+		assert.Equal(t, "deleteFollow", r.json(`$.paths./follows/{user_id}/{pet_id}.delete.operationId`))
+
+		assert.Equal(t, "user_id", r.json(`$.components.parameters.FollowUserID.name`))
+		assert.Equal(t, "path", r.json(`$.components.parameters.FollowUserID.in`))
+		assert.Equal(t, "integer", r.json(`$.components.parameters.FollowUserID.schema.type`))
+		assert.Equal(t, "pet_id", r.json(`$.components.parameters.FollowPetID.name`))
+
+		params := r.json(`$.paths./follows/{user_id}/{pet_id}.parameters[*].$ref`)
+		assert.Contains(t, params, "#/components/parameters/FollowUserID")
+		assert.Contains(t, params, "#/components/parameters/FollowPetID")
+		assert.Nil(t, r.json(`$.components.parameters.FollowID`))
+	})
+
+	t.Run("custom-namer", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{Namer: testNamer{}})
+
+		assert.NotNil(t, r.json(`$.paths./follows/{userID}/{petID}.get`))
+		assert.Equal(t, "userID", r.json(`$.components.parameters.ApiFollowUserID.name`))
+	})
+
+	t.Run("excluded-operations", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{DefaultOperations: []Operation{OperationList, OperationCreate}})
+
+		assert.Nil(t, r.json(`$.paths./follows/{user_id}/{pet_id}`))
+	})
+}
@@ -0,0 +1,237 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+// ComputedField is a read-only property of the responses of a schema, which isn't
+// stored, but derived from the entity by a resolver (see [WithComputedField]).
+type ComputedField struct {
+	// Name is the name of the property (snake_case), e.g. "full_name".
+	Name string
+
+	// Schema is the schema of the property.
+	Schema *ogen.Schema
+
+	// Resolver is the name of the method of the generated ComputedFieldResolver
+	// interface which resolves the value. Defaults to "Resolve<Schema><Name>", e.g.
+	// "ResolveUserFullName".
+	Resolver string `json:",omitempty"`
+}
+
+var (
+	// computedFieldNameRegex matches valid names of computed fields.
+	computedFieldNameRegex = regexp.MustCompile(`^[a-z][a-z0-9]*(_[a-z0-9]+)*$`)
+
+	// computedFieldResolverRegex matches valid (exported) resolver method names.
+	computedFieldResolverRegex = regexp.MustCompile(`^[A-Z][A-Za-z0-9_]*$`)
+)
+
+// GetComputedFields returns the computed fields of the provided type (see
+// [WithComputedField]), with their resolver names defaulted.
+func GetComputedFields(t *gen.Type) []*ComputedField {
+	fields := GetAnnotation(t).ComputedFields
+	for _, cf := range fields {
+		if cf.Resolver == "" {
+			cf.Resolver = "Resolve" + t.Name + PascalCase(cf.Name)
+		}
+	}
+	return fields
+}
+
+// getComputedFieldName returns the JSON property name of the provided computed field,
+// which belongs to the provided type. See [Config.FieldNameStyle].
+func getComputedFieldName(t *gen.Type, cf *ComputedField) string {
+	return getFieldNameStyle(t).Format(cf.Name)
+}
+
+// getComputedFieldStructField returns the name of the struct field of the provided
+// computed field, on the generated ent entity, e.g. "FullName".
+func getComputedFieldStructField(cf *ComputedField) string {
+	return PascalCase(cf.Name)
+}
+
+// getComputedFieldType returns the Go type of the provided computed field, derived
+// from its schema. Nullable primitive types are pointers, and any other types are
+// "any".
+func getComputedFieldType(cf *ComputedField) string {
+	var typ string
+
+	switch cf.Schema.Type {
+	case "string":
+		typ = "string"
+		if cf.Schema.Format == "date-time" {
+			typ = "time.Time"
+		}
+	case "integer":
+		typ = "int"
+		if cf.Schema.Format == "int32" || cf.Schema.Format == "int64" {
+			typ = cf.Schema.Format
+		}
+	case "number":
+		typ = "float64"
+		if cf.Schema.Format == "float" {
+			typ = "float32"
+		}
+	case "boolean":
+		typ = "bool"
+	default:
+		return "any"
+	}
+
+	if cf.Schema.Nullable {
+		return "*" + typ
+	}
+	return typ
+}
+
+// getComputedFieldTypes returns the types which have computed fields, or which can
+// include types with computed fields in their responses through eager-loaded edges.
+func getComputedFieldTypes(cfg *Config, nodes []*gen.Type) (types []*gen.Type) {
+	for _, t := range nodes {
+		if len(GetComputedFields(t)) > 0 && !GetAnnotation(t).GetSkip(cfg) {
+			types = append(types, t)
+		}
+	}
+
+	for changed := len(types) > 0; changed; {
+		changed = false
+
+		for _, t := range nodes {
+			if slices.Contains(types, t) || GetAnnotation(t).GetSkip(cfg) {
+				continue
+			}
+
+			for _, node := range GetEagerLoadEdges(t) {
+				if slices.Contains(types, node.Edge.Type) {
+					types = append(types, t)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(types, func(a, b *gen.Type) int {
+		return slices.Index(nodes, a) - slices.Index(nodes, b)
+	})
+	return types
+}
+
+// getComputedFieldEdges returns the eager-loaded edges of the provided type, which can
+// include types with computed fields.
+func getComputedFieldEdges(cfg *Config, nodes []*gen.Type, t *gen.Type) (edges []*gen.Edge) {
+	types := getComputedFieldTypes(cfg, nodes)
+
+	for _, node := range GetEagerLoadEdges(t) {
+		if slices.Contains(types, node.Edge.Type) {
+			edges = append(edges, node.Edge)
+		}
+	}
+	return edges
+}
+
+// addComputedFieldProperties adds the properties of all computed fields of the provided
+// type to the provided (read) schema. [ogen.Schema] doesn't support "readOnly", so it's
+// provided as an extension, which is encoded by [MarshalSpec].
+func addComputedFieldProperties(t *gen.Type, schema *ogen.Schema) {
+	for _, cf := range GetComputedFields(t) {
+		prop := *cf.Schema
+		prop.Common.Extensions = maps.Clone(prop.Common.Extensions)
+		if prop.Common.Extensions == nil {
+			prop.Common.Extensions = ogen.Extensions{}
+		}
+		prop.Common.Extensions["readOnly"] = yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"}
+
+		name := getComputedFieldName(t, cf)
+		schema.Properties = append(schema.Properties, *prop.ToProperty(name))
+		schema.Required = append(schema.Required, name)
+	}
+}
+
+// computedFieldReservedNames are struct field names which conflict with fields or
+// methods of generated ent entities.
+var computedFieldReservedNames = []string{"ID", "Edges", "Update", "Unwrap", "String", "Value", "MarshalJSON"}
+
+// validateComputedFields checks that computed fields of a schema (see
+// [WithComputedField]) have valid names and schemas, which don't conflict with fields,
+// edges or other computed fields of the schema. Skipped schemas are validated as well,
+// as the struct fields are always added to the generated ent entities.
+func validateComputedFields(t *gen.Type, ta *Annotation) (errs []error) {
+	if len(ta.ComputedFields) == 0 {
+		return nil
+	}
+
+	names := []string{"id", "edges"}
+	structFields := slices.Clone(computedFieldReservedNames)
+
+	for _, f := range t.Fields {
+		names = append(names, GetFieldName(t, f))
+		structFields = append(structFields, f.StructField())
+	}
+
+	for _, e := range t.Edges {
+		names = append(names, GetEdgeName(t, e, ""))
+		structFields = append(structFields, e.StructField(), "Query"+e.StructField())
+	}
+
+	for _, cf := range GetComputedFields(t) {
+		if !computedFieldNameRegex.MatchString(cf.Name) {
+			errs = append(errs, fmt.Errorf("computed field name %q must be snake_case", cf.Name))
+			continue
+		}
+
+		if cf.Schema == nil {
+			errs = append(errs, fmt.Errorf("computed field %q has no schema", cf.Name))
+		}
+
+		if !computedFieldResolverRegex.MatchString(cf.Resolver) {
+			errs = append(errs, fmt.Errorf("computed field %q resolver %q must be an exported Go identifier", cf.Name, cf.Resolver))
+		}
+
+		if name := getComputedFieldName(t, cf); slices.Contains(names, name) {
+			errs = append(errs, fmt.Errorf("computed field %q conflicts with an existing field or edge", cf.Name))
+		} else {
+			names = append(names, name)
+		}
+
+		if sf := getComputedFieldStructField(cf); slices.Contains(structFields, sf) {
+			errs = append(errs, fmt.Errorf("computed field %q conflicts with the struct field or method %q of the entity", cf.Name, sf))
+		} else {
+			structFields = append(structFields, sf)
+		}
+	}
+	return errs
+}
+
+// validateComputedFieldResolvers checks that no two computed fields (see
+// [WithComputedField]) use the same resolver name, as all resolvers are methods of the
+// same interface.
+func validateComputedFieldResolvers(nodes []*gen.Type) (errs []error) {
+	resolvers := map[string]string{}
+
+	for _, t := range nodes {
+		for _, cf := range GetComputedFields(t) {
+			if other, ok := resolvers[cf.Resolver]; ok {
+				errs = append(errs, &AnnotationError{
+					Schema: t.Name,
+					Err:    fmt.Errorf("computed field %q resolver %q is already used by schema %s", cf.Name, cf.Resolver, other),
+				})
+				continue
+			}
+			resolvers[cf.Resolver] = t.Name
+		}
+	}
+	return errs
+}
@@ -0,0 +1,89 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_ComputedFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet",
+					WithComputedField("display_name", &ogen.Schema{Type: "string"}, ""),
+					WithComputedField("age_in_months", &ogen.Schema{Type: "integer", Nullable: true}, "PetMonths"),
+				)
+				return nil
+			},
+		})
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.Pet.properties.display_name.type`))
+		assert.Equal(t, true, r.json(`$.components.schemas.Pet.properties.display_name.readOnly`))
+		assert.Equal(t, true, r.json(`$.components.schemas.Pet.properties.age_in_months.nullable`))
+		assert.Contains(t, r.json(`$.components.schemas.Pet.required`), "display_name")
+		assert.Contains(t, r.json(`$.components.schemas.Pet.required`), "age_in_months")
+
+		// Computed fields are never accepted as input.
+		assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.display_name`))
+		assert.Nil(t, r.json(`$.components.schemas.PetUpdate.properties.display_name`))
+
+		var fields []*ComputedField
+		for _, n := range r.graph.Nodes {
+			if n.Name == "Pet" {
+				fields = GetComputedFields(n)
+			}
+		}
+
+		if assert.Len(t, fields, 2) {
+			assert.Equal(t, "ResolvePetDisplayName", fields[0].Resolver)
+			assert.Equal(t, "PetMonths", fields[1].Resolver)
+			assert.Equal(t, "string", getComputedFieldType(fields[0]))
+			assert.Equal(t, "*int", getComputedFieldType(fields[1]))
+		}
+	})
+
+	t.Run("field-name-style", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			FieldNameStyle: FieldNameStyleCamel,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithComputedField("display_name", &ogen.Schema{Type: "string"}, ""))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.Pet.properties.displayName.type`))
+	})
+
+	t.Run("edge-types", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithComputedField("display_name", &ogen.Schema{Type: "string"}, ""))
+				injectAnnotations(t, g, "User.pets", WithEagerLoad(true))
+				return nil
+			},
+		})
+
+		var names []string
+		for _, n := range getComputedFieldTypes(GetConfig(r.graph.Config), r.graph.Nodes) {
+			names = append(names, n.Name)
+		}
+		assert.Contains(t, names, "Pet")
+		assert.Contains(t, names, "User")
+		assert.NotContains(t, names, "Settings")
+	})
+}
@@ -9,7 +9,13 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path"
+	"runtime"
 	"slices"
+	"sync"
 
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
@@ -22,6 +28,10 @@ var _ entc.Annotation = (*Config)(nil)
 type Config struct {
 	isValidated bool
 
+	// decoded caches the copy of the config returned by [GetConfig] while the spec is
+	// generated (see [Config.cacheDecoded]).
+	decoded *decodedConfig
+
 	// Spec is an optional default spec to merge all generated endpoints/schemas/etc
 	// into, which will allow you to specify API info, servers, security schemes, etc.
 	Spec *ogen.Spec
@@ -33,6 +43,25 @@ type Config struct {
 	// can be a bit tedious to use [Config.Spec] directly.
 	SpecFromPath string
 
+	// ReconcileSpecPath is the path to an existing, hand-written spec (JSON or YAML), for
+	// teams migrating a spec-first API onto ent. Rather than being used as a base spec
+	// (like [Config.SpecFromPath]), generated paths and components are reconciled into it
+	// (see [ReconcileSpec]), and it is written back to the same path (in addition to
+	// "<ent>/rest/openapi.json"). Operations and component schemas marked with the
+	// "x-entrest-manual: true" extension are never replaced or removed. Not applied to
+	// [Config.Targets].
+	ReconcileSpecPath string
+
+	// OperationExtensions are spec extensions (e.g. Lambda ARNs, or auth scopes for
+	// infrastructure tooling) to add to operations, keyed by a glob pattern of operation
+	// IDs. See [OperationExtensions] for more information.
+	OperationExtensions OperationExtensions
+
+	// OperationExtensionsFromPath is similar to [Config.OperationExtensions], but reads
+	// the extensions from the provided JSON or YAML file (in the same format). Extensions
+	// provided through [Config.OperationExtensions] take precedence.
+	OperationExtensionsFromPath string
+
 	// DisablePagination disables pagination support for all schemas by default.
 	// It scan still be enabled on a per-schema basis with annotations.
 	DisablePagination bool
@@ -49,6 +78,16 @@ type Config struct {
 	// This can be overridden on a per-schema basis with annotations.
 	ItemsPerPage int
 
+	// PaginationSchema customizes the fields of paginated list responses, mapping them to
+	// the names they're encoded with, e.g. {"total_count": "total", "content": "items"}.
+	// The [PaginationFieldPage], [PaginationFieldLastPage], [PaginationFieldIsFirstPage],
+	// [PaginationFieldIsLastPage], [PaginationFieldTotalCount] and [PaginationFieldContent]
+	// fields are always included, and default to their own names. The [PaginationFieldPerPage],
+	// [PaginationFieldNextPage] and [PaginationFieldPreviousPage] fields are only included
+	// when provided. Only supported with [FlavorDefault], as the other flavors have their
+	// own conventions.
+	PaginationSchema map[PaginationField]string
+
 	// DefaultEagerLoad enables eager loading of all edges by default. This can be
 	// overridden on a per-edge basis with annotations. If edges load a lot of data
 	// or are expensive, this can be a performance hit and isn't recommended.
@@ -83,6 +122,30 @@ type Config struct {
 	// This can be overridden on a per-edge basis with annotations.
 	EagerLoadLimit int
 
+	// EagerLoadCycleDepth controls how many times a schema can be repeated within a
+	// single nested eager-load path (see [WithEagerLoadDepth] and [WithEagerLoadEdges]),
+	// before its nested edges are no longer loaded. For example, with the default of 0,
+	// eager-loading "pets.owner" from users (where owner is a user) will still load the
+	// owner, but not the edges of the owner, with the owner referencing the base user
+	// schema. A warning is written to [Config.WarningWriter] for each truncated edge.
+	EagerLoadCycleDepth int
+
+	// EmptyEdges controls how eager-loaded list edges without any entities are encoded
+	// in responses: omitted ([EmptyEdgesOmit], the default), as empty arrays
+	// ([EmptyEdgesArray]), or as null ([EmptyEdgesNull]). This applies to both the ent
+	// entities and DTOs (see [Config.WithDTOs]), and edges which aren't loaded are always
+	// omitted. The spec documents the encoding, i.e. eager-loaded list edges are only
+	// required with [EmptyEdgesArray] and [EmptyEdgesNull], and nullable with
+	// [EmptyEdgesNull].
+	EmptyEdges EmptyEdges
+
+	// NullableEdges encodes eager-loaded optional unique edges (e.g. O2O and M2O) without
+	// an entity as null in responses, rather than omitting them. This applies to both the
+	// ent entities and DTOs (see [Config.WithDTOs]), and edges which aren't loaded are
+	// always omitted. The spec documents the encoding, i.e. such edges are required and
+	// nullable.
+	NullableEdges bool
+
 	// AddEdgesToTags enables the addition of edge fields to the "tags" field in the
 	// OpenAPI spec. This is helpful to see if querying a specific entity also returns
 	// the thing you're looking for, though can be very noisy for large schemas. Note
@@ -107,7 +170,9 @@ type Config struct {
 	GlobalRequestHeaders RequestHeaders
 
 	// GlobalResponseHeaders are headers to add to every response, recommended for headers
-	// like X-Ratelimit-Limit, X-Ratelimit-Remaining, X-Ratelimit-Reset, etc.
+	// like X-Ratelimit-Limit, X-Ratelimit-Remaining, X-Ratelimit-Reset, etc. Note that
+	// these are only documented in the spec -- the values can be set in the generated
+	// handlers through the ResponseHeaders option of the generated ServerConfig.
 	GlobalResponseHeaders ResponseHeaders
 
 	// GlobalErrorResponses are status code -> response mappings for errors, which are
@@ -117,17 +182,127 @@ type Config struct {
 	// built-in auto-generated HTTP handlers (see below). Defaults to [DefaultErrorResponses].
 	GlobalErrorResponses ErrorResponses
 
+	// IncludeSchemas is a list of glob patterns (see [path.Match]) of schema names to
+	// expose through the REST API. If provided, all schemas which don't match any of
+	// the patterns are skipped, as if [WithSkip] was provided on them (including any
+	// edges pointing to them). Useful for large graphs, where only a subset of schemas
+	// should be exposed.
+	IncludeSchemas []string
+
+	// ExcludeSchemas is a list of glob patterns (see [path.Match]) of schema names to
+	// skip, as if [WithSkip] was provided on them (including any edges pointing to
+	// them). Takes precedence over [Config.IncludeSchemas].
+	ExcludeSchemas []string
+
+	// Targets are additional, named, generation targets, each of which generates an
+	// additional spec from the same graph (e.g. a public and an admin API), written to
+	// "<ent>/rest/<name>/openapi.json" by default. See [Target] for more information.
+	Targets []*Target
+
+	// Versions are the API versions (e.g. "v1", "v2", oldest first), which schemas,
+	// fields, edges and operations can be restricted to, through [WithVersions] and
+	// [WithOperationVersions]. A spec is generated for each version (as a target, see
+	// [Config.Targets]) with all paths prefixed with the version (e.g. "/v1/pets"), and
+	// the main spec documents the latest version. The generated handlers serve each
+	// version under its prefix, returning a 404 for endpoints which aren't available in
+	// the version, and omitting fields and edges which aren't available in the version
+	// from responses. Unprefixed paths serve the latest version.
+	Versions []string
+
+	// VersionNegotiation is the way in which clients select the API version (see
+	// [Config.Versions]). Defaults to path prefixes (see [VersionNegotiationPath]). With
+	// [VersionNegotiationHeader], paths aren't prefixed, and the [VersionHeader] request
+	// header (documented on all operations) selects the version instead, defaulting to the
+	// latest version. With [VersionNegotiationMediaType], paths also aren't prefixed, and
+	// the version is selected through vendor media types in the Accept header instead
+	// (see [Config.MediaTypeVendor]).
+	VersionNegotiation VersionNegotiation
+
+	// MediaTypeVendor is the vendor of the media types used by [VersionNegotiationMediaType],
+	// e.g. "myapp" for "application/vnd.myapp.pet.v2+json". Required when using
+	// [VersionNegotiationMediaType].
+	MediaTypeVendor string
+
 	// Handler enables the generation of HTTP handlers for the specified server/routing
 	// library. If this is disabled, no Go code will be generated, and only the OpenAPI
 	// spec will be generated.
 	Handler HTTPHandler
 
-	// StrictMutate if set to true, will cause a 400 "Bad Request" response if an unknown
-	// field is provided to the update/create/etc functions. This is useful for ensuring
-	// that all fields are provided, and that the client is not attempting to provide
-	// fields that are not defined in the schema.
+	// StrictMutate if set to true, will cause an error response (see
+	// [Config.UnknownFieldErrorStatus]) if an unknown field is provided to the
+	// update/create/etc functions. This is useful for ensuring that all fields are
+	// provided, and that the client is not attempting to provide fields that are not
+	// defined in the schema.
 	StrictMutate bool
 
+	// TolerantReader if set to true, will cause create and update handlers to accept
+	// (and ignore) properties of the entity as returned by read endpoints which can't be
+	// written, e.g. "id", read-only, immutable and computed fields, and "edges", as well
+	// as fields which aren't available in the requested version (see [Config.Versions]).
+	// This allows clients to send back an entity as it was returned (read-modify-write),
+	// including properties which they don't know about (e.g. fields added after the
+	// client was built), without the request being rejected when [Config.StrictMutate]
+	// is enabled. The stored values of these properties are always preserved.
+	TolerantReader bool
+
+	// ValidationErrorStatus is the status code of error responses for create/update
+	// requests which are well-formed, but fail validation (e.g. ent field validators,
+	// or JSON values of the wrong type). This allows clients to distinguish them from
+	// malformed requests (e.g. invalid JSON), which always use 400 "Bad Request".
+	// Defaults to 422 "Unprocessable Entity".
+	ValidationErrorStatus int
+
+	// UnknownFieldErrorStatus is the status code of error responses for create/update
+	// requests which include unknown fields, when [Config.StrictMutate] is enabled.
+	// Defaults to 400 "Bad Request".
+	UnknownFieldErrorStatus int
+
+	// ReadYourWrites if set to true, will cause create and update handlers to run the
+	// mutation and the re-fetch of the entity (including all eager-loaded edges, and
+	// values computed by the database) within a single transaction, so the response is
+	// always the canonical representation of the entity as written. Create responses
+	// also include a "Location" header, pointing to the read endpoint of the entity.
+	ReadYourWrites bool
+
+	// MaintenanceMode adds a runtime maintenance mode switch to the generated server (see
+	// the generated Server.SetMaintenanceMode). While enabled, all create, update and
+	// delete operations (including edge and file operations) are rejected with a 503,
+	// which is documented in the spec, while read and list operations are still served.
+	// This allows the API to be made read-only (e.g. during database migrations) without
+	// redeploying.
+	MaintenanceMode bool
+
+	// FeatureGates adds a runtime feature gate to all entity endpoints of the generated
+	// server (see the generated FeatureGate, and ServerConfig.FeatureGate), which is
+	// consulted for each request, allowing new endpoints to ship dark, and be enabled per
+	// environment or per tenant. Requests to disabled endpoints are rejected with a 404
+	// (or a 403, see the generated ServerConfig.FeatureGateForbidden).
+	FeatureGates bool
+
+	// RequestPolicy if provided, adds a request policy to the generated server (see the
+	// generated ServerConfig.Policy), which resolves the pagination caps, rate limits and
+	// tree traversal depths of each request (e.g. per tenant), and declares the ranges
+	// which policies can grant, which are documented in the spec.
+	RequestPolicy *RequestPolicy
+
+	// Principal if provided, generates typed context helpers for the authenticated
+	// principal of requests (see [Principal]), which is resolved for each request by the
+	// generated ServerConfig.Authenticate.
+	Principal *Principal
+
+	// PayloadSizes if provided, observes the request and response payload sizes of all
+	// entity endpoints of the generated server in per-operation histograms (see the
+	// generated Server.PayloadSizes), and optionally enforces a byte budget on responses
+	// (see [PayloadSizes]).
+	PayloadSizes *PayloadSizes
+
+	// Batch if provided, adds a batch endpoint to the generated server (e.g. "POST
+	// /batch"), which accepts multiple requests (method, path and body), executes them
+	// in-process against the generated handlers, in order, and returns the response of
+	// each, so clients (e.g. mobile apps) can reduce round trips. Batches can optionally
+	// be executed within a single transaction, which is rolled back if any request fails.
+	Batch *Batch
+
 	// ListNotFound if set to true, will cause a 404 "Not Found" response if a list endpoint
 	// (with any filtering as part of the request) returns no results. This is technically
 	// "more correct" according to the RFC, but some prefer to return a 200 "OK". In either
@@ -135,16 +310,80 @@ type Config struct {
 	// with the "content" field being an empty array.
 	ListNotFound bool
 
+	// EnableLastModified enables the "Last-Modified" response header, and conditional
+	// requests (returning a 304 "Not Modified"), on the read and list endpoints of all
+	// schemas with an "updated_at" time field. For lists, the last modification time is
+	// the latest "updated_at" of the entities within the page. As it doesn't change when
+	// entities are removed from (or added to) a page, lists are validated through a weak
+	// "ETag" of the page (and the "If-None-Match" request header) rather than the
+	// "If-Modified-Since" request header, which is used for reads.
+	EnableLastModified bool
+
+	// ReadMask enables the "read_mask" query parameter on read and list operations, which
+	// implements field mask semantics (see Google AIP-161), e.g. "name,owner.name". Only
+	// the requested fields (and the fields of eager-loaded edges, separated by a dot) are
+	// returned, and only the requested fields of the entity are selected from the
+	// database. The supported paths are documented on each operation.
+	ReadMask bool
+
+	// Flavor controls the conventions which list operations follow. With [FlavorAIP],
+	// list operations use the parameters and response fields of Google AIP-132 (i.e.
+	// "page_size", "page_token", "next_page_token", "filter" and "order_by"), with
+	// opaque, token based pagination (see [FlavorAIP] for how page tokens are resolved).
+	// With [FlavorOData], list operations support the "$filter", "$orderby", "$top",
+	// "$skip", "$count" and "$select" query options. List operations must use the GET
+	// method with all flavors other than [FlavorDefault] (see [WithOperationMethod]).
+	// Defaults to [FlavorDefault].
+	Flavor Flavor
+
 	// DisableSpecHandler disables the generation of an OpenAPI spec handler (e.g.
 	// /openapi.json). Disabling this will also disable embedding the spec into the
 	// binary/rest generated library.
 	DisableSpecHandler bool
 
+	// SpecPaths are the paths the spec handler is mounted at (e.g. "/openapi.json" and
+	// "/.well-known/openapi.yaml"), relative to the base path of the server. Paths ending
+	// in ".yaml" or ".yml" serve the spec as YAML (in which case an "openapi.yaml" file is
+	// generated alongside the JSON spec), all others serve the spec as JSON. The first
+	// JSON path is used for links and the API reference documentation. Defaults to
+	// "/openapi.json".
+	SpecPaths []string
+
+	// DisableSpecOperations disables documenting the spec handler paths (see
+	// [Config.SpecPaths]) as operations within the spec itself.
+	DisableSpecOperations bool
+
 	// AllowClientIDs, when enabled, allows the built-in "id" field as part of a "Create"
 	// payload for entity creation, allowing the client to supply UUIDs as primary keys
 	// and for idempotency.
+	//
+	// Deprecated: use [Config.ClientProvidedIDs] instead, which supports all ID types.
+	// This is equivalent to enabling [WithClientProvidedID] on all schemas with UUID IDs.
 	AllowClientUUIDs bool
 
+	// ClientProvidedIDs, when enabled, allows clients to provide the "id" field as part of
+	// a "Create" payload for all schemas with a single, user-defined ID field (string,
+	// int, UUID, or custom types like ULIDs/KSUIDs), e.g. for idempotency, or IDs which
+	// are generated client-side. If an entity with the same ID already exists, a 409
+	// Conflict is returned. Can be overridden per-schema with [WithClientProvidedID].
+	ClientProvidedIDs bool
+
+	// IDFormat, when provided, declares the representation (format, pattern, example)
+	// of all string-based IDs (e.g. ULIDs or KSUIDs) in the OpenAPI spec, used in ID
+	// path parameters and schemas. Can be overridden per-schema with [WithIDFormat].
+	IDFormat *IDFormat
+
+	// IDCodec, when provided, exposes the integer IDs of all schemas as opaque encoded
+	// strings with the provided format (e.g. [IDFormatHashids] or [IDFormatAESSIV]), so
+	// internal IDs aren't exposed, without changing the ent schema. IDs (including edge
+	// fields and edges referencing the schemas) are encoded in responses, and decoded
+	// from ID path parameters, filter query parameters and request bodies, through the
+	// "DefaultIDCodec" of the generated package, which has to be set before creating the
+	// server. Filtering by encoded IDs is limited to (in)equality, as encoded IDs aren't
+	// ordered. Requires [Config.WithDTOs], and isn't supported with other flavors than
+	// [FlavorDefault]. Note that subscription and outbox payloads aren't affected.
+	IDCodec *IDFormat
+
 	// DisablePatchJSONTag disables a ent generation hook that patches the JSON tag of all
 	// fields in the schema, removing the usage of omitempty. This helps ensure that fields
 	// that have default values and/or aren't required, still get returned in JSON response
@@ -152,9 +391,170 @@ type Config struct {
 	DisablePatchJSONTag bool
 
 	// WithTesting enables the generation of a resttest package, which contains a
-	// set of helpers for testing the generated REST API.
+	// set of helpers for testing the generated REST API. This includes fuzz targets
+	// for each list endpoint, which feed arbitrary query strings through the
+	// parameter binder (filtering, sorting, pagination).
 	WithTesting bool
 
+	// WithBenchmarks enables the generation of Go benchmarks for each operation of
+	// each entity, as part of the resttest package (requires [Config.WithTesting]).
+	// Benchmarks seed the provided database (e.g. an in-memory SQLite database)
+	// through the create endpoints, using the example payloads (see
+	// [Config.WithExamples]), seeding required edges first, and making unique fields
+	// unique for each entity. They report allocations, so performance regressions in
+	// the generated handlers are measurable between releases. Only list benchmarks are
+	// generated for schemas which can't be seeded (e.g. without a create operation).
+	WithBenchmarks bool
+
+	// WithExamples enables the generation of deterministic examples for all schema
+	// properties which don't already have one (see [WithExample]), respecting enums,
+	// formats, patterns and length/range constraints. The same examples are what most
+	// mock servers built from the spec will return, and are also available as fixtures
+	// through the generated "rest.Example<Name>Create" and "rest.Example<Name>Update"
+	// helpers (also generated when [Config.WithTesting] is enabled).
+	WithExamples bool
+
+	// WithOutbox adds an "Outbox" schema to the graph (skipped from the REST API), and
+	// inserts an entry into it within the same transaction as each create, update and
+	// delete handler of schemas with a single ID (e.g. a "pet.created" event), so changes
+	// can be reliably relayed to downstream consumers (transactional outbox pattern). The
+	// payload of each entry matches the REST schema of the entity (or only its ID, for
+	// deleted entities). See the generated ProcessOutbox and DecodeOutboxPayload helpers.
+	WithOutbox bool
+
+	// WithHistory adds a "History" schema to the graph (skipped from the REST API), which
+	// records a version of each entity of schemas with the [HistoryMixin], for each
+	// create, update and delete of a single entity, with the changes of its fields (and
+	// a snapshot of the entity), through the generated HistoryHook ent hook. The history
+	// is exposed through dedicated endpoints, which allow listing and reading versions,
+	// and reverting entities to a previous version. Bulk mutations aren't recorded.
+	WithHistory bool
+
+	// WithDTOs enables the generation of dedicated response structs (DTOs) for each
+	// schema (e.g. "PetDTO"), with explicit mapping functions from the ent entities (e.g.
+	// "NewPetDTO"), which are used by the generated handlers instead of encoding the ent
+	// entities directly. DTOs only contain the fields and edges included in the REST
+	// schema, so the wire format is insulated from internal schema refactors (e.g. fields
+	// which are added with [WithSkip] are never leaked). Request bodies already use
+	// dedicated structs (e.g. "CreatePetParams").
+	WithDTOs bool
+
+	// LoadTest enables the generation of load-testing scenarios (e.g. k6 or vegeta),
+	// derived from the spec. Only safe (GET) operations are included, using example
+	// values for all path and required query parameters. The base URL defaults to the
+	// first server in the spec (if any), and can be overridden at runtime through the
+	// BASE_URL environment variable (k6 only). See [LoadTestFormat] for the supported
+	// formats and where they are written to (unless [Config.LoadTestWriter] is provided).
+	// Nothing is written when [Config.DryRun] is enabled.
+	LoadTest LoadTestFormat
+
+	// Changelog enables the generation of a changelog of the routes, schemas and schema
+	// fields which were added, removed or changed compared to the previous generation,
+	// so release notes for API consumers can be assembled automatically. The previous
+	// generation is tracked through a manifest, written to
+	// "<ent>/rest/manifest.generated.json". Each generation with changes adds a new entry
+	// (using the version of the spec, and the current date) to the top of the changelog.
+	// The first generation only writes the manifest. See [ChangelogFormat] for the
+	// supported formats and where they are written to.
+	Changelog ChangelogFormat
+
+	// Gateways enables the generation of API gateway configuration (e.g. Kong or AWS
+	// API Gateway), derived from the spec, so the gateway routes stay in sync with the
+	// generated API. See [GatewayFormat] for the supported formats and where they are
+	// written to.
+	Gateways []GatewayFormat
+
+	// GatewayUpstream is the URL of the upstream service which the gateway proxies
+	// requests to (see [Config.Gateways]). Defaults to the first server in the spec (if
+	// any).
+	GatewayUpstream string
+
+	// Namer is an optional naming policy, which controls how schema names, field names,
+	// path parameters, path segments, and operation IDs are derived from the graph, for
+	// both the spec and the generated code. Defaults to [DefaultNamer].
+	Namer Namer `json:"-"`
+
+	// FieldNameStyle is the casing applied to the names of fields and edges in request
+	// and response bodies, the JSON tags of the generated ent entities (unless
+	// [Config.DisablePatchJSONTag] is set), filter parameters, and sort fields. The
+	// style is applied to the names returned by the [Namer]. See [FieldNameStyle] for
+	// the supported styles.
+	FieldNameStyle FieldNameStyle
+
+	// ProtoInterop aligns the REST API with the protobuf messages generated by entproto
+	// (entgo.io/contrib/entproto) from the same graph, so the REST and gRPC surfaces of
+	// a service don't diverge. Field and edge names default to [FieldNameStyleCamel]
+	// (matching protojson), enum schemas include the names of the protobuf enum values
+	// through the "x-enum-varnames" extension, and generation fails if a JSON name, enum
+	// value, or integer enum number (see [WithIntEnum]) doesn't match the entproto
+	// annotations of the schema. A mapping table between the two is written to
+	// [Config.ProtoMappingWriter], or "<target>/rest/proto_mapping.json" if not provided.
+	ProtoInterop bool
+
+	// EntGQLDefaults uses the entgql (entgo.io/contrib/entgql) annotations of schemas,
+	// fields and edges as defaults for REST generation, for graphs which are exposed
+	// through both GraphQL and REST, so they don't have to be annotated twice: types,
+	// fields and edges skipped with entgql.Skip are skipped, fields skipped in both
+	// mutation inputs are read-only, schemas and edges with entgql.RelayConnection are
+	// paginated, and fields with entgql.OrderField are sortable. Explicitly provided
+	// entrest annotations take precedence, though note that a boolean annotation set to
+	// false (e.g. WithSkip(false)) can't be distinguished from one which isn't set.
+	EntGQLDefaults bool
+
+	// TimeFormat is the format of all time fields in request and response bodies (both
+	// the REST API and the JSON encoding of the generated ent entities), and in filter
+	// query parameters, as well as the OpenAPI type, format and example of the fields.
+	// Times in RFC3339 formats are always encoded in UTC. Can be overridden per-field
+	// with [WithTimeFormat]. Defaults to the standard encoding of [time.Time]. See
+	// [TimeFormat] for the supported formats.
+	TimeFormat TimeFormat
+
+	// NumericFormat is the format of all high-precision numeric fields (fields with a
+	// GoType of *big.Int or decimal.Decimal) in request and response bodies (both the
+	// REST API and the JSON encoding of the generated ent entities), and in filter query
+	// parameters, as well as the OpenAPI type, format and example of the fields. Can be
+	// overridden per-field with [WithNumericFormat]. Fields with a custom schema (see
+	// [WithSchema]) are left as-is. Defaults to encoding numbers as strings, without
+	// rounding.
+	NumericFormat *NumericFormat
+
+	// BytesAsFiles exposes all non-sensitive bytes fields as files, as if [WithFile]
+	// was provided with the default [FileOptions], rather than as base64-encoded
+	// strings in JSON request and response bodies.
+	BytesAsFiles bool
+
+	// DeduplicateSchemas enables a deduplication pass on the component schemas of the
+	// generated spec, which reduces the size of the spec, and the number of (near)
+	// identical types generated by most client generators. Properties shared between
+	// the read, create and update schemas of an entity are factored into a
+	// "<Entity>Fields" schema, with each variant referencing it through allOf, and only
+	// containing its own differences. Component schemas which are identical to another
+	// component schema are replaced with references to it. See [DeduplicateSchemas]
+	// for more information.
+	DeduplicateSchemas bool
+
+	// PathNameFunc is an optional function which returns the URL path segment for the
+	// provided schema (e.g. "people" for a "User" schema), applied to all paths of the
+	// schema, including edge paths. Returning an empty string falls back to the
+	// [Namer]. Can be overridden per-schema with [WithPathName].
+	PathNameFunc func(t *gen.Type) string `json:"-"`
+
+	// OperationGate is an optional function which is evaluated at generation time for
+	// each operation of each schema (by schema name), and returns false if the operation
+	// should be excluded, as if it was excluded through [WithExcludeOperations]. This
+	// allows different builds (e.g. open source and enterprise editions) to produce
+	// different API surfaces from the same ent schemas. Schemas with all operations
+	// excluded are skipped, as if [WithSkip] was provided.
+	OperationGate func(schema string, op Operation) bool `json:"-"`
+
+	// TemplateOverrides is an optional filesystem containing templates ("*.tmpl",
+	// recursively) which override or append to the built-in templates. Templates which
+	// define a template with the same name as a built-in template (e.g. "rest/list",
+	// "rest/create" or "helper/rest/server/req") replace it, and templates with new
+	// names generate new files (e.g. "rest/custom" generates "rest/custom.go"). See
+	// [TemplateFuncs] for the functions available within templates.
+	TemplateOverrides fs.FS `json:"-"`
+
 	// PreHook is a hook that runs before the spec is generated. This is useful for
 	// things like adding global security schemes, or adding global request headers,
 	// if you're unable to provide the [Config.Spec] field for some reason.
@@ -170,9 +570,52 @@ type Config struct {
 	// writing to disk, after the entire spec has been resolved.
 	PreWriteHook func(spec *ogen.Spec) error `json:"-"`
 
+	// Concurrency is the maximum number of schemas for which the spec is generated
+	// concurrently. The generated spec is the same regardless of the concurrency. Note that
+	// when greater than 1, functions provided through the config (e.g. [Config.Namer]) may
+	// be called concurrently. Defaults to [runtime.GOMAXPROCS].
+	Concurrency int
+
+	// DryRun, if enabled, performs the full spec generation in memory, and writes a
+	// human-readable plan of the differences (routes added/removed/changed, schema
+	// fields added/removed) compared to the existing "<ent>/rest/openapi.json" spec,
+	// to [Config.DryRunWriter]. No files are written, and no further code generation
+	// (including ent's own) is performed.
+	DryRun bool
+
+	// DryRunWriter is an optional writer to write the plan to when [Config.DryRun] is
+	// enabled. Defaults to stdout.
+	DryRunWriter io.Writer `json:"-"`
+
+	// StrictAnnotations, if enabled, fails the generation on annotation conflicts which
+	// are otherwise ignored, and only warned about (e.g. pagination disabled but
+	// items-per-page set, or eager-loading a skipped edge). See
+	// [ValidateAnnotationConflicts].
+	StrictAnnotations bool
+
+	// WarningWriter is an optional writer to write generation warnings to (e.g. eager-load
+	// cycles, see [Config.EagerLoadCycleDepth]). Defaults to stderr.
+	WarningWriter io.Writer `json:"-"`
+
+	// TimingWriter is an optional writer to write a report of how long the generation
+	// took to, including the duration of each phase of the spec generation, and of each
+	// schema (slowest first), to help find slow schemas.
+	TimingWriter io.Writer `json:"-"`
+
 	// Writer is an optional writer to write the spec to. If not provided, the spec
 	// will be written to the filesystem under "<ent>/rest/openapi.json".
 	Writer io.Writer `json:"-"`
+
+	// ProtoMappingWriter is an optional writer to write the proto mapping table to when
+	// [Config.ProtoInterop] is enabled. If not provided, the mapping table will be
+	// written to the filesystem under "<ent>/rest/proto_mapping.json".
+	ProtoMappingWriter io.Writer `json:"-"`
+
+	// LoadTestWriter is an optional writer to write the load-testing scenario to when
+	// [Config.LoadTest] is enabled. If not provided, the scenario will be written to the
+	// filesystem under "<ent>/rest/loadtest.js" (k6) or "<ent>/rest/loadtest.targets"
+	// (vegeta).
+	LoadTestWriter io.Writer `json:"-"`
 }
 
 func (c *Config) Validate() error {
@@ -184,6 +627,10 @@ func (c *Config) Validate() error {
 		return errors.New("Config.Spec and Config.SpecFromPath cannot be provided at the same time")
 	}
 
+	if c.ReconcileSpecPath != "" && (c.Spec != nil || c.SpecFromPath != "") {
+		return errors.New("Config.ReconcileSpecPath cannot be provided with Config.Spec or Config.SpecFromPath")
+	}
+
 	if c.MinItemsPerPage < 1 {
 		c.MinItemsPerPage = defaultMinItemsPerPage
 	}
@@ -200,6 +647,10 @@ func (c *Config) Validate() error {
 		c.ItemsPerPage = defaultItemsPerPage
 	}
 
+	if c.Concurrency < 1 {
+		c.Concurrency = runtime.GOMAXPROCS(0)
+	}
+
 	if c.ItemsPerPage < c.MinItemsPerPage {
 		c.ItemsPerPage = c.MinItemsPerPage
 	}
@@ -216,6 +667,10 @@ func (c *Config) Validate() error {
 		c.EagerLoadLimit = 1000
 	}
 
+	if c.EagerLoadCycleDepth < 0 {
+		c.EagerLoadCycleDepth = 0
+	}
+
 	if c.DefaultOperations == nil {
 		c.DefaultOperations = AllOperations
 	}
@@ -224,6 +679,27 @@ func (c *Config) Validate() error {
 		c.GlobalErrorResponses = DefaultErrorResponses
 	}
 
+	if c.ValidationErrorStatus == 0 {
+		c.ValidationErrorStatus = http.StatusUnprocessableEntity
+	}
+
+	if c.UnknownFieldErrorStatus == 0 {
+		c.UnknownFieldErrorStatus = http.StatusBadRequest
+	}
+
+	for _, k := range []int{c.ValidationErrorStatus, c.UnknownFieldErrorStatus} {
+		if k < 400 || k > 499 {
+			return fmt.Errorf("validation/unknown field error status code %d is not an HTTP client error (4xx) code", k)
+		}
+	}
+
+	// Make sure the error responses returned by the generated handlers are documented.
+	mutationErrors := ErrorResponses{c.ValidationErrorStatus: ErrorResponseObject(c.ValidationErrorStatus)}
+	if c.StrictMutate {
+		mutationErrors[c.UnknownFieldErrorStatus] = ErrorResponseObject(c.UnknownFieldErrorStatus)
+	}
+	c.GlobalErrorResponses = mutationErrors.Append(c.GlobalErrorResponses)
+
 	for k := range c.GlobalErrorResponses {
 		if k < 400 {
 			return fmt.Errorf("error response defined with status code %d, which is not an HTTP error code", k)
@@ -234,10 +710,159 @@ func (c *Config) Validate() error {
 		return fmt.Errorf("unsupported handler provided: %s", c.Handler)
 	}
 
+	if !slices.Contains(AllFieldNameStyles, c.FieldNameStyle) {
+		return fmt.Errorf("unsupported field name style provided: %s", c.FieldNameStyle)
+	}
+
+	if c.ProtoInterop {
+		if c.FieldNameStyle == FieldNameStyleDefault {
+			c.FieldNameStyle = FieldNameStyleCamel
+		} else if c.FieldNameStyle != FieldNameStyleCamel {
+			return errors.New("Config.ProtoInterop requires the camel field name style, as used by protojson")
+		}
+	}
+
+	if !slices.Contains(AllTimeFormats, c.TimeFormat) {
+		return fmt.Errorf("unsupported time format provided: %s", c.TimeFormat)
+	}
+
+	if c.NumericFormat != nil {
+		if err := c.NumericFormat.validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.IDFormat != nil {
+		if err := c.IDFormat.validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.IDCodec != nil {
+		if err := validateIDCodec(c); err != nil {
+			return err
+		}
+	}
+
+	if c.RequestPolicy != nil {
+		if err := c.RequestPolicy.validate(c); err != nil {
+			return err
+		}
+	}
+
+	if c.Principal != nil {
+		if err := c.Principal.validate(c); err != nil {
+			return err
+		}
+	}
+
+	if c.PayloadSizes != nil {
+		if err := c.PayloadSizes.validate(); err != nil {
+			return err
+		}
+	}
+
+	if c.Batch != nil {
+		if err := c.Batch.validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pattern := range slices.Concat(c.IncludeSchemas, c.ExcludeSchemas) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid schema pattern %q: %w", pattern, err)
+		}
+	}
+
+	if len(c.SpecPaths) == 0 {
+		c.SpecPaths = []string{defaultSpecPath}
+	}
+
+	if err := c.OperationExtensions.validate(); err != nil {
+		return err
+	}
+
+	if err := validateSpecPaths(c.SpecPaths); err != nil {
+		return err
+	}
+
+	if err := validateTargets(c.Targets); err != nil {
+		return err
+	}
+
+	if err := validateVersions(c); err != nil {
+		return err
+	}
+
+	if !slices.Contains(AllSupportedVersionNegotiations, c.VersionNegotiation) {
+		return fmt.Errorf("unsupported version negotiation provided: %s", c.VersionNegotiation)
+	}
+
+	if c.VersionNegotiation != VersionNegotiationPath {
+		if len(c.Versions) == 0 {
+			return fmt.Errorf("%s version negotiation requires Config.Versions", c.VersionNegotiation)
+		}
+
+		if c.VersionNegotiation == VersionNegotiationMediaType && !mediaTypeVendorRegex.MatchString(c.MediaTypeVendor) {
+			return fmt.Errorf("media type version negotiation requires a valid Config.MediaTypeVendor, got %q", c.MediaTypeVendor)
+		}
+
+		// Make sure the version header (if any), and the error returned for unsupported
+		// versions, are documented.
+		if c.VersionNegotiation == VersionNegotiationHeader {
+			c.GlobalRequestHeaders = c.GlobalRequestHeaders.Append(RequestHeaders{VersionHeader: getVersionHeader(c)})
+		}
+		c.GlobalErrorResponses = ErrorResponses{
+			http.StatusNotAcceptable: ErrorResponseObject(http.StatusNotAcceptable),
+		}.Append(c.GlobalErrorResponses)
+	}
+
+	if !slices.Contains(AllSupportedFlavors, c.Flavor) {
+		return fmt.Errorf("unsupported flavor provided: %s", c.Flavor)
+	}
+
+	if err := validatePaginationSchema(c); err != nil {
+		return err
+	}
+
+	if !slices.Contains(AllSupportedEmptyEdges, c.EmptyEdges) {
+		return fmt.Errorf("unsupported empty edges encoding provided: %s", c.EmptyEdges)
+	}
+
+	if !slices.Contains(AllSupportedLoadTestFormats, c.LoadTest) {
+		return fmt.Errorf("unsupported load test format provided: %s", c.LoadTest)
+	}
+
+	if !slices.Contains(AllSupportedChangelogFormats, c.Changelog) {
+		return fmt.Errorf("unsupported changelog format provided: %s", c.Changelog)
+	}
+
+	for _, format := range c.Gateways {
+		if !slices.Contains(AllSupportedGatewayFormats, format) {
+			return fmt.Errorf("unsupported gateway format provided: %s", format)
+		}
+	}
+
 	if c.Handler == HandlerNone && c.WithTesting {
 		c.WithTesting = false
 	}
 
+	if c.Handler == HandlerNone && c.WithOutbox {
+		c.WithOutbox = false
+	}
+
+	if c.Handler == HandlerNone && c.WithHistory {
+		c.WithHistory = false
+	}
+
+	if c.Handler == HandlerNone && c.Batch != nil {
+		c.Batch = nil
+	}
+
+	if c.Handler == HandlerNone && c.PayloadSizes != nil {
+		c.PayloadSizes = nil
+	}
+
 	c.isValidated = true
 	return nil
 }
@@ -251,11 +876,79 @@ func (c *Config) Decode(o any) error {
 	if err != nil {
 		return err
 	}
-	return json.Unmarshal(buf, c) //nolint:musttag
+
+	err = json.Unmarshal(buf, c) //nolint:musttag
+	if err != nil {
+		return err
+	}
+
+	// Fields which can't be serialized are carried over as-is, if possible.
+	switch o := o.(type) {
+	case *Config:
+		c.Namer = o.Namer
+		c.PathNameFunc = o.PathNameFunc
+	case Config:
+		c.Namer = o.Namer
+		c.PathNameFunc = o.PathNameFunc
+	}
+	return nil
+}
+
+// GetNamer returns the configured naming policy, or [DefaultNamer] if not configured.
+func (c *Config) GetNamer() Namer {
+	if c.Namer == nil {
+		return DefaultNamer{}
+	}
+	return c.Namer
+}
+
+// warnf writes a generation warning to [Config.WarningWriter], or stderr if it isn't
+// provided.
+func (c *Config) warnf(format string, args ...any) error {
+	w := c.WarningWriter
+	if w == nil {
+		w = os.Stderr
+	}
+	_, err := fmt.Fprintf(w, "entrest: warning: "+format+"\n", args...)
+	return err
+}
+
+// hasConfig returns true if the provided ent config has the entrest [Config] attached.
+func hasConfig(gc *gen.Config) bool {
+	return gc != nil && gc.Annotations != nil && gc.Annotations[(&Config{}).Name()] != nil
+}
+
+// decodedConfig is the decoded (and validated) copy of a config, which is only decoded
+// once, as decoding it through JSON (including all error response schemas) for every
+// call of [GetConfig] is expensive.
+type decodedConfig struct {
+	once sync.Once
+	cfg  *Config
+	err  error
+}
+
+// cacheDecoded enables caching the copy of the config returned by [GetConfig] until the
+// returned function is called. The config must not be modified in the meantime. If
+// caching is already enabled, the returned function is a no-op. If a graph is provided,
+// its annotations are decoded as well (see [cacheAnnotations]).
+func (c *Config) cacheDecoded(g *gen.Graph) (done func()) {
+	if g != nil {
+		cacheAnnotations(g)
+	}
+
+	if c.decoded != nil {
+		return func() {}
+	}
+
+	c.decoded = &decodedConfig{}
+	return func() { c.decoded = nil }
 }
 
 // GetConfig returns the rest config for the given graph. If the graph does not
-// contain the config (extension was not loaded), this will panic.
+// contain the config (extension was not loaded), this will panic. While generating, the
+// returned config is a shallow copy of a cached copy, i.e. it shares its slices, maps
+// and pointers (e.g. [Config.Spec]) with it, so these must not be modified. Outside of
+// generation, a newly decoded copy is returned.
 func GetConfig(gc *gen.Config) *Config {
 	c := &Config{}
 
@@ -263,14 +956,39 @@ func GetConfig(gc *gen.Config) *Config {
 		panic("nil config")
 	}
 
-	err := c.Decode(gc.Annotations[c.Name()])
+	if src, ok := gc.Annotations[c.Name()].(*Config); ok && src.decoded != nil {
+		d := src.decoded
+		d.once.Do(func() {
+			d.cfg, d.err = decodeConfig(src)
+		})
+		if d.err != nil {
+			panic(d.err.Error())
+		}
+
+		// Shallow copy, so callers can't modify the cached config itself.
+		*c = *d.cfg
+		return c
+	}
+
+	c, err := decodeConfig(gc.Annotations[c.Name()])
 	if err != nil {
-		panic(fmt.Sprintf("failed to decode config: %v", err))
+		panic(err.Error())
+	}
+	return c
+}
+
+// decodeConfig decodes and validates a copy of the provided config.
+func decodeConfig(o any) (*Config, error) {
+	c := &Config{}
+
+	err := c.Decode(o)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode config: %w", err)
 	}
 
 	err = c.Validate()
 	if err != nil {
-		panic(fmt.Sprintf("failed to validate config: %v", err))
+		return nil, fmt.Errorf("failed to validate config: %w", err)
 	}
-	return c
+	return c, nil
 }
@@ -5,6 +5,7 @@
 package entrest
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -341,6 +342,75 @@ func TestConfig_EagerLoadLimit(t *testing.T) {
 	})
 }
 
+func TestConfig_EmptyEdges(t *testing.T) {
+	t.Parallel()
+
+	hook := func(g *gen.Graph, _ *ogen.Spec) error {
+		injectAnnotations(t, g, "Pet.categories", WithEagerLoad(true))
+		injectAnnotations(t, g, "Pet.owner", WithEagerLoad(true))
+		return nil
+	}
+
+	t.Run("omit", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{PreGenerateHook: hook})
+
+		assert.Nil(t, r.json(`$.components.schemas.PetEdges.required`))
+		assert.Nil(t, r.json(`$.components.schemas.PetEdges.properties.categories.nullable`))
+	})
+
+	t.Run("array", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{EmptyEdges: EmptyEdgesArray, PreGenerateHook: hook})
+
+		assert.Equal(t, []any{"categories"}, r.json(`$.components.schemas.PetEdges.required`))
+		assert.Nil(t, r.json(`$.components.schemas.PetEdges.properties.categories.nullable`))
+	})
+
+	t.Run("null", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{EmptyEdges: EmptyEdgesNull, PreGenerateHook: hook})
+
+		assert.Equal(t, []any{"categories"}, r.json(`$.components.schemas.PetEdges.required`))
+		assert.Equal(t, true, r.json(`$.components.schemas.PetEdges.properties.categories.nullable`))
+		assert.Nil(t, r.json(`$.components.schemas.PetEdges.properties.owner.nullable`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+		_, err := NewExtension(&Config{EmptyEdges: "invalid"})
+		assert.Error(t, err)
+	})
+}
+
+func TestConfig_NullableEdges(t *testing.T) {
+	t.Parallel()
+
+	hook := func(g *gen.Graph, _ *ogen.Spec) error {
+		injectAnnotations(t, g, "Pet.categories", WithEagerLoad(true))
+		injectAnnotations(t, g, "Pet.owner", WithEagerLoad(true))
+		return nil
+	}
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{PreGenerateHook: hook})
+
+		assert.Nil(t, r.json(`$.components.schemas.PetEdges.required`))
+		assert.Equal(t, "#/components/schemas/User", r.json(`$.components.schemas.PetEdges.properties.owner.$ref`))
+	})
+
+	t.Run("nullable", func(t *testing.T) {
+		t.Parallel()
+		r := mustBuildSpec(t, &Config{NullableEdges: true, PreGenerateHook: hook})
+
+		assert.Equal(t, []any{"owner"}, r.json(`$.components.schemas.PetEdges.required`))
+		assert.Equal(t, true, r.json(`$.components.schemas.PetEdges.properties.owner.nullable`))
+		assert.Equal(t, "#/components/schemas/User", r.json(`$.components.schemas.PetEdges.properties.owner.allOf[0].$ref`))
+		assert.Nil(t, r.json(`$.components.schemas.PetEdges.properties.categories.nullable`))
+	})
+}
+
 func TestConfig_AddEdgesToTags(t *testing.T) {
 	t.Parallel()
 
@@ -619,6 +689,49 @@ func TestConfig_GlobalErrorResponses(t *testing.T) {
 	})
 }
 
+func TestConfig_ValidationErrorStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.NotNil(t, r.json(`$.components.responses.ErrorUnprocessableEntity`))
+		assert.Contains(t, r.json(`$.paths./pets.post.responses`), "422")
+		assert.Contains(t, r.json(`$.paths./pets/{petID}.patch.responses`), "422")
+		assert.NotContains(t, r.json(`$.paths./pets.get.responses`), "422")
+		assert.NotContains(t, r.json(`$.paths./pets/{petID}.get.responses`), "422")
+		assert.NotContains(t, r.json(`$.paths./pets/{petID}.delete.responses`), "422")
+		assert.Contains(t, r.json(`$.paths./pets.get.responses`), "400")
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			StrictMutate:            true,
+			ValidationErrorStatus:   http.StatusBadRequest,
+			UnknownFieldErrorStatus: http.StatusUnprocessableEntity,
+			GlobalErrorResponses: ErrorResponses{
+				http.StatusInternalServerError: ErrorResponseObject(http.StatusInternalServerError),
+			},
+		})
+
+		assert.Contains(t, r.json(`$.paths./pets.post.responses`), "422")
+		assert.Contains(t, r.json(`$.paths./pets.post.responses`), "400")
+		assert.Contains(t, r.json(`$.paths./pets.get.responses`), "400")
+		assert.NotContains(t, r.json(`$.paths./pets.get.responses`), "422")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildSpec(t, &Config{ValidationErrorStatus: http.StatusOK})
+		assert.ErrorContains(t, err, "not an HTTP client error")
+	})
+}
+
 func TestConfig_AllowClientUUIDs(t *testing.T) {
 	t.Parallel()
 
@@ -648,6 +761,59 @@ func TestConfig_AllowClientUUIDs(t *testing.T) {
 	})
 }
 
+func TestConfig_ClientProvidedIDs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{ClientProvidedIDs: true})
+
+		assert.Equal(t, "integer", r.json(`$.components.schemas.PetCreate.properties.id.type`))
+		assert.Contains(t, r.json(`$.components.schemas.PetCreate.required`), "id")
+		assert.Equal(t, "uuid", r.json(`$.components.schemas.AllTypeCreate.properties.id.format`))
+		assert.NotContains(t, r.json(`$.components.schemas.AllTypeCreate.required`), "id")
+
+		// Not user-defined, so not supported.
+		assert.Nil(t, r.json(`$.components.schemas.CategoryCreate.properties.id`))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.id`))
+		assert.Nil(t, r.json(`$.components.schemas.AllTypeCreate.properties.id`))
+	})
+
+	t.Run("annotation", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			ClientProvidedIDs: true,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithClientProvidedID(false))
+				injectAnnotations(t, g, "AllTypes", WithClientProvidedID(false))
+				return nil
+			},
+		})
+
+		assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.id`))
+		assert.Nil(t, r.json(`$.components.schemas.AllTypeCreate.properties.id`))
+
+		r = mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithClientProvidedID(true))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "integer", r.json(`$.components.schemas.PetCreate.properties.id.type`))
+		assert.Nil(t, r.json(`$.components.schemas.AllTypeCreate.properties.id`))
+	})
+}
+
 func TestConfig_DisablePatchJSONTag(t *testing.T) {
 	t.Parallel()
 
@@ -721,3 +887,171 @@ func TestConfig_DefaultFilterID(t *testing.T) {
 		assert.Contains(t, r.json(`$.paths./pets.get.parameters.*.$ref`), "#/components/parameters/EdgeCategoryIDEQ")
 	})
 }
+
+func TestConfig_WithExamples(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{WithExamples: true})
+
+		assert.Equal(t, "user@example.com", r.json(`$.components.schemas.User.properties.email.example`))
+		assert.Equal(t, "user@example.com", r.json(`$.components.schemas.UserCreate.properties.email.example`))
+		assert.Equal(t, "2024-01-01T00:00:00Z", r.json(`$.components.schemas.User.properties.created_at.example`))
+		assert.Equal(t, "Example Name", r.json(`$.components.schemas.Pet.properties.name.example`))
+		assert.InDelta(t, 1, r.json(`$.components.schemas.Pet.properties.age.example`), 0)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Nil(t, r.json(`$.components.schemas.User.properties.email.example`))
+		assert.Nil(t, r.json(`$.components.schemas.Pet.properties.age.example`))
+	})
+}
+
+func TestConfig_IncludeExcludeSchemas(t *testing.T) {
+	t.Parallel()
+
+	t.Run("include", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{IncludeSchemas: []string{"Pet", "Cat*"}})
+
+		assert.NotNil(t, r.json(`$.paths./pets`))
+		assert.NotNil(t, r.json(`$.paths./categories`))
+		assert.Nil(t, r.json(`$.paths./users`))
+		assert.Nil(t, r.json(`$.components.schemas.User`))
+		assert.Nil(t, r.json(`$.paths./pets/{petID}/owner`))
+		assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.owner`))
+	})
+
+	t.Run("exclude", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{ExcludeSchemas: []string{"User"}})
+
+		assert.NotNil(t, r.json(`$.paths./pets`))
+		assert.Nil(t, r.json(`$.paths./users`))
+		assert.Nil(t, r.json(`$.paths./pets/{petID}/owner`))
+	})
+
+	t.Run("exclude-precedence", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{IncludeSchemas: []string{"*"}, ExcludeSchemas: []string{"Pet"}})
+
+		assert.Nil(t, r.json(`$.paths./pets`))
+		assert.NotNil(t, r.json(`$.paths./users`))
+	})
+
+	t.Run("invalid-pattern", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewExtension(&Config{IncludeSchemas: []string{"[Pet"}})
+		assert.Error(t, err)
+	})
+}
+
+func TestConfig_SpecPaths(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Equal(t, "getOpenAPI", r.json(`$.paths['/openapi.json'].get.operationId`))
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{SpecPaths: []string{"/.well-known/openapi.json", "/openapi.yaml"}})
+
+		assert.Nil(t, r.json(`$.paths['/openapi.json']`))
+		assert.Equal(t, "getOpenAPI", r.json(`$.paths['/.well-known/openapi.json'].get.operationId`))
+		assert.Equal(t, "getOpenAPIYAML", r.json(`$.paths['/openapi.yaml'].get.operationId`))
+		assert.NotNil(t, r.json(`$.paths['/openapi.yaml'].get.responses.200.content['application/yaml']`))
+		assert.Equal(t, "/.well-known/openapi.json", getSpecPath(r.config))
+	})
+
+	t.Run("disable-operations", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{DisableSpecOperations: true})
+
+		assert.Nil(t, r.json(`$.paths['/openapi.json']`))
+		assert.NotNil(t, r.json(`$.paths./pets`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, paths := range [][]string{
+			{"openapi.json"},
+			{"/openapi.txt"},
+			{"/{version}/openapi.json"},
+			{"/openapi.json", "/openapi.json"},
+		} {
+			_, err := NewExtension(&Config{SpecPaths: paths})
+			assert.Error(t, err, paths)
+		}
+	})
+}
+
+func TestConfig_Concurrency(t *testing.T) {
+	t.Parallel()
+
+	sequential, err := MarshalSpec(mustBuildSpec(t, &Config{Concurrency: 1}).spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for range 3 {
+		concurrent, err := MarshalSpec(mustBuildSpec(t, &Config{Concurrency: 8}).spec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		assert.Equal(t, string(sequential), string(concurrent))
+	}
+}
+
+func TestConfig_TimingWriter(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+	mustBuildSpec(t, &Config{Concurrency: 2, TimingWriter: &buf})
+
+	assert.Contains(t, buf.String(), "entrest: generated spec in")
+	assert.Contains(t, buf.String(), "(concurrency: 2)")
+	assert.Regexp(t, `phase schemas\s+\S+`, buf.String())
+	assert.Regexp(t, `schema Pet\s+\S+\s+\d+ operations`, buf.String())
+}
+
+func TestGetConfig_Cached(t *testing.T) {
+	t.Parallel()
+
+	cfg := &Config{ItemsPerPage: 25}
+	gc := &gen.Config{Annotations: gen.Annotations{cfg.Name(): cfg}}
+
+	done := cfg.cacheDecoded(nil)
+
+	c1 := GetConfig(gc)
+	c1.ItemsPerPage = 50
+
+	c2 := GetConfig(gc)
+	assert.NotSame(t, c1, c2)
+	assert.Equal(t, 25, c2.ItemsPerPage)
+	assert.Nil(t, c2.decoded)
+
+	done()
+	assert.Nil(t, cfg.decoded)
+
+	// Changes are picked up again once caching is disabled.
+	cfg.ItemsPerPage = 10
+	assert.Equal(t, 10, GetConfig(gc).ItemsPerPage)
+}
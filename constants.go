@@ -30,25 +30,56 @@ var (
 type Operation string
 
 const (
-	// OperationCreate represents the create operation (method: POST).
+	// OperationCreate represents the create operation (default method: POST).
 	OperationCreate Operation = "create"
-	// OperationRead represents the read operation (method: GET).
+	// OperationRead represents the read operation (default method: GET).
 	OperationRead Operation = "read"
-	// OperationUpdate represents the update operation (method: PATCH).
+	// OperationUpdate represents the update operation (default method: PATCH).
 	OperationUpdate Operation = "update"
-	// OperationDelete represents the delete operation (method: DELETE).
+	// OperationDelete represents the delete operation (default method: DELETE).
 	OperationDelete Operation = "delete"
-	// OperationList represents the list operation (method: GET).
+	// OperationList represents the list operation (default method: GET).
 	OperationList Operation = "list"
 )
 
 // AllOperations holds a list of all supported operations.
 var AllOperations = []Operation{OperationCreate, OperationRead, OperationUpdate, OperationDelete, OperationList}
 
+// defaultOperationMethods holds the default HTTP method of each operation.
+var defaultOperationMethods = map[Operation]string{
+	OperationCreate: http.MethodPost,
+	OperationRead:   http.MethodGet,
+	OperationUpdate: http.MethodPatch,
+	OperationDelete: http.MethodDelete,
+	OperationList:   http.MethodGet,
+}
+
+// AllowedOperationMethods holds the HTTP methods which each operation can be exposed
+// through (see [WithOperationMethod]), with the default method first.
+var AllowedOperationMethods = map[Operation][]string{
+	OperationCreate: {http.MethodPost, http.MethodPut},
+	OperationRead:   {http.MethodGet, http.MethodPost},
+	OperationUpdate: {http.MethodPatch, http.MethodPut, http.MethodPost},
+	OperationDelete: {http.MethodDelete, http.MethodPost},
+	OperationList:   {http.MethodGet, http.MethodPost},
+}
+
+// defaultResponseStatuses holds the default status code of successful responses of
+// each operation.
+var defaultResponseStatuses = map[Operation]int{
+	OperationCreate: http.StatusCreated,
+	OperationRead:   http.StatusOK,
+	OperationUpdate: http.StatusOK,
+	OperationDelete: http.StatusNoContent,
+	OperationList:   http.StatusOK,
+}
+
 const (
 	defaultMinItemsPerPage = 1
 	defaultMaxItemsPerPage = 100
 	defaultItemsPerPage    = 10
+
+	defaultTreeTraversalDepth = 25
 )
 
 // HTTPHandler represents the HTTP handler to use for the HTTP server implementation.
@@ -76,6 +107,239 @@ var AllSupportedHTTPHandlers = []HTTPHandler{
 	HandlerChi,
 }
 
+// LoadTestFormat represents the format of the load-testing scenarios generated from
+// the spec.
+type LoadTestFormat string
+
+const (
+	// LoadTestNone disables the generation of load-testing scenarios.
+	LoadTestNone LoadTestFormat = ""
+	// LoadTestK6 generates a k6 (https://k6.io) script, written to
+	// "<ent>/rest/loadtest.js".
+	LoadTestK6 LoadTestFormat = "k6"
+	// LoadTestVegeta generates a vegeta (https://github.com/tsenart/vegeta) targets
+	// file, written to "<ent>/rest/loadtest.targets".
+	LoadTestVegeta LoadTestFormat = "vegeta"
+)
+
+// AllSupportedLoadTestFormats is a list of all supported load-testing formats.
+var AllSupportedLoadTestFormats = []LoadTestFormat{
+	LoadTestNone,
+	LoadTestK6,
+	LoadTestVegeta,
+}
+
+// GatewayFormat represents the format of the API gateway configuration generated from
+// the spec.
+type GatewayFormat string
+
+const (
+	// GatewayKong generates a Kong (https://konghq.com) declarative configuration,
+	// written to "<ent>/rest/gateway.kong.yaml".
+	GatewayKong GatewayFormat = "kong"
+	// GatewayAWS generates a copy of the spec with AWS API Gateway integration
+	// extensions (x-amazon-apigateway-integration), written to
+	// "<ent>/rest/gateway.aws.json".
+	GatewayAWS GatewayFormat = "aws"
+)
+
+// AllSupportedGatewayFormats is a list of all supported API gateway formats.
+var AllSupportedGatewayFormats = []GatewayFormat{
+	GatewayKong,
+	GatewayAWS,
+}
+
+// ChangelogFormat represents the format of the changelog generated from the differences
+// between the previous and current generation of the spec.
+type ChangelogFormat string
+
+const (
+	// ChangelogNone disables the generation of the changelog.
+	ChangelogNone ChangelogFormat = ""
+	// ChangelogMarkdown generates a markdown changelog, written to
+	// "<ent>/rest/CHANGELOG.generated.md".
+	ChangelogMarkdown ChangelogFormat = "markdown"
+	// ChangelogJSON generates a JSON changelog, written to
+	// "<ent>/rest/changelog.generated.json".
+	ChangelogJSON ChangelogFormat = "json"
+)
+
+// AllSupportedChangelogFormats is a list of all supported changelog formats.
+var AllSupportedChangelogFormats = []ChangelogFormat{
+	ChangelogNone,
+	ChangelogMarkdown,
+	ChangelogJSON,
+}
+
+// PayloadSizeAction represents what happens to responses which exceed the byte budget
+// of [PayloadSizes].
+type PayloadSizeAction string
+
+const (
+	// PayloadSizeWarn sends responses which exceed the budget as-is, and reports them
+	// through the generated ServerConfig.ResponseBudgetExceeded. This is the default.
+	PayloadSizeWarn PayloadSizeAction = "warn"
+	// PayloadSizeReject replaces responses which exceed the budget with a 500 "Internal
+	// Server Error" (the generated ErrResponseTooLarge), rather than truncating them, which
+	// would result in invalid JSON. Responses are buffered up to the budget.
+	PayloadSizeReject PayloadSizeAction = "reject"
+)
+
+// AllSupportedPayloadSizeActions is a list of all supported payload size actions.
+var AllSupportedPayloadSizeActions = []PayloadSizeAction{
+	PayloadSizeWarn,
+	PayloadSizeReject,
+}
+
+// VersionNegotiation represents the way in which clients select the API version (see
+// [Config.Versions]) of requests.
+type VersionNegotiation string
+
+const (
+	// VersionNegotiationPath selects the version through a path prefix, e.g. "/v1/pets".
+	// This is the default.
+	VersionNegotiationPath VersionNegotiation = ""
+	// VersionNegotiationHeader selects the version through the [VersionHeader] request
+	// header. Unsupported versions return "406 Not Acceptable".
+	VersionNegotiationHeader VersionNegotiation = "header"
+	// VersionNegotiationMediaType selects the version through vendor media types (see
+	// [Config.MediaTypeVendor]) in the Accept header, per entity, e.g.
+	// "application/vnd.myapp.pet.v2+json". Unsupported versions return "406 Not
+	// Acceptable", and responses use the requested media type.
+	VersionNegotiationMediaType VersionNegotiation = "media-type"
+)
+
+// AllSupportedVersionNegotiations is a list of all supported version negotiations.
+var AllSupportedVersionNegotiations = []VersionNegotiation{
+	VersionNegotiationPath,
+	VersionNegotiationHeader,
+	VersionNegotiationMediaType,
+}
+
+// VersionHeader is the request header which selects the API version of requests when
+// using [VersionNegotiationHeader].
+const VersionHeader = "Api-Version"
+
+// Flavor represents the conventions which list operations follow (parameter names,
+// response structure, and pagination semantics).
+type Flavor string
+
+const (
+	// FlavorDefault uses page-number based pagination ("page" and "per_page"), the
+	// "sort" and "order" parameters, a parameter per filter (e.g. "name.eq"), and
+	// paginated responses with the results in the "content" field. This is the default.
+	FlavorDefault Flavor = ""
+	// FlavorAIP follows the list semantics of Google AIP-132, AIP-158 and AIP-160, with
+	// token based pagination ("page_size" and "page_token"), the "order_by" parameter,
+	// a single "filter" expression parameter, and paginated responses with the results
+	// in a field named after the collection (e.g. "pets"), as well as the
+	// "next_page_token" and "total_size" fields. Note that page tokens are opaque to
+	// clients, but are an encoded page number which is resolved using an offset, so they
+	// have the same cost and consistency trade-offs as page-number based pagination, and
+	// are not keyset cursors (see [WithSeekPagination], which isn't supported with this
+	// flavor).
+	FlavorAIP Flavor = "aip"
+	// FlavorOData supports a minimal subset of the OData v4 system query options:
+	// "$filter", "$orderby", "$top", "$skip", "$count" and "$select" (requires
+	// [Config.ReadMask]), and paginated responses with the results in the "value" field,
+	// as well as the "@odata.count" (if requested) and "@odata.nextLink" fields.
+	FlavorOData Flavor = "odata"
+)
+
+// AllSupportedFlavors is a list of all supported flavors.
+var AllSupportedFlavors = []Flavor{
+	FlavorDefault,
+	FlavorAIP,
+	FlavorOData,
+}
+
+// EmptyEdges represents how eager-loaded list edges without any entities are encoded
+// in responses.
+type EmptyEdges string
+
+const (
+	// EmptyEdgesOmit omits eager-loaded list edges without any entities from responses.
+	// This is the default.
+	EmptyEdgesOmit EmptyEdges = ""
+	// EmptyEdgesArray encodes eager-loaded list edges without any entities as empty
+	// arrays.
+	EmptyEdgesArray EmptyEdges = "array"
+	// EmptyEdgesNull encodes eager-loaded list edges without any entities as null.
+	EmptyEdgesNull EmptyEdges = "null"
+)
+
+// AllSupportedEmptyEdges is a list of all supported encodings of empty edges.
+var AllSupportedEmptyEdges = []EmptyEdges{
+	EmptyEdgesOmit,
+	EmptyEdgesArray,
+	EmptyEdgesNull,
+}
+
+// PaginationField represents a field of paginated list responses (see
+// [Config.PaginationSchema]).
+type PaginationField string
+
+const (
+	// PaginationFieldPage is the page which the results are associated with.
+	PaginationFieldPage PaginationField = "page"
+	// PaginationFieldLastPage is the number of the last page of results.
+	PaginationFieldLastPage PaginationField = "last_page"
+	// PaginationFieldIsFirstPage is true if the results are the first page of results.
+	PaginationFieldIsFirstPage PaginationField = "is_first_page"
+	// PaginationFieldIsLastPage is true if the results are the last page of results.
+	PaginationFieldIsLastPage PaginationField = "is_last_page"
+	// PaginationFieldTotalCount is the total number of results.
+	PaginationFieldTotalCount PaginationField = "total_count"
+	// PaginationFieldContent is the results of the page.
+	PaginationFieldContent PaginationField = "content"
+	// PaginationFieldPerPage is the number of results per page. Only included when
+	// provided in [Config.PaginationSchema].
+	PaginationFieldPerPage PaginationField = "per_page"
+	// PaginationFieldNextPage is the number of the next page of results, or null if the
+	// results are the last page of results. Only included when provided in
+	// [Config.PaginationSchema].
+	PaginationFieldNextPage PaginationField = "next_page"
+	// PaginationFieldPreviousPage is the number of the previous page of results, or null
+	// if the results are the first page of results. Only included when provided in
+	// [Config.PaginationSchema].
+	PaginationFieldPreviousPage PaginationField = "previous_page"
+)
+
+// AllPaginationFields is a list of all supported pagination fields.
+var AllPaginationFields = []PaginationField{
+	PaginationFieldPage,
+	PaginationFieldLastPage,
+	PaginationFieldIsFirstPage,
+	PaginationFieldIsLastPage,
+	PaginationFieldTotalCount,
+	PaginationFieldContent,
+	PaginationFieldPerPage,
+	PaginationFieldNextPage,
+	PaginationFieldPreviousPage,
+}
+
+// CreateResponse represents the response of the create operation of a schema.
+type CreateResponse string
+
+const (
+	// CreateResponseBody responds with "201 Created", and the created entity in the
+	// response body. This is the default.
+	CreateResponseBody CreateResponse = ""
+	// CreateResponseLocation responds with "201 Created", and a "Location" header
+	// containing the path of the created entity, without a response body. Requires the
+	// read operation to be enabled on the schema.
+	CreateResponseLocation CreateResponse = "location"
+	// CreateResponseNoContent responds with "204 No Content", without a response body.
+	CreateResponseNoContent CreateResponse = "no-content"
+)
+
+// AllCreateResponses is a list of all supported create responses.
+var AllCreateResponses = []CreateResponse{
+	CreateResponseBody,
+	CreateResponseLocation,
+	CreateResponseNoContent,
+}
+
 type RequestHeaders map[string]*ogen.Parameter
 
 // Append merges the provided request headers into the current request headers, returning
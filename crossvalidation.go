@@ -0,0 +1,135 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// CrossValidation is a request-level validation rule of a schema, which spans multiple
+// fields (see [WithCrossValidation]).
+type CrossValidation struct {
+	// Description is the human-readable rule, e.g. "start_date must be before end_date",
+	// which is appended to the description of the create and update operations.
+	Description string
+
+	// Validator is the name of the method of the generated CrossValidator interface,
+	// which enforces the rule, e.g. "ValidateEventDates".
+	Validator string
+}
+
+// crossValidationOperations are the operations which cross validations apply to.
+var crossValidationOperations = []Operation{OperationCreate, OperationUpdate}
+
+// GetCrossValidations returns the cross validations of the provided type (see
+// [WithCrossValidation]).
+func GetCrossValidations(t *gen.Type) []*CrossValidation {
+	return GetAnnotation(t).CrossValidations
+}
+
+// hasCrossValidations returns true if the provided type has cross validations, which
+// are enforced in create and update requests.
+func hasCrossValidations(t *gen.Type) bool {
+	ta := GetAnnotation(t)
+	return len(ta.CrossValidations) > 0 && !ta.GetSkip(GetConfig(t.Config)) && !IsReadOnly(t)
+}
+
+// getCrossValidators returns the (unique) names of the validators of all cross
+// validations of the provided types, which are the methods of the generated
+// CrossValidator interface. Validators can be shared by multiple schemas.
+func getCrossValidators(nodes []*gen.Type) (validators []string) {
+	for _, t := range nodes {
+		if !hasCrossValidations(t) {
+			continue
+		}
+
+		for _, cv := range GetCrossValidations(t) {
+			if !slices.Contains(validators, cv.Validator) {
+				validators = append(validators, cv.Validator)
+			}
+		}
+	}
+	return validators
+}
+
+// getCrossValidatorRules returns the descriptions of the cross validations of
+// the provided types which use the provided validator.
+func getCrossValidatorRules(nodes []*gen.Type, validator string) (descriptions []string) {
+	for _, t := range nodes {
+		if !hasCrossValidations(t) {
+			continue
+		}
+
+		for _, cv := range GetCrossValidations(t) {
+			if cv.Validator == validator {
+				descriptions = append(descriptions, fmt.Sprintf("%s: %s", t.Name, cv.Description))
+			}
+		}
+	}
+	return descriptions
+}
+
+// addCrossValidationRules appends the rules of the cross validations of the provided
+// type (see [WithCrossValidation]) to the description of the provided operation.
+func addCrossValidationRules(t *gen.Type, op Operation, oper *ogen.Operation) {
+	if oper == nil || !slices.Contains(crossValidationOperations, op) || !hasCrossValidations(t) {
+		return
+	}
+
+	rules := make([]string, len(GetCrossValidations(t)))
+	for i, cv := range GetCrossValidations(t) {
+		rules[i] = "- " + cv.Description
+	}
+
+	oper.Description = strings.TrimSpace(fmt.Sprintf(
+		"%s\n\nValidation rules:\n%s",
+		oper.Description,
+		strings.Join(rules, "\n"),
+	))
+}
+
+// validateCrossValidations checks that the cross validations of the provided type (see
+// [WithCrossValidation]) have a description and an exported validator name, and that
+// the type has operations which they apply to.
+func validateCrossValidations(cfg *Config, ta *Annotation) (errs []error) {
+	if len(ta.CrossValidations) == 0 {
+		return nil
+	}
+
+	if !slices.ContainsFunc(ta.GetOperations(cfg), func(op Operation) bool {
+		return slices.Contains(crossValidationOperations, op)
+	}) {
+		errs = append(errs, errors.New("cross validations require the create or update operation"))
+	}
+
+	var validators []string
+	for _, cv := range ta.CrossValidations {
+		if cv == nil {
+			errs = append(errs, errors.New("cross validation must not be nil"))
+			continue
+		}
+
+		if strings.TrimSpace(cv.Description) == "" {
+			errs = append(errs, fmt.Errorf("cross validation %q has no description", cv.Validator))
+		}
+
+		if !computedFieldResolverRegex.MatchString(cv.Validator) {
+			errs = append(errs, fmt.Errorf("cross validation validator %q must be an exported Go identifier", cv.Validator))
+			continue
+		}
+
+		if slices.Contains(validators, cv.Validator) {
+			errs = append(errs, fmt.Errorf("cross validation validator %q is used multiple times", cv.Validator))
+		}
+		validators = append(validators, cv.Validator)
+	}
+	return errs
+}
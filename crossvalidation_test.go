@@ -0,0 +1,67 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_CrossValidations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("description", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(
+					t, g, "Pet",
+					WithCrossValidation("age must be set for dogs", "ValidatePetAge"),
+					WithCrossValidation("name must not match the owner name", "ValidatePetName"),
+				)
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+
+		rules := "Validation rules:\n- age must be set for dogs\n- name must not match the owner name"
+		assert.Contains(t, r.json(`$.paths./pets.post.description`), rules)
+		assert.Contains(t, r.json(`$.paths./pets/{petID}.patch.description`), rules)
+		assert.NotContains(t, r.json(`$.paths./pets.get.description`), "Validation rules")
+		assert.NotContains(t, r.json(`$.paths./users.post.description`), "Validation rules")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			annotations []Annotation
+			contains    string
+		}{
+			{[]Annotation{WithCrossValidation("", "ValidatePet")}, `cross validation "ValidatePet" has no description`},
+			{[]Annotation{WithCrossValidation("foo", "validatePet")}, `validator "validatePet" must be an exported Go identifier`},
+			{
+				[]Annotation{WithCrossValidation("foo", "ValidatePet"), WithCrossValidation("bar", "ValidatePet")},
+				`validator "ValidatePet" is used multiple times`,
+			},
+			{
+				[]Annotation{WithCrossValidation("foo", "ValidatePet"), WithExcludeOperations(OperationCreate, OperationUpdate)},
+				"cross validations require the create or update operation",
+			},
+		} {
+			_, err := buildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, "Pet", tt.annotations...)
+					return ValidateAnnotations(g.Nodes...)
+				},
+			})
+			require.ErrorContains(t, err, tt.contains)
+		}
+	})
+}
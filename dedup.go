@@ -0,0 +1,159 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ogen-go/ogen"
+)
+
+// minSharedProperties is the minimum number of properties which must be shared between
+// the schema variants of an entity, before they are factored into a shared schema.
+const minSharedProperties = 2
+
+// schemaVariantSuffixes are the suffixes of the schema variants of an entity, which
+// are factored into a shared schema with the entity schema itself (if any).
+var schemaVariantSuffixes = []string{"Create", "Update"}
+
+// DeduplicateSchemas applies a deduplication pass to the component schemas of the
+// provided spec (see [Config.DeduplicateSchemas]):
+//   - Properties which are identical between an entity schema (e.g. "Pet") and its
+//     create/update schemas (e.g. "PetCreate" and "PetUpdate") are factored into a
+//     "<Entity>Fields" schema. Each variant is replaced with an allOf, referencing the
+//     shared schema, and containing its own properties and required properties.
+//   - Component schemas which are identical to another component schema are replaced
+//     with a reference to it (the first by name).
+//
+// Only object schemas with properties, and without references or compositions, are
+// factored. Variants which share fewer than two properties, or where the shared
+// schema name is already taken, are left as-is.
+func DeduplicateSchemas(spec *ogen.Spec) {
+	if spec.Components == nil || len(spec.Components.Schemas) == 0 {
+		return
+	}
+
+	schemas := spec.Components.Schemas
+
+	for _, k := range mapKeys(schemas) {
+		if slices.ContainsFunc(schemaVariantSuffixes, func(s string) bool { return strings.HasSuffix(k, s) }) {
+			continue
+		}
+
+		names := []string{k}
+		for _, suffix := range schemaVariantSuffixes {
+			names = append(names, k+suffix)
+		}
+
+		factorSchemas(schemas, k+"Fields", names)
+	}
+
+	// Replace identical schemas with references to the first schema (by name) with
+	// the same contents.
+	seen := map[string]string{}
+	for _, k := range mapKeys(schemas) {
+		s := schemas[k]
+		if s == nil || s.Ref != "" {
+			continue
+		}
+
+		b, err := json.Marshal(s)
+		if err != nil {
+			panic(fmt.Sprintf("failed to marshal schema %q: %v", k, err))
+		}
+
+		if orig, ok := seen[string(b)]; ok {
+			schemas[k] = &ogen.Schema{Ref: "#/components/schemas/" + orig}
+			continue
+		}
+		seen[string(b)] = k
+	}
+}
+
+// isFactorableSchema returns true if the provided schema is a plain object schema with
+// properties, which can be factored into a shared schema.
+func isFactorableSchema(s *ogen.Schema) bool {
+	return s != nil &&
+		s.Ref == "" &&
+		s.Type == "object" &&
+		len(s.Properties) > 0 &&
+		s.AdditionalProperties == nil &&
+		len(s.AllOf) == 0 &&
+		len(s.OneOf) == 0 &&
+		len(s.AnyOf) == 0
+}
+
+// factorSchemas factors the properties shared between all of the provided component
+// schemas (which exist, and are factorable) into a new component schema with the
+// provided name.
+func factorSchemas(schemas map[string]*ogen.Schema, name string, names []string) {
+	if _, ok := schemas[name]; ok {
+		return
+	}
+
+	var variants []string
+	for _, n := range names {
+		if isFactorableSchema(schemas[n]) {
+			variants = append(variants, n)
+		}
+	}
+
+	if len(variants) < 2 {
+		return
+	}
+
+	var shared ogen.Properties
+	for _, prop := range schemas[variants[0]].Properties {
+		if !slices.ContainsFunc(variants[1:], func(n string) bool {
+			return slices.ContainsFunc(schemas[n].Properties, func(p ogen.Property) bool {
+				return p.Name == prop.Name && jsonEqual(p.Schema, prop.Schema)
+			})
+		}) {
+			continue
+		}
+
+		shared = append(shared, prop)
+	}
+
+	if len(shared) < minSharedProperties {
+		return
+	}
+
+	schemas[name] = &ogen.Schema{
+		Description: fmt.Sprintf("Fields shared by the %s schemas.", strings.Join(variants, ", ")),
+		Type:        "object",
+		Properties:  shared,
+	}
+
+	for _, n := range variants {
+		orig := schemas[n]
+
+		diff := &ogen.Schema{
+			Type:     "object",
+			Required: orig.Required,
+		}
+
+		for _, prop := range orig.Properties {
+			if !slices.ContainsFunc(shared, func(p ogen.Property) bool { return p.Name == prop.Name }) {
+				diff.Properties = append(diff.Properties, prop)
+			}
+		}
+
+		factored := *orig
+		factored.Type = ""
+		factored.Properties = nil
+		factored.Required = nil
+		factored.AllOf = []*ogen.Schema{{Ref: "#/components/schemas/" + name}}
+
+		if len(diff.Properties) > 0 || len(diff.Required) > 0 {
+			factored.AllOf = append(factored.AllOf, diff)
+		}
+
+		schemas[n] = &factored
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_DeduplicateSchemas(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Nil(t, r.json(`$.components.schemas.PetFields`))
+		assert.Equal(t, "object", r.json(`$.components.schemas.PetCreate.type`))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		orig := mustBuildSpec(t, &Config{})
+		r := mustBuildSpec(t, &Config{DeduplicateSchemas: true})
+
+		assert.Equal(t, "object", r.json(`$.components.schemas.PetFields.type`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetFields.properties.name`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetFields.properties.nicknames`))
+		assert.Nil(t, r.json(`$.components.schemas.PetFields.properties.id`))
+
+		for _, name := range []string{"Pet", "PetCreate", "PetUpdate"} {
+			assert.Equal(t, "#/components/schemas/PetFields", r.json(`$.components.schemas.`+name+`.allOf[0].$ref`), name)
+			assert.Nil(t, r.json(`$.components.schemas.`+name+`.properties`), name)
+		}
+
+		// Differences and required properties stay with each variant.
+		assert.Equal(t, "integer", r.json(`$.components.schemas.Pet.allOf[1].properties.id.type`))
+		assert.ElementsMatch(t, []any{"id", "name"}, r.json(`$.components.schemas.Pet.allOf[1].required`))
+		assert.Equal(t, "name", r.json(`$.components.schemas.PetCreate.allOf[1].required[0]`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetUpdate.allOf[1].properties.add_categories`))
+
+		origSize, err := json.Marshal(orig.spec)
+		require.NoError(t, err)
+		newSize, err := json.Marshal(r.spec)
+		require.NoError(t, err)
+		assert.Less(t, len(newSize), len(origSize))
+	})
+
+	t.Run("identical", func(t *testing.T) {
+		t.Parallel()
+
+		spec := &ogen.Spec{
+			Components: &ogen.Components{
+				Schemas: map[string]*ogen.Schema{
+					"A": ogen.String().SetDescription("foo"),
+					"B": ogen.String().SetDescription("foo"),
+					"C": ogen.String().SetDescription("bar"),
+				},
+			},
+		}
+
+		DeduplicateSchemas(spec)
+
+		assert.Equal(t, "string", spec.Components.Schemas["A"].Type)
+		assert.Equal(t, "#/components/schemas/A", spec.Components.Schemas["B"].Ref)
+		assert.Equal(t, "string", spec.Components.Schemas["C"].Type)
+	})
+}
@@ -0,0 +1,157 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// Deprecation is the deprecation schedule of a schema or edge (see [WithDeprecation]).
+type Deprecation struct {
+	// Since is when the schema or edge was deprecated, used for the "Deprecation"
+	// response header.
+	Since time.Time
+
+	// Sunset is when the schema or edge will be removed, used for the "Sunset" response
+	// header.
+	Sunset time.Time
+}
+
+// DeprecationHeader returns the value of the "Deprecation" response header (RFC 9745),
+// e.g. "@1688169599".
+func (d *Deprecation) DeprecationHeader() string {
+	return "@" + strconv.FormatInt(d.Since.Unix(), 10)
+}
+
+// SunsetHeader returns the value of the "Sunset" response header (RFC 8594), e.g.
+// "Sat, 31 Dec 2025 23:59:59 GMT".
+func (d *Deprecation) SunsetHeader() string {
+	return d.Sunset.UTC().Format(http.TimeFormat)
+}
+
+// validate checks that both dates of the schedule are provided, and that the sunset
+// is after the deprecation.
+func (d *Deprecation) validate() error {
+	if d == nil {
+		return nil
+	}
+
+	if d.Since.IsZero() || d.Sunset.IsZero() {
+		return errors.New("deprecation requires both a deprecation and sunset date")
+	}
+
+	if !d.Sunset.After(d.Since) {
+		return fmt.Errorf(
+			"sunset date %s must be after the deprecation date %s",
+			d.Sunset.Format(time.DateOnly),
+			d.Since.Format(time.DateOnly),
+		)
+	}
+	return nil
+}
+
+// getDeprecation returns the deprecation schedule (see [WithDeprecation]) of the
+// operations of the provided type, or if provided, the operations of the edge, which
+// are deprecated if either the type, the edge, or the type the edge points to is. If
+// multiple are deprecated, the one with the earliest sunset is returned. Returns nil if
+// there is no schedule.
+func getDeprecation(t *gen.Type, e *gen.Edge) (d *Deprecation) {
+	candidates := []*Deprecation{GetAnnotation(t).Deprecation}
+	if e != nil {
+		candidates = append(candidates, GetAnnotation(e).Deprecation, GetAnnotation(e.Type).Deprecation)
+	}
+
+	for _, c := range candidates {
+		if c != nil && (d == nil || c.Sunset.Before(d.Sunset)) {
+			d = c
+		}
+	}
+	return d
+}
+
+// hasDeprecations returns true if any of the provided types, or their edges, have
+// a deprecation schedule (see [WithDeprecation]).
+func hasDeprecations(nodes []*gen.Type) bool {
+	for _, t := range nodes {
+		if GetAnnotation(t).Deprecation != nil {
+			return true
+		}
+		for _, e := range t.Edges {
+			if GetAnnotation(e).Deprecation != nil {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// wrapDeprecation wraps the provided handler (Go expression) of the provided operation,
+// setting the deprecation response headers, and recording the usage of the operation,
+// if it has a deprecation schedule (see [WithDeprecation]).
+func wrapDeprecation(d *Deprecation, operationID, handler string) string {
+	if d == nil {
+		return handler
+	}
+	return fmt.Sprintf(
+		"withDeprecation(s, %q, %q, %q, %s)",
+		operationID,
+		d.DeprecationHeader(),
+		d.SunsetHeader(),
+		handler,
+	)
+}
+
+// addDeprecationHeaders documents the deprecation response headers on all responses
+// of all operations within the provided spec, if there is a deprecation schedule.
+func addDeprecationHeaders(spec *ogen.Spec, d *Deprecation) {
+	if d == nil {
+		return
+	}
+
+	for pathName, pathItem := range spec.Paths {
+		spec.Paths[pathName] = PatchPathItem(pathItem, func(resp *ogen.Response) *ogen.Response {
+			if resp.Ref != "" {
+				return resp
+			}
+
+			if resp.Headers == nil {
+				resp.Headers = make(map[string]*ogen.Header)
+			}
+
+			resp.Headers["Deprecation"] = &ogen.Header{
+				Description: "The date the operation was deprecated (RFC 9745).",
+				Schema:      &ogen.Schema{Type: "string", Example: jsonschema.RawValue(strconv.Quote(d.DeprecationHeader()))},
+			}
+			resp.Headers["Sunset"] = &ogen.Header{
+				Description: "The date the operation will be removed (RFC 8594).",
+				Schema:      &ogen.Schema{Type: "string", Example: jsonschema.RawValue(strconv.Quote(d.SunsetHeader()))},
+			}
+			return resp
+		})
+	}
+}
+
+// validateDeprecations checks the deprecation schedules (see [WithDeprecation]) of the
+// provided type and its edges.
+func validateDeprecations(t *gen.Type) (errs []error) {
+	if err := GetAnnotation(t).Deprecation.validate(); err != nil {
+		errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+	}
+
+	for _, e := range t.Edges {
+		if err := GetAnnotation(e).Deprecation.validate(); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
+		}
+	}
+	return errs
+}
@@ -0,0 +1,68 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_Deprecation(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2025, 6, 30, 0, 0, 0, 0, time.UTC)
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Category", WithDeprecation(since, sunset))
+			return nil
+		},
+	})
+
+	list := r.spec.Paths["/categories"].Get
+	require.NotNil(t, list)
+	assert.True(t, list.Deprecated)
+	require.Contains(t, list.Responses["200"].Headers, "Deprecation")
+	require.Contains(t, list.Responses["200"].Headers, "Sunset")
+	assert.JSONEq(t, `"@1704067200"`, string(list.Responses["200"].Headers["Deprecation"].Schema.Example))
+	assert.JSONEq(t, `"Mon, 30 Jun 2025 00:00:00 GMT"`, string(list.Responses["200"].Headers["Sunset"].Schema.Example))
+
+	// Edges to the deprecated schema are also deprecated.
+	edge := r.spec.Paths["/pets/{petID}/categories"].Get
+	require.NotNil(t, edge)
+	assert.Contains(t, edge.Responses["200"].Headers, "Sunset")
+
+	pets := r.spec.Paths["/pets"].Get
+	require.NotNil(t, pets)
+	assert.NotContains(t, pets.Responses["200"].Headers, "Sunset")
+}
+
+func TestDeprecation(t *testing.T) {
+	t.Parallel()
+
+	since := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sunset := time.Date(2025, 6, 30, 12, 0, 0, 0, time.FixedZone("", 2*60*60))
+
+	d := &Deprecation{Since: since, Sunset: sunset}
+	assert.Equal(t, "@1704067200", d.DeprecationHeader())
+	assert.Equal(t, "Mon, 30 Jun 2025 10:00:00 GMT", d.SunsetHeader())
+	require.NoError(t, d.validate())
+
+	assert.Equal(t, "handler", wrapDeprecation(nil, "listPets", "handler"))
+	assert.Equal(
+		t,
+		`withDeprecation(s, "listPets", "@1704067200", "Mon, 30 Jun 2025 10:00:00 GMT", handler)`,
+		wrapDeprecation(d, "listPets", "handler"),
+	)
+
+	require.ErrorContains(t, (&Deprecation{Since: since}).validate(), "requires both")
+	require.ErrorContains(t, (&Deprecation{Since: sunset, Sunset: since}).validate(), "must be after")
+}
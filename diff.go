@@ -0,0 +1,227 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/ogen-go/ogen"
+)
+
+// SpecDiff is a human-readable summary of the differences between two specs, with
+// routes in the "<METHOD> <path>" format, and schema fields in the "<schema>.<field>"
+// format. All slices are sorted.
+type SpecDiff struct {
+	AddedRoutes   []string `json:"added_routes,omitempty"`
+	RemovedRoutes []string `json:"removed_routes,omitempty"`
+	ChangedRoutes []string `json:"changed_routes,omitempty"`
+
+	AddedSchemas   []string `json:"added_schemas,omitempty"`
+	RemovedSchemas []string `json:"removed_schemas,omitempty"`
+
+	AddedFields   []string `json:"added_fields,omitempty"`
+	RemovedFields []string `json:"removed_fields,omitempty"`
+	ChangedFields []string `json:"changed_fields,omitempty"`
+}
+
+// IsEmpty returns true if there are no differences.
+func (d *SpecDiff) IsEmpty() bool {
+	return len(d.AddedRoutes) == 0 && len(d.RemovedRoutes) == 0 && len(d.ChangedRoutes) == 0 &&
+		len(d.AddedSchemas) == 0 && len(d.RemovedSchemas) == 0 &&
+		len(d.AddedFields) == 0 && len(d.RemovedFields) == 0 && len(d.ChangedFields) == 0
+}
+
+// String returns a human-readable plan of the differences, similar to the output of
+// tools like "terraform plan".
+func (d *SpecDiff) String() string {
+	if d.IsEmpty() {
+		return "No changes. The generated API matches the existing spec.\n"
+	}
+
+	var sb strings.Builder
+
+	section := func(title, prefix string, values []string) {
+		if len(values) == 0 {
+			return
+		}
+		fmt.Fprintf(&sb, "%s:\n", title)
+		for _, v := range values {
+			fmt.Fprintf(&sb, "  %s %s\n", prefix, v)
+		}
+	}
+
+	section("Routes added", "+", d.AddedRoutes)
+	section("Routes removed", "-", d.RemovedRoutes)
+	section("Routes changed", "~", d.ChangedRoutes)
+	section("Schemas added", "+", d.AddedSchemas)
+	section("Schemas removed", "-", d.RemovedSchemas)
+	section("Schema fields added", "+", d.AddedFields)
+	section("Schema fields removed", "-", d.RemovedFields)
+	section("Schema fields changed", "~", d.ChangedFields)
+
+	fmt.Fprintf(
+		&sb,
+		"\nPlan: %d route(s) to add, %d to change, %d to remove.\n",
+		len(d.AddedRoutes), len(d.ChangedRoutes), len(d.RemovedRoutes),
+	)
+	return sb.String()
+}
+
+// DiffSpecs compares two specs, returning the routes and schema fields which were
+// added, removed or changed between oldSpec and newSpec. oldSpec may be nil, in which
+// case everything in newSpec is considered to be added.
+func DiffSpecs(oldSpec, newSpec *ogen.Spec) *SpecDiff {
+	return DiffManifests(NewSpecManifest(oldSpec), NewSpecManifest(newSpec))
+}
+
+// SpecManifest is a compact summary of the routes and schema fields of a spec, which is
+// enough to compare specs (see [DiffManifests]), without having to retain the full spec.
+type SpecManifest struct {
+	// Routes maps each route ("<METHOD> <path>") to a hash of its operation.
+	Routes map[string]string `json:"routes"`
+
+	// Schemas maps each component schema to its fields, and each field to a hash of
+	// its schema.
+	Schemas map[string]map[string]string `json:"schemas"`
+}
+
+// NewSpecManifest returns the manifest of the provided spec, which may be nil.
+func NewSpecManifest(spec *ogen.Spec) *SpecManifest {
+	m := &SpecManifest{
+		Routes:  map[string]string{},
+		Schemas: map[string]map[string]string{},
+	}
+
+	if spec == nil {
+		return m
+	}
+
+	for route, op := range specRoutes(spec) {
+		m.Routes[route] = jsonHash(op)
+	}
+
+	for name, schema := range specSchemas(spec) {
+		fields := map[string]string{}
+		if schema != nil {
+			for _, prop := range schema.Properties {
+				fields[prop.Name] = jsonHash(prop.Schema)
+			}
+		}
+		m.Schemas[name] = fields
+	}
+	return m
+}
+
+// DiffManifests compares two spec manifests (see [DiffSpecs]). oldManifest may be nil,
+// in which case everything in newManifest is considered to be added.
+func DiffManifests(oldManifest, newManifest *SpecManifest) *SpecDiff {
+	if oldManifest == nil {
+		oldManifest = NewSpecManifest(nil)
+	}
+	if newManifest == nil {
+		newManifest = NewSpecManifest(nil)
+	}
+
+	d := &SpecDiff{}
+
+	for _, route := range mapKeys(newManifest.Routes) {
+		oldHash, ok := oldManifest.Routes[route]
+		if !ok {
+			d.AddedRoutes = append(d.AddedRoutes, route)
+			continue
+		}
+		if oldHash != newManifest.Routes[route] {
+			d.ChangedRoutes = append(d.ChangedRoutes, route)
+		}
+	}
+
+	for _, route := range mapKeys(oldManifest.Routes) {
+		if _, ok := newManifest.Routes[route]; !ok {
+			d.RemovedRoutes = append(d.RemovedRoutes, route)
+		}
+	}
+
+	for _, name := range mapKeys(newManifest.Schemas) {
+		oldFields, ok := oldManifest.Schemas[name]
+		if !ok {
+			d.AddedSchemas = append(d.AddedSchemas, name)
+			continue
+		}
+
+		newFields := newManifest.Schemas[name]
+
+		for _, field := range mapKeys(newFields) {
+			oldHash, ok := oldFields[field]
+			switch {
+			case !ok:
+				d.AddedFields = append(d.AddedFields, name+"."+field)
+			case oldHash != newFields[field]:
+				d.ChangedFields = append(d.ChangedFields, name+"."+field)
+			}
+		}
+
+		for _, field := range mapKeys(oldFields) {
+			if _, ok := newFields[field]; !ok {
+				d.RemovedFields = append(d.RemovedFields, name+"."+field)
+			}
+		}
+	}
+
+	for _, name := range mapKeys(oldManifest.Schemas) {
+		if _, ok := newManifest.Schemas[name]; !ok {
+			d.RemovedSchemas = append(d.RemovedSchemas, name)
+		}
+	}
+	return d
+}
+
+// specRoutes returns all operations within the spec, keyed by "<METHOD> <path>".
+func specRoutes(spec *ogen.Spec) map[string]*ogen.Operation {
+	routes := map[string]*ogen.Operation{}
+	for path, item := range spec.Paths {
+		if item == nil {
+			continue
+		}
+		PatchOperations(item, func(method string, op *ogen.Operation) *ogen.Operation {
+			if op != nil {
+				routes[method+" "+path] = op
+			}
+			return op
+		})
+	}
+	return routes
+}
+
+// specSchemas returns all component schemas within the spec.
+func specSchemas(spec *ogen.Spec) map[string]*ogen.Schema {
+	if spec.Components == nil || spec.Components.Schemas == nil {
+		return map[string]*ogen.Schema{}
+	}
+	return spec.Components.Schemas
+}
+
+// jsonEqual returns true if both values marshal to the same JSON.
+func jsonEqual(a, b any) bool {
+	ab, aerr := json.Marshal(a)
+	bb, berr := json.Marshal(b)
+	return aerr == nil && berr == nil && bytes.Equal(ab, bb)
+}
+
+// jsonHash returns a (truncated) hash of the JSON encoding of the provided value, or an
+// empty string if it can't be encoded.
+func jsonHash(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:8])
+}
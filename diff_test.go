@@ -0,0 +1,57 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffSpecs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("no-changes", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		d := DiffSpecs(r.spec, r.spec)
+		assert.True(t, d.IsEmpty())
+		assert.Contains(t, d.String(), "No changes")
+	})
+
+	t.Run("nil-old", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		d := DiffSpecs(nil, r.spec)
+		assert.Contains(t, d.AddedRoutes, "GET /pets")
+		assert.Contains(t, d.AddedSchemas, "Pet")
+		assert.Empty(t, d.RemovedRoutes)
+	})
+
+	t.Run("changes", func(t *testing.T) {
+		t.Parallel()
+
+		oldSpec := mustBuildSpec(t, &Config{}).spec
+		newSpec := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithExcludeOperations(OperationDelete))
+				injectAnnotations(t, g, "Pet.age", WithSkip(true))
+				injectAnnotations(t, g, "Pet", WithOperationSummary(OperationList, "foo"))
+				return nil
+			},
+		}).spec
+
+		d := DiffSpecs(oldSpec, newSpec)
+		assert.False(t, d.IsEmpty())
+		assert.Contains(t, d.RemovedRoutes, "DELETE /pets/{petID}")
+		assert.Contains(t, d.ChangedRoutes, "GET /pets")
+		assert.Contains(t, d.RemovedFields, "Pet.age")
+		assert.Contains(t, d.String(), "- DELETE /pets/{petID}")
+	})
+}
@@ -0,0 +1,81 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// dryRunOperations are the operations which support dry runs (see [WithDryRun]).
+var dryRunOperations = []Operation{OperationCreate, OperationUpdate, OperationDelete}
+
+// supportsDryRun returns true if the provided operation of the provided type supports
+// dry runs (see [WithDryRun]).
+func supportsDryRun(t *gen.Type, op Operation) bool {
+	ta := GetAnnotation(t)
+	return ta.DryRun && slices.Contains(dryRunOperations, op) && ta.HasOperation(GetConfig(t.Config), op)
+}
+
+// hasDryRun returns true if any operation of the provided types supports dry runs.
+func hasDryRun(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return slices.ContainsFunc(dryRunOperations, func(op Operation) bool {
+			return supportsDryRun(t, op)
+		})
+	})
+}
+
+// wrapDryRun wraps the provided handler (Go expression) of the provided operation, so
+// requests with the "dry_run" query parameter are rolled back, if the schema supports
+// dry runs (see [WithDryRun]).
+func wrapDryRun(t *gen.Type, op Operation, handler string) string {
+	if !supportsDryRun(t, op) {
+		return handler
+	}
+	return fmt.Sprintf("withDryRun(s, Operation%s, %s)", PascalCase(string(op)), handler)
+}
+
+// addDryRunParameter adds the "dry_run" query parameter to the provided operation, if
+// the schema supports dry runs of the operation (see [WithDryRun]).
+func addDryRunParameter(spec *ogen.Spec, t *gen.Type, op Operation, oper *ogen.Operation) {
+	if oper == nil || !supportsDryRun(t, op) {
+		return
+	}
+
+	if spec.Components == nil {
+		spec.Components = &ogen.Components{}
+	}
+	if spec.Components.Parameters == nil {
+		spec.Components.Parameters = map[string]*ogen.Parameter{}
+	}
+
+	spec.Components.Parameters["DryRun"] = &ogen.Parameter{
+		Name: "dry_run",
+		In:   "query",
+		Description: "If true, the request is validated and executed (including hooks and constraint " +
+			"checks) within a transaction which is rolled back, responding with the would-be result.",
+		Schema: &ogen.Schema{Type: "boolean", Default: jsonschema.RawValue(`false`)},
+	}
+	oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/DryRun"})
+}
+
+// validateDryRun checks that a schema with dry runs (see [WithDryRun]) has at least
+// one operation which supports them.
+func validateDryRun(cfg *Config, t *gen.Type, ta *Annotation) []error {
+	if !ta.DryRun || ta.GetSkip(cfg) {
+		return nil
+	}
+
+	if !slices.ContainsFunc(dryRunOperations, func(op Operation) bool { return supportsDryRun(t, op) }) {
+		return []error{errors.New("dry runs are only supported on schemas with create, update or delete operations")}
+	}
+	return nil
+}
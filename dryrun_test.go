@@ -0,0 +1,35 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_DryRun(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithDryRun(true), WithExcludeOperations(OperationDelete))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "dry_run", r.json(`$.components.parameters.DryRun.name`))
+	assert.Equal(t, "boolean", r.json(`$.components.parameters.DryRun.schema.type`))
+
+	dryRun := &ogen.Parameter{Ref: "#/components/parameters/DryRun"}
+
+	assert.Contains(t, r.spec.Paths["/pets"].Post.Parameters, dryRun)
+	assert.Contains(t, r.spec.Paths["/pets/{petID}"].Patch.Parameters, dryRun)
+	assert.NotContains(t, r.spec.Paths["/pets"].Get.Parameters, dryRun)
+	assert.NotContains(t, r.spec.Paths["/pets/{petID}"].Get.Parameters, dryRun)
+	assert.NotContains(t, r.spec.Paths["/users"].Post.Parameters, dryRun)
+}
@@ -0,0 +1,24 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"entgo.io/ent/entc/gen"
+)
+
+// getDTOEdges returns the edges of the provided type which are included in its response
+// DTO (see [Config.WithDTOs]), i.e. all edges which aren't skipped, and don't point to
+// a skipped type.
+func getDTOEdges(t *gen.Type) (edges []*gen.Edge) {
+	cfg := GetConfig(t.Config)
+
+	for _, e := range t.Edges {
+		if e.StructTag == `json:"-"` || GetAnnotation(e).GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg) {
+			continue
+		}
+		edges = append(edges, e)
+	}
+	return edges
+}
@@ -0,0 +1,42 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetDTOEdges(t *testing.T) {
+	t.Parallel()
+
+	var edges []string
+
+	_ = mustBuildSpec(t, &Config{
+		WithDTOs:       true,
+		ExcludeSchemas: []string{"Category"},
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.friends", WithSkip(true))
+
+			for _, n := range g.Nodes {
+				if n.Name != "Pet" {
+					continue
+				}
+				for _, e := range getDTOEdges(n) {
+					edges = append(edges, e.Name)
+				}
+			}
+			return nil
+		},
+	})
+
+	assert.Contains(t, edges, "owner")
+	assert.Contains(t, edges, "best_friend")
+	assert.NotContains(t, edges, "friends")    // Skipped.
+	assert.NotContains(t, edges, "categories") // Points to a skipped schema.
+}
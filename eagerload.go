@@ -0,0 +1,383 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// EagerLoadEdge is an edge which should be eager-loaded, including any nested edges
+// which should be eager-loaded along with it.
+type EagerLoadEdge struct {
+	// Edge is the edge to eager-load.
+	Edge *gen.Edge
+	// Path is the dot-separated path of the edge, relative to the root type (e.g.
+	// "pets.toys").
+	Path string
+	// Edges are the nested edges (of the edge type) to eager-load.
+	Edges []*EagerLoadEdge
+	// Fields are the fields of the edge type to load (in addition to the ID), or nil
+	// if all fields should be loaded (see [WithEagerLoadFields]).
+	Fields []*gen.Field
+	// Truncated is true if the nested edges of the edge were not loaded, as the edge
+	// type was already repeated within the path more than [Config.EagerLoadCycleDepth]
+	// times (e.g. "pets.owner", where owner is the root type).
+	Truncated bool
+}
+
+// Trimmed returns true if only a subset of the fields of the edge type should be loaded.
+func (e *EagerLoadEdge) Trimmed() bool {
+	return e.Fields != nil
+}
+
+// Walk returns the edge, and all nested edges (recursively), in depth-first order.
+func (e *EagerLoadEdge) Walk() []*EagerLoadEdge {
+	edges := []*EagerLoadEdge{e}
+	for _, child := range e.Edges {
+		edges = append(edges, child.Walk()...)
+	}
+	return edges
+}
+
+// GetEagerLoadEdges returns all edges of the provided type which should be eager-loaded,
+// including nested edges (see [WithEagerLoadDepth] and [WithEagerLoadEdges]).
+func GetEagerLoadEdges(t *gen.Type) []*EagerLoadEdge {
+	cfg := GetConfig(t.Config)
+
+	var edges []*EagerLoadEdge
+
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+
+		if ea.GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg) || !ea.GetEagerLoad(cfg) {
+			continue
+		}
+
+		node := &EagerLoadEdge{Edge: e, Path: e.Name, Fields: eagerLoadFields(e)}
+		seen := []*gen.Type{t}
+
+		switch {
+		case eagerLoadCycle(cfg, seen, e.Type):
+			node.Truncated = ea.EagerLoadEdges != nil || ea.GetEagerLoadDepth() > 1
+		case ea.EagerLoadEdges != nil:
+			node.Edges = selectEagerLoadEdges(cfg, e.Type, e.Name, ea.EagerLoadEdges, append(seen, e.Type))
+		default:
+			node.Edges = depthEagerLoadEdges(cfg, e.Type, e.Name, ea.GetEagerLoadDepth()-1, append(seen, e.Type))
+		}
+
+		edges = append(edges, node)
+	}
+
+	return edges
+}
+
+// eagerLoadCycle returns true if the provided type has already been repeated within
+// the eager-load path (seen) more than [Config.EagerLoadCycleDepth] times, in which
+// case its nested edges should not be loaded.
+func eagerLoadCycle(cfg *Config, seen []*gen.Type, t *gen.Type) bool {
+	var count int
+	for _, s := range seen {
+		if s == t {
+			count++
+		}
+	}
+	return count > cfg.EagerLoadCycleDepth
+}
+
+// warnEagerLoadCycles writes a warning to [Config.WarningWriter] for each eager-loaded
+// edge (of the provided types) which was truncated due to a cycle.
+func warnEagerLoadCycles(cfg *Config, nodes []*gen.Type) error {
+	for _, t := range nodes {
+		if GetAnnotation(t).GetSkip(cfg) {
+			continue
+		}
+
+		for _, root := range GetEagerLoadEdges(t) {
+			for _, node := range root.Walk() {
+				if !node.Truncated {
+					continue
+				}
+
+				err := cfg.warnf(
+					"eager-load cycle detected on schema %s at %q (%s is repeated), nested edges are not loaded beyond this point (see Config.EagerLoadCycleDepth)",
+					t.Name,
+					node.Path,
+					node.Edge.Type.Name,
+				)
+				if err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// depthEagerLoadEdges returns all eager-loaded edges of the provided type, recursing
+// until the provided depth is reached, or a cycle is detected (see [eagerLoadCycle]).
+// seen contains all types within the path so far, including the provided type.
+func depthEagerLoadEdges(cfg *Config, t *gen.Type, prefix string, depth int, seen []*gen.Type) (edges []*EagerLoadEdge) {
+	if depth < 1 {
+		return nil
+	}
+
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+
+		if ea.GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg) || !ea.GetEagerLoad(cfg) {
+			continue
+		}
+
+		node := &EagerLoadEdge{Edge: e, Path: prefix + "." + e.Name, Fields: eagerLoadFields(e)}
+
+		if eagerLoadCycle(cfg, seen, e.Type) {
+			node.Truncated = depth > 1
+		} else {
+			node.Edges = depthEagerLoadEdges(cfg, e.Type, node.Path, depth-1, append(slices.Clip(seen), e.Type))
+		}
+
+		edges = append(edges, node)
+	}
+
+	return edges
+}
+
+// selectEagerLoadEdges returns the edges of the provided type which are selected by the
+// provided dot-separated paths. Paths which reference unknown or skipped edges are
+// ignored (see [ValidateAnnotationConflicts]). seen contains all types within the
+// path so far, including the provided type.
+func selectEagerLoadEdges(cfg *Config, t *gen.Type, prefix string, paths []string, seen []*gen.Type) (edges []*EagerLoadEdge) {
+	for _, e := range t.Edges {
+		if GetAnnotation(e).GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg) {
+			continue
+		}
+
+		var selected bool
+		var nested []string
+
+		for _, p := range paths {
+			name, rest, _ := strings.Cut(p, ".")
+			if name != e.Name {
+				continue
+			}
+
+			selected = true
+			if rest != "" {
+				nested = append(nested, rest)
+			}
+		}
+
+		if !selected {
+			continue
+		}
+
+		node := &EagerLoadEdge{Edge: e, Path: prefix + "." + e.Name, Fields: eagerLoadFields(e)}
+
+		if eagerLoadCycle(cfg, seen, e.Type) {
+			node.Truncated = len(nested) > 0
+		} else {
+			node.Edges = selectEagerLoadEdges(cfg, e.Type, node.Path, nested, append(slices.Clip(seen), e.Type))
+		}
+
+		edges = append(edges, node)
+	}
+
+	return edges
+}
+
+// eagerLoadFields returns the fields of the edge type which should be loaded when the
+// edge is eager-loaded, or nil if all fields should be loaded. Unknown fields and the
+// ID field are ignored (the ID is always loaded).
+func eagerLoadFields(e *gen.Edge) (fields []*gen.Field) {
+	ea := GetAnnotation(e)
+	if ea.EagerLoadFields == nil {
+		return nil
+	}
+
+	fields = []*gen.Field{}
+	for _, f := range e.Type.Fields {
+		if slices.Contains(ea.EagerLoadFields, f.Name) {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// findEdgePath returns the edge at the provided dot-separated path, relative to the
+// provided type, or nil if any edge in the path doesn't exist.
+func findEdgePath(t *gen.Type, path string) *gen.Edge {
+	var found *gen.Edge
+
+	for _, name := range strings.Split(path, ".") {
+		found = nil
+		for _, e := range t.Edges {
+			if e.Name == name {
+				found = e
+				break
+			}
+		}
+		if found == nil {
+			return nil
+		}
+		t = found.Type
+	}
+
+	return found
+}
+
+// isEagerLoadEdgeRequired returns true if the provided eager-loaded edge is always
+// included in the edges of responses. Optional edges without any entities are omitted,
+// unless configured otherwise (see [Config.EmptyEdges] and [Config.NullableEdges]).
+func isEagerLoadEdgeRequired(cfg *Config, e *gen.Edge) bool {
+	if e.Unique {
+		return !e.Optional || cfg.NullableEdges
+	}
+	return !e.Optional || cfg.EmptyEdges != EmptyEdgesOmit
+}
+
+// hasEdgesEncoding returns true if the eager-loaded edges of the provided type have to
+// be encoded explicitly, as list edges without any entities (see [Config.EmptyEdges]),
+// or unique edges without an entity (see [Config.NullableEdges]), aren't omitted.
+func hasEdgesEncoding(t *gen.Type) bool {
+	cfg := GetConfig(t.Config)
+
+	if GetAnnotation(t).GetSkip(cfg) {
+		return false
+	}
+
+	return slices.ContainsFunc(t.Edges, func(e *gen.Edge) bool {
+		if e.StructTag == `json:"-"` {
+			return false
+		}
+		if e.Unique {
+			return cfg.NullableEdges
+		}
+		return cfg.EmptyEdges != EmptyEdgesOmit
+	})
+}
+
+// eagerLoadProperty returns the property of an eager-loaded edge, for use within the
+// edges schema of the root type. If the edge has nested edges or restricted fields, a
+// dedicated schema for the edge is added to schemas.
+func eagerLoadProperty(cfg *Config, rootName string, node *EagerLoadEdge, schemas map[string]*ogen.Schema) ogen.Property {
+	e := node.Edge
+	ea := GetAnnotation(e)
+
+	prop := ogen.Property{
+		Name:   GetEdgeName(e.Owner, e, ""),
+		Schema: &ogen.Schema{Ref: "#/components/schemas/" + GetSchemaName(e.Type)},
+	}
+
+	if len(node.Edges) > 0 || node.Trimmed() {
+		base := prop.Schema
+		if node.Trimmed() {
+			base = trimmedSchema(cfg, e.Type, node.Fields, schemas)
+		}
+
+		name := rootName + "Edge" + PascalCase(strings.ReplaceAll(node.Path, ".", "_"))
+
+		if len(node.Edges) == 0 {
+			base.Description = fmt.Sprintf("A %s entity, only including a subset of fields.", GetSchemaName(e.Type))
+			schemas[name] = base
+		} else {
+			edgeSchema := &ogen.Schema{
+				Type:       "object",
+				Properties: ogen.Properties{},
+				Required:   []string{},
+			}
+
+			for _, child := range node.Edges {
+				if isEagerLoadEdgeRequired(cfg, child.Edge) {
+					edgeSchema.Required = append(edgeSchema.Required, GetEdgeName(child.Edge.Owner, child.Edge, ""))
+				}
+				edgeSchema.Properties = append(edgeSchema.Properties, eagerLoadProperty(cfg, rootName, child, schemas))
+			}
+
+			schemas[name] = &ogen.Schema{
+				Description: fmt.Sprintf("A %s entity, including the nested eager-loaded edges of %q.", GetSchemaName(e.Type), node.Path),
+				AllOf: []*ogen.Schema{
+					base,
+					{
+						Type: "object",
+						Properties: ogen.Properties{
+							{Name: "edges", Schema: edgeSchema},
+						},
+						Required: []string{"edges"},
+					},
+				},
+			}
+		}
+
+		prop.Schema = &ogen.Schema{Ref: "#/components/schemas/" + name}
+	}
+
+	if e.Unique && e.Optional && cfg.NullableEdges {
+		// "nullable" is ignored alongside "$ref", so the reference has to be wrapped.
+		prop.Schema = &ogen.Schema{AllOf: []*ogen.Schema{prop.Schema}, Nullable: true}
+	}
+
+	if !e.Unique {
+		prop.Schema = prop.Schema.AsArray()
+		prop.Schema.Nullable = cfg.EmptyEdges == EmptyEdgesNull
+
+		if limit := ea.GetEagerLoadLimit(cfg); limit > 0 {
+			prop.Schema.MinItems = ptr(uint64(0))
+			prop.Schema.MaxItems = ptr(uint64(limit))
+			prop.Schema.Description = fmt.Sprintf("A list of %s entities. Limited to %d items. If there are more results than the limit, the results are capped and you must use the associated edge endpoint with pagination -- see also the 'EagerLoadLimit' config option.", GetSchemaName(e.Type), limit)
+		}
+	}
+
+	return prop
+}
+
+// trimmedSchema returns an object schema for the provided type, which only includes
+// the ID and the provided fields.
+func trimmedSchema(cfg *Config, t *gen.Type, fields []*gen.Field, schemas map[string]*ogen.Schema) *ogen.Schema {
+	schema := &ogen.Schema{
+		Type:       "object",
+		Properties: ogen.Properties{},
+		Required:   []string{},
+	}
+
+	if t.ID != nil {
+		fieldSchema, err := GetSchemaID(t)
+		if err != nil {
+			panic(fmt.Sprintf("failed to generate schema for field %s: %v", t.ID.StructField(), err))
+		}
+		fieldSchema.Description = fmt.Sprintf("The ID of the %s entity.", GetSchemaName(t))
+		schema.Properties = append(schema.Properties, *fieldSchema.ToProperty("id"))
+		schema.Required = append(schema.Required, "id")
+	}
+
+	for _, f := range fields {
+		if GetAnnotation(f).GetSkip(cfg) || f.Sensitive() || !GetAnnotation(f).GetReadable() {
+			continue
+		}
+
+		fieldSchema, err := GetSchemaField(f)
+		if err != nil {
+			panic(fmt.Sprintf("failed to generate schema for field %s: %v", f.StructField(), err))
+		}
+
+		if updated, asRef, ref, ok := hoistEnums(t, f, fieldSchema); ok {
+			schemas[ref] = updated
+			schema.Properties = append(schema.Properties, ogen.Property{Name: GetFieldName(t, f), Schema: asRef})
+		} else {
+			schema.Properties = append(schema.Properties, *updated.ToProperty(GetFieldName(t, f)))
+		}
+
+		if !f.Optional {
+			schema.Required = append(schema.Required, GetFieldName(t, f))
+		}
+	}
+
+	applyTranslationProperties(cfg, t, schema)
+	return schema
+}
@@ -0,0 +1,128 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"bytes"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEagerLoad_Depth(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User.pets", WithEagerLoad(true))
+				injectAnnotations(t, g, "Pet.categories", WithEagerLoad(true))
+				return nil
+			},
+		})
+
+		assert.Contains(t, r.json(`$.components.schemas.UserEdges.properties.pets.items.$ref`), "/Pet")
+		assert.Nil(t, r.json(`$.components.schemas.UserEdgePets`))
+	})
+
+	t.Run("nested", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User.pets", WithEagerLoad(true), WithEagerLoadDepth(2))
+				injectAnnotations(t, g, "Pet.categories", WithEagerLoad(true))
+				return nil
+			},
+		})
+
+		assert.Contains(t, r.json(`$.components.schemas.UserEdges.properties.pets.items.$ref`), "/UserEdgePets")
+		assert.NotNil(t, r.json(`$.components.schemas.UserEdgePets.allOf[1].properties.edges.properties.categories`))
+		assert.Nil(t, r.json(`$.components.schemas.UserEdgePets.allOf[1].properties.edges.properties.owner`))
+	})
+}
+
+func TestEagerLoad_Edges(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		// "pets.friends" repeats Pet, which would otherwise be truncated as a cycle.
+		EagerLoadCycleDepth: 1,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.pets", WithEagerLoad(true), WithEagerLoadEdges("owner", "friends.categories"))
+			injectAnnotations(t, g, "Pet.categories", WithEagerLoad(true))
+			return nil
+		},
+	})
+
+	edges := `$.components.schemas.UserEdgePets.allOf[1].properties.edges.properties`
+
+	assert.NotNil(t, r.json(edges+`.owner`))
+	assert.Contains(t, r.json(edges+`.friends.items.$ref`), "/UserEdgePetsFriends")
+	assert.Nil(t, r.json(edges+`.categories`), "categories should not be loaded unless selected")
+	assert.NotNil(t, r.json(`$.components.schemas.UserEdgePetsFriends.allOf[1].properties.edges.properties.categories`))
+}
+
+func TestEagerLoad_Fields(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.owner", WithEagerLoad(true), WithEagerLoadFields("name", "type"))
+			return nil
+		},
+	})
+
+	assert.Contains(t, r.json(`$.components.schemas.PetEdges.properties.owner.$ref`), "/PetEdgeOwner")
+
+	owner := `$.components.schemas.PetEdgeOwner`
+	assert.NotNil(t, r.json(owner+`.properties.id`))
+	assert.NotNil(t, r.json(owner+`.properties.name`))
+	assert.Contains(t, r.json(owner+`.properties.type.$ref`), "/UserTypeEnum")
+	assert.Nil(t, r.json(owner+`.properties.email`))
+	assert.Nil(t, r.json(owner+`.properties.password_hashed`))
+
+	// The full schema should be unaffected.
+	assert.NotNil(t, r.json(`$.components.schemas.User.properties.email`))
+}
+
+func TestEagerLoad_Cycle(t *testing.T) {
+	t.Parallel()
+
+	hook := func(g *gen.Graph, _ *ogen.Spec) error {
+		injectAnnotations(t, g, "User.pets", WithEagerLoad(true), WithEagerLoadDepth(3))
+		injectAnnotations(t, g, "Pet.owner", WithEagerLoad(true))
+		return nil
+	}
+
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+
+		var warnings bytes.Buffer
+
+		r := mustBuildSpec(t, &Config{WarningWriter: &warnings, PreGenerateHook: hook})
+
+		edges := `$.components.schemas.UserEdgePets.allOf[1].properties.edges.properties`
+
+		assert.Equal(t, "#/components/schemas/User", r.json(edges+`.owner.$ref`))
+		assert.Nil(t, r.json(`$.components.schemas.UserEdgePetsOwner`))
+		assert.Contains(t, warnings.String(), `schema User at "pets.owner"`)
+	})
+
+	t.Run("cycle-depth", func(t *testing.T) {
+		t.Parallel()
+
+		var warnings bytes.Buffer
+
+		r := mustBuildSpec(t, &Config{EagerLoadCycleDepth: 1, WarningWriter: &warnings, PreGenerateHook: hook})
+
+		assert.Contains(t, r.json(`$.components.schemas.UserEdgePets.allOf[1].properties.edges.properties.owner.$ref`), "/UserEdgePetsOwner")
+		assert.NotContains(t, warnings.String(), `schema User at "pets.owner"`)
+	})
+}
@@ -0,0 +1,107 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"entgo.io/ent/entc/gen"
+)
+
+// entgqlAnnotationName is the name of the annotation of entgql (entgo.io/contrib/entgql),
+// which is read (without depending on entgql) when [Config.EntGQLDefaults] is enabled.
+const entgqlAnnotationName = "EntGQL"
+
+// Skip modes of entgql annotations (entgql.SkipMode), which is a bitmask.
+const (
+	entgqlSkipType                = 1 << 0
+	entgqlSkipOrderField          = 1 << 2
+	entgqlSkipMutationCreateInput = 1 << 4
+	entgqlSkipMutationUpdateInput = 1 << 5
+)
+
+// entgqlAnnotation is the subset of the entgql annotation used by entrest.
+type entgqlAnnotation struct {
+	OrderField      string
+	Skip            int
+	RelayConnection bool
+}
+
+// getEntGQLAnnotation returns the entgql annotation within the provided annotations, or
+// nil if not set.
+func getEntGQLAnnotation(annotations map[string]any) *entgqlAnnotation {
+	var a entgqlAnnotation
+	if !decodeExternalAnnotation(annotations, entgqlAnnotationName, &a) {
+		return nil
+	}
+	return &a
+}
+
+// applyEntGQLDefaults uses the entgql annotations of all schemas, fields and edges as
+// defaults for the entrest annotations (see [Config.EntGQLDefaults]):
+//
+//   - Schemas, fields and edges which are skipped in the GraphQL type (entgql.Skip) are
+//     skipped, as if [WithSkip] was provided, including all edges which point to
+//     skipped schemas.
+//   - Fields which are skipped in both the create and update mutation inputs are
+//     read-only, as if [WithReadOnly] was provided.
+//   - Schemas and edges with a Relay connection (entgql.RelayConnection) are paginated,
+//     as if [WithPagination] was provided.
+//   - Fields with an order field (entgql.OrderField) are sortable, as if [WithSortable]
+//     was provided.
+//
+// Annotations which are explicitly provided through entrest take precedence.
+func applyEntGQLDefaults(cfg *Config, g *gen.Graph) {
+	if !cfg.EntGQLDefaults {
+		return
+	}
+
+	skipped := map[string]bool{}
+
+	for _, t := range g.Nodes {
+		if ga := getEntGQLAnnotation(t.Annotations); ga != nil {
+			skipped[t.Name] = ga.Skip&entgqlSkipType != 0
+			t.Annotations = withAnnotation(t.Annotations, func(a *Annotation) {
+				a.Skip = a.Skip || ga.Skip&entgqlSkipType != 0
+				if a.Pagination == nil && ga.RelayConnection {
+					a.Pagination = ptr(true)
+				}
+			})
+		}
+
+		for _, f := range t.Fields {
+			ga := getEntGQLAnnotation(f.Annotations)
+			if ga == nil {
+				continue
+			}
+
+			f.Annotations = withAnnotation(f.Annotations, func(a *Annotation) {
+				a.Skip = a.Skip || ga.Skip&entgqlSkipType != 0
+				a.ReadOnly = a.ReadOnly || ga.Skip&(entgqlSkipMutationCreateInput|entgqlSkipMutationUpdateInput) ==
+					entgqlSkipMutationCreateInput|entgqlSkipMutationUpdateInput
+				a.Sortable = a.Sortable || (ga.OrderField != "" && ga.Skip&entgqlSkipOrderField == 0 && !a.Skip)
+			})
+		}
+	}
+
+	// Edges are handled separately, as edges to skipped schemas are also skipped (the
+	// same as entgql).
+	for _, t := range g.Nodes {
+		for _, e := range t.Edges {
+			ga := getEntGQLAnnotation(e.Annotations)
+			if ga == nil {
+				if !skipped[e.Type.Name] {
+					continue
+				}
+				ga = &entgqlAnnotation{}
+			}
+
+			e.Annotations = withAnnotation(e.Annotations, func(a *Annotation) {
+				a.Skip = a.Skip || ga.Skip&entgqlSkipType != 0 || skipped[e.Type.Name]
+				if a.Pagination == nil && ga.RelayConnection && !e.Unique {
+					a.Pagination = ptr(true)
+				}
+			})
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyEntGQLDefaults(t *testing.T) {
+	t.Parallel()
+
+	inject := func(g *gen.Graph, schema, name string, a map[string]any) {
+		for _, n := range g.Nodes {
+			if n.Name != schema {
+				continue
+			}
+			if name == "" {
+				n.Annotations.Set(entgqlAnnotationName, a)
+			}
+			for _, f := range n.Fields {
+				if f.Name == name {
+					f.Annotations.Set(entgqlAnnotationName, a)
+				}
+			}
+		}
+	}
+
+	build := func(enabled bool) *testSpecResult {
+		cfg := &Config{DisablePagination: true, EntGQLDefaults: enabled}
+		cfg.PreGenerateHook = func(g *gen.Graph, _ *ogen.Spec) error {
+			inject(g, "Pet", "", map[string]any{"RelayConnection": true})
+			inject(g, "Pet", "name", map[string]any{"OrderField": "NAME"})
+			inject(g, "Pet", "age", map[string]any{"Skip": entgqlSkipMutationCreateInput | entgqlSkipMutationUpdateInput})
+			inject(g, "User", "email", map[string]any{"Skip": 63})
+			inject(g, "Category", "", map[string]any{"Skip": entgqlSkipType})
+			applyEntGQLDefaults(cfg, g)
+			return nil
+		}
+		return mustBuildSpec(t, cfg)
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := build(true)
+
+		assert.NotNil(t, r.json(`$.components.schemas.PetList.allOf`))
+		assert.Contains(t, r.json(`$.components.schemas.PetSortableFields.enum`), "name")
+		assert.NotNil(t, r.json(`$.components.schemas.Pet.properties.age`))
+		assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.age`))
+		assert.Nil(t, r.json(`$.components.schemas.User.properties.email`))
+		assert.Nil(t, r.json(`$.paths./categories`))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := build(false)
+
+		assert.Nil(t, r.json(`$.components.schemas.PetList.allOf`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetCreate.properties.age`))
+		assert.NotNil(t, r.json(`$.components.schemas.User.properties.email`))
+		assert.NotNil(t, r.json(`$.paths./categories`))
+	})
+}
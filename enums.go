@@ -0,0 +1,184 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+var (
+	textMarshalerType   = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// IsIntEnum returns true if the provided field is an integer-backed enum, i.e. a
+// field.Enum with a GoType whose underlying type is an integer.
+func IsIntEnum(f *gen.Field) bool {
+	if !f.IsEnum() || f.Type.RType == nil {
+		return false
+	}
+
+	switch f.Type.RType.Kind {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasIntEnumValues returns true if the provided field is an integer-backed enum which
+// is exposed as integers, through [WithIntEnum].
+func HasIntEnumValues(f *gen.Field) bool {
+	return IsIntEnum(f) && len(GetAnnotation(f).IntEnumValues) > 0
+}
+
+// GetIntEnumValues returns the integer values of the provided integer-backed enum
+// field (see [WithIntEnum]), in the same order as the enum values of the field.
+func GetIntEnumValues(f *gen.Field) []int {
+	if !HasIntEnumValues(f) {
+		return nil
+	}
+
+	fa := GetAnnotation(f)
+	values := make([]int, 0, len(f.EnumValues()))
+	for _, v := range f.EnumValues() {
+		values = append(values, fa.IntEnumValues[v])
+	}
+	return values
+}
+
+// getIntEnumFields returns all integer-backed enum fields of the provided types,
+// which aren't skipped, with a single field for each distinct Go type.
+func getIntEnumFields(nodes []*gen.Type) (fields []*gen.Field) {
+	seen := map[string]struct{}{}
+
+	for _, t := range nodes {
+		cfg := GetConfig(t.Config)
+		if GetAnnotation(t).GetSkip(cfg) {
+			continue
+		}
+
+		for _, f := range t.Fields {
+			if !IsIntEnum(f) || GetAnnotation(f).GetSkip(cfg) {
+				continue
+			}
+
+			if _, ok := seen[f.Type.String()]; ok {
+				continue
+			}
+			seen[f.Type.String()] = struct{}{}
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// getSchemaEnum returns the schema of the provided enum field, which is either a
+// string enum, or an integer enum for integer-backed enums with [WithIntEnum].
+func getSchemaEnum(f *gen.Field) (schema *ogen.Schema, err error) {
+	if HasIntEnumValues(f) {
+		schema = ogen.Int()
+		schema.Enum, err = ToEnum(GetIntEnumValues(f))
+		return schema, err
+	}
+
+	schema = &ogen.Schema{Type: "string"}
+	schema.Enum, err = ToEnum(f.EnumValues())
+	return schema, err
+}
+
+// getEnumDescription returns a description of all values of the provided enum field,
+// including their descriptions (see [WithEnumDescriptions]), whether they are
+// deprecated (see [WithDeprecatedEnumValues]), and the enum value of integers (see
+// [WithIntEnum]). Returns an empty string if none of these are set.
+func getEnumDescription(f *gen.Field) string {
+	fa := GetAnnotation(f)
+
+	if !f.IsEnum() || (len(fa.EnumDescriptions) == 0 && len(fa.DeprecatedEnumValues) == 0 && !HasIntEnumValues(f)) {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString("Possible values:")
+
+	for _, v := range f.EnumValues() {
+		if HasIntEnumValues(f) {
+			fmt.Fprintf(&b, "\n  - `%d` (`%s`)", fa.IntEnumValues[v], v)
+		} else {
+			fmt.Fprintf(&b, "\n  - `%s`", v)
+		}
+
+		if slices.Contains(fa.DeprecatedEnumValues, v) {
+			b.WriteString(" **deprecated**")
+		}
+
+		if desc := fa.EnumDescriptions[v]; desc != "" {
+			b.WriteString(": " + desc)
+		}
+	}
+	return b.String()
+}
+
+// validateEnum checks that all enum annotations of the provided field reference
+// existing enum values, and that integer-backed enums can be exposed in the REST API.
+func validateEnum(f *gen.Field, fa *Annotation) (errs []error) {
+	if !f.IsEnum() {
+		if len(fa.EnumDescriptions) > 0 || len(fa.DeprecatedEnumValues) > 0 || len(fa.IntEnumValues) > 0 {
+			errs = append(errs, errors.New("enum annotations are set on a non-enum field"))
+		}
+		return errs
+	}
+
+	values := f.EnumValues()
+
+	for k := range fa.EnumDescriptions {
+		if !slices.Contains(values, k) {
+			errs = append(errs, fmt.Errorf("enum description provided for unknown enum value %q", k))
+		}
+	}
+
+	for _, v := range fa.DeprecatedEnumValues {
+		if !slices.Contains(values, v) {
+			errs = append(errs, fmt.Errorf("unknown enum value %q marked as deprecated", v))
+		}
+	}
+
+	if len(fa.IntEnumValues) > 0 {
+		if !IsIntEnum(f) {
+			errs = append(errs, errors.New("integer enum values are set on an enum which isn't integer-backed"))
+			return errs
+		}
+
+		for _, v := range values {
+			if _, ok := fa.IntEnumValues[v]; !ok {
+				errs = append(errs, fmt.Errorf("no integer value provided for enum value %q", v))
+			}
+		}
+
+		for k := range fa.IntEnumValues {
+			if !slices.Contains(values, k) {
+				errs = append(errs, fmt.Errorf("integer value provided for unknown enum value %q", k))
+			}
+		}
+		return errs
+	}
+
+	if IsIntEnum(f) && (!f.Type.RType.Implements(textMarshalerType) || !f.Type.RType.Implements(textUnmarshalerType)) {
+		errs = append(errs, fmt.Errorf(
+			"integer-backed enum type %s must either implement encoding.TextMarshaler and encoding.TextUnmarshaler, or have integer values provided through WithIntEnum",
+			f.Type.String(),
+		))
+	}
+	return errs
+}
@@ -0,0 +1,120 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withIntEnumField changes the Go type of the provided enum field to an integer-backed
+// type, optionally with encoding.TextMarshaler and encoding.TextUnmarshaler methods.
+func withIntEnumField(t *testing.T, g *gen.Graph, typeName, fieldName string, text bool) {
+	t.Helper()
+
+	rtype := &field.RType{
+		Name:    "Priority",
+		Ident:   "schema.Priority",
+		Kind:    reflect.Int,
+		PkgPath: "example.com/schema",
+		Methods: map[string]struct{ In, Out []*field.RType }{},
+	}
+
+	if text {
+		bytesType := &field.RType{Ident: "[]uint8", Kind: reflect.Slice}
+		errType := &field.RType{Name: "error", Ident: "error", Kind: reflect.Interface}
+
+		rtype.Methods["MarshalText"] = struct{ In, Out []*field.RType }{Out: []*field.RType{bytesType, errType}}
+		rtype.Methods["UnmarshalText"] = struct{ In, Out []*field.RType }{In: []*field.RType{bytesType}, Out: []*field.RType{errType}}
+	}
+
+	for _, n := range g.Nodes {
+		if n.Name != typeName {
+			continue
+		}
+		for _, f := range n.Fields {
+			if f.Name == fieldName {
+				typ := *f.Type // Type info may be shared between test graphs.
+				typ.RType = rtype
+				f.Type = &typ
+				return
+			}
+		}
+	}
+	t.Fatalf("failed to find field %q in type %q", fieldName, typeName)
+}
+
+func TestSpec_EnumDescriptions(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.type",
+				WithEnumDescriptions(map[string]string{"USER": "A regular user."}),
+				WithDeprecatedEnumValues("SYSTEM"),
+			)
+			return nil
+		},
+	})
+
+	desc, ok := r.json(`$.components.schemas.UserTypeEnum.description`).(string)
+	require.True(t, ok)
+	assert.Contains(t, desc, "Type of object being defined")
+	assert.Contains(t, desc, "- `SYSTEM` **deprecated**")
+	assert.Contains(t, desc, "- `USER`: A regular user.")
+	assert.Equal(t, "string", r.json(`$.components.schemas.UserTypeEnum.type`))
+}
+
+func TestSpec_IntEnum(t *testing.T) {
+	t.Parallel()
+
+	t.Run("int", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withIntEnumField(t, g, "User", "type", false)
+				injectAnnotations(t, g, "User.type", WithIntEnum(map[string]int{"SYSTEM": 1, "USER": 2}))
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+
+		assert.Equal(t, "integer", r.json(`$.components.schemas.UserTypeEnum.type`))
+		assert.Equal(t, []any{float64(1), float64(2)}, r.json(`$.components.schemas.UserTypeEnum.enum`))
+		assert.Contains(t, r.json(`$.components.schemas.UserTypeEnum.description`), "- `2` (`USER`)")
+	})
+
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withIntEnumField(t, g, "User", "type", true)
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.UserTypeEnum.type`))
+		assert.Equal(t, []any{"SYSTEM", "USER"}, r.json(`$.components.schemas.UserTypeEnum.enum`))
+	})
+	t.Run("missing-text-marshaler", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withIntEnumField(t, g, "User", "type", false)
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must either implement encoding.TextMarshaler")
+	})
+}
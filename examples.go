@@ -0,0 +1,359 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"regexp/syntax"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+const exampleString = "example"
+
+// exampleFormats maps OpenAPI string formats to deterministic example values.
+var exampleFormats = map[string]any{
+	"date-time": "2024-01-01T00:00:00Z",
+	"date":      "2024-01-01",
+	"time":      "00:00:00",
+	"uuid":      "2b1f4c6e-58d3-4b1a-9c2e-7f3d2a1b0c9d",
+	"byte":      "ZXhhbXBsZQ==",
+	"email":     "user@example.com",
+	"uri":       "https://example.com",
+	"hostname":  "example.com",
+	"ipv4":      "192.0.2.1",
+	"ipv6":      "2001:db8::1",
+}
+
+// exampleNames maps common (suffixes of) property names to deterministic example
+// values, which makes the examples a bit more realistic than a generic string.
+var exampleNames = []struct {
+	suffix string
+	value  string
+}{
+	{"email", "user@example.com"},
+	{"url", "https://example.com"},
+	{"uri", "https://example.com"},
+	{"domain", "example.com"},
+	{"hostname", "example.com"},
+	{"phone", "+15555550100"},
+	{"slug", "example-slug"},
+	{"name", "Example Name"},
+	{"description", "An example description."},
+}
+
+// GetExampleSchema returns a deterministic example value for the provided schema,
+// respecting any existing example or default, enums, formats, and length/range
+// constraints. name is the property name the schema is associated with (if any),
+// and is used to provide more realistic string examples. References cannot be
+// resolved, see [GetExamplePayload] if you need that.
+func GetExampleSchema(name string, s *ogen.Schema) any {
+	return exampleFromSchema(name, s, nil)
+}
+
+// exampleFromSchema is the underlying implementation of [GetExampleSchema], with
+// an optional set of component schemas to resolve references against.
+func exampleFromSchema(name string, s *ogen.Schema, components map[string]*ogen.Schema) any { //nolint:gocyclo,cyclop
+	if s == nil {
+		return nil
+	}
+
+	if s.Ref != "" {
+		if components == nil {
+			return nil
+		}
+		return exampleFromSchema(name, components[strings.TrimPrefix(s.Ref, "#/components/schemas/")], components)
+	}
+
+	for _, raw := range [][]byte{s.Example, s.Default} {
+		if len(raw) == 0 {
+			continue
+		}
+		var v any
+		if err := json.Unmarshal(raw, &v); err == nil {
+			return v
+		}
+	}
+
+	if len(s.Enum) > 0 {
+		var v any
+		if err := json.Unmarshal(s.Enum[0], &v); err == nil {
+			return v
+		}
+	}
+
+	if len(s.AllOf) > 0 {
+		out := map[string]any{}
+		for _, sub := range s.AllOf {
+			if v, ok := exampleFromSchema(name, sub, components).(map[string]any); ok {
+				for k := range v {
+					out[k] = v[k]
+				}
+			}
+		}
+		return out
+	}
+
+	for _, alt := range [][]*ogen.Schema{s.OneOf, s.AnyOf} {
+		if len(alt) > 0 {
+			return exampleFromSchema(name, alt[0], components)
+		}
+	}
+
+	switch s.Type {
+	case "boolean":
+		return true
+	case "integer":
+		return int64(clampExample(1, s))
+	case "number":
+		return clampExample(1.5, s)
+	case "string":
+		if v, ok := exampleFormats[s.Format]; ok {
+			return v
+		}
+		return exampleStringValue(name, s)
+	case "array":
+		if s.MaxItems != nil && *s.MaxItems == 0 {
+			return []any{}
+		}
+
+		var item *ogen.Schema
+		if s.Items != nil {
+			item = s.Items.Item
+			if item == nil && len(s.Items.Items) > 0 {
+				item = s.Items.Items[0]
+			}
+		}
+
+		n := uint64(1)
+		if s.MinItems != nil && *s.MinItems > n {
+			n = *s.MinItems
+		}
+
+		out := make([]any, 0, n)
+		for range n {
+			out = append(out, exampleFromSchema(Singularize(name), item, components))
+		}
+		return out
+	case "object", "":
+		if len(s.Properties) == 0 {
+			if s.Type == "" {
+				return nil
+			}
+			return map[string]any{}
+		}
+
+		out := map[string]any{}
+		for _, prop := range s.Properties {
+			if v := exampleFromSchema(prop.Name, prop.Schema, components); v != nil {
+				out[prop.Name] = v
+			}
+		}
+		return out
+	default:
+		return nil
+	}
+}
+
+// clampExample returns v, clamped to the minimum/maximum of the schema (if any).
+func clampExample(v float64, s *ogen.Schema) float64 {
+	if len(s.Minimum) > 0 {
+		if minimum, err := strconv.ParseFloat(string(s.Minimum), 64); err == nil && v < minimum {
+			v = minimum
+			if s.ExclusiveMinimum {
+				v++
+			}
+		}
+	}
+	if len(s.Maximum) > 0 {
+		if maximum, err := strconv.ParseFloat(string(s.Maximum), 64); err == nil && v > maximum {
+			v = maximum
+			if s.ExclusiveMaximum {
+				v--
+			}
+		}
+	}
+	if s.Type == "integer" {
+		return math.Trunc(v)
+	}
+	return v
+}
+
+// exampleStringValue returns a string example based on the property name, padded or
+// truncated to fit within the min/max length of the schema. If the schema has a
+// pattern which the example doesn't match, an example generated from the pattern is
+// returned instead (see [examplePattern]).
+func exampleStringValue(name string, s *ogen.Schema) string {
+	v := exampleString
+
+	lname := strings.ToLower(name)
+	for _, n := range exampleNames {
+		if strings.HasSuffix(lname, n.suffix) {
+			v = n.value
+			break
+		}
+	}
+
+	if s.MinLength != nil && uint64(len(v)) < *s.MinLength {
+		v += strings.Repeat("x", int(*s.MinLength)-len(v)) //nolint:gosec
+	}
+	if s.MaxLength != nil && uint64(len(v)) > *s.MaxLength {
+		v = v[:*s.MaxLength]
+	}
+
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil || re.MatchString(v) {
+			return v
+		}
+
+		if pv, ok := examplePattern(s.Pattern); ok && re.MatchString(pv) {
+			return pv
+		}
+	}
+	return v
+}
+
+// exampleRunes are the runes preferred when picking a rune from a character class,
+// so pattern examples are readable.
+const exampleRunes = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789-_"
+
+// examplePattern returns the shortest deterministic example string which matches the
+// provided (Go RE2 compatible) pattern, e.g. "AAA" for "^[A-Z]{3}$". Returns false if
+// the pattern can't be parsed.
+func examplePattern(pattern string) (string, bool) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return "", false
+	}
+
+	var sb strings.Builder
+	writeExamplePattern(&sb, re.Simplify())
+	return sb.String(), true
+}
+
+// writeExamplePattern writes the shortest example matching the provided regular
+// expression to sb.
+func writeExamplePattern(sb *strings.Builder, re *syntax.Regexp) {
+	switch re.Op { //nolint:exhaustive
+	case syntax.OpLiteral:
+		sb.WriteString(string(re.Rune))
+	case syntax.OpCharClass:
+		sb.WriteRune(exampleClassRune(re.Rune))
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		sb.WriteRune('a')
+	case syntax.OpCapture, syntax.OpPlus:
+		writeExamplePattern(sb, re.Sub[0])
+	case syntax.OpRepeat:
+		for range re.Min {
+			writeExamplePattern(sb, re.Sub[0])
+		}
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			writeExamplePattern(sb, sub)
+		}
+	case syntax.OpAlternate:
+		writeExamplePattern(sb, re.Sub[0])
+	}
+}
+
+// exampleClassRune returns a rune within the provided character class ranges (pairs of
+// inclusive lower and upper bounds), preferring [exampleRunes].
+func exampleClassRune(ranges []rune) rune {
+	for _, r := range exampleRunes {
+		for i := 0; i+1 < len(ranges); i += 2 {
+			if r >= ranges[i] && r <= ranges[i+1] {
+				return r
+			}
+		}
+	}
+
+	if len(ranges) == 0 {
+		return 'a'
+	}
+	return ranges[0]
+}
+
+// GetExampleField returns a deterministic example value for the provided field. If
+// the field has an example provided through [WithExample], it is returned as-is.
+func GetExampleField(f *gen.Field) (any, error) {
+	if fa := GetAnnotation(f); fa.Example != nil {
+		return fa.Example, nil
+	}
+
+	schema, err := GetSchemaField(f)
+	if err != nil {
+		return nil, err
+	}
+	return GetExampleSchema(f.Name, schema), nil
+}
+
+// GetExamplePayload returns a deterministic example JSON payload for the provided type
+// and operation (create, update or read), generated from the same schemas used within
+// the OpenAPI spec. This is the single source used for spec examples (see
+// [Config.WithExamples]) and the generated "rest.Example<Name>Create" fixtures, so mock
+// servers built from the spec and tests use the same data. Note that edge IDs are included as-is, and may need
+// to be replaced with IDs of existing entities.
+func GetExamplePayload(t *gen.Type, op Operation) (json.RawMessage, error) {
+	schemas := GetSchemaType(t, op, nil)
+
+	var name string
+	switch op {
+	case OperationCreate:
+		name = GetSchemaName(t) + "Create"
+	case OperationUpdate:
+		name = GetSchemaName(t) + "Update"
+	case OperationRead:
+		name = GetSchemaName(t)
+	default:
+		return nil, fmt.Errorf("unsupported operation %q for example payloads", op)
+	}
+
+	schema, ok := schemas[name]
+	if !ok {
+		return nil, fmt.Errorf("no schema %q generated for type %q", name, t.Name)
+	}
+
+	return json.Marshal(exampleFromSchema("", schema, schemas))
+}
+
+// addSchemaExamples adds examples to all properties of the provided schemas, if they
+// don't already have one. Properties which are references are skipped, as the
+// referenced schema will receive its own example.
+func addSchemaExamples(schemas map[string]*ogen.Schema) error {
+	var err error
+	for _, k := range mapKeys(schemas) {
+		s := schemas[k]
+
+		if s.Ref == "" && len(s.Example) == 0 && len(s.Enum) > 0 {
+			s.Example, err = json.Marshal(GetExampleSchema(k, s))
+			if err != nil {
+				return fmt.Errorf("failed to marshal example for schema %q: %w", k, err)
+			}
+		}
+
+		for i := range s.Properties {
+			ps := s.Properties[i].Schema
+			if ps == nil || ps.Ref != "" || len(ps.Example) > 0 {
+				continue
+			}
+			v := GetExampleSchema(s.Properties[i].Name, ps)
+			if v == nil {
+				continue
+			}
+			ps.Example, err = json.Marshal(v)
+			if err != nil {
+				return fmt.Errorf("failed to marshal example for property %q of schema %q: %w", s.Properties[i].Name, k, err)
+			}
+		}
+	}
+	return nil
+}
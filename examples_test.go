@@ -0,0 +1,116 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetExampleSchema(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		property string
+		schema   *ogen.Schema
+		want     any
+	}{
+		{name: "nil", schema: nil, want: nil},
+		{name: "ref", schema: &ogen.Schema{Ref: "#/components/schemas/Foo"}, want: nil},
+		{name: "bool", schema: ogen.Bool(), want: true},
+		{name: "int", schema: ogen.Int(), want: int64(1)},
+		{name: "int-min", schema: ogen.Int().SetMinimum(ptr(int64(5))), want: int64(5)},
+		{name: "int-max", schema: ogen.Int().SetMaximum(ptr(int64(-3))), want: int64(-3)},
+		{name: "number", schema: ogen.Float(), want: 1.5},
+		{name: "string", schema: ogen.String(), want: "example"},
+		{name: "string-format", schema: ogen.DateTime(), want: "2024-01-01T00:00:00Z"},
+		{name: "string-name", property: "contact_email", schema: ogen.String(), want: "user@example.com"},
+		{name: "string-min", schema: ogen.String().SetMinLength(ptr(uint64(10))), want: "examplexxx"},
+		{name: "string-max", schema: ogen.String().SetMaxLength(ptr(uint64(3))), want: "exa"},
+		{name: "string-pattern", schema: &ogen.Schema{Type: "string", Pattern: `^[A-Z]{3}$`}, want: "AAA"},
+		{name: "string-pattern-match", property: "slug", schema: &ogen.Schema{Type: "string", Pattern: `^[a-z-]+$`}, want: "example-slug"},
+		{name: "string-pattern-alternate", schema: &ogen.Schema{Type: "string", Pattern: `^(foo|bar)-\d+$`}, want: "foo-0"},
+		{name: "string-pattern-invalid", schema: &ogen.Schema{Type: "string", Pattern: `^[a-z`}, want: "example"},
+		{
+			name:   "enum",
+			schema: &ogen.Schema{Type: "string", Enum: []json.RawMessage{json.RawMessage(`"b"`), json.RawMessage(`"a"`)}},
+			want:   "b",
+		},
+		{name: "existing-example", schema: &ogen.Schema{Type: "integer", Example: ogen.ExampleValue(`42`)}, want: float64(42)},
+		{name: "array", property: "names", schema: ogen.String().AsArray(), want: []any{"Example Name"}},
+		{
+			name: "object",
+			schema: &ogen.Schema{
+				Type: "object",
+				Properties: ogen.Properties{
+					{Name: "id", Schema: ogen.Int()},
+					{Name: "name", Schema: ogen.String()},
+				},
+			},
+			want: map[string]any{"id": int64(1), "name": "Example Name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, GetExampleSchema(tt.property, tt.schema))
+		})
+	}
+}
+
+func TestGetExamplePayload(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	for _, n := range r.graph.Nodes {
+		if n.Name != "Pet" {
+			continue
+		}
+
+		first, err := GetExamplePayload(n, OperationCreate)
+		if err != nil {
+			t.Fatalf("failed to generate example payload: %v", err)
+		}
+
+		second, err := GetExamplePayload(n, OperationCreate)
+		if err != nil {
+			t.Fatalf("failed to generate example payload: %v", err)
+		}
+
+		assert.JSONEq(t, string(first), string(second))
+
+		var v map[string]any
+		if err = json.Unmarshal(first, &v); err != nil {
+			t.Fatalf("failed to decode example payload: %v", err)
+		}
+
+		assert.Equal(t, "Example Name", v["name"])
+		assert.Contains(t, v, "age")
+
+		if _, err = GetExamplePayload(n, OperationDelete); err == nil {
+			t.Error("expected error for unsupported operation")
+		}
+		return
+	}
+	t.Error("failed to find type 'Pet'")
+}
+
+func TestExampleHelpers(t *testing.T) {
+	t.Parallel()
+
+	files := renderGraph(t, &Config{Handler: HandlerStdlib, WithExamples: true}, nil)
+
+	require.Contains(t, files, "rest/examples.go")
+	assert.Contains(t, files["rest/examples.go"], "package rest")
+	assert.Contains(t, files["rest/examples.go"], "func ExamplePetCreate() map[string]any {")
+	assert.Contains(t, files["rest/examples.go"], "func ExamplePetUpdate() map[string]any {")
+}
@@ -8,9 +8,12 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
 	"slices"
+	"sync"
+	"time"
 
 	"entgo.io/ent/entc"
 	"entgo.io/ent/entc/gen"
@@ -23,7 +26,12 @@ var _ entc.Extension = (*Extension)(nil)
 type Extension struct {
 	entc.DefaultExtension
 
-	config *Config
+	config    *Config
+	overrides *gen.Template
+
+	// target is the name of the target being generated (see [Extension.GenerateTarget]),
+	// if any, for reporting.
+	target string
 }
 
 func NewExtension(config *Config) (*Extension, error) {
@@ -35,23 +43,74 @@ func NewExtension(config *Config) (*Extension, error) {
 		return nil, err
 	}
 
-	return &Extension{config: config}, nil
+	ext := &Extension{config: config}
+
+	if config.TemplateOverrides != nil {
+		var err error
+		ext.overrides, err = parseTemplateOverrides(config.TemplateOverrides)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ext, nil
 }
 
 func (e *Extension) Templates() []*gen.Template {
 	if e.config.Handler == HandlerNone {
 		return []*gen.Template{}
 	}
-	return []*gen.Template{
+	templates := []*gen.Template{
 		baseTemplates,
 		testingTemplates,
+		benchmarkTemplates,
 	}
+	templates = append(templates, featureTemplates...)
+
+	// Overrides must be last, so they replace any built-in templates.
+	if e.overrides != nil {
+		templates = append(templates, e.overrides)
+	}
+	return templates
 }
 
 func (e *Extension) Hooks() []gen.Hook {
 	return []gen.Hook{
 		func(next gen.Generator) gen.Generator {
 			return gen.GenerateFunc(func(g *gen.Graph) error {
+				if err := applyOutbox(e.config, g); err != nil {
+					return err
+				}
+
+				if err := applyHistory(e.config, g); err != nil {
+					return err
+				}
+
+				// After the outbox and history schemas are added, so they are sorted as well.
+				CanonicalizeGraph(g)
+
+				// Targets have to be generated before anything else, as the main generation
+				// modifies both the graph (schema filters) and the base spec.
+				if !e.config.DryRun {
+					if err := e.writeTargets(g); err != nil {
+						return err
+					}
+				}
+
+				// The config is no longer modified from this point on (including the code
+				// generation), and decoding it (and the annotations) for every lookup
+				// through the graph is expensive.
+				defer e.config.cacheDecoded(g)()
+
+				applyEntGQLDefaults(e.config, g)
+				applyFieldToggles(g)
+				applySchemaFilters(e.config, g)
+				applyReadOnlySchemas(e.config, g)
+				applyOperationGate(e.config, g)
+				applyTimeFormats(e.config, g)
+				applyNumericFormats(e.config, g)
+				applyFileFields(e.config, g)
+
 				if !e.config.DisablePatchJSONTag {
 					err := patchJSONTag(g)
 					if err != nil {
@@ -63,22 +122,82 @@ func (e *Extension) Hooks() []gen.Hook {
 		},
 		func(next gen.Generator) gen.Generator {
 			return gen.GenerateFunc(func(g *gen.Graph) error {
+				// The main spec documents the latest version (if any). It has to be
+				// generated first, as the main generation modifies the base spec.
+				var latest *ogen.Spec
+				if target := getLatestVersionTarget(e.config); target != nil {
+					var err error
+					latest, err = e.GenerateTarget(g, target)
+					if err != nil {
+						return err
+					}
+				}
+
 				spec, err := e.Generate(g)
 				if err != nil {
 					return err
 				}
 
+				if latest != nil {
+					spec = latest
+				}
+
+				if e.config.DryRun {
+					// Don't write the spec, or invoke the rest of the generators, as
+					// we don't want to write anything to disk.
+					return e.writePlan(g, spec)
+				}
+
 				err = e.writeSpec(g, spec)
 				if err != nil {
 					return err
 				}
-				return next.Generate(g)
+
+				if e.config.ReconcileSpecPath != "" {
+					err = writeReconcileSpec(e.config.ReconcileSpecPath, spec)
+					if err != nil {
+						return err
+					}
+				}
+
+				err = e.writeLoadTest(g, spec)
+				if err != nil {
+					return err
+				}
+
+				err = e.writeChangelog(g, spec)
+				if err != nil {
+					return err
+				}
+
+				err = e.writeGateways(g, spec)
+				if err != nil {
+					return err
+				}
+
+				err = e.writeProtoMapping(g)
+				if err != nil {
+					return err
+				}
+
+				start := time.Now()
+				err = next.Generate(g)
+				if err != nil {
+					return err
+				}
+				return writeCodegenTiming(e.config.TimingWriter, time.Since(start))
 			})
 		},
 	}
 }
 
 func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
+	timings := newGenerateTimings()
+	phaseStart := time.Now()
+
+	// Apply schema filters (no-op if they were already applied through the hooks).
+	applySchemaFilters(e.config, g)
+
 	// Validate all annotations first.
 	err := ValidateAnnotations(g.Nodes...)
 	if err != nil {
@@ -116,6 +235,42 @@ func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
 		}
 	}
 
+	// Applied after the pre-generate hook, as it may toggle additional fields, annotate
+	// additional schemas as read-only, or enable additional operations (no-op if they
+	// were already applied through the hooks).
+	applyFieldToggles(g)
+	applyReadOnlySchemas(e.config, g)
+	applyOperationGate(e.config, g)
+	applyTimeFormats(e.config, g)
+	applyNumericFormats(e.config, g)
+	applyFileFields(e.config, g)
+
+	// The config is no longer modified from this point on, and decoding it (and the
+	// annotations, which may have been replaced by the pre-generate hook) for every lookup
+	// through the graph is expensive.
+	defer e.config.cacheDecoded(g)()
+
+	// Also after the pre-generate hook, as it may enable additional eager-loading.
+	err = warnEagerLoadCycles(e.config, g.Nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write warnings: %w", err)
+	}
+
+	err = warnSensitiveFields(e.config, g.Nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write warnings: %w", err)
+	}
+
+	err = warnRandomSorting(e.config, g.Nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write warnings: %w", err)
+	}
+
+	err = warnAnnotationConflicts(e.config, g.Nodes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write warnings: %w", err)
+	}
+
 	// If they weren't provided, set some defaults which are required by OpenAPI,
 	// as well as most code-generators.
 	if spec.OpenAPI == "" {
@@ -128,64 +283,30 @@ func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
 		spec.Info.Version = "1.0.0"
 	}
 
-	var specs []*ogen.Spec
-	var tspec *ogen.Spec
-	var ops []Operation
-
-	for _, t := range g.Nodes {
-		ta := GetAnnotation(t)
-
-		if ta.GetSkip(e.config) {
-			continue
-		}
-
-		ops = ta.GetOperations(e.config)
-
-		for _, op := range ops {
-			if t.ID == nil && (op != OperationList && op != OperationCreate) {
-				continue
-			}
-			tspec, err = GetSpecType(t, op)
-			if err != nil {
-				panic(err)
-			}
-			specs = append(specs, tspec)
-		}
+	timings.phase("prepare", phaseStart)
+	phaseStart = time.Now()
 
-		if t.ID == nil {
-			continue
-		}
-
-		for _, edge := range t.Edges {
-			if edge.Type.ID == nil {
-				// It's a through-edge which has no individual ID, rather a composite ID,
-				// which likely shouldn't be queryable.
-				continue
-			}
-			ea := GetAnnotation(edge)
-
-			if ea.GetSkip(e.config) || !ea.GetEdgeEndpoint(e.config) {
-				continue
-			}
+	specs, err := e.getSpecsConcurrently(g.Nodes, timings)
+	if err != nil {
+		return nil, err
+	}
 
-			ops = ta.GetOperations(e.config)
+	timings.phase("schemas", phaseStart)
+	phaseStart = time.Now()
 
-			if edge.Unique && slices.Contains(ops, OperationRead) {
-				tspec, err = GetSpecEdge(t, edge, OperationRead)
-			}
-			if !edge.Unique && slices.Contains(ops, OperationList) {
-				tspec, err = GetSpecEdge(t, edge, OperationList)
-			}
+	if subSpec := GetSpecSubscriptions(e.config, g.Nodes); subSpec != nil {
+		specs = append(specs, subSpec)
+	}
 
-			if err != nil {
-				panic(err)
-			}
-			specs = append(specs, tspec)
-		}
+	var specPaths int
+	if batchSpec := GetSpecBatch(e.config); batchSpec != nil {
+		specs = append(specs, batchSpec)
+		specPaths++
 	}
 
-	if !e.config.DisableSpecHandler {
-		specs = append(specs, addOpenAPIEndpoint("/openapi.json"))
+	if !e.config.DisableSpecHandler && !e.config.DisableSpecOperations {
+		specs = append(specs, addOpenAPIEndpoints(e.config.SpecPaths))
+		specPaths += len(e.config.SpecPaths)
 	}
 
 	err = MergeSpecOverlap(spec, specs...)
@@ -193,7 +314,15 @@ func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
 		panic(err)
 	}
 
-	if (!e.config.DisableSpecHandler && len(spec.Paths) == 1) || (e.config.DisableSpecHandler && len(spec.Paths) == 0) {
+	err = addTagGroups(spec, g.Nodes)
+	if err != nil {
+		return nil, err
+	}
+
+	timings.phase("merge", phaseStart)
+	phaseStart = time.Now()
+
+	if len(spec.Paths) <= specPaths {
 		return nil, errors.New("spec generated no operations, thus no spec paths can be generated")
 	}
 
@@ -208,6 +337,47 @@ func (e *Extension) Generate(g *gen.Graph) (*ogen.Spec, error) {
 	addGlobalRequestHeaders(spec, e.config.GlobalRequestHeaders)
 	addGlobalResponseHeaders(spec, e.config.GlobalResponseHeaders)
 
+	exts := e.config.OperationExtensions
+	if e.config.OperationExtensionsFromPath != "" {
+		exts, err = loadOperationExtensions(e.config.OperationExtensionsFromPath)
+		if err != nil {
+			return nil, err
+		}
+		exts.merge(e.config.OperationExtensions)
+	}
+
+	err = addOperationExtensions(spec, exts)
+	if err != nil {
+		return nil, err
+	}
+
+	if e.config.DeduplicateSchemas {
+		DeduplicateSchemas(spec)
+	}
+
+	if e.config.ReconcileSpecPath != "" {
+		var existing *ogen.Spec
+		existing, err = loadReconcileSpec(e.config.ReconcileSpecPath)
+		if err != nil {
+			return nil, err
+		}
+
+		err = ReconcileSpec(existing, spec)
+		if err != nil {
+			return nil, fmt.Errorf("failed to reconcile spec from path %q: %w", e.config.ReconcileSpecPath, err)
+		}
+		spec = existing
+	}
+
+	CanonicalizeSpec(spec)
+
+	timings.phase("finalize", phaseStart)
+
+	err = timings.write(e.config.TimingWriter, e.target, e.config.Concurrency)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write timings: %w", err)
+	}
+
 	return spec, nil
 }
 
@@ -233,13 +403,384 @@ func (e *Extension) writeSpec(g *gen.Graph, spec *ogen.Spec) error {
 		defer f.Close()
 
 		e.config.Writer = f
+
+		if !e.config.DisableSpecHandler && hasYAMLSpecPath(e.config) {
+			err = writeSpecYAML(dir, spec)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
-	enc := json.NewEncoder(e.config.Writer)
-	enc.SetIndent("", "    ")
-	return enc.Encode(spec)
+	b, err := MarshalSpec(spec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spec: %w", err)
+	}
+
+	_, err = e.config.Writer.Write(b)
+	return err
+}
+
+// writePlan compares the generated spec with the existing spec on disk (if any), and
+// writes a human-readable plan of the differences.
+func (e *Extension) writePlan(g *gen.Graph, spec *ogen.Spec) error {
+	var existing *ogen.Spec
+
+	f, err := os.Open(filepath.Join(g.Target, "rest", "openapi.json"))
+	switch {
+	case err == nil:
+		defer f.Close()
+
+		existing = ogen.NewSpec()
+		if err = json.NewDecoder(f).Decode(existing); err != nil {
+			return fmt.Errorf("failed to decode existing spec: %w", err)
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return fmt.Errorf("failed to open existing spec: %w", err)
+	}
+
+	// Round-trip the generated spec through JSON, so both specs are compared in the
+	// same form they would be written in.
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spec: %w", err)
+	}
+
+	generated := ogen.NewSpec()
+	if err = json.Unmarshal(b, generated); err != nil {
+		return fmt.Errorf("failed to unmarshal spec: %w", err)
+	}
+
+	w := e.config.DryRunWriter
+	if w == nil {
+		w = os.Stdout
+	}
+
+	_, err = io.WriteString(w, DiffSpecs(existing, generated).String())
+	return err
+}
+
+func (e *Extension) writeLoadTest(g *gen.Graph, spec *ogen.Spec) error {
+	var fn string
+
+	switch e.config.LoadTest {
+	case LoadTestNone:
+		return nil
+	case LoadTestK6:
+		fn = "loadtest.js"
+	case LoadTestVegeta:
+		fn = "loadtest.targets"
+	}
+
+	if e.config.LoadTestWriter != nil {
+		return GenerateLoadTest(spec, e.config.LoadTest, e.config.LoadTestWriter)
+	}
+
+	dir := filepath.Join(g.Target, "rest")
+
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, fn), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	return GenerateLoadTest(spec, e.config.LoadTest, f)
+}
+
+func (e *Extension) writeChangelog(g *gen.Graph, spec *ogen.Spec) error {
+	if e.config.Changelog == ChangelogNone {
+		return nil
+	}
+
+	dir := filepath.Join(g.Target, "rest")
+
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	err = writeChangelog(dir, spec, e.config.Changelog, time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to write changelog: %w", err)
+	}
+	return nil
+}
+
+func (e *Extension) writeGateways(g *gen.Graph, spec *ogen.Spec) error {
+	if len(e.config.Gateways) == 0 {
+		return nil
+	}
+
+	dir := filepath.Join(g.Target, "rest")
+
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	for _, format := range e.config.Gateways {
+		var fn string
+
+		switch format {
+		case GatewayKong:
+			fn = "gateway.kong.yaml"
+		case GatewayAWS:
+			fn = "gateway.aws.json"
+		}
+
+		err = writeGateway(filepath.Join(dir, fn), spec, format, e.config.GatewayUpstream)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func writeGateway(path string, spec *ogen.Spec, format GatewayFormat, upstream string) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	return GenerateGateway(spec, format, upstream, f)
+}
+
+func (e *Extension) writeProtoMapping(g *gen.Graph) error {
+	if !e.config.ProtoInterop {
+		return nil
+	}
+
+	if e.config.ProtoMappingWriter != nil {
+		return GenerateProtoMapping(g.Nodes, e.config.ProtoMappingWriter)
+	}
+
+	dir := filepath.Join(g.Target, "rest")
+
+	err := os.MkdirAll(dir, 0o750)
+	if err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, "proto_mapping.json"), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to open file: %w", err)
+	}
+	defer f.Close()
+
+	return GenerateProtoMapping(g.Nodes, f)
 }
 
 func (e *Extension) Annotations() []entc.Annotation {
 	return []entc.Annotation{e.config}
 }
+
+// schemaSpecsResult is the result of generating the specs for a single schema.
+type schemaSpecsResult struct {
+	specs    []*ogen.Spec
+	duration time.Duration
+	err      error
+	panic    any
+}
+
+// getSpecsConcurrently generates the specs for all operations of the provided types (see
+// [Extension.getSchemaSpecs]), using up to [Config.Concurrency] workers. The specs are
+// returned in the order of the provided types, so the merged spec is deterministic.
+func (e *Extension) getSpecsConcurrently(nodes []*gen.Type, timings *generateTimings) ([]*ogen.Spec, error) {
+	results := make([]schemaSpecsResult, len(nodes))
+	jobs := make(chan int)
+
+	var wg sync.WaitGroup
+	for range max(1, min(e.config.Concurrency, len(nodes))) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = e.runSchemaSpecs(nodes[i])
+			}
+		}()
+	}
+
+	for i := range nodes {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	var specs []*ogen.Spec
+	for i, r := range results {
+		if r.panic != nil {
+			// Re-panic on the calling goroutine, the same as without workers.
+			panic(r.panic)
+		}
+		if r.err != nil {
+			return nil, fmt.Errorf("failed to generate spec for schema %s: %w", nodes[i].Name, r.err)
+		}
+		if len(r.specs) > 0 {
+			timings.schema(nodes[i].Name, r.duration, r.specs)
+		}
+		specs = append(specs, r.specs...)
+	}
+	return specs, nil
+}
+
+// runSchemaSpecs runs [Extension.getSchemaSpecs] for the provided type, recovering any
+// panics, so they can be raised on the calling goroutine.
+func (e *Extension) runSchemaSpecs(t *gen.Type) (result schemaSpecsResult) {
+	start := time.Now()
+	defer func() {
+		result.duration = time.Since(start)
+		if r := recover(); r != nil {
+			result.panic = r
+		}
+	}()
+
+	result.specs, result.err = e.getSchemaSpecs(t)
+	return result
+}
+
+// getSchemaSpecs generates the specs for all operations of the provided type, including
+// those of its edges, alternate keys, files, history, recycle bin and tree traversal.
+func (e *Extension) getSchemaSpecs(t *gen.Type) (specs []*ogen.Spec, err error) { // nolint:gocyclo,cyclop
+	ta := GetAnnotation(t)
+
+	if ta.GetSkip(e.config) {
+		return nil, nil
+	}
+
+	var tspec *ogen.Spec
+	ops := ta.GetOperations(e.config)
+
+	for _, op := range ops {
+		if !HasItemID(t) && (op != OperationList && op != OperationCreate) {
+			continue
+		}
+		tspec, err = GetSpecType(t, op)
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, nil))
+		if op == OperationRead || op == OperationList {
+			addLastModifiedHeaders(e.config, tspec, t, op)
+		}
+		addReadMaskParam(e.config, tspec, t)
+		addTranslationParams(e.config, tspec, t)
+		if op == OperationList {
+			renameODataSelectParam(e.config, tspec)
+		}
+		specs = append(specs, tspec)
+	}
+
+	if t.ID == nil {
+		return specs, nil
+	}
+
+	if edge := GetTreeEdge(t); edge != nil && slices.Contains(ops, OperationList) {
+		tspec, err = GetSpecTree(t, edge)
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, edge))
+		addLastModifiedHeaders(e.config, tspec, t, OperationList)
+		addReadMaskParam(e.config, tspec, t)
+		addTranslationParams(e.config, tspec, t)
+		specs = append(specs, tspec)
+	}
+
+	for _, f := range GetAlternateKeyFields(t) {
+		tspec, err = GetSpecAlternateKey(t, f)
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, nil))
+		addLastModifiedHeaders(e.config, tspec, t, OperationRead)
+		addReadMaskParam(e.config, tspec, t)
+		addTranslationParams(e.config, tspec, t)
+		specs = append(specs, tspec)
+	}
+
+	if hasHistory(t) {
+		tspec, err = GetSpecHistory(t)
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, nil))
+		specs = append(specs, tspec)
+	}
+
+	if hasSoftDelete(t) {
+		tspec, err = GetSpecSoftDelete(t)
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, nil))
+		specs = append(specs, tspec)
+	}
+
+	if hasAttachments(t) {
+		tspec, err = GetSpecAttachments(t)
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, nil))
+		specs = append(specs, tspec)
+	}
+
+	for _, f := range GetFileFields(t) {
+		if len(GetFileOperations(t, f)) == 0 {
+			continue
+		}
+
+		tspec, err = GetSpecFile(t, f)
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, nil))
+		specs = append(specs, tspec)
+	}
+
+	for _, edge := range t.Edges {
+		if edge.Type.ID == nil && !IsThroughEdge(edge) {
+			// It's an edge to a type which has no individual ID, rather a composite
+			// ID, which likely shouldn't be queryable. Edges to edge schemas (join
+			// tables) are the exception, as they expose the fields of the join table.
+			continue
+		}
+		ea := GetAnnotation(edge)
+
+		if ea.GetSkip(e.config) || !ea.GetEdgeEndpoint(e.config) {
+			continue
+		}
+
+		switch {
+		case edge.Unique && slices.Contains(ops, OperationRead):
+			tspec, err = GetSpecEdge(t, edge, OperationRead)
+		case !edge.Unique && slices.Contains(ops, OperationList):
+			tspec, err = GetSpecEdge(t, edge, OperationList)
+		default:
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		addDeprecationHeaders(tspec, getDeprecation(t, edge))
+		if edge.Unique {
+			addLastModifiedHeaders(e.config, tspec, edge.Type, OperationRead)
+		} else {
+			addLastModifiedHeaders(e.config, tspec, edge.Type, OperationList)
+		}
+		addReadMaskParam(e.config, tspec, edge.Type)
+		addTranslationParams(e.config, tspec, edge.Type)
+		if !edge.Unique {
+			renameODataSelectParam(e.config, tspec)
+		}
+		specs = append(specs, tspec)
+	}
+	return specs, nil
+}
@@ -7,7 +7,10 @@ package entrest
 import (
 	"encoding/json"
 	"fmt"
+	"go/parser"
+	"go/token"
 	"io"
+	"maps"
 	"os"
 	"path"
 	"path/filepath"
@@ -15,6 +18,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"testing/fstest"
 
 	"entgo.io/ent/entc/gen"
 	"entgo.io/ent/entc/load"
@@ -129,6 +133,14 @@ func buildSpec(t *testing.T, config *Config) (*testSpecResult, error) {
 		config.Writer = io.Discard
 	}
 
+	if config.ProtoMappingWriter == nil {
+		config.ProtoMappingWriter = io.Discard
+	}
+
+	if config.LoadTestWriter == nil {
+		config.LoadTestWriter = io.Discard
+	}
+
 	result := &testSpecResult{config: config}
 
 	config.PreWriteHook = func(s *ogen.Spec) error {
@@ -184,7 +196,7 @@ func buildSpec(t *testing.T, config *Config) (*testSpecResult, error) {
 
 	result.ensureObj = sync.OnceFunc(func() {
 		var b []byte
-		b, err = json.Marshal(result.spec)
+		b, err = MarshalSpec(result.spec)
 		if err != nil {
 			panic(fmt.Sprintf("failed to marshal spec: %v", err))
 		}
@@ -212,6 +224,72 @@ func buildSpec(t *testing.T, config *Config) (*testSpecResult, error) {
 	return result, nil
 }
 
+// renderGraph is similar to buildSpec, however, it runs the full code generation (ent,
+// and all templates of the extension) into a temporary directory, with the schemas in
+// the provided order, and returns the contents of the generated files, keyed by their
+// path relative to the target directory.
+func renderGraph(t *testing.T, config *Config, order func(schemas []*load.Schema)) map[string]string {
+	t.Helper()
+
+	if config == nil {
+		config = &Config{}
+	}
+
+	ext, err := NewExtension(config)
+	require.NoError(t, err)
+
+	specMutex.Lock()
+	defer specMutex.Unlock()
+
+	schema := integrationSchema()
+	target := t.TempDir()
+
+	gconfig := &gen.Config{
+		Target:      target,
+		Schema:      schema.PkgPath,
+		Package:     path.Dir(schema.PkgPath) + "/ent",
+		Hooks:       ext.Hooks(),
+		Templates:   ext.Templates(),
+		Annotations: gen.Annotations{},
+	}
+	for _, a := range ext.Annotations() {
+		gconfig.Annotations[a.Name()] = a
+	}
+
+	gconfig.Storage, err = gen.NewStorage("sql")
+	require.NoError(t, err)
+
+	schemas := slices.Clone(schema.Schemas)
+	if order != nil {
+		order(schemas)
+	}
+
+	graph, err := gen.NewGraph(gconfig, schemas...)
+	require.NoError(t, err)
+	require.NoError(t, graph.Gen())
+
+	files := map[string]string{}
+	err = filepath.WalkDir(target, func(fn string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+
+		b, err := os.ReadFile(fn)
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(target, fn)
+		if err != nil {
+			return err
+		}
+		files[filepath.ToSlash(rel)] = string(b)
+		return nil
+	})
+	require.NoError(t, err)
+	return files
+}
+
 // injectAnnotations injects the provided annotations into the provided schema path.
 // "Pet" means the Pet schema, "Pet.categories" means the categories edge on the Pet,
 // and "Pet.some_field" means the some_field field on the Pet schema.
@@ -229,6 +307,11 @@ func injectAnnotations(t *testing.T, g *gen.Graph, schemaPath string, annotation
 			return
 		}
 
+		if g.Nodes[i].ID != nil && g.Nodes[i].ID.Name == parts[1] {
+			g.Nodes[i].ID.Annotations = mergeAnnotations(t, g.Nodes[i].ID.Annotations, annotations...)
+			return
+		}
+
 		for j := range g.Nodes[i].Fields {
 			if g.Nodes[i].Fields[j].Name == parts[1] {
 				g.Nodes[i].Fields[j].Annotations = mergeAnnotations(t, g.Nodes[i].Fields[j].Annotations, annotations...)
@@ -253,8 +336,11 @@ func mergeAnnotations(t *testing.T, in gen.Annotations, annotations ...Annotatio
 	for _, a := range annotations { // nolint:gocritic
 		ant, _ = ant.Merge(a).(Annotation)
 	}
-	in.Set(ant.Name(), ant)
-	return in
+	// Annotations without an entrest annotation aren't replaced when they are cached (see
+	// [cacheAnnotations]), so they are still shared with the loaded schema.
+	out := maps.Clone(in)
+	out.Set(ant.Name(), ant)
+	return out
 }
 
 func validateSpec(t *testing.T, spec *ogen.Spec) {
@@ -297,3 +383,104 @@ func getPathMethods(t *testing.T, r *testSpecResult, endpoint string) (methods [
 	}
 	return methods
 }
+
+func TestExtension_TemplateOverrides(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		ext, err := NewExtension(&Config{
+			Handler: HandlerStdlib,
+			TemplateOverrides: fstest.MapFS{
+				"list.tmpl":        {Data: []byte(`{{ define "rest/list" }}{{ zpascal "foo_bar" }}{{ end }}`)},
+				"nested/foo.tmpl":  {Data: []byte(`{{ define "rest/foo" }}package rest{{ end }}`)},
+				"nested/README.md": {Data: []byte(`not a template`)},
+			},
+		})
+		require.NoError(t, err)
+
+		templates := ext.Templates()
+		require.Len(t, templates, 4+len(featureTemplates))
+
+		// Overrides must be last.
+		overrides := templates[len(templates)-1]
+		assert.NotNil(t, overrides.Lookup("rest/list"))
+		assert.NotNil(t, overrides.Lookup("rest/foo"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewExtension(&Config{
+			TemplateOverrides: fstest.MapFS{
+				"list.tmpl": {Data: []byte(`{{ define "rest/list" }}{{ unknownFunc }}{{ end }}`)},
+			},
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewExtension(&Config{TemplateOverrides: fstest.MapFS{}})
+		assert.Error(t, err)
+	})
+}
+
+func TestExtension_RenderFeatures(t *testing.T) {
+	t.Parallel()
+
+	// Generated code can't be compiled here, as the dependencies of the handlers and
+	// database drivers aren't available, so the kitchensink example has a variant with
+	// the same features enabled, which is compiled and tested.
+	for _, handler := range []HTTPHandler{HandlerStdlib, HandlerChi} {
+		t.Run(string(handler), func(t *testing.T) {
+			t.Parallel()
+
+			files := renderGraph(t, &Config{
+				Handler:            handler,
+				WithTesting:        true,
+				WithBenchmarks:     true,
+				WithExamples:       true,
+				WithDTOs:           true,
+				WithOutbox:         true,
+				StrictMutate:       true,
+				TolerantReader:     true,
+				ReadYourWrites:     true,
+				MaintenanceMode:    true,
+				FeatureGates:       true,
+				EnableLastModified: true,
+				ReadMask:           true,
+				BytesAsFiles:       true,
+				DeduplicateSchemas: true,
+				FieldNameStyle:     FieldNameStyleCamel,
+				Batch:              &Batch{},
+				PayloadSizes:       &PayloadSizes{},
+				LoadTest:           LoadTestK6,
+				LoadTestWriter:     io.Discard,
+			}, nil)
+
+			for _, fn := range []string{
+				"rest/server.go",
+				"rest/dto.go",
+				"rest/examples.go",
+				"rest/openapi.json",
+				"enttest/rest_request.go",
+				"enttest/rest_benchmark.go",
+				"enttest/rest_fuzz.go",
+			} {
+				assert.Contains(t, files, fn)
+			}
+
+			fset := token.NewFileSet()
+			for fn, src := range files {
+				if !strings.HasSuffix(fn, ".go") {
+					continue
+				}
+				_, err := parser.ParseFile(fset, fn, src, parser.SkipObjectResolution)
+				assert.NoError(t, err, "generated file %q is invalid", fn)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// FieldToggle is a toggle of a single behavior of a field in the REST API, which can be
+// enabled or disabled independently of all other behaviors (see [WithFieldToggle]).
+type FieldToggle string
+
+const (
+	// FieldFilterable toggles filtering by the field. When enabled on a field without
+	// filter predicates (see [WithFilter]), the field uses the
+	// [FilterGroupEqualExact] and [FilterGroupArray] predicates. When disabled, the
+	// field isn't filterable, regardless of any filter predicates, including the
+	// default filters of the ID field (see [Config.DefaultFilterID]).
+	FieldFilterable FieldToggle = "filterable"
+
+	// FieldSortable toggles sorting by the field, the same as [WithSortable], though
+	// it can also disable sorting by the ID field, which is sortable by default.
+	FieldSortable FieldToggle = "sortable"
+
+	// FieldReadable toggles whether the field is included in responses. Fields which
+	// aren't readable are write-only, i.e. they can still be set when creating and
+	// updating entities (e.g. a secret, which is hashed by a hook).
+	FieldReadable FieldToggle = "readable"
+
+	// FieldCreatable toggles whether the field can be set when creating entities.
+	FieldCreatable FieldToggle = "creatable"
+
+	// FieldUpdatable toggles whether the field can be set when updating entities.
+	// Immutable fields can never be updated.
+	FieldUpdatable FieldToggle = "updatable"
+)
+
+// AllFieldToggles are all supported field toggles.
+var AllFieldToggles = []FieldToggle{
+	FieldFilterable,
+	FieldSortable,
+	FieldReadable,
+	FieldCreatable,
+	FieldUpdatable,
+}
+
+// applyFieldToggles applies the filter and sort toggles of all fields (see
+// [WithFieldToggle]) to their annotations, and hides fields which aren't readable (see
+// [FieldReadable] and [WithHashed]) from the JSON representation of entities.
+func applyFieldToggles(g *gen.Graph) {
+	for _, t := range g.Nodes {
+		fields := t.Fields
+		if t.ID != nil {
+			fields = append([]*gen.Field{t.ID}, fields...)
+		}
+
+		for _, f := range fields {
+			if !GetAnnotation(f).GetReadable() {
+				f.StructTag = `json:"-"`
+			}
+
+			if len(GetAnnotation(f).FieldToggles) == 0 {
+				continue
+			}
+
+			f.Annotations = withAnnotation(f.Annotations, func(a *Annotation) {
+				if v, ok := a.FieldToggles[FieldFilterable]; ok {
+					switch {
+					case !v:
+						a.Filter = 0
+						a.FilterGroup = ""
+					case a.Filter == 0:
+						a.Filter = FilterGroupEqualExact | FilterGroupArray
+					}
+				}
+
+				if v, ok := a.FieldToggles[FieldSortable]; ok {
+					a.Sortable = v
+				}
+			})
+		}
+	}
+}
+
+// validateFieldToggles checks that the toggles of the provided field are supported.
+// The ID field only supports the [FieldFilterable] and [FieldSortable] toggles.
+func validateFieldToggles(t *gen.Type, f *gen.Field, fa *Annotation) (errs []error) {
+	for _, toggle := range slices.Sorted(maps.Keys(fa.FieldToggles)) {
+		switch {
+		case !slices.Contains(AllFieldToggles, toggle):
+			errs = append(errs, fmt.Errorf("unknown field toggle %q", toggle))
+		case f == t.ID && toggle != FieldFilterable && toggle != FieldSortable:
+			errs = append(errs, fmt.Errorf("field toggle %q isn't supported on ID fields", toggle))
+		}
+	}
+
+	if f == t.ID {
+		return errs
+	}
+
+	if !fa.GetReadable() && !fa.GetCreatable() && (!fa.GetUpdatable() || f.Immutable) {
+		errs = append(errs, errors.New("field isn't readable, creatable or updatable, skip it instead"))
+	}
+	return errs
+}
@@ -0,0 +1,133 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_FieldToggles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("filterable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			DefaultFilterID: true,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.name", WithFilterable(true))
+				injectAnnotations(t, g, "Pet.age", WithFilter(FilterGroupEqual), WithFilterable(false))
+				injectAnnotations(t, g, "Pet.id", WithFilterable(false))
+				return nil
+			},
+		})
+
+		params := map[string]bool{}
+		for _, param := range r.spec.Paths["/pets"].Get.Parameters {
+			if c, ok := r.spec.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]; ok {
+				params[c.Name] = true
+			}
+		}
+
+		assert.True(t, params["name.eq"])
+		assert.True(t, params["name.in"])
+		assert.False(t, params["age.eq"])
+		assert.False(t, params["id.eq"])
+	})
+
+	t.Run("sortable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithDefaultSort("name"))
+				injectAnnotations(t, g, "Pet.name", WithFieldToggle(FieldSortable, true))
+				injectAnnotations(t, g, "Pet.id", WithFieldToggle(FieldSortable, false))
+				return nil
+			},
+		})
+
+		assert.Contains(t, r.json(`$.components.schemas.PetSortableFields.enum`), "name")
+		assert.NotContains(t, r.json(`$.components.schemas.PetSortableFields.enum`), "id")
+	})
+
+	t.Run("readable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.age", WithReadable(false))
+				return nil
+			},
+		})
+
+		assert.Nil(t, r.json(`$.components.schemas.Pet.properties.age`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetCreate.properties.age`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetUpdate.properties.age`))
+	})
+
+	t.Run("creatable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.name", WithCreatable(false))
+				return nil
+			},
+		})
+
+		assert.NotNil(t, r.json(`$.components.schemas.Pet.properties.name`))
+		assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.name`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetUpdate.properties.name`))
+	})
+
+	t.Run("updatable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.name", WithUpdatable(false))
+				return nil
+			},
+		})
+
+		assert.NotNil(t, r.json(`$.components.schemas.PetCreate.properties.name`))
+		assert.Nil(t, r.json(`$.components.schemas.PetUpdate.properties.name`))
+	})
+	t.Run("create-only", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.nicknames", WithCreateOnly())
+				return nil
+			},
+		})
+
+		assert.NotNil(t, r.json(`$.components.schemas.PetCreate.properties.nicknames`))
+		assert.Nil(t, r.json(`$.components.schemas.PetUpdate.properties.nicknames`))
+		assert.Nil(t, r.json(`$.components.schemas.Pet.properties.nicknames`))
+	})
+
+	t.Run("update-only", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.nicknames", WithUpdateOnly())
+				return nil
+			},
+		})
+
+		assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.nicknames`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetUpdate.properties.nicknames`))
+		assert.Nil(t, r.json(`$.components.schemas.Pet.properties.nicknames`))
+	})
+}
@@ -0,0 +1,367 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// DefaultFileMaxSize is the default maximum size (in bytes) of files uploaded to file
+// fields (see [FileOptions.MaxSize]).
+const DefaultFileMaxSize int64 = 10 << 20
+
+// FileOptions configures a file field (see [WithFile]).
+type FileOptions struct {
+	// ContentTypeField is the name of an optional string field of the same schema,
+	// which stores the media type of the uploaded file (as provided by the client, or
+	// detected from the content of the file), and is used as the Content-Type when the
+	// file is downloaded.
+	ContentTypeField string `json:",omitempty"`
+
+	// NameField is the name of an optional string field of the same schema, which
+	// stores the original name of the uploaded file, and is used as the filename in
+	// the Content-Disposition header when the file is downloaded.
+	NameField string `json:",omitempty"`
+
+	// ContentTypes is an optional list of media types which are accepted when
+	// uploading files, which may contain wildcard subtypes (e.g. "image/*"). Any
+	// media type is accepted if empty.
+	ContentTypes []string `json:",omitempty"`
+
+	// MaxSize is the maximum size (in bytes) of uploaded files. Defaults to
+	// [DefaultFileMaxSize].
+	MaxSize int64 `json:",omitempty"`
+}
+
+// GetMaxSize returns the maximum size (in bytes) of uploaded files.
+func (o *FileOptions) GetMaxSize() int64 {
+	if o.MaxSize > 0 {
+		return o.MaxSize
+	}
+	return DefaultFileMaxSize
+}
+
+// GetContentTypes returns the media types of downloaded files, which are the accepted
+// media types, or "application/octet-stream" if any media type is accepted.
+func (o *FileOptions) GetContentTypes() []string {
+	if len(o.ContentTypes) > 0 {
+		return o.ContentTypes
+	}
+	return []string{"application/octet-stream"}
+}
+
+// isFileField returns true if the provided field is a file field, through [WithFile]
+// or [Config.BytesAsFiles].
+func isFileField(f *gen.Field) bool {
+	return GetAnnotation(f).File != nil
+}
+
+// applyFileFields marks all bytes fields as file fields, if [Config.BytesAsFiles] is
+// enabled, as if [WithFile] was provided. It also excludes all file fields from the
+// JSON encoding of the generated ent entities, as they are exposed through dedicated
+// endpoints.
+func applyFileFields(cfg *Config, g *gen.Graph) {
+	for _, t := range g.Nodes {
+		for _, f := range t.Fields {
+			if cfg.BytesAsFiles && f.IsBytes() && !f.Sensitive() && !isFileField(f) {
+				f.Annotations = withAnnotation(f.Annotations, func(a *Annotation) { a.File = &FileOptions{} })
+			}
+
+			if isFileField(f) {
+				f.StructTag = `json:"-"`
+			}
+		}
+	}
+}
+
+// GetFileFields returns the file fields of the provided type (see [WithFile]), each
+// of which has a download endpoint, as well as upload and delete endpoints (depending
+// on the operations of the type, and whether the field is immutable or optional).
+// Returns nil if the type is skipped, or doesn't have a single ID field.
+func GetFileFields(t *gen.Type) []*gen.Field {
+	cfg := GetConfig(t.Config)
+
+	if t.ID == nil || GetAnnotation(t).GetSkip(cfg) {
+		return nil
+	}
+
+	var fields []*gen.Field
+
+	for _, f := range t.Fields {
+		if fa := GetAnnotation(f); fa.File != nil && !fa.Skip {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// hasFileFields returns true if any of the provided types have file fields.
+func hasFileFields(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool { return len(GetFileFields(t)) > 0 })
+}
+
+// getFileField returns the field with the provided name on the provided type, if any,
+// which is used to look up the companion fields of a file field.
+func getFileField(t *gen.Type, name string) *gen.Field {
+	if name == "" {
+		return nil
+	}
+
+	idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == name })
+	if idx == -1 {
+		return nil
+	}
+	return t.Fields[idx]
+}
+
+// GetFileContentTypeField returns the field which stores the media type of the
+// provided file field (see [FileOptions.ContentTypeField]), if any.
+func GetFileContentTypeField(t *gen.Type, f *gen.Field) *gen.Field {
+	return getFileField(t, GetAnnotation(f).File.ContentTypeField)
+}
+
+// GetFileNameField returns the field which stores the original name of the provided
+// file field (see [FileOptions.NameField]), if any.
+func GetFileNameField(t *gen.Type, f *gen.Field) *gen.Field {
+	return getFileField(t, GetAnnotation(f).File.NameField)
+}
+
+// GetFilePathName returns the path of the download, upload and delete endpoints of
+// the provided file field of the provided type, e.g. "/users/{id}/avatar". useUniqueID
+// determines if the ID path parameter should be "{id}" or "{type|camel}ID".
+func GetFilePathName(t *gen.Type, f *gen.Field, useUniqueID bool) string {
+	return GetPathName(OperationRead, t, nil, useUniqueID) + "/" + KebabCase(f.Name)
+}
+
+// GetFileOperationID returns the operation ID of the endpoint for the provided
+// operation of the provided file field, which is either [OperationRead] (download,
+// e.g. "getUserAvatar"), [OperationUpdate] (upload, e.g. "uploadUserAvatar"), or
+// [OperationDelete] (e.g. "deleteUserAvatar").
+func GetFileOperationID(op Operation, t *gen.Type, f *gen.Field) string {
+	switch op {
+	case OperationRead:
+		return "get" + GetSchemaName(t) + PascalCase(f.Name)
+	case OperationUpdate:
+		return "upload" + GetSchemaName(t) + PascalCase(f.Name)
+	case OperationDelete:
+		return "delete" + GetSchemaName(t) + PascalCase(f.Name)
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+}
+
+// GetFileOperations returns the operations which are supported for the provided file
+// field of the provided type: [OperationRead] (download) if the type supports reads,
+// [OperationUpdate] (upload) if the type supports updates and the field isn't
+// immutable or read-only, and [OperationDelete] if uploads are supported, and the
+// field is optional.
+func GetFileOperations(t *gen.Type, f *gen.Field) (ops []Operation) {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	if ta.HasOperation(cfg, OperationRead) {
+		ops = append(ops, OperationRead)
+	}
+
+	if ta.HasOperation(cfg, OperationUpdate) && !f.Immutable && GetAnnotation(f).GetUpdatable() && !IsReadOnly(t) {
+		ops = append(ops, OperationUpdate)
+
+		if f.Optional {
+			ops = append(ops, OperationDelete)
+		}
+	}
+	return ops
+}
+
+// hasFileOperation returns true if the provided operation is supported for the provided
+// file field of the provided type (see [GetFileOperations]).
+func hasFileOperation(t *gen.Type, f *gen.Field, op Operation) bool {
+	return slices.Contains(GetFileOperations(t, f), op)
+}
+
+// GetSpecFile generates an independent spec for the download, upload and delete
+// endpoints of the provided file field of the provided type (see [WithFile]).
+func GetSpecFile(t *gen.Type, f *gen.Field) (*ogen.Spec, error) {
+	ops := GetFileOperations(t, f)
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("file field %s of schema %s has no supported operations", f.Name, t.Name)
+	}
+
+	ta := GetAnnotation(t)
+	fa := GetAnnotation(f)
+	entityName := GetSchemaName(t)
+
+	spec := newBaseSpec(GetConfig(t.Config))
+	spec.Tags = append(spec.Tags, ogen.Tag{Name: Pluralize(t.Name), Description: ta.Description})
+
+	if err := addIDParameters(spec, t); err != nil {
+		return nil, err
+	}
+
+	binary := &ogen.Schema{Type: "string", Format: "binary"}
+	pathItem := &ogen.PathItem{Parameters: getIDParameterRefs(t)}
+
+	if slices.Contains(ops, OperationRead) {
+		content := map[string]ogen.Media{}
+		for _, v := range fa.File.GetContentTypes() {
+			content[v] = ogen.Media{Schema: binary}
+		}
+
+		pathItem.Get = &ogen.Operation{
+			Tags:        []string{Pluralize(t.Name)},
+			Summary:     fmt.Sprintf("Download the %s of a %s", f.Name, CamelCase(entityName)),
+			Description: cmp.Or(fa.Description, f.Comment()),
+			OperationID: GetFileOperationID(OperationRead, t, f),
+			Deprecated:  ta.Deprecated || fa.Deprecated,
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): &ogen.Response{
+					Description: fmt.Sprintf("The %s of the %s.", f.Name, entityName),
+					Content:     content,
+				},
+			},
+		}
+	}
+
+	if slices.Contains(ops, OperationUpdate) {
+		upload := &ogen.Schema{
+			Type: "object",
+			Properties: []ogen.Property{
+				{Name: "file", Schema: binary},
+			},
+			Required: []string{"file"},
+		}
+
+		media := ogen.Media{Schema: upload}
+		if len(fa.File.ContentTypes) > 0 {
+			media.Encoding = map[string]ogen.Encoding{
+				"file": {ContentType: strings.Join(fa.File.ContentTypes, ", ")},
+			}
+		}
+
+		pathItem.Put = &ogen.Operation{
+			Tags:    []string{Pluralize(t.Name)},
+			Summary: fmt.Sprintf("Upload the %s of a %s", f.Name, CamelCase(entityName)),
+			Description: fmt.Sprintf(
+				"Upload the %s of a %s, replacing any existing file. Files may be up to %d bytes.",
+				f.Name,
+				CamelCase(entityName),
+				fa.File.GetMaxSize(),
+			),
+			OperationID: GetFileOperationID(OperationUpdate, t, f),
+			Deprecated:  ta.Deprecated || fa.Deprecated,
+			RequestBody: &ogen.RequestBody{
+				Required: true,
+				Content:  map[string]ogen.Media{"multipart/form-data": media},
+			},
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The %s of the %s was uploaded.", f.Name, entityName)).
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(t)}),
+				strconv.Itoa(http.StatusRequestEntityTooLarge): ogen.NewResponse().
+					SetDescription("The uploaded file is too large.").
+					SetJSONContent(ErrorResponseObject(http.StatusRequestEntityTooLarge)),
+				strconv.Itoa(http.StatusUnsupportedMediaType): ogen.NewResponse().
+					SetDescription("The media type of the uploaded file isn't supported.").
+					SetJSONContent(ErrorResponseObject(http.StatusUnsupportedMediaType)),
+			},
+		}
+
+		addMaintenanceResponse(GetConfig(t.Config), pathItem.Put)
+
+		for k, v := range GetSchemaType(t, OperationRead, nil) {
+			spec.Components.Schemas[k] = v
+		}
+	}
+
+	if slices.Contains(ops, OperationDelete) {
+		pathItem.Delete = &ogen.Operation{
+			Tags:        []string{Pluralize(t.Name)},
+			Summary:     fmt.Sprintf("Delete the %s of a %s", f.Name, CamelCase(entityName)),
+			OperationID: GetFileOperationID(OperationDelete, t, f),
+			Deprecated:  ta.Deprecated || fa.Deprecated,
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusNoContent): &ogen.Response{
+					Description: fmt.Sprintf("The %s of the %s was deleted.", f.Name, entityName),
+				},
+			},
+		}
+		addMaintenanceResponse(GetConfig(t.Config), pathItem.Delete)
+	}
+
+	spec.Paths[GetFilePathName(t, f, true)] = pathItem
+	return spec, nil
+}
+
+// validateFileFields checks that all file fields of the provided type are bytes
+// fields of a schema with a single ID field, with companion fields which exist, are
+// optional string fields, and don't conflict with the path of any edge.
+func validateFileFields(cfg *Config, t *gen.Type) (errs []error) {
+	if GetAnnotation(t).GetSkip(cfg) {
+		return nil
+	}
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.File == nil {
+			continue
+		}
+
+		wrap := func(err error) error {
+			return &AnnotationError{Schema: t.Name, Field: f.Name, Err: err}
+		}
+
+		if !f.IsBytes() || f.Sensitive() {
+			errs = append(errs, wrap(errors.New("file fields must be non-sensitive bytes fields")))
+			continue
+		}
+
+		if t.ID == nil {
+			errs = append(errs, wrap(errors.New("file fields are only supported on schemas with a single ID field")))
+			continue
+		}
+
+		if fa.File.MaxSize < 0 {
+			errs = append(errs, wrap(fmt.Errorf("invalid max file size %d", fa.File.MaxSize)))
+		}
+
+		for _, v := range fa.File.ContentTypes {
+			if _, _, err := mime.ParseMediaType(v); err != nil {
+				errs = append(errs, wrap(fmt.Errorf("invalid file content type %q: %w", v, err)))
+			}
+		}
+
+		for _, name := range []string{fa.File.ContentTypeField, fa.File.NameField} {
+			if name == "" {
+				continue
+			}
+
+			cf := getFileField(t, name)
+
+			switch {
+			case cf == nil:
+				errs = append(errs, wrap(fmt.Errorf("file companion field %q does not exist", name)))
+			case !cf.IsString() || !cf.Optional || cf.Immutable:
+				errs = append(errs, wrap(fmt.Errorf("file companion field %q must be an optional, mutable string field", name)))
+			}
+		}
+
+		for _, e := range t.Edges {
+			if GetPathSegment(t, e) == KebabCase(f.Name) {
+				errs = append(errs, wrap(fmt.Errorf("file endpoint path conflicts with the path of edge %q", e.Name)))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,73 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_File(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User.avatar", WithFile(FileOptions{
+					ContentTypeField: "email",
+					ContentTypes:     []string{"image/png", "image/jpeg"},
+				}))
+				return nil
+			},
+		})
+
+		path := `$.paths./users/{userID}/avatar`
+
+		assert.Equal(t, "getUserAvatar", r.json(path+`.get.operationId`))
+		assert.Equal(t, "binary", r.json(path+`.get.responses.200.content.image/png.schema.format`))
+		assert.Equal(t, "binary", r.json(path+`.get.responses.200.content.image/jpeg.schema.format`))
+
+		assert.Equal(t, "uploadUserAvatar", r.json(path+`.put.operationId`))
+		assert.Equal(t, "binary", r.json(path+`.put.requestBody.content.multipart/form-data.schema.properties.file.format`))
+		assert.Equal(t, "image/png, image/jpeg", r.json(path+`.put.requestBody.content.multipart/form-data.encoding.file.contentType`))
+		assert.NotNil(t, r.json(path+`.put.responses.413`))
+		assert.NotNil(t, r.json(path+`.put.responses.415`))
+
+		assert.Equal(t, "deleteUserAvatar", r.json(path+`.delete.operationId`))
+		assert.Contains(t, r.json(path+`.parameters[*].$ref`), "#/components/parameters/UserID")
+
+		assert.Nil(t, r.json(`$.components.schemas.User.properties.avatar`))
+		assert.Nil(t, r.json(`$.components.schemas.UserCreate.properties.avatar`))
+	})
+
+	t.Run("bytes-as-files", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{BytesAsFiles: true})
+
+		assert.Equal(t, "getUserAvatar", r.json(`$.paths./users/{userID}/avatar.get.operationId`))
+		assert.NotNil(t, r.json(`$.paths./users/{userID}/avatar.get.responses.200.content.application/octet-stream`))
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User.avatar", WithFile(FileOptions{}), WithReadOnly(true))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "getUserAvatar", r.json(`$.paths./users/{userID}/avatar.get.operationId`))
+		assert.Nil(t, r.json(`$.paths./users/{userID}/avatar.put`))
+		assert.Nil(t, r.json(`$.paths./users/{userID}/avatar.delete`))
+	})
+}
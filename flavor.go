@@ -0,0 +1,208 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// flavorFilter is a filter which can be used through the filter expressions of a flavor
+// (see [Config.Flavor]), and the filter parameter which implements it.
+type flavorFilter struct {
+	Path     string // Path of the field or edge, e.g. "owner.name".
+	Operator string // Operator (and argument, if specific), e.g. "=", or "= null".
+	Param    string // Filter parameter, e.g. "owner.name.eq".
+}
+
+// Example returns an example restriction of the filter, e.g. "owner.name = 1", or
+// "contains(owner/name,'1')" for function operators.
+func (f *flavorFilter) Example() string {
+	switch {
+	case strings.HasSuffix(f.Operator, "()"):
+		return strings.TrimSuffix(f.Operator, "()") + "(" + f.Path + ",'1')"
+	case f.Operator == ":*":
+		return f.Path + f.Operator
+	case strings.Contains(f.Operator, " "):
+		return f.Path + " " + f.Operator
+	default:
+		return f.Path + " " + f.Operator + " 1"
+	}
+}
+
+// getFlavorFilters returns the filters of list operations returning the provided type,
+// which can be expressed through the provided operators. Edge filters use the provided
+// edge operator, and are skipped if it's empty.
+func getFlavorFilters(t *gen.Type, operators map[gen.Op]string, edgeOperator string) (filters []*flavorFilter) {
+	for _, f := range GetFilterableFields(t, nil) {
+		param := f.ParameterName()
+
+		if f.Edge != nil && f.Field == nil {
+			if edgeOperator != "" {
+				filters = append(filters, &flavorFilter{
+					Path:     strings.TrimPrefix(param, "has."),
+					Operator: edgeOperator,
+					Param:    param,
+				})
+			}
+			continue
+		}
+
+		if op, ok := operators[f.Operation]; ok {
+			filters = append(filters, &flavorFilter{
+				Path:     strings.TrimSuffix(param, "."+predicateFormat(f.Operation)),
+				Operator: op,
+				Param:    param,
+			})
+		}
+	}
+
+	for _, g := range GetFilterGroups(t, nil) {
+		for _, o := range g.Operations {
+			if op, ok := operators[o]; ok {
+				filters = append(filters, &flavorFilter{Path: g.Name, Operator: op, Param: g.ParameterName(o)})
+			}
+		}
+	}
+	return filters
+}
+
+// getFlavorFilterSummary returns the supported paths and operators of the provided
+// filters, for use in parameter descriptions, e.g. "`name` (`=`, `!=`)", or an empty
+// string if there are no filters.
+func getFlavorFilterSummary(filters []*flavorFilter) string {
+	var paths []string
+	operators := map[string][]string{}
+
+	for _, f := range filters {
+		if _, ok := operators[f.Path]; !ok {
+			paths = append(paths, f.Path)
+		}
+		operators[f.Path] = append(operators[f.Path], "`"+f.Operator+"`")
+	}
+
+	supported := make([]string, len(paths))
+	for i, path := range paths {
+		supported[i] = fmt.Sprintf("`%s` (%s)", path, strings.Join(operators[path], ", "))
+	}
+	return strings.Join(supported, ", ")
+}
+
+// getFlavorCollectionName returns the name of the response field which contains the
+// results of paginated list operations returning the provided type.
+func getFlavorCollectionName(cfg *Config, t *gen.Type) string {
+	switch cfg.Flavor {
+	case FlavorAIP:
+		return getAIPCollectionName(t)
+	case FlavorOData:
+		return "value"
+	default:
+		return getPaginationField(cfg, PaginationFieldContent)
+	}
+}
+
+// getFlavorPagedSchema returns the schema of the pagination fields of paginated list
+// responses.
+func getFlavorPagedSchema(cfg *Config) *ogen.Schema {
+	switch cfg.Flavor {
+	case FlavorAIP:
+		return aipPagedSchema()
+	case FlavorOData:
+		return odataPagedSchema()
+	default:
+		return nil
+	}
+}
+
+// getFlavorPaginationParameters returns the pagination parameters of paginated list
+// operations, using the provided page sizes.
+func getFlavorPaginationParameters(cfg *Config, minItems, maxItems, items int) []*ogen.Parameter {
+	var params []*ogen.Parameter
+
+	switch cfg.Flavor {
+	case FlavorAIP:
+		params = aipPaginationParameters(minItems, maxItems, items)
+	case FlavorOData:
+		params = odataPaginationParameters(minItems, maxItems, items)
+	default:
+		params = []*ogen.Parameter{
+			{Ref: "#/components/parameters/Page"},
+			{
+				Name:        "per_page",
+				In:          "query",
+				Description: "The number of entities to retrieve per page.",
+				Schema: ogen.Int().
+					SetMinimum(ptr(int64(minItems))).
+					SetMaximum(ptr(int64(maxItems))).
+					SetDefault(json.RawMessage(strconv.Itoa(items))),
+			},
+		}
+	}
+
+	if cfg.RequestPolicy != nil {
+		for _, param := range params {
+			if param.Schema != nil && param.Schema.Maximum != nil {
+				withPolicyRange(param, maxItems, cfg.RequestPolicy.MaxItemsPerPage)
+			}
+		}
+	}
+	return params
+}
+
+// getFlavorOrderParameter returns the sorting parameter of list operations returning
+// the provided type, which can be sorted by the provided fields. Not used by
+// [FlavorDefault], which uses the "sort" and "order" parameters.
+func getFlavorOrderParameter(cfg *Config, t *gen.Type, sortable []string, defaultSort string, defaultOrder SortOrder) *ogen.Parameter {
+	if cfg.Flavor == FlavorOData {
+		return odataOrderByParameter(t, sortable, defaultSort, defaultOrder)
+	}
+	return aipOrderByParameter(t, sortable, defaultSort, defaultOrder)
+}
+
+// getFlavorFilterParameter returns the filter expression parameter of list operations
+// returning the provided type, or nil if the type has no filters. Not used by
+// [FlavorDefault], which uses a parameter per filter.
+func getFlavorFilterParameter(cfg *Config, t *gen.Type) *ogen.Parameter {
+	if cfg.Flavor == FlavorOData {
+		return odataFilterParameter(t)
+	}
+	return aipFilterParameter(t)
+}
+
+// wrapFlavor wraps the provided handler (Go expression) of a list operation which
+// returns the provided type, translating the list parameters of the configured flavor
+// (see [Config.Flavor]).
+func wrapFlavor(cfg *Config, t *gen.Type, handler string) string {
+	switch cfg.Flavor {
+	case FlavorAIP:
+		return fmt.Sprintf("withAIP(s, OperationList, %q, %q, %s)", t.Name, getAIPCollectionName(t), handler)
+	case FlavorOData:
+		return fmt.Sprintf("withOData(s, OperationList, %q, %s)", t.Name, handler)
+	default:
+		return handler
+	}
+}
+
+// validateFlavor checks that the operations of the provided schema are compatible with
+// the configured flavor (see [Config.Flavor]).
+func validateFlavor(cfg *Config, a *Annotation) error {
+	if cfg.Flavor != FlavorDefault && a.GetOperationMethod(OperationList) != http.MethodGet {
+		return fmt.Errorf("the %s flavor requires the list operation to use the GET method", flavorNames[cfg.Flavor])
+	}
+	return nil
+}
+
+// flavorNames are the human readable names of each flavor.
+var flavorNames = map[Flavor]string{
+	FlavorDefault: "default",
+	FlavorAIP:     "AIP",
+	FlavorOData:   "OData",
+}
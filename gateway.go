@@ -0,0 +1,227 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+const defaultGatewayUpstream = "http://localhost:8080"
+
+// rePathParam matches the path parameters of a spec path, e.g. "{petID}".
+var rePathParam = regexp.MustCompile(`\{([^}/]+)\}`)
+
+// GatewayRoute is a single route within the exported gateway configuration.
+type GatewayRoute struct {
+	// Name is the name of the route, which is the operation ID.
+	Name string
+	// Method is the HTTP method of the route.
+	Method string
+	// Path is the path of the route, as used in the spec (e.g. "/pets/{petID}").
+	Path string
+	// Params are the names of the path parameters of the route, in order.
+	Params []string
+}
+
+// GetGatewayRoutes returns the routes used for gateway configuration, derived from all
+// operations within the spec, sorted by path.
+func GetGatewayRoutes(spec *ogen.Spec) []GatewayRoute {
+	var routes []GatewayRoute
+
+	for _, path := range mapKeys(spec.Paths) {
+		item := spec.Paths[path]
+		if item == nil {
+			continue
+		}
+
+		var params []string
+		for _, m := range rePathParam.FindAllStringSubmatch(path, -1) {
+			params = append(params, m[1])
+		}
+
+		PatchOperations(item, func(method string, op *ogen.Operation) *ogen.Operation {
+			if op != nil {
+				routes = append(routes, GatewayRoute{
+					Name:   op.OperationID,
+					Method: method,
+					Path:   path,
+					Params: params,
+				})
+			}
+			return op
+		})
+	}
+
+	return routes
+}
+
+// GenerateGateway generates the gateway configuration for the provided spec in the
+// requested format, writing it to w. Requests are proxied to the provided upstream,
+// which defaults to the first server in the spec (if any). See [GetGatewayRoutes] for
+// which routes are included.
+func GenerateGateway(spec *ogen.Spec, format GatewayFormat, upstream string, w io.Writer) error {
+	if upstream == "" {
+		upstream = defaultGatewayUpstream
+		if len(spec.Servers) > 0 && spec.Servers[0].URL != "" {
+			upstream = spec.Servers[0].URL
+		}
+	}
+	upstream = strings.TrimSuffix(upstream, "/")
+
+	switch format {
+	case GatewayKong:
+		return generateKongGateway(spec, upstream, w)
+	case GatewayAWS:
+		return generateAWSGateway(spec, upstream, w)
+	default:
+		return fmt.Errorf("unsupported gateway format: %q", format)
+	}
+}
+
+type kongConfig struct {
+	FormatVersion string         `yaml:"_format_version"`
+	Services      []*kongService `yaml:"services"`
+}
+
+type kongService struct {
+	Name   string       `yaml:"name"`
+	URL    string       `yaml:"url"`
+	Routes []*kongRoute `yaml:"routes"`
+}
+
+type kongRoute struct {
+	Name          string   `yaml:"name"`
+	Methods       []string `yaml:"methods"`
+	Paths         []string `yaml:"paths"`
+	RegexPriority int      `yaml:"regex_priority,omitempty"`
+	StripPath     bool     `yaml:"strip_path"`
+}
+
+// generateKongGateway generates a Kong declarative configuration, with a single service
+// for the upstream, and a route for each operation.
+func generateKongGateway(spec *ogen.Spec, upstream string, w io.Writer) error {
+	service := &kongService{
+		Name: gatewayServiceName(spec),
+		URL:  upstream,
+	}
+
+	for _, r := range GetGatewayRoutes(spec) {
+		// Kong matches plain paths by prefix, so all paths are anchored regex paths.
+		// Routes with more static segments are prioritized, so they take precedence
+		// over routes which match the same segment with a parameter.
+		service.Routes = append(service.Routes, &kongRoute{
+			Name:          r.Name,
+			Methods:       []string{r.Method},
+			Paths:         []string{kongPathRegex(r.Path)},
+			RegexPriority: strings.Count(r.Path, "/") - len(r.Params),
+			StripPath:     false,
+		})
+	}
+
+	_, err := io.WriteString(w, "# Code generated by entrest, DO NOT EDIT.\n")
+	if err != nil {
+		return err
+	}
+
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	err = enc.Encode(&kongConfig{FormatVersion: "3.0", Services: []*kongService{service}})
+	if err != nil {
+		return fmt.Errorf("failed to encode kong config: %w", err)
+	}
+	return enc.Close()
+}
+
+// kongPathRegex returns the Kong regex path of the provided spec path, with a named
+// capture group for each path parameter (e.g. "~/pets/(?<petID>[^/]+)$").
+func kongPathRegex(path string) string {
+	var b strings.Builder
+	b.WriteString("~")
+
+	var last int
+	for _, m := range rePathParam.FindAllStringSubmatchIndex(path, -1) {
+		b.WriteString(regexp.QuoteMeta(path[last:m[0]]))
+		b.WriteString("(?<" + path[m[2]:m[3]] + ">[^/]+)")
+		last = m[1]
+	}
+	b.WriteString(regexp.QuoteMeta(path[last:]))
+	b.WriteString("$")
+	return b.String()
+}
+
+// generateAWSGateway generates a copy of the spec, with an
+// "x-amazon-apigateway-integration" extension on each operation, proxying requests
+// (including path parameters) to the upstream. This can be imported into AWS API
+// Gateway.
+func generateAWSGateway(spec *ogen.Spec, upstream string, w io.Writer) error {
+	b, err := MarshalSpec(spec)
+	if err != nil {
+		return err
+	}
+
+	var out map[string]any
+	err = json.Unmarshal(b, &out)
+	if err != nil {
+		return fmt.Errorf("failed to decode spec: %w", err)
+	}
+
+	paths, _ := out["paths"].(map[string]any)
+
+	for _, r := range GetGatewayRoutes(spec) {
+		item, _ := paths[r.Path].(map[string]any)
+		op, ok := item[strings.ToLower(r.Method)].(map[string]any)
+		if !ok {
+			continue
+		}
+
+		integration := map[string]any{
+			"type":                "http_proxy",
+			"httpMethod":          r.Method,
+			"uri":                 upstream + r.Path,
+			"passthroughBehavior": "when_no_match",
+		}
+
+		if len(r.Params) > 0 {
+			params := map[string]string{}
+			for _, p := range r.Params {
+				params["integration.request.path."+p] = "method.request.path." + p
+			}
+			integration["requestParameters"] = params
+		}
+
+		op["x-amazon-apigateway-integration"] = integration
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(out)
+}
+
+// gatewayServiceName returns the name of the gateway service, derived from the title
+// of the spec (e.g. "entgo-rest-api").
+func gatewayServiceName(spec *ogen.Spec) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(spec.Info.Title) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			b.WriteRune(r)
+		case b.Len() > 0 && !strings.HasSuffix(b.String(), "-"):
+			b.WriteByte('-')
+		}
+	}
+
+	if name := strings.TrimSuffix(b.String(), "-"); name != "" {
+		return name
+	}
+	return "entrest"
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateGateway(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	t.Run("routes", func(t *testing.T) {
+		t.Parallel()
+
+		routes := GetGatewayRoutes(r.spec)
+		assert.Contains(t, routes, GatewayRoute{Name: "listPets", Method: "GET", Path: "/pets"})
+		assert.Contains(t, routes, GatewayRoute{Name: "createPet", Method: "POST", Path: "/pets"})
+		assert.Contains(t, routes, GatewayRoute{Name: "getPet", Method: "GET", Path: "/pets/{petID}", Params: []string{"petID"}})
+	})
+
+	t.Run("kong", func(t *testing.T) {
+		t.Parallel()
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, GenerateGateway(r.spec, GatewayKong, "http://api.internal:8080/", buf))
+		assert.Contains(t, buf.String(), "_format_version: \"3.0\"\n")
+		assert.Contains(t, buf.String(), "url: http://api.internal:8080\n")
+		assert.Contains(t, buf.String(), "- name: getPet\n")
+		assert.Contains(t, buf.String(), `~/pets/(?<petID>[^/]+)$`)
+		assert.Contains(t, buf.String(), `~/pets$`)
+	})
+
+	t.Run("aws", func(t *testing.T) {
+		t.Parallel()
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, GenerateGateway(r.spec, GatewayAWS, "", buf))
+
+		var out map[string]any
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &out))
+
+		op := out["paths"].(map[string]any)["/pets/{petID}"].(map[string]any)["get"].(map[string]any)
+		assert.Equal(t, map[string]any{
+			"type":                "http_proxy",
+			"httpMethod":          "GET",
+			"uri":                 "http://localhost:8080/pets/{petID}",
+			"passthroughBehavior": "when_no_match",
+			"requestParameters": map[string]any{
+				"integration.request.path.petID": "method.request.path.petID",
+			},
+		}, op["x-amazon-apigateway-integration"])
+	})
+
+	t.Run("server-url", func(t *testing.T) {
+		t.Parallel()
+
+		spec := ogen.NewSpec()
+		spec.Info.Title = "My API"
+		spec.Servers = []ogen.Server{{URL: "https://api.example.com/"}}
+		spec.Paths = ogen.Paths{"/foo": &ogen.PathItem{Get: &ogen.Operation{OperationID: "listFoo"}}}
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, GenerateGateway(spec, GatewayKong, "", buf))
+		assert.Contains(t, buf.String(), "name: my-api\n")
+		assert.Contains(t, buf.String(), "url: https://api.example.com\n")
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		assert.Error(t, GenerateGateway(r.spec, GatewayFormat("foo"), "", &bytes.Buffer{}))
+	})
+}
@@ -0,0 +1,341 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+)
+
+// GeoType represents the GeoJSON geometry type of a geo field (see [WithGeo]).
+type GeoType string
+
+const (
+	// GeoTypePoint is a single position. The Go type of the field must be encoded as a
+	// JSON array of the longitude and latitude, i.e. [2]float64 or orb.Point.
+	GeoTypePoint GeoType = "Point"
+	// GeoTypePolygon is a list of linear rings (the exterior ring, followed by any
+	// holes). The Go type of the field must be encoded as a JSON array of rings, which
+	// are arrays of positions, i.e. [][][2]float64, [][][]float64 or orb.Polygon.
+	GeoTypePolygon GeoType = "Polygon"
+)
+
+// AllGeoTypes is a list of all supported geo types.
+var AllGeoTypes = []GeoType{
+	GeoTypePoint,
+	GeoTypePolygon,
+}
+
+// defaultGeoSRID is the default spatial reference system of geo fields (WGS 84).
+const defaultGeoSRID = 4326
+
+// GeoOptions represents the options of a geo field (see [WithGeo]).
+type GeoOptions struct {
+	// Type is the GeoJSON geometry type of the field. Required.
+	Type GeoType `json:",omitempty"`
+
+	// SRID is the spatial reference system identifier of the field in the database,
+	// which is used by spatial filters. Defaults to 4326 (WGS 84).
+	SRID int `json:",omitempty"`
+
+	// BoundingBoxFilter adds a "<field>.bbox" filter to list operations, which only
+	// returns entities where the field intersects the provided bounding box (e.g.
+	// "minLng,minLat,maxLng,maxLat").
+	BoundingBoxFilter bool `json:",omitempty"`
+
+	// RadiusFilter adds a "<field>.near" filter to list operations, which only returns
+	// entities where the field is within the provided distance (in meters) of the
+	// provided position (e.g. "lng,lat,meters").
+	RadiusFilter bool `json:",omitempty"`
+}
+
+// GetSRID returns the SRID of the geo field, or the default if not set.
+func (o *GeoOptions) GetSRID() int {
+	if o.SRID == 0 {
+		return defaultGeoSRID
+	}
+	return o.SRID
+}
+
+// validate validates the geo options.
+func (o *GeoOptions) validate() error {
+	if !slices.Contains(AllGeoTypes, o.Type) {
+		return fmt.Errorf("unsupported geo type provided: %q", o.Type)
+	}
+
+	if o.SRID < 0 {
+		return errors.New("GeoOptions.SRID must be >= 0")
+	}
+	return nil
+}
+
+// GetGeoOptions returns the [GeoOptions] of the provided field, through [WithGeo], or
+// nil if it isn't a geo field.
+func GetGeoOptions(f *gen.Field) *GeoOptions {
+	if f == nil {
+		return nil
+	}
+	return GetAnnotation(f).Geo
+}
+
+// getGeoFields returns all geo fields of the provided type which are included in JSON
+// output.
+func getGeoFields(t *gen.Type) (fields []*gen.Field) {
+	for _, f := range t.Fields {
+		if GetGeoOptions(f) != nil && f.StructTag != `json:"-"` {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// hasGeoFields returns true if any of the provided types have geo fields.
+func hasGeoFields(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return slices.ContainsFunc(t.Fields, func(f *gen.Field) bool { return GetGeoOptions(f) != nil })
+	})
+}
+
+// getGeoType returns the type (relative to the ent package) which encodes the provided
+// geo field as a GeoJSON geometry, e.g. "GeoPoint[orb.Point]".
+func getGeoType(f *gen.Field) string {
+	return fmt.Sprintf("Geo%s[%s]", GetGeoOptions(f).Type, f.Type.String())
+}
+
+// geoExamples are the examples of each geo type.
+var geoExamples = map[GeoType]string{
+	GeoTypePoint:   `{"type":"Point","coordinates":[-122.4194,37.7749]}`,
+	GeoTypePolygon: `{"type":"Polygon","coordinates":[[[-122.5,37.7],[-122.3,37.7],[-122.3,37.8],[-122.5,37.8],[-122.5,37.7]]]}`,
+}
+
+// geoSchema returns the schema of the provided geo field, i.e. a GeoJSON geometry of
+// the geo type of the field.
+func geoSchema(f *gen.Field) *ogen.Schema {
+	geoType := GetGeoOptions(f).Type
+
+	position := &ogen.Schema{
+		Description: "A position, as the longitude and latitude.",
+		Type:        "array",
+		Items:       &ogen.Items{Item: &ogen.Schema{Type: "number", Format: "double"}},
+		MinItems:    ptr(uint64(2)),
+		MaxItems:    ptr(uint64(2)),
+	}
+
+	coordinates := position
+	if geoType == GeoTypePolygon {
+		coordinates = &ogen.Schema{
+			Description: "The linear rings of the polygon (the exterior ring, followed by any holes), which are closed lists of positions.",
+			Type:        "array",
+			Items: &ogen.Items{Item: &ogen.Schema{
+				Type:     "array",
+				Items:    &ogen.Items{Item: position},
+				MinItems: ptr(uint64(4)),
+			}},
+			MinItems: ptr(uint64(1)),
+		}
+	}
+
+	return &ogen.Schema{
+		Type: "object",
+		Properties: ogen.Properties{
+			{Name: "type", Schema: &ogen.Schema{Type: "string", Enum: sliceToRawMessage([]string{string(geoType)})}},
+			{Name: "coordinates", Schema: coordinates},
+		},
+		Required: []string{"type", "coordinates"},
+		Example:  ogen.ExampleValue(geoExamples[geoType]),
+	}
+}
+
+// GeoFilter represents a spatial filter of a geo field (see [GeoOptions]), in list
+// operations.
+type GeoFilter struct {
+	Type  *gen.Type
+	Field *gen.Field
+	// Name is the name of the filter, i.e. "bbox" or "near".
+	Name string
+}
+
+// GetGeoFilters returns the spatial filters of all geo fields of the provided type.
+func GetGeoFilters(t *gen.Type) (filters []*GeoFilter) {
+	cfg := GetConfig(t.Config)
+
+	for _, f := range t.Fields {
+		opts := GetGeoOptions(f)
+		if opts == nil || GetAnnotation(f).GetSkip(cfg) {
+			continue
+		}
+
+		if opts.BoundingBoxFilter {
+			filters = append(filters, &GeoFilter{Type: t, Field: f, Name: "bbox"})
+		}
+		if opts.RadiusFilter {
+			filters = append(filters, &GeoFilter{Type: t, Field: f, Name: "near"})
+		}
+	}
+	return filters
+}
+
+// ParameterName returns the query parameter name of the filter, e.g. "location.bbox".
+func (f *GeoFilter) ParameterName() string {
+	name := CamelCase(f.Field.Name)
+	if getFieldNameStyle(f.Type) != FieldNameStyleDefault {
+		name = GetFieldName(f.Type, f.Field)
+	}
+	return name + "." + f.Name
+}
+
+// ComponentName returns the name/component alias for the parameter.
+func (f *GeoFilter) ComponentName() string {
+	return PascalCase(f.Type.Name) + PascalCase(f.Field.Name) + PascalCase(f.Name)
+}
+
+// StructTag returns the struct tag for the filter.
+func (f *GeoFilter) StructTag() string {
+	return fmt.Sprintf(
+		`form:%q json:%q`,
+		f.ParameterName()+",omitempty",
+		SnakeCase(f.ComponentName())+",omitempty",
+	)
+}
+
+// Description returns a description for the filter.
+func (f *GeoFilter) Description() string {
+	if f.Name == "bbox" {
+		return fmt.Sprintf(
+			"Filters to entities where the %s field intersects the provided bounding box, as `minLng,minLat,maxLng,maxLat`.",
+			f.Field.Name,
+		)
+	}
+	return fmt.Sprintf(
+		"Filters to entities where the %s field is within the provided distance (in meters) of the provided position, as `lng,lat,meters`.",
+		f.Field.Name,
+	)
+}
+
+// Parameter returns the parameter for the filter.
+func (f *GeoFilter) Parameter() *ogen.Parameter {
+	pattern, example := geoFilterPattern(4), "-122.5,37.7,-122.3,37.8"
+	if f.Name == "near" {
+		pattern, example = geoFilterPattern(3), "-122.4194,37.7749,1000"
+	}
+
+	return &ogen.Parameter{
+		Name:        f.ParameterName(),
+		In:          "query",
+		Description: f.Description(),
+		Schema:      &ogen.Schema{Type: "string", Pattern: pattern},
+		Example:     ogen.ExampleValue(fmt.Sprintf("%q", example)),
+	}
+}
+
+// geoFilterPattern returns the pattern of a comma-separated list of the provided
+// amount of numbers.
+func geoFilterPattern(n int) string {
+	return fmt.Sprintf(`^-?[0-9]+(\.[0-9]+)?(,-?[0-9]+(\.[0-9]+)?){%d}$`, n-1)
+}
+
+// PredicateBuilder returns an expression which parses the filter value, and returns
+// the spatial predicate and any parsing error (see the generated geo predicates of the
+// rest package).
+func (f *GeoFilter) PredicateBuilder(structName string) string {
+	fn := "geoWithinBoundingBox"
+	if f.Name == "near" {
+		fn = "geoWithinRadius"
+	}
+
+	return fmt.Sprintf(
+		"%s[predicate.%s](%q, %s.%s, %d, *%s.%s)",
+		fn,
+		f.Type.Name,
+		f.ParameterName(),
+		f.Type.Package(),
+		f.Field.Constant(),
+		GetGeoOptions(f.Field).GetSRID(),
+		structName,
+		f.ComponentName(),
+	)
+}
+
+// orbPkgPath is the package path of github.com/paulmach/orb, whose geometry types are
+// encoded as GeoJSON coordinates.
+const orbPkgPath = "github.com/paulmach/orb"
+
+// geoGoTypes are the Go types (as their reflect string representation) which are
+// encoded as the GeoJSON coordinates of each geo type. Named types are only supported
+// from the orb package, as the underlying type of other named types isn't known.
+var geoGoTypes = map[GeoType][]string{
+	GeoTypePoint:   {"[2]float64", "orb.Point"},
+	GeoTypePolygon: {"[][][2]float64", "[][][]float64", "orb.Polygon"},
+}
+
+// geoColumnTypes are the (lowercase) substrings of the spatial column types, one of
+// which the column type of a geo field must contain for each dialect, e.g.
+// "geometry(Point, 4326)" for PostGIS, or "POINT" for MySQL.
+var geoColumnTypes = []string{"geometry", "geography", "point", "polygon"}
+
+// isGeoGoType returns true if the provided Go type is encoded as the GeoJSON
+// coordinates of the provided geo type.
+func isGeoGoType(rt *field.RType, geoType GeoType) bool {
+	if rt == nil || (rt.PkgPath != "" && rt.PkgPath != orbPkgPath) {
+		return false
+	}
+	return slices.Contains(geoGoTypes[geoType], rt.Ident)
+}
+
+// isGeoColumnType returns true if the provided column types (by dialect) are all
+// spatial column types.
+func isGeoColumnType(schemaTypes map[string]string) bool {
+	if len(schemaTypes) == 0 {
+		return false
+	}
+
+	for _, v := range schemaTypes {
+		if !slices.ContainsFunc(geoColumnTypes, func(s string) bool {
+			return strings.Contains(strings.ToLower(v), s)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// validateGeo checks that the geo options of the provided field are valid, and
+// supported by the Go type and the column type of the field.
+func validateGeo(f *gen.Field, fa *Annotation) (errs []error) {
+	if fa.Geo == nil {
+		return nil
+	}
+
+	if err := fa.Geo.validate(); err != nil {
+		errs = append(errs, err)
+	} else if !isGeoGoType(f.Type.RType, fa.Geo.Type) {
+		errs = append(errs, fmt.Errorf(
+			"geo type %s requires a GoType (or JSON type) of %s, which is encoded as GeoJSON coordinates",
+			fa.Geo.Type,
+			strings.Join(geoGoTypes[fa.Geo.Type], " or "),
+		))
+	}
+
+	if !isGeoColumnType(f.Column().SchemaType) {
+		errs = append(errs, errors.New(
+			"geo fields require a spatial column type for each dialect (e.g. SchemaType(map[string]string{dialect.Postgres: \"geometry(Point, 4326)\"}))",
+		))
+	}
+
+	if fa.TimeFormat != TimeFormatDefault || fa.NumericFormat != nil || fa.File != nil || fa.Hashed || fa.MoneyCurrencyField != "" {
+		errs = append(errs, errors.New("geo fields can't also be time, numeric, file, hashed or money fields"))
+	}
+
+	if fa.Filter != 0 || fa.FilterGroup != "" || fa.Sortable {
+		errs = append(errs, errors.New("geo fields only support spatial filters (see GeoOptions), and can't be sorted"))
+	}
+	return errs
+}
@@ -0,0 +1,113 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// injectGeo replaces the annotations of the provided field (i.e. the [WithSchema]
+// annotation of the integration schema, which would take precedence) with the provided
+// geo options, merged with the provided annotations. The annotations are replaced
+// rather than modified, as they are shared with the cached schema.
+func injectGeo(g *gen.Graph, schemaPath string, opts GeoOptions, annotations ...Annotation) {
+	parts := strings.Split(schemaPath, ".")
+
+	ant := WithGeo(opts)
+	for _, a := range annotations { // nolint:gocritic
+		ant, _ = ant.Merge(a).(Annotation)
+	}
+
+	for _, n := range g.Nodes {
+		if n.Name != parts[0] {
+			continue
+		}
+		for _, f := range n.Fields {
+			if f.Name == parts[1] {
+				f.Annotations = gen.Annotations{ant.Name(): ant}
+			}
+		}
+	}
+}
+
+func TestSpec_Geo(t *testing.T) {
+	t.Parallel()
+
+	t.Run("polygon", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectGeo(g, "AllTypes.polygon", GeoOptions{
+					Type:              GeoTypePolygon,
+					BoundingBoxFilter: true,
+					RadiusFilter:      true,
+				})
+				return nil
+			},
+		})
+
+		for _, name := range []string{"AllType", "AllTypeCreate", "AllTypeUpdate"} {
+			prefix := "$.components.schemas." + name + ".properties.polygon"
+
+			assert.Equal(t, "object", r.json(prefix+".type"), name)
+			assert.Equal(t, []any{"type", "coordinates"}, r.json(prefix+".required"), name)
+			assert.Equal(t, []any{"Polygon"}, r.json(prefix+".properties.type.enum"), name)
+			assert.Equal(t, "array", r.json(prefix+".properties.coordinates.items.items.type"), name)
+			assert.Equal(t, float64(4), r.json(prefix+".properties.coordinates.items.minItems"), name)
+		}
+
+		assert.Equal(t, "polygon.bbox", r.json(`$.components.parameters.AllTypesPolygonBbox.name`))
+		assert.Equal(t, "polygon.near", r.json(`$.components.parameters.AllTypesPolygonNear.name`))
+		assert.Contains(t, r.json(`$.paths./all-types.get.parameters[*].$ref`), "#/components/parameters/AllTypesPolygonBbox")
+		assert.Contains(t, r.json(`$.paths./all-types.get.parameters[*].$ref`), "#/components/parameters/AllTypesPolygonNear")
+	})
+
+	t.Run("no-filters", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectGeo(g, "AllTypes.polygon", GeoOptions{Type: GeoTypePolygon})
+				return nil
+			},
+		})
+
+		assert.Nil(t, r.json(`$.components.parameters.AllTypesPolygonBbox`))
+		assert.Nil(t, r.json(`$.components.parameters.AllTypesPolygonNear`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			path     string
+			opts     GeoOptions
+			extra    []Annotation
+			contains string
+		}{
+			{"AllTypes.point", GeoOptions{Type: "LineString"}, nil, `unsupported geo type provided: "LineString"`},
+			{"AllTypes.polygon", GeoOptions{Type: GeoTypePolygon, SRID: -1}, nil, "GeoOptions.SRID must be >= 0"},
+			{"AllTypes.polygon", GeoOptions{Type: GeoTypePoint}, nil, "geo type Point requires a GoType (or JSON type) of [2]float64 or orb.Point"},
+			{"AllTypes.floats", GeoOptions{Type: GeoTypePolygon}, nil, "geo type Polygon requires a GoType (or JSON type) of [][][2]float64 or [][][]float64 or orb.Polygon"},
+			{"AllTypes.floats", GeoOptions{Type: GeoTypePolygon}, nil, "geo fields require a spatial column type"},
+			{"AllTypes.polygon", GeoOptions{Type: GeoTypePolygon}, []Annotation{WithSortable(true)}, "geo fields only support spatial filters"},
+		} {
+			_, err := buildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectGeo(g, tt.path, tt.opts, tt.extra...)
+					return ValidateAnnotations(g.Nodes...)
+				},
+			})
+			require.ErrorContains(t, err, tt.contains, tt.path)
+		}
+	})
+}
@@ -4,6 +4,8 @@ go 1.23.0
 
 require (
 	entgo.io/ent v0.14.1
+	github.com/go-faster/jx v1.1.0
+	github.com/go-faster/yaml v0.4.6
 	github.com/go-openapi/inflect v0.21.0
 	github.com/ogen-go/ogen v1.3.0
 	github.com/stoewer/go-strcase v1.3.0
@@ -17,8 +19,6 @@ require (
 	github.com/fatih/color v1.17.0 // indirect
 	github.com/ghodss/yaml v1.0.0 // indirect
 	github.com/go-faster/errors v0.7.1 // indirect
-	github.com/go-faster/jx v1.1.0 // indirect
-	github.com/go-faster/yaml v0.4.6 // indirect
 	github.com/google/go-cmp v0.6.0 // indirect
 	github.com/google/uuid v1.6.0 // indirect
 	github.com/hashicorp/hcl/v2 v2.22.0 // indirect
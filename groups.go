@@ -0,0 +1,132 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+// tagGroupsExtension is the spec extension used to group tags, which is supported by
+// documentation tools like Redoc.
+const tagGroupsExtension = "x-tagGroups"
+
+// ungroupedTagGroup is the name of the tag group which includes all tags not part of
+// any other group.
+const ungroupedTagGroup = "Other"
+
+// TagGroup is a group of tags, as used by the "x-tagGroups" spec extension.
+type TagGroup struct {
+	Name string   `json:"name" yaml:"name"`
+	Tags []string `json:"tags" yaml:"tags"`
+}
+
+// GetTagGroups returns the tag groups of the provided types, based on their group (see
+// [WithGroup]), in the order the groups are first seen. Tags of the spec which aren't
+// part of any group are added to a final "Other" group, as documentation tools which
+// support tag groups typically hide tags which aren't part of a group. Returns nil if
+// none of the types have a group.
+func GetTagGroups(spec *ogen.Spec, nodes []*gen.Type) (groups []*TagGroup) {
+	var grouped []string
+
+	for _, t := range nodes {
+		ta := GetAnnotation(t)
+		if ta.Group == "" || ta.GetSkip(GetConfig(t.Config)) {
+			continue
+		}
+
+		idx := slices.IndexFunc(groups, func(g *TagGroup) bool { return g.Name == ta.Group })
+		if idx == -1 {
+			groups = append(groups, &TagGroup{Name: ta.Group})
+			idx = len(groups) - 1
+		}
+
+		tags := sliceOr(ta.Tags, []string{Pluralize(t.Name)})
+		groups[idx].Tags = appendCompact(groups[idx].Tags, tags)
+		grouped = append(grouped, tags...)
+	}
+
+	if len(groups) == 0 {
+		return nil
+	}
+
+	other := &TagGroup{Name: ungroupedTagGroup}
+	for _, tag := range spec.Tags {
+		if !slices.Contains(grouped, tag.Name) {
+			other.Tags = appendCompact(other.Tags, []string{tag.Name})
+		}
+	}
+
+	if len(other.Tags) > 0 {
+		groups = append(groups, other)
+	}
+	return groups
+}
+
+// addTagGroups adds the "x-tagGroups" spec extension, based on the groups of the
+// provided types (see [GetTagGroups]).
+func addTagGroups(spec *ogen.Spec, nodes []*gen.Type) error {
+	groups := GetTagGroups(spec, nodes)
+	if groups == nil {
+		return nil
+	}
+
+	var node yaml.Node
+	if err := node.Encode(groups); err != nil {
+		return fmt.Errorf("failed to encode tag groups: %w", err)
+	}
+
+	if spec.Extensions == nil {
+		spec.Extensions = ogen.Extensions{}
+	}
+	spec.Extensions[tagGroupsExtension] = node
+	return nil
+}
+
+// MarshalSpec marshals the provided spec into indented JSON. Unlike [json.Marshal],
+// this also includes the top-level spec extensions (e.g. "x-tagGroups") and the
+// extensions of schema properties (e.g. "readOnly" on computed fields), and retains
+// the type of operation extensions (see [Config.OperationExtensions]).
+func MarshalSpec(spec *ogen.Spec) ([]byte, error) {
+	b, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = marshalOperationExtensions(spec, b)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err = marshalSchemaExtensions(spec, b)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(spec.Extensions) > 0 {
+		ext, err := json.Marshal(spec.Extensions)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal spec extensions: %w", err)
+		}
+
+		if len(ext) > 2 {
+			// Both are JSON objects, so splice the extensions into the end of the spec.
+			b = append(append(b[:len(b)-1], ','), ext[1:]...)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err = json.Indent(&buf, b, "", "    "); err != nil {
+		return nil, err
+	}
+	buf.WriteByte('\n')
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_Group(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Nil(t, r.json(`$.x-tagGroups`))
+	})
+
+	t.Run("grouped", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithGroup("animals"))
+				injectAnnotations(t, g, "Category", WithGroup("animals"))
+				return nil
+			},
+		})
+
+		assert.NotNil(t, r.json(`$.paths./animals/pets.get`))
+		assert.NotNil(t, r.json(`$.paths./animals/pets/{petID}.get`))
+		assert.NotNil(t, r.json(`$.paths./animals/pets/{petID}/owner.get`))
+		assert.NotNil(t, r.json(`$.paths./animals/categories.get`))
+		assert.Nil(t, r.json(`$.paths./pets.get`))
+		assert.NotNil(t, r.json(`$.paths./users.get`))
+
+		assert.Equal(t, "animals", r.json(`$.x-tagGroups[0].name`))
+		assert.Equal(t, []any{"Categories", "Pets"}, r.json(`$.x-tagGroups[0].tags`))
+		assert.Equal(t, "Other", r.json(`$.x-tagGroups[1].name`))
+		assert.Contains(t, r.json(`$.x-tagGroups[1].tags`), "Users")
+		assert.Contains(t, r.json(`$.x-tagGroups[1].tags`), "Meta")
+		assert.NotContains(t, r.json(`$.x-tagGroups[1].tags`), "Pets")
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"maps"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+// getHashedFields returns the fields of the provided type which are hashed before
+// they're persisted (see [WithHashed]), and can be set in the request body of the
+// provided operation (create or update).
+func getHashedFields(t *gen.Type, op Operation) (fields []*gen.Field) {
+	cfg := GetConfig(t.Config)
+
+	if GetAnnotation(t).GetSkip(cfg) || IsReadOnly(t) {
+		return nil
+	}
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if !fa.Hashed || fa.GetSkip(cfg) || !fa.GetWritable(op) || (op == OperationUpdate && f.Immutable) {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// hasHashedFields returns true if any of the provided types have hashed fields.
+func hasHashedFields(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return len(getHashedFields(t, OperationCreate)) > 0 || len(getHashedFields(t, OperationUpdate)) > 0
+	})
+}
+
+// hashedFieldSchema returns a copy of the provided schema of a hashed field (see
+// [WithHashed]), marked as write-only, with the "password" format. [ogen.Schema] doesn't
+// support "writeOnly", so it's provided as an extension, which is encoded by
+// [MarshalSpec].
+func hashedFieldSchema(schema *ogen.Schema) *ogen.Schema {
+	out := *schema
+	out.Format = "password"
+	out.Common.Extensions = maps.Clone(out.Common.Extensions)
+	if out.Common.Extensions == nil {
+		out.Common.Extensions = ogen.Extensions{}
+	}
+	out.Common.Extensions["writeOnly"] = yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"}
+	return &out
+}
+
+// validateHashedField checks that the provided hashed field (see [WithHashed]) is a
+// string field, which isn't exposed through any other means.
+func validateHashedField(t *gen.Type, f *gen.Field, fa *Annotation) (errs []error) {
+	if !fa.Hashed {
+		return nil
+	}
+
+	if f == t.ID {
+		return append(errs, errors.New("hashed fields aren't supported on ID fields"))
+	}
+
+	if !f.IsString() || f.HasGoType() {
+		errs = append(errs, errors.New("hashed fields must be string fields"))
+	}
+
+	if fa.Filter != 0 || fa.FilterGroup != "" || fa.Sortable {
+		errs = append(errs, errors.New("filtering or sorting is enabled on a hashed field"))
+	}
+
+	if fa.Example != nil {
+		errs = append(errs, errors.New("example is set on a hashed field, which would leak into the spec"))
+	}
+	return errs
+}
@@ -0,0 +1,32 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_HashedFields(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.password_hashed", WithHashed(true))
+			return nil
+		},
+	})
+
+	for _, name := range []string{"UserCreate", "UserUpdate"} {
+		base := `$.components.schemas.` + name + `.properties.password_hashed`
+		assert.Equal(t, "password", r.json(base+`.format`), name)
+		assert.Equal(t, true, r.json(base+`.writeOnly`), name)
+	}
+
+	assert.Nil(t, r.json(`$.components.schemas.User.properties.password_hashed`))
+}
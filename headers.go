@@ -0,0 +1,199 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// requestHeaderGoTypes maps the supported schema types of request headers (see
+// [WithRequestHeader]) to the Go type of their parsed values.
+var requestHeaderGoTypes = map[string]string{
+	"":        "string",
+	"string":  "string",
+	"integer": "int64",
+	"number":  "float64",
+	"boolean": "bool",
+}
+
+// requestHeader is a request header of one or more operations (see
+// [WithRequestHeader]), as used by the generated handlers.
+type requestHeader struct {
+	Name     string   // The canonical name of the header, e.g. "X-Tenant-Id".
+	FuncName string   // The suffix of the generated functions, e.g. "XTenantID".
+	GoType   string   // The Go type of the parsed value, e.g. "int64".
+	Enum     []string // The allowed values (string headers only).
+}
+
+// getRequestHeaderName returns the canonical name of the provided header.
+func getRequestHeaderName(header string) string {
+	return http.CanonicalHeaderKey(header)
+}
+
+// getRequestHeaderFuncName returns the suffix of the generated functions of the
+// provided header, e.g. "XTenantID" for "X-Tenant-ID".
+func getRequestHeaderFuncName(header string) string {
+	return PascalCase(strings.ReplaceAll(strings.ToLower(header), "-", "_"))
+}
+
+// getRequestHeaderGoType returns the Go type of the parsed value of a header with the
+// provided schema, or an empty string if the schema isn't supported.
+func getRequestHeaderGoType(schema *ogen.Schema) string {
+	if schema == nil {
+		return "string"
+	}
+	return requestHeaderGoTypes[schema.Type]
+}
+
+// getRequestHeaders returns all request headers of the provided types (see
+// [WithRequestHeader]) which are used by the generated handlers, sorted by name.
+func getRequestHeaders(nodes []*gen.Type) (headers []*requestHeader) {
+	for _, t := range nodes {
+		cfg := GetConfig(t.Config)
+		ta := GetAnnotation(t)
+
+		if ta.GetSkip(cfg) || ta.DisableHandler {
+			continue
+		}
+
+		for _, op := range ta.GetOperations(cfg) {
+			for _, k := range mapKeys(ta.RequestHeaders[op]) {
+				name := getRequestHeaderName(k)
+				if slices.ContainsFunc(headers, func(h *requestHeader) bool { return h.Name == name }) {
+					continue
+				}
+
+				p := ta.RequestHeaders[op][k]
+				h := &requestHeader{
+					Name:     name,
+					FuncName: getRequestHeaderFuncName(name),
+					GoType:   getRequestHeaderGoType(p.Schema),
+				}
+
+				if h.GoType == "string" && p.Schema != nil {
+					for _, v := range p.Schema.Enum {
+						var s string
+						if err := json.Unmarshal(v, &s); err == nil {
+							h.Enum = append(h.Enum, s)
+						}
+					}
+				}
+
+				headers = append(headers, h)
+			}
+		}
+	}
+
+	slices.SortFunc(headers, func(a, b *requestHeader) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return headers
+}
+
+// wrapRequestHeaders wraps the provided handler (Go expression) of the provided
+// operation, so the request headers of the operation are validated, and stored in the
+// request context (see [WithRequestHeader]).
+func wrapRequestHeaders(t *gen.Type, op Operation, handler string) string {
+	headers := GetAnnotation(t).RequestHeaders[op]
+	if len(headers) == 0 {
+		return handler
+	}
+
+	var args []string
+	for _, k := range mapKeys(headers) {
+		name := getRequestHeaderName(k)
+		args = append(args, fmt.Sprintf(
+			"{name: %q, required: %t, parse: parseRequestHeader%s}",
+			name,
+			headers[k].Required,
+			getRequestHeaderFuncName(name),
+		))
+	}
+
+	return fmt.Sprintf(
+		"withRequestHeaders(s, Operation%s, []requestHeader{%s}, %s)",
+		PascalCase(string(op)),
+		strings.Join(args, ", "),
+		handler,
+	)
+}
+
+// addRequestHeaders adds the provided request headers (see [WithRequestHeader]) to
+// the parameters of the provided operation.
+func addRequestHeaders(oper *ogen.Operation, headers RequestHeaders) {
+	for _, k := range mapKeys(headers) {
+		p := *headers[k]
+		p.Name = k
+		p.In = "header"
+		if p.Schema == nil {
+			p.Schema = ogen.String()
+		}
+		oper.Parameters = append(oper.Parameters, &p)
+	}
+}
+
+// validateRequestHeaders checks that the request headers of a schema (see
+// [WithRequestHeader]) are provided for known operations, and use a supported schema.
+func validateRequestHeaders(a *Annotation) (errs []error) {
+	for _, op := range mapKeys(a.RequestHeaders) {
+		if !slices.Contains(AllOperations, op) {
+			errs = append(errs, fmt.Errorf("request header provided for unknown operation %q", op))
+			continue
+		}
+
+		for _, k := range mapKeys(a.RequestHeaders[op]) {
+			if k == "" {
+				errs = append(errs, fmt.Errorf("request header with an empty name provided for the %s operation", op))
+				continue
+			}
+
+			if p := a.RequestHeaders[op][k]; getRequestHeaderGoType(p.Schema) == "" {
+				errs = append(errs, fmt.Errorf(
+					"request header %q of the %s operation has an unsupported schema type %q (must be string, integer, number or boolean)",
+					k, op, p.Schema.Type,
+				))
+			}
+		}
+	}
+	return errs
+}
+
+// validateRequestHeaderTypes checks that request headers with the same name (see
+// [WithRequestHeader]) use the same schema type across all schemas and operations,
+// as their parsed values are retrieved through the same generated function.
+func validateRequestHeaderTypes(cfg *Config, nodes []*gen.Type) (errs []error) {
+	seen := map[string]string{}
+
+	for _, t := range nodes {
+		ta := GetAnnotation(t)
+		if ta.GetSkip(cfg) {
+			continue
+		}
+
+		for _, op := range mapKeys(ta.RequestHeaders) {
+			for _, k := range mapKeys(ta.RequestHeaders[op]) {
+				name := getRequestHeaderName(k)
+				typ := getRequestHeaderGoType(ta.RequestHeaders[op][k].Schema)
+
+				if other, ok := seen[name]; ok && other != typ {
+					errs = append(errs, &AnnotationError{
+						Schema: t.Name,
+						Err:    fmt.Errorf("request header %q is used with conflicting schema types across operations", name),
+					})
+					continue
+				}
+				seen[name] = typ
+			}
+		}
+	}
+	return errs
+}
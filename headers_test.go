@@ -0,0 +1,45 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_RequestHeader(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet",
+				WithRequestHeader(OperationList, "X-Tenant-ID", ogen.Int(), true),
+				WithRequestHeader(OperationCreate, "X-Source", nil, false),
+			)
+			return nil
+		},
+	})
+
+	assert.Equal(t, "header", r.json(`$.paths./pets.get.parameters[?(@.name == 'X-Tenant-ID')].in`))
+	assert.Equal(t, true, r.json(`$.paths./pets.get.parameters[?(@.name == 'X-Tenant-ID')].required`))
+	assert.Equal(t, "integer", r.json(`$.paths./pets.get.parameters[?(@.name == 'X-Tenant-ID')].schema.type`))
+
+	assert.Equal(t, "string", r.json(`$.paths./pets.post.parameters[?(@.name == 'X-Source')].schema.type`))
+	assert.Nil(t, r.json(`$.paths./pets.post.parameters[?(@.name == 'X-Source')].required`))
+
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.get.parameters[?(@.name == 'X-Tenant-ID')]`))
+	assert.Nil(t, r.json(`$.paths./users.get.parameters[?(@.name == 'X-Tenant-ID')]`))
+}
+
+func TestGetRequestHeaderFuncName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "XTenantID", getRequestHeaderFuncName("X-Tenant-ID"))
+	assert.Equal(t, "XTenantID", getRequestHeaderFuncName(getRequestHeaderName("x-tenant-id")))
+	assert.Equal(t, "APIVersion", getRequestHeaderFuncName("Api-Version"))
+}
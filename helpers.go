@@ -8,6 +8,7 @@ import (
 	"cmp"
 	"encoding/json"
 	"fmt"
+	"path"
 	"slices"
 	"sync"
 
@@ -177,8 +178,117 @@ func patchJSONTag(g *gen.Graph) error {
 			if field.StructTag == `json:"-"` {
 				continue
 			}
-			field.StructTag = fmt.Sprintf("json:%q", field.Name)
+			field.StructTag = fmt.Sprintf("json:%q", GetFieldName(node, field))
+		}
+
+		if getFieldNameStyle(node) == FieldNameStyleDefault {
+			continue
+		}
+
+		for _, edge := range node.Edges {
+			if edge.StructTag == `json:"-"` {
+				continue
+			}
+			edge.StructTag = fmt.Sprintf("json:%q", GetEdgeName(node, edge, "")+",omitempty")
 		}
 	}
 	return nil
 }
+
+// matchSchema returns true if the provided schema name matches any of the provided
+// glob patterns (see [path.Match]). Patterns are validated in [Config.Validate].
+func matchSchema(name string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// applySchemaFilters marks all schemas which are excluded through
+// [Config.IncludeSchemas] and [Config.ExcludeSchemas] as skipped, as if [WithSkip]
+// was provided, including all edges which point to those schemas.
+func applySchemaFilters(cfg *Config, g *gen.Graph) {
+	if len(cfg.IncludeSchemas) == 0 && len(cfg.ExcludeSchemas) == 0 {
+		return
+	}
+
+	skip := func(as gen.Annotations) gen.Annotations {
+		return withAnnotation(as, func(a *Annotation) { a.Skip = true })
+	}
+
+	excluded := map[string]bool{}
+	for _, t := range g.Nodes {
+		if (len(cfg.IncludeSchemas) > 0 && !matchSchema(t.Name, cfg.IncludeSchemas)) ||
+			matchSchema(t.Name, cfg.ExcludeSchemas) {
+			excluded[t.Name] = true
+			t.Annotations = skip(t.Annotations)
+		}
+	}
+
+	for _, t := range g.Nodes {
+		for _, e := range t.Edges {
+			if excluded[e.Type.Name] {
+				e.Annotations = skip(e.Annotations)
+			}
+		}
+	}
+}
+
+// applyOperationGate excludes all operations of each schema for which
+// [Config.OperationGate] returns false. If no operations remain, the schema is skipped,
+// as if [WithSkip] was provided.
+func applyOperationGate(cfg *Config, g *gen.Graph) {
+	if cfg.OperationGate == nil {
+		return
+	}
+
+	for _, t := range g.Nodes {
+		ops := GetAnnotation(t).GetOperations(cfg)
+
+		gated := slices.DeleteFunc(slices.Clone(ops), func(op Operation) bool {
+			return !cfg.OperationGate(t.Name, op)
+		})
+		if len(gated) == len(ops) {
+			continue
+		}
+
+		t.Annotations = withAnnotation(t.Annotations, func(a *Annotation) {
+			a.Operations = gated
+			a.Skip = a.Skip || len(gated) == 0
+		})
+	}
+}
+
+// withAnnotation returns a copy of the provided annotations, with the entrest
+// annotation modified by fn. The annotations are copied, as they may be shared with
+// the loaded schema.
+func withAnnotation(as gen.Annotations, fn func(a *Annotation)) gen.Annotations {
+	a := decodeAnnotation(as)
+	fn(a)
+
+	out := make(gen.Annotations, len(as)+1)
+	for k, v := range as {
+		out[k] = v
+	}
+	out.Set(a.Name(), a)
+	return out
+}
+
+// decodeExternalAnnotation decodes the annotation of another extension (e.g. entproto
+// or entgql) with the provided name from the provided annotations into v, returning
+// false if the annotation isn't set. Annotations are decoded through JSON, which avoids
+// depending on the extension, as they are provided as maps when loaded from a schema.
+func decodeExternalAnnotation(annotations map[string]any, name string, v any) bool {
+	raw, ok := annotations[name]
+	if !ok || raw == nil {
+		return false
+	}
+
+	b, err := json.Marshal(raw)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(b, v) == nil
+}
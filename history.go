@@ -0,0 +1,372 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/ogen-go/ogen"
+)
+
+// HistorySchemaName is the name of the schema which is added to the graph when
+// [Config.WithHistory] is enabled.
+const HistorySchemaName = "History"
+
+// HistoryMixin enables the history of the schema which it's mixed into, when
+// [Config.WithHistory] is enabled. Each create, update and delete of a single entity
+// of the schema is recorded (see [Config.WithHistory]), and the history is exposed
+// through the following endpoints:
+//
+//   - "GET /<entities>/{id}/history" lists the versions of the entity.
+//   - "GET /<entities>/{id}/history/{version}" returns a version, including a snapshot
+//     of the entity.
+//   - "POST /<entities>/{id}/history/{version}/revert" reverts the entity to a
+//     version (if the schema has the update operation).
+type HistoryMixin struct {
+	mixin.Schema
+}
+
+// Annotations implements [ent.Mixin].
+func (HistoryMixin) Annotations() []schema.Annotation {
+	return []schema.Annotation{Annotation{History: true}}
+}
+
+var _ ent.Mixin = HistoryMixin{}
+
+// historySchema returns the schema which stores history entries (see
+// [Config.WithHistory]), which also records the actor of each entry when the type of
+// the principal is configured (see [Config.Principal]).
+func historySchema(cfg *Config) *load.Schema {
+	s := &load.Schema{
+		Name: HistorySchemaName,
+		Fields: []*load.Field{
+			{
+				Name:      "entity",
+				Info:      &field.TypeInfo{Type: field.TypeString},
+				Immutable: true,
+				Comment:   `The name of the schema of the entity, e.g. "Pet".`,
+			},
+			{
+				Name:      "entity_id",
+				Info:      &field.TypeInfo{Type: field.TypeInt},
+				Immutable: true,
+				Comment:   "The ID of the entity.",
+			},
+			{
+				Name:      "version",
+				Info:      &field.TypeInfo{Type: field.TypeInt},
+				Immutable: true,
+				Comment:   "The version of the entity, starting at 1, incremented by each mutation.",
+			},
+			{
+				Name:      "operation",
+				Info:      &field.TypeInfo{Type: field.TypeString},
+				Immutable: true,
+				Comment:   `The operation which created the version, e.g. "update".`,
+			},
+			{
+				Name:      "changes",
+				Info:      &field.TypeInfo{Type: field.TypeBytes},
+				Immutable: true,
+				Comment:   "The JSON encoded changes of the fields of the entity, with their previous and new values.",
+			},
+			{
+				Name:      "data",
+				Info:      &field.TypeInfo{Type: field.TypeBytes, Nillable: true},
+				Optional:  true,
+				Nillable:  true,
+				Immutable: true,
+				Comment:   "The JSON encoded entity after the mutation, or nil for deleted entities.",
+			},
+			{
+				Name:      "created_at",
+				Info:      &field.TypeInfo{Type: field.TypeTime, PkgPath: "time"},
+				Immutable: true,
+				Comment:   "When the version was created.",
+			},
+		},
+		Indexes: []*load.Index{
+			{Fields: []string{"entity", "entity_id", "version"}, Unique: true},
+		},
+	}
+
+	if cfg.Principal != nil {
+		s.Fields = append(s.Fields, &load.Field{
+			Name:      "actor",
+			Info:      &field.TypeInfo{Type: field.TypeString, Nillable: true},
+			Optional:  true,
+			Nillable:  true,
+			Immutable: true,
+			Comment:   "The principal which created the version, if the mutation was executed on behalf of one.",
+		})
+	}
+	return s
+}
+
+// applyHistory adds the history schema (see [Config.WithHistory]) to the graph, skipped
+// from the REST API.
+func applyHistory(cfg *Config, g *gen.Graph) error {
+	if !cfg.WithHistory {
+		return nil
+	}
+
+	if err := addGraphSchema(g, historySchema(cfg)); err != nil {
+		return fmt.Errorf("failed to add history schema (see Config.WithHistory): %w", err)
+	}
+	return nil
+}
+
+// hasHistory returns true if the history of the provided type is recorded, and exposed
+// through its history endpoints (see [HistoryMixin]).
+func hasHistory(t *gen.Type) bool {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	return cfg.WithHistory &&
+		ta.History &&
+		t.ID != nil &&
+		!ta.GetSkip(cfg) &&
+		!IsReadOnly(t) &&
+		ta.HasOperation(cfg, OperationRead)
+}
+
+// hasAnyHistory returns true if the history of any of the provided types is recorded
+// (see [HistoryMixin]).
+func hasAnyHistory(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, hasHistory)
+}
+
+// hasHistoryRevert returns true if the provided type, of which the history is recorded,
+// can be reverted to a previous version, which requires the update operation.
+func hasHistoryRevert(t *gen.Type) bool {
+	return hasHistory(t) && GetAnnotation(t).HasOperation(GetConfig(t.Config), OperationUpdate)
+}
+
+// getHistoryFieldNames returns the names of the fields of the provided type which are
+// recorded in its history (see [HistoryMixin]), mapped to their names in the API.
+// Sensitive, skipped and write-only fields are never recorded.
+func getHistoryFieldNames(t *gen.Type) map[string]string {
+	cfg := GetConfig(t.Config)
+	names := map[string]string{}
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if f.Sensitive() || isFileField(f) || fa.GetSkip(cfg) || !fa.GetReadable() {
+			continue
+		}
+		names[f.Name] = GetFieldName(t, f)
+	}
+	return names
+}
+
+// GetHistoryPathName returns the path of the history endpoint of the provided type
+// (see [HistoryMixin]) for the provided operation: [OperationList] (e.g.
+// "/pets/{id}/history"), [OperationRead] (e.g. "/pets/{id}/history/{version}"), or
+// [OperationUpdate] (revert, e.g. "/pets/{id}/history/{version}/revert"). useUniqueID
+// determines if the ID path parameter should be "{id}" or "{type|camel}ID".
+func GetHistoryPathName(op Operation, t *gen.Type, useUniqueID bool) string {
+	base := GetPathName(OperationRead, t, nil, useUniqueID) + "/history"
+
+	switch op {
+	case OperationList:
+		return base
+	case OperationRead:
+		return base + "/{version}"
+	case OperationUpdate:
+		return base + "/{version}/revert"
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+}
+
+// GetHistoryOperationID returns the operation ID of the history endpoint of the provided
+// type for the provided operation (see [GetHistoryPathName]), e.g. "listPetHistory",
+// "readPetHistory" or "revertPetHistory".
+func GetHistoryOperationID(op Operation, t *gen.Type) string {
+	switch op {
+	case OperationList:
+		return "list" + GetSchemaName(t) + "History"
+	case OperationRead:
+		return "read" + GetSchemaName(t) + "History"
+	case OperationUpdate:
+		return "revert" + GetSchemaName(t) + "History"
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+}
+
+// GetSpecHistory generates an independent spec for the history endpoints of the provided
+// type (see [HistoryMixin]).
+func GetSpecHistory(t *gen.Type) (*ogen.Spec, error) {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	entityName := GetSchemaName(t)
+
+	spec := newBaseSpec(cfg)
+	spec.Tags = append(spec.Tags, ogen.Tag{Name: Pluralize(t.Name), Description: ta.Description})
+
+	if err := addIDParameters(spec, t); err != nil {
+		return nil, err
+	}
+
+	spec.Components.Parameters["HistoryVersion"] = &ogen.Parameter{
+		Name:        "version",
+		In:          "path",
+		Description: "The version of the entity.",
+		Required:    true,
+		Schema:      ogen.Int().SetMinimum(ptr(int64(1))),
+	}
+
+	spec.Components.Schemas["HistoryChange"] = &ogen.Schema{
+		Type:        "object",
+		Description: "A change of a field of an entity.",
+		Properties: ogen.Properties{
+			{Name: "field", Schema: &ogen.Schema{Type: "string", Description: "The name of the field."}},
+			{Name: "old", Schema: &ogen.Schema{Description: "The previous value of the field, or null if it wasn't set."}},
+			{Name: "new", Schema: &ogen.Schema{Description: "The new value of the field, or null if it was cleared."}},
+		},
+		Required: []string{"field", "old", "new"},
+	}
+
+	spec.Components.Schemas["HistoryEntry"] = &ogen.Schema{
+		Type:        "object",
+		Description: "A version of an entity, created by a mutation.",
+		Properties: ogen.Properties{
+			{Name: "version", Schema: &ogen.Schema{Type: "integer", Description: "The version of the entity, starting at 1."}},
+			{Name: "operation", Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "The operation which created the version.",
+				Enum:        sliceToRawMessage([]Operation{OperationCreate, OperationUpdate, OperationDelete}),
+			}},
+			{Name: "changes", Schema: &ogen.Schema{
+				Type:        "array",
+				Description: "The changes of the fields of the entity.",
+				Items:       &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/HistoryChange"}},
+			}},
+			{Name: "data", Schema: &ogen.Schema{
+				Type:        "object",
+				Description: "A snapshot of the entity at the version (only included when reading a single version), or null for deleted entities.",
+				Nullable:    true,
+			}},
+			{Name: "created_at", Schema: &ogen.Schema{Type: "string", Format: "date-time", Description: "When the version was created."}},
+		},
+		Required: []string{"version", "operation", "changes", "created_at"},
+	}
+
+	if cfg.Principal != nil {
+		spec.Components.Schemas["HistoryEntry"].Properties = append(
+			spec.Components.Schemas["HistoryEntry"].Properties,
+			ogen.Property{Name: "actor", Schema: &ogen.Schema{
+				Type:        "string",
+				Description: "The principal which created the version, or null if it wasn't created on behalf of one.",
+				Nullable:    true,
+			}},
+		)
+	}
+
+	spec.Paths[GetHistoryPathName(OperationList, t, true)] = &ogen.PathItem{
+		Parameters: append([]*ogen.Parameter{{Ref: "#/components/parameters/PrettyResponse"}}, getIDParameterRefs(t)...),
+		Get: &ogen.Operation{
+			Tags:        []string{Pluralize(t.Name)},
+			Summary:     fmt.Sprintf("List the history of a %s", CamelCase(entityName)),
+			Description: fmt.Sprintf("List the versions of a %s entity, in the order they were created.", entityName),
+			OperationID: GetHistoryOperationID(OperationList, t),
+			Deprecated:  ta.Deprecated,
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The versions of the %s entity.", entityName)).
+					SetJSONContent(&ogen.Schema{
+						Type:  "array",
+						Items: &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/HistoryEntry"}},
+					}),
+			},
+		},
+	}
+
+	spec.Paths[GetHistoryPathName(OperationRead, t, true)] = &ogen.PathItem{
+		Parameters: append(
+			append([]*ogen.Parameter{{Ref: "#/components/parameters/PrettyResponse"}}, getIDParameterRefs(t)...),
+			&ogen.Parameter{Ref: "#/components/parameters/HistoryVersion"},
+		),
+		Get: &ogen.Operation{
+			Tags:        []string{Pluralize(t.Name)},
+			Summary:     fmt.Sprintf("Retrieve a version of a %s", CamelCase(entityName)),
+			Description: fmt.Sprintf("Retrieve a single version of a %s entity, including a snapshot of the entity.", entityName),
+			OperationID: GetHistoryOperationID(OperationRead, t),
+			Deprecated:  ta.Deprecated,
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The requested version of the %s entity.", entityName)).
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/HistoryEntry"}),
+			},
+		},
+	}
+
+	if hasHistoryRevert(t) {
+		for k, v := range GetSchemaType(t, OperationRead, nil) {
+			spec.Components.Schemas[k] = v
+		}
+
+		revert := &ogen.Operation{
+			Tags:    []string{Pluralize(t.Name)},
+			Summary: fmt.Sprintf("Revert a %s to a version", CamelCase(entityName)),
+			Description: fmt.Sprintf(
+				"Revert a %s entity to a previous version, updating its fields with the snapshot of the version, which creates a new version.",
+				entityName,
+			),
+			OperationID: GetHistoryOperationID(OperationUpdate, t),
+			Deprecated:  ta.Deprecated,
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The reverted %s entity.", entityName)).
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(t)}),
+			},
+		}
+		addMaintenanceResponse(cfg, revert)
+
+		spec.Paths[GetHistoryPathName(OperationUpdate, t, true)] = &ogen.PathItem{
+			Parameters: append(
+				append([]*ogen.Parameter{{Ref: "#/components/parameters/PrettyResponse"}}, getIDParameterRefs(t)...),
+				&ogen.Parameter{Ref: "#/components/parameters/HistoryVersion"},
+			),
+			Post: revert,
+		}
+	}
+	return spec, nil
+}
+
+// validateHistory checks that a schema with history (see [HistoryMixin]) has a single
+// ID field, history is enabled (see [Config.WithHistory]), and the history endpoints
+// don't conflict with the path of any edge.
+func validateHistory(cfg *Config, t *gen.Type, ta *Annotation) (errs []error) {
+	if !ta.History || ta.GetSkip(cfg) {
+		return nil
+	}
+
+	if !cfg.WithHistory {
+		return []error{errors.New("history is enabled (see HistoryMixin), but Config.WithHistory isn't")}
+	}
+
+	if t.ID == nil {
+		return []error{errors.New("history is only supported on schemas with a single ID field")}
+	}
+
+	for _, e := range t.Edges {
+		if GetPathSegment(t, e) == "history" {
+			errs = append(errs, fmt.Errorf("history endpoint path conflicts with the path of edge %q", e.Name))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,66 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_WithHistory(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			Handler:     HandlerStdlib,
+			WithHistory: true,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", Annotation{History: true})
+				return nil
+			},
+		})
+
+		var history *gen.Type
+		for _, n := range r.graph.Nodes {
+			if n.Name == HistorySchemaName {
+				history = n
+			}
+		}
+		require.NotNil(t, history)
+		assert.True(t, GetAnnotation(history).Skip)
+
+		assert.Equal(t, "listPetHistory", r.json(`$.paths./pets/{petID}/history.get.operationId`))
+		assert.Equal(t, "#/components/schemas/HistoryEntry", r.json(`$.paths./pets/{petID}/history.get.responses.200.content['application/json'].schema.items.$ref`))
+		assert.Equal(t, "readPetHistory", r.json(`$.paths./pets/{petID}/history/{version}.get.operationId`))
+		assert.Equal(t, "revertPetHistory", r.json(`$.paths./pets/{petID}/history/{version}/revert.post.operationId`))
+		assert.Equal(t, "#/components/schemas/PetRead", r.json(`$.paths./pets/{petID}/history/{version}/revert.post.responses.200.content['application/json'].schema.$ref`))
+		assert.NotNil(t, r.json(`$.components.schemas.HistoryChange`))
+
+		assert.Nil(t, r.json(`$.paths./histories`))
+		assert.Nil(t, r.json(`$.paths./users/{userID}/history`))
+	})
+
+	t.Run("no-revert", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			Handler:     HandlerStdlib,
+			WithHistory: true,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", Annotation{History: true}, WithExcludeOperations(OperationUpdate))
+				return nil
+			},
+		})
+
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}/history/{version}`))
+		assert.Nil(t, r.json(`$.paths./pets/{petID}/history/{version}/revert`))
+	})
+}
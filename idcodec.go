@@ -0,0 +1,145 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+)
+
+var (
+	// IDFormatHashids is the [IDFormat] of IDs encoded using hashids
+	// (https://hashids.org), for use with [Config.IDCodec].
+	IDFormatHashids = IDFormat{
+		Format:  "hashid",
+		Pattern: "^[0-9A-Za-z]+$",
+		Example: "jR3kPq",
+	}
+
+	// IDFormatAESSIV is the [IDFormat] of IDs encrypted using AES-SIV (RFC 5297), in
+	// their unpadded base64url representation, for use with [Config.IDCodec].
+	IDFormatAESSIV = IDFormat{
+		Format:  "aes-siv",
+		Pattern: "^[0-9A-Za-z_-]+$",
+		Example: "jzocXpstR-CmwfSTfS5bCMShnn8w1rKl",
+	}
+)
+
+// encodedIDFilterOps are the filter operations supported on encoded IDs, as encoded
+// IDs aren't ordered.
+var encodedIDFilterOps = []gen.Op{gen.EQ, gen.NEQ, gen.In, gen.NotIn, gen.IsNil}
+
+// HasEncodedID returns true if the ID of the provided type is exposed as an opaque
+// encoded string (see [Config.IDCodec]), i.e. the type has a single integer ID field.
+func HasEncodedID(t *gen.Type) bool {
+	return t.HasOneFieldID() &&
+		t.ID.Type.Type == field.TypeInt &&
+		!t.ID.HasGoType() &&
+		GetConfig(t.Config).IDCodec != nil
+}
+
+// hasEncodedIDs returns true if the ID of any of the provided types is encoded (see
+// [Config.IDCodec]).
+func hasEncodedIDs(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return !GetAnnotation(t).GetSkip(GetConfig(t.Config)) && HasEncodedID(t)
+	})
+}
+
+// getChiIDPattern returns the pattern of "{id}" path parameters in chi routes, if IDs
+// are encoded (see [Config.IDCodec]), otherwise an empty string, in which case IDs are
+// matched as integers.
+func getChiIDPattern(nodes []*gen.Type) string {
+	if !hasEncodedIDs(nodes) {
+		return ""
+	}
+
+	if v := GetConfig(nodes[0].Config).IDCodec.Pattern; v != "" {
+		return v
+	}
+	return "[^/]+"
+}
+
+// isEncodedIDField returns true if the provided field of the provided type holds an
+// encoded ID (see [Config.IDCodec]), i.e. it's the ID of the type, or an edge field
+// referencing a type with an encoded ID.
+func isEncodedIDField(t *gen.Type, f *gen.Field) bool {
+	if f == t.ID {
+		return HasEncodedID(t)
+	}
+
+	ref := getEdgeFieldType(f)
+	return ref != nil && HasEncodedID(ref)
+}
+
+// getIDGoType returns the Go type used for IDs of the provided type in the generated
+// REST API, which is "EncodedID" if the ID is encoded (see [Config.IDCodec]).
+func getIDGoType(t *gen.Type) string {
+	if HasEncodedID(t) {
+		return "EncodedID"
+	}
+	return t.ID.Type.String()
+}
+
+// convertIDValue returns an expression which converts the provided ID value (of the
+// type returned by [getIDGoType]) to the type of the ID of the provided type.
+func convertIDValue(t *gen.Type, v string) string {
+	if HasEncodedID(t) {
+		return "int(" + v + ")"
+	}
+	return v
+}
+
+// convertIDValues is the same as [convertIDValue], but for a slice of IDs.
+func convertIDValues(t *gen.Type, v string) string {
+	if HasEncodedID(t) {
+		return "toIDs(" + v + ")"
+	}
+	return v
+}
+
+// encodeIDValue returns an expression which converts the provided ID of the provided
+// type to the type used in JSON output, which encodes it if needed.
+func encodeIDValue(t *gen.Type, v string) string {
+	if HasEncodedID(t) {
+		return "EncodedID(" + v + ")"
+	}
+	return v
+}
+
+// encodeFieldValue returns an expression which converts the provided value of the
+// provided edge field to the type used in JSON output, which encodes it if the field
+// references a type with an encoded ID.
+func encodeFieldValue(f *gen.Field, v string) string {
+	if ref := getEdgeFieldType(f); ref == nil || !HasEncodedID(ref) {
+		return v
+	}
+
+	if f.Nillable {
+		return "(*EncodedID)(" + v + ")"
+	}
+	return "EncodedID(" + v + ")"
+}
+
+// validateIDCodec checks that the [Config.IDCodec] is valid, and supported with the
+// rest of the provided config.
+func validateIDCodec(cfg *Config) error {
+	if err := cfg.IDCodec.validate(); err != nil {
+		return fmt.Errorf("invalid ID codec: %w", err)
+	}
+
+	if !cfg.WithDTOs {
+		return errors.New("Config.IDCodec requires Config.WithDTOs, as IDs can only be encoded in DTOs")
+	}
+
+	if cfg.Flavor != FlavorDefault {
+		return fmt.Errorf("Config.IDCodec isn't supported with the %s flavor", flavorNames[cfg.Flavor])
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_IDCodec(t *testing.T) {
+	t.Parallel()
+
+	t.Run("schemas", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{IDCodec: &IDFormatHashids, WithDTOs: true})
+
+		assert.Equal(t, "string", r.json(`$.components.parameters.PetID.schema.type`))
+		assert.Equal(t, "hashid", r.json(`$.components.parameters.PetID.schema.format`))
+		assert.Nil(t, r.json(`$.components.parameters.PetID.schema.minimum`))
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.Pet.properties.id.type`))
+		assert.Equal(t, "jR3kPq", r.json(`$.components.schemas.Pet.properties.id.example`))
+		assert.Equal(t, "string", r.json(`$.components.schemas.UserCreate.properties.pets.items.type`))
+		assert.Equal(t, "hashid", r.json(`$.components.schemas.FriendshipCreate.properties.user_id.format`))
+
+		// UUID IDs aren't integers, so they aren't encoded.
+		assert.Equal(t, "uuid", r.json(`$.components.parameters.AllTypeID.schema.format`))
+	})
+
+	t.Run("filters", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{IDCodec: &IDFormatAESSIV, WithDTOs: true, DefaultFilterID: true})
+
+		params := map[string]string{}
+		for _, param := range r.spec.Paths["/pets"].Get.Parameters {
+			if c, ok := r.spec.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]; ok {
+				params[c.Name] = c.Schema.Type
+			}
+		}
+
+		assert.Equal(t, "string", params["id.eq"])
+		assert.Equal(t, "array", params["id.in"])
+		assert.NotContains(t, params, "id.gt")
+	})
+
+	t.Run("requires-dtos", func(t *testing.T) {
+		t.Parallel()
+
+		err := (&Config{IDCodec: &IDFormatHashids}).Validate()
+		assert.ErrorContains(t, err, "requires Config.WithDTOs")
+	})
+
+	t.Run("flavor", func(t *testing.T) {
+		t.Parallel()
+
+		err := (&Config{IDCodec: &IDFormatHashids, WithDTOs: true, Flavor: FlavorAIP}).Validate()
+		assert.ErrorContains(t, err, "isn't supported with the")
+	})
+
+	t.Run("invalid-pattern", func(t *testing.T) {
+		t.Parallel()
+
+		err := (&Config{IDCodec: &IDFormat{Pattern: "^[a-z"}, WithDTOs: true}).Validate()
+		assert.ErrorContains(t, err, "invalid ID codec")
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// IDFormat declares the representation of an ID in the OpenAPI spec, which is used
+// in the schema of ID path parameters, and ID properties in request/response schemas
+// (including edges referencing the schema), allowing clients generating code from
+// the spec to validate IDs correctly, rather than seeing a bare string. See
+// [IDFormatULID] and [IDFormatKSUID] for common formats.
+type IDFormat struct {
+	// Format is the OpenAPI "format" of the ID, e.g. "ulid".
+	Format string `json:",omitempty"`
+
+	// Pattern is an optional regular expression (ECMA-262 compatible) which IDs must
+	// match.
+	Pattern string `json:",omitempty"`
+
+	// Example is an optional example ID.
+	Example any `json:",omitempty"`
+}
+
+var (
+	// IDFormatULID is the [IDFormat] of a ULID (https://github.com/ulid/spec), in its
+	// canonical (Crockford's base32) string representation.
+	IDFormatULID = IDFormat{
+		Format:  "ulid",
+		Pattern: "^[0-9A-HJKMNP-TV-Z]{26}$",
+		Example: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+	}
+
+	// IDFormatKSUID is the [IDFormat] of a KSUID (https://github.com/segmentio/ksuid),
+	// in its base62 string representation.
+	IDFormatKSUID = IDFormat{
+		Format:  "ksuid",
+		Pattern: "^[0-9A-Za-z]{27}$",
+		Example: "0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+	}
+)
+
+// validate validates the ID format, returning an error if the pattern is invalid.
+func (f *IDFormat) validate() error {
+	if f.Pattern == "" {
+		return nil
+	}
+
+	if _, err := regexp.Compile(f.Pattern); err != nil {
+		return fmt.Errorf("invalid ID format pattern %q: %w", f.Pattern, err)
+	}
+	return nil
+}
+
+// apply applies the ID format to the provided schema. Examples already set on the
+// schema (e.g. through [WithExample] on the ID field) take precedence.
+func (f *IDFormat) apply(schema *ogen.Schema) error {
+	if f.Format != "" {
+		schema.Format = f.Format
+	}
+
+	if f.Pattern != "" {
+		schema.Pattern = f.Pattern
+	}
+
+	if f.Example != nil && schema.Example == nil {
+		example, err := json.Marshal(f.Example)
+		if err != nil {
+			return fmt.Errorf("failed to marshal ID format example: %w", err)
+		}
+		schema.Example = example
+	}
+	return nil
+}
+
+// GetIDFormat returns the [IDFormat] of the ID of the provided type, through
+// [Config.IDCodec] (for encoded IDs, see [HasEncodedID]), [WithIDFormat], or
+// [Config.IDFormat] (for string-based IDs only). Returns nil if no format has been
+// declared, or the type doesn't have a single ID field.
+func GetIDFormat(t *gen.Type) *IDFormat {
+	if t.ID == nil {
+		return nil
+	}
+
+	if HasEncodedID(t) {
+		return GetConfig(t.Config).IDCodec
+	}
+
+	if f := GetAnnotation(t).IDFormat; f != nil {
+		return f
+	}
+
+	if cfg := GetConfig(t.Config); cfg.IDFormat != nil && t.ID.IsString() {
+		return cfg.IDFormat
+	}
+	return nil
+}
+
+// GetSchemaID returns the OpenAPI schema of the ID field of the provided type, with
+// the declared [IDFormat] applied (see [GetIDFormat]). String-based custom ID types
+// (e.g. ULIDs or KSUIDs) which have no known OpenAPI type are represented as strings
+// when an ID format is declared, as are encoded IDs (see [Config.IDCodec]).
+func GetSchemaID(t *gen.Type) (*ogen.Schema, error) {
+	format := GetIDFormat(t)
+
+	schema, err := GetSchemaField(t.ID)
+	switch {
+	case err != nil && (format == nil || !t.ID.IsString()):
+		return nil, err
+	case err != nil:
+		schema = &ogen.Schema{Type: "string", Description: t.ID.Comment()}
+	case HasEncodedID(t):
+		schema = &ogen.Schema{Type: "string", Description: schema.Description, Deprecated: schema.Deprecated}
+	}
+
+	if format != nil {
+		if err = format.apply(schema); err != nil {
+			return nil, err
+		}
+	}
+	return schema, nil
+}
+
+// getEdgeFieldType returns the type referenced by the provided edge field (e.g. the
+// "User" type for the "owner_id" field of an "owner" edge), or nil if the field isn't
+// an edge field.
+func getEdgeFieldType(f *gen.Field) *gen.Type {
+	if !f.IsEdgeField() {
+		return nil
+	}
+
+	e, err := f.Edge()
+	if err != nil {
+		return nil
+	}
+	return e.Type
+}
+
+// SupportsClientProvidedID returns true if the provided type has a single, user-defined
+// ID field (e.g. field.String("id"), field.Int("id"), field.UUID("id", ...), or a
+// custom type like a ULID or KSUID), which is required for the ID to be provided by
+// clients during creation.
+func SupportsClientProvidedID(t *gen.Type) bool {
+	return t.HasOneFieldID() && t.ID.UserDefined
+}
+
+// HasClientProvidedID returns true if clients are allowed to provide the ID of new
+// entities of the provided type when creating them, through [WithClientProvidedID],
+// [Config.ClientProvidedIDs], or [Config.AllowClientUUIDs] (for UUID IDs only). The
+// ID is optional if the ID field has a default, and required otherwise. The provided
+// ID is validated through its type (e.g. when decoding ints, UUIDs, or custom types
+// implementing [encoding.TextUnmarshaler]), as well as any validators on the ID
+// field, and if an entity with the same ID already exists, a 409 Conflict is
+// returned.
+func HasClientProvidedID(t *gen.Type) bool {
+	if !SupportsClientProvidedID(t) {
+		return false
+	}
+
+	if v := GetAnnotation(t).ClientID; v != nil {
+		return *v
+	}
+
+	cfg := GetConfig(t.Config)
+	return cfg.ClientProvidedIDs || (cfg.AllowClientUUIDs && t.ID.IsUUID())
+}
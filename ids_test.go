@@ -0,0 +1,84 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_IDFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Equal(t, "integer", r.json(`$.components.parameters.PetID.schema.type`))
+		assert.Nil(t, r.json(`$.components.parameters.PetID.schema.pattern`))
+	})
+
+	t.Run("config-string-ids-only", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{IDFormat: &IDFormatULID})
+
+		// Integer and UUID IDs aren't string-based, so the format isn't applied.
+		assert.Nil(t, r.json(`$.components.parameters.PetID.schema.pattern`))
+		assert.Equal(t, "uuid", r.json(`$.components.parameters.AllTypeID.schema.format`))
+	})
+
+	t.Run("annotation", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithIDFormat(IDFormat{
+					Format:  "pet-id",
+					Pattern: "^[1-9][0-9]*$",
+					Example: 42,
+				}))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "pet-id", r.json(`$.components.parameters.PetID.schema.format`))
+		assert.Equal(t, "^[1-9][0-9]*$", r.json(`$.components.parameters.PetID.schema.pattern`))
+		assert.InDelta(t, 42, r.json(`$.components.parameters.PetID.schema.example`), 0)
+
+		assert.Equal(t, "^[1-9][0-9]*$", r.json(`$.components.schemas.Pet.properties.id.pattern`))
+		assert.Equal(t, "^[1-9][0-9]*$", r.json(`$.components.schemas.UserCreate.properties.pets.items.pattern`))
+	})
+
+	t.Run("edge-field", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User", WithIDFormat(IDFormat{Pattern: "^[1-9][0-9]*$"}))
+				return nil
+			},
+		})
+
+		// Edge fields are accepted directly, rather than through the edge, and use the
+		// ID format of the referenced type.
+		assert.Nil(t, r.json(`$.components.schemas.FriendshipCreate.properties.user`))
+		assert.Equal(t, "^[1-9][0-9]*$", r.json(`$.components.schemas.FriendshipCreate.properties.user_id.pattern`))
+		assert.Equal(t, "The ID of the referenced User entity.", r.json(`$.components.schemas.FriendshipCreate.properties.user_id.description`))
+		assert.Equal(t, "^[1-9][0-9]*$", r.json(`$.components.schemas.Friendship.properties.friend_id.pattern`))
+		assert.Nil(t, r.json(`$.components.schemas.Friendship.properties.created_at.pattern`))
+	})
+
+	t.Run("invalid-pattern", func(t *testing.T) {
+		t.Parallel()
+
+		err := (&Config{IDFormat: &IDFormat{Pattern: "^[a-z"}}).Validate()
+		assert.ErrorContains(t, err, "invalid ID format pattern")
+	})
+}
@@ -0,0 +1,114 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// lastModifiedFieldName is the name of the field which is used for the "Last-Modified"
+// response header (see [Config.EnableLastModified]).
+const lastModifiedFieldName = "updated_at"
+
+// getLastModifiedField returns the field of the provided type which is used for the
+// "Last-Modified" response header, or nil if the type has no such field, or it's
+// not enabled (see [Config.EnableLastModified]).
+func getLastModifiedField(cfg *Config, t *gen.Type) *gen.Field {
+	if !cfg.EnableLastModified {
+		return nil
+	}
+
+	for _, f := range t.Fields {
+		if f.Name != lastModifiedFieldName || f.Type.String() != "time.Time" || f.Sensitive() {
+			continue
+		}
+		if GetAnnotation(f).GetSkip(cfg) {
+			return nil
+		}
+		return f
+	}
+	return nil
+}
+
+// hasLastModified returns true if any of the provided types have a field which is used
+// for the "Last-Modified" response header (see [Config.EnableLastModified]).
+func hasLastModified(cfg *Config, nodes []*gen.Type) bool {
+	for _, t := range nodes {
+		if !GetAnnotation(t).GetSkip(cfg) && getLastModifiedField(cfg, t) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// addLastModifiedHeaders documents the "Last-Modified" response header, and conditional
+// requests (returning a 304 "Not Modified"), on all GET operations within the provided
+// read or list spec, if the provided type (which the operations return) has a last
+// modified field (see [Config.EnableLastModified]). Reads are validated through the
+// "If-Modified-Since" request header, and lists through the "If-None-Match" request
+// header (with the weak "ETag" of the page).
+func addLastModifiedHeaders(cfg *Config, spec *ogen.Spec, t *gen.Type, op Operation) {
+	if getLastModifiedField(cfg, t) == nil {
+		return
+	}
+
+	for pathName, pathItem := range spec.Paths {
+		spec.Paths[pathName] = PatchOperations(pathItem, func(method string, sop *ogen.Operation) *ogen.Operation {
+			if sop == nil || method != http.MethodGet {
+				return sop
+			}
+
+			if op == OperationList {
+				sop.Parameters = append(sop.Parameters, &ogen.Parameter{
+					Name:        "If-None-Match",
+					In:          "header",
+					Description: "Only return the page if its ETag doesn't match any of the provided ETags, otherwise returns a 304 (Not Modified).",
+					Schema:      ogen.String(),
+				})
+			} else {
+				sop.Parameters = append(sop.Parameters, &ogen.Parameter{
+					Name:        "If-Modified-Since",
+					In:          "header",
+					Description: "Only return the response if it was modified after the provided date, otherwise returns a 304 (Not Modified).",
+					Schema:      ogen.String(),
+				})
+			}
+
+			for code, resp := range sop.Responses {
+				if resp.Ref != "" || !strings.HasPrefix(code, "2") {
+					continue
+				}
+
+				if resp.Headers == nil {
+					resp.Headers = make(map[string]*ogen.Header)
+				}
+				resp.Headers["Last-Modified"] = &ogen.Header{
+					Description: "The date the returned entity (or for lists, the latest of the returned entities) was last modified.",
+					Schema:      ogen.String(),
+				}
+				if op == OperationList {
+					resp.Headers["ETag"] = &ogen.Header{
+						Description: "A weak ETag of the returned page, which changes when entities within the page are modified, added or removed.",
+						Schema:      ogen.String(),
+					}
+				}
+			}
+
+			if op == OperationList {
+				sop.Responses[strconv.Itoa(http.StatusNotModified)] = ogen.NewResponse().
+					SetDescription("The page wasn't modified, as its ETag matches the If-None-Match header.")
+			} else {
+				sop.Responses[strconv.Itoa(http.StatusNotModified)] = ogen.NewResponse().
+					SetDescription("The response wasn't modified since the date provided through the If-Modified-Since header.")
+			}
+			return sop
+		})
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_LastModified(t *testing.T) {
+	t.Parallel()
+
+	hasParam := func(op *ogen.Operation, name string) bool {
+		return slices.ContainsFunc(op.Parameters, func(p *ogen.Parameter) bool {
+			return p.Name == name && p.In == "header"
+		})
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{EnableLastModified: true})
+
+		for _, path := range []string{"/users/{userID}", "/pets/{petID}/owner"} {
+			op := r.spec.Paths[path].Get
+			require.NotNil(t, op, path)
+			assert.True(t, hasParam(op, "If-Modified-Since"), path)
+			assert.False(t, hasParam(op, "If-None-Match"), path)
+			assert.Contains(t, op.Responses["200"].Headers, "Last-Modified", path)
+			assert.NotContains(t, op.Responses["200"].Headers, "ETag", path)
+			assert.Contains(t, op.Responses, "304", path)
+		}
+
+		// Lists are validated through the ETag of the page.
+		for _, path := range []string{"/users", "/users/{userID}/friends", "/pets/{petID}/followed-by"} {
+			op := r.spec.Paths[path].Get
+			require.NotNil(t, op, path)
+			assert.False(t, hasParam(op, "If-Modified-Since"), path)
+			assert.True(t, hasParam(op, "If-None-Match"), path)
+			assert.Contains(t, op.Responses["200"].Headers, "Last-Modified", path)
+			assert.Contains(t, op.Responses["200"].Headers, "ETag", path)
+			assert.Contains(t, op.Responses, "304", path)
+		}
+
+		// Mutations and schemas without an updated_at field are unaffected.
+		assert.NotContains(t, r.spec.Paths["/users/{userID}"].Patch.Responses, "304")
+		assert.False(t, hasParam(r.spec.Paths["/pets/{petID}"].Get, "If-Modified-Since"))
+		assert.NotContains(t, r.spec.Paths["/pets/{petID}"].Get.Responses, "304")
+		assert.False(t, hasParam(r.spec.Paths["/pets"].Get, "If-None-Match"))
+		assert.NotContains(t, r.spec.Paths["/pets"].Get.Responses, "304")
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		op := r.spec.Paths["/users/{userID}"].Get
+		require.NotNil(t, op)
+		assert.False(t, hasParam(op, "If-Modified-Since"))
+		assert.NotContains(t, op.Responses, "304")
+	})
+}
@@ -0,0 +1,155 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/ogen-go/ogen"
+)
+
+const defaultLoadTestBaseURL = "http://localhost:8080"
+
+// LoadTestRequest is a single request within a load-testing scenario.
+type LoadTestRequest struct {
+	// Name is the name of the request, which is the operation ID.
+	Name string `json:"name"`
+	// Method is the HTTP method of the request.
+	Method string `json:"method"`
+	// Path is the request path (relative to the base URL), with all path and
+	// required query parameters populated using example values.
+	Path string `json:"path"`
+}
+
+// GetLoadTestRequests returns the requests used for load-testing scenarios, derived
+// from all safe (GET) operations within the spec, sorted by path. Path and required
+// query parameters are populated using example values (see [GetExampleSchema]).
+// Operations which have parameters that can't be populated are skipped.
+func GetLoadTestRequests(spec *ogen.Spec) []LoadTestRequest {
+	var requests []LoadTestRequest
+
+	for _, path := range mapKeys(spec.Paths) {
+		item := spec.Paths[path]
+		if item == nil || item.Get == nil {
+			continue
+		}
+
+		params := make([]*ogen.Parameter, 0, len(item.Parameters)+len(item.Get.Parameters))
+		params = append(params, item.Parameters...)
+		params = append(params, item.Get.Parameters...)
+
+		resolved := path
+		query := url.Values{}
+		ok := true
+
+		for _, param := range params {
+			param = resolveLoadTestParameter(spec, param)
+			if param == nil || (param.In != "path" && (param.In != "query" || !param.Required)) {
+				continue
+			}
+
+			v := GetExampleSchema(param.Name, param.Schema)
+			if v == nil {
+				ok = false
+				break
+			}
+
+			value := fmt.Sprint(v)
+			if param.In == "path" {
+				resolved = strings.ReplaceAll(resolved, "{"+param.Name+"}", url.PathEscape(value))
+				continue
+			}
+			query.Set(param.Name, value)
+		}
+
+		if !ok || strings.Contains(resolved, "{") {
+			continue
+		}
+
+		if len(query) > 0 {
+			resolved += "?" + query.Encode()
+		}
+
+		requests = append(requests, LoadTestRequest{
+			Name:   item.Get.OperationID,
+			Method: "GET",
+			Path:   resolved,
+		})
+	}
+
+	return requests
+}
+
+// resolveLoadTestParameter resolves a parameter reference against the components of
+// the spec, if necessary.
+func resolveLoadTestParameter(spec *ogen.Spec, param *ogen.Parameter) *ogen.Parameter {
+	if param == nil || param.Ref == "" {
+		return param
+	}
+	if spec.Components == nil {
+		return nil
+	}
+	return spec.Components.Parameters[strings.TrimPrefix(param.Ref, "#/components/parameters/")]
+}
+
+// GenerateLoadTest generates a load-testing scenario for the provided spec in the
+// requested format, writing it to w. See [GetLoadTestRequests] for which requests
+// are included.
+func GenerateLoadTest(spec *ogen.Spec, format LoadTestFormat, w io.Writer) error {
+	baseURL := defaultLoadTestBaseURL
+	if len(spec.Servers) > 0 && spec.Servers[0].URL != "" {
+		baseURL = strings.TrimSuffix(spec.Servers[0].URL, "/")
+	}
+
+	requests := GetLoadTestRequests(spec)
+
+	switch format {
+	case LoadTestK6:
+		b, err := json.MarshalIndent(requests, "", "    ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal load test requests: %w", err)
+		}
+
+		_, err = fmt.Fprintf(w, k6Script, baseURL, b)
+		return err
+	case LoadTestVegeta:
+		for _, r := range requests {
+			if _, err := fmt.Fprintf(w, "%s %s%s\n\n", r.Method, baseURL, r.Path); err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		return fmt.Errorf("unsupported load test format: %q", format)
+	}
+}
+
+const k6Script = `// Code generated by entrest, DO NOT EDIT.
+import http from "k6/http";
+import { check } from "k6";
+
+const BASE_URL = __ENV.BASE_URL || %q;
+
+export const options = {
+    vus: Number(__ENV.VUS || 10),
+    duration: __ENV.DURATION || "30s",
+    thresholds: {
+        http_req_failed: ["rate<0.01"],
+    },
+};
+
+const requests = %s;
+
+export default function () {
+    for (const r of requests) {
+        const res = http.request(r.method, BASE_URL + r.path, null, { tags: { name: r.name } });
+        check(res, { [r.name + " is not a server error"]: (res) => res.status < 500 });
+    }
+}
+`
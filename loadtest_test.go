@@ -0,0 +1,84 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateLoadTest(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	t.Run("requests", func(t *testing.T) {
+		t.Parallel()
+
+		requests := GetLoadTestRequests(r.spec)
+		assert.Contains(t, requests, LoadTestRequest{Name: "listPets", Method: "GET", Path: "/pets"})
+		assert.Contains(t, requests, LoadTestRequest{Name: "getPet", Method: "GET", Path: "/pets/1"})
+
+		for _, req := range requests {
+			assert.NotContains(t, req.Path, "{")
+		}
+	})
+
+	t.Run("vegeta", func(t *testing.T) {
+		t.Parallel()
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, GenerateLoadTest(r.spec, LoadTestVegeta, buf))
+		assert.Contains(t, buf.String(), "GET http://localhost:8080/pets\n\n")
+		assert.NotContains(t, buf.String(), "POST ")
+	})
+
+	t.Run("k6", func(t *testing.T) {
+		t.Parallel()
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, GenerateLoadTest(r.spec, LoadTestK6, buf))
+		assert.Contains(t, buf.String(), `import http from "k6/http";`)
+		assert.Contains(t, buf.String(), `"path": "/pets/1"`)
+	})
+
+	t.Run("server-url", func(t *testing.T) {
+		t.Parallel()
+
+		spec := ogen.NewSpec()
+		spec.Servers = []ogen.Server{{URL: "https://api.example.com/"}}
+		spec.Paths = ogen.Paths{"/foo": &ogen.PathItem{Get: &ogen.Operation{OperationID: "listFoo"}}}
+
+		buf := &bytes.Buffer{}
+		require.NoError(t, GenerateLoadTest(spec, LoadTestVegeta, buf))
+		assert.Equal(t, "GET https://api.example.com/foo\n\n", buf.String())
+	})
+
+	t.Run("writer", func(t *testing.T) {
+		t.Parallel()
+
+		buf := &bytes.Buffer{}
+		files := renderGraph(t, &Config{LoadTest: LoadTestK6, LoadTestWriter: buf}, nil)
+		assert.Contains(t, buf.String(), `import http from "k6/http";`)
+		assert.NotContains(t, files, "rest/loadtest.js")
+	})
+
+	t.Run("dry-run", func(t *testing.T) {
+		t.Parallel()
+
+		files := renderGraph(t, &Config{LoadTest: LoadTestVegeta, DryRun: true, DryRunWriter: io.Discard}, nil)
+		assert.NotContains(t, files, "rest/loadtest.targets")
+	})
+
+	t.Run("unsupported", func(t *testing.T) {
+		t.Parallel()
+		assert.Error(t, GenerateLoadTest(r.spec, LoadTestFormat("foo"), &bytes.Buffer{}))
+	})
+}
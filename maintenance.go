@@ -0,0 +1,29 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/ogen-go/ogen"
+)
+
+// addMaintenanceResponse documents the 503 response of the provided mutating operation,
+// which is returned by the generated handlers while the server is in maintenance mode
+// (see [Config.MaintenanceMode]).
+func addMaintenanceResponse(cfg *Config, oper *ogen.Operation) {
+	if !cfg.MaintenanceMode || oper == nil {
+		return
+	}
+
+	if oper.Responses == nil {
+		oper.Responses = ogen.Responses{}
+	}
+
+	oper.Responses[strconv.Itoa(http.StatusServiceUnavailable)] = ogen.NewResponse().
+		SetDescription("The API is in maintenance mode, and doesn't accept changes.").
+		SetJSONContent(ErrorResponseObject(http.StatusServiceUnavailable))
+}
@@ -0,0 +1,34 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_MaintenanceMode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		assert.Nil(t, r.json(`$.paths./pets.post.responses.503`))
+	})
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{MaintenanceMode: true})
+
+		assert.NotNil(t, r.json(`$.paths./pets.post.responses.503`))
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}.patch.responses.503`))
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}.delete.responses.503`))
+		assert.Nil(t, r.json(`$.paths./pets.get.responses.503`))
+		assert.Nil(t, r.json(`$.paths./pets/{petID}.get.responses.503`))
+	})
+}
@@ -0,0 +1,205 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// moneyCurrencyPattern is the pattern of (string) currency fields of money objects
+// (see [WithMoney]), i.e. ISO 4217 currency codes.
+const moneyCurrencyPattern = `^[A-Z]{3}$`
+
+// GetMoneyCurrencyField returns the currency field which is paired with the provided
+// (amount) field of the provided type through [WithMoney], if any.
+func GetMoneyCurrencyField(t *gen.Type, f *gen.Field) *gen.Field {
+	name := GetAnnotation(f).MoneyCurrencyField
+	if name == "" || name == f.Name {
+		return nil
+	}
+	return getFileField(t, name)
+}
+
+// GetMoneyFields returns all amount fields of the provided type which are paired with
+// a currency field through [WithMoney].
+func GetMoneyFields(t *gen.Type) (fields []*gen.Field) {
+	for _, f := range t.Fields {
+		if GetMoneyCurrencyField(t, f) != nil {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// getMoneyFields returns all amount fields of the provided type which are paired with a
+// currency field, and are included in JSON output.
+func getMoneyFields(t *gen.Type) (fields []*gen.Field) {
+	for _, f := range GetMoneyFields(t) {
+		if f.StructTag != `json:"-"` {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// isMoneyCurrencyField returns true if the provided field is the currency field of an
+// amount field of the provided type (see [WithMoney]), and thus isn't exposed on its
+// own.
+func isMoneyCurrencyField(t *gen.Type, f *gen.Field) bool {
+	return slices.ContainsFunc(t.Fields, func(af *gen.Field) bool {
+		return GetMoneyCurrencyField(t, af) == f
+	})
+}
+
+// hasMoneyFields returns true if any of the provided types have money fields.
+func hasMoneyFields(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool { return len(GetMoneyFields(t)) > 0 })
+}
+
+// getMoneyType returns the type (relative to the ent package) of the money object of
+// the provided amount field in responses, e.g. "Money[float64, string]". High-precision
+// amounts (see [NumericFormat]) are pre-encoded as a json.RawMessage.
+func getMoneyType(t *gen.Type, f *gen.Field) string {
+	amount := f.Type.String()
+	if GetNumericFormat(f) != nil {
+		amount = "json.RawMessage"
+	}
+	return fmt.Sprintf("Money[%s, %s]", amount, GetMoneyCurrencyField(t, f).Type.String())
+}
+
+// getMoneyInputType returns the type (relative to the ent package, with type arguments
+// relative to the rest package) of the money object of the provided amount field in
+// create and update requests, e.g. "Money[Numeric[decimal.Decimal], string]".
+func getMoneyInputType(t *gen.Type, f *gen.Field) string {
+	return fmt.Sprintf("Money[%s, %s]", getFieldGoType(f), getFieldGoType(GetMoneyCurrencyField(t, f)))
+}
+
+// getMoneyCurrencyTag returns the struct tag which omits the currency field of the
+// provided amount field from the JSON encoding of entities, as it's encoded as part of
+// the money object instead.
+func getMoneyCurrencyTag(t *gen.Type, f *gen.Field) string {
+	name, _, _ := strings.Cut(reflect.StructTag(GetMoneyCurrencyField(t, f).StructTag).Get("json"), ",")
+	return fmt.Sprintf("json:%q", name+",omitempty")
+}
+
+// applyMoneyProperties replaces the properties of all amount fields of the provided
+// type within the provided (create, update or read) schema with money objects, which
+// include the currency field, and removes the currency field properties.
+func applyMoneyProperties(t *gen.Type, schema *ogen.Schema) {
+	for _, f := range GetMoneyFields(t) {
+		cf := GetMoneyCurrencyField(t, f)
+		name, currencyName := GetFieldName(t, f), GetFieldName(t, cf)
+
+		ai := slices.IndexFunc(schema.Properties, func(p ogen.Property) bool { return p.Name == name })
+		ci := slices.IndexFunc(schema.Properties, func(p ogen.Property) bool { return p.Name == currencyName })
+		if ai == -1 || ci == -1 {
+			continue
+		}
+
+		amount := *schema.Properties[ai].Schema
+		currency := *schema.Properties[ci].Schema
+
+		money := &ogen.Schema{
+			Description: amount.Description,
+			Type:        "object",
+			Properties: ogen.Properties{
+				{Name: "amount", Schema: &amount},
+				{Name: "currency", Schema: &currency},
+			},
+			Required:   []string{"amount", "currency"},
+			Nullable:   amount.Nullable,
+			Deprecated: amount.Deprecated,
+		}
+
+		amount.Description = "The amount of money."
+		amount.Nullable = false
+		amount.Deprecated = false
+		amount.Default = nil
+
+		if currency.Ref == "" {
+			currency.Description = "The ISO 4217 currency code of the amount."
+			currency.Nullable = false
+			currency.Deprecated = false
+			currency.Default = nil
+
+			if currency.Type == "string" && len(currency.Enum) == 0 && currency.Pattern == "" {
+				currency.Pattern = moneyCurrencyPattern
+				if currency.Example == nil {
+					currency.Example = ogen.ExampleValue(`"USD"`)
+				}
+			}
+		}
+
+		schema.Properties[ai].Schema = money
+		schema.Properties = slices.Delete(schema.Properties, ci, ci+1)
+		schema.Required = slices.DeleteFunc(schema.Required, func(v string) bool { return v == currencyName })
+	}
+}
+
+// validateMoneyFields checks that the currency fields of all amount fields of the
+// provided type (see [WithMoney]) exist, and are compatible with the amount fields.
+func validateMoneyFields(cfg *Config, t *gen.Type) (errs []error) { // nolint:gocyclo,cyclop
+	paired := map[string]string{}
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.MoneyCurrencyField == "" {
+			continue
+		}
+
+		wrap := func(err error) error {
+			return &AnnotationError{Schema: t.Name, Field: f.Name, Err: err}
+		}
+
+		cf := GetMoneyCurrencyField(t, f)
+		if cf == nil {
+			errs = append(errs, wrap(fmt.Errorf("money currency field %q does not exist", fa.MoneyCurrencyField)))
+			continue
+		}
+		ca := GetAnnotation(cf)
+
+		if other, ok := paired[cf.Name]; ok {
+			errs = append(errs, wrap(fmt.Errorf("money currency field %q is already paired with field %q", cf.Name, other)))
+		}
+		paired[cf.Name] = f.Name
+
+		if !f.Type.Numeric() && !isHighPrecisionField(f) && fa.NumericFormat == nil {
+			errs = append(errs, wrap(errors.New("money amount fields must be numeric fields")))
+		}
+
+		if fa.TimeFormat != TimeFormatDefault || fa.Hashed || fa.File != nil || f.Sensitive() {
+			errs = append(errs, wrap(errors.New("money amount fields can't be time, hashed, file or sensitive fields")))
+		}
+
+		if ca.MoneyCurrencyField != "" || (!cf.IsString() && !cf.IsEnum()) || ca.Hashed || ca.File != nil || cf.Sensitive() {
+			errs = append(errs, wrap(fmt.Errorf("money currency field %q must be a string or enum field", cf.Name)))
+		}
+
+		if f.Optional != cf.Optional || f.Nillable != cf.Nillable || f.Immutable != cf.Immutable {
+			errs = append(errs, wrap(fmt.Errorf(
+				"money currency field %q must have the same optionality, nillability and immutability as the amount field",
+				cf.Name,
+			)))
+		}
+
+		if fa.GetSkip(cfg) != ca.GetSkip(cfg) ||
+			fa.GetReadable() != ca.GetReadable() ||
+			fa.GetWritable(OperationCreate) != ca.GetWritable(OperationCreate) ||
+			fa.GetWritable(OperationUpdate) != ca.GetWritable(OperationUpdate) {
+			errs = append(errs, wrap(fmt.Errorf(
+				"money currency field %q must be skipped, readable and writable like the amount field",
+				cf.Name,
+			)))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,97 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_Money(t *testing.T) {
+	t.Parallel()
+
+	t.Run("string-currency", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "AllTypes.float64", WithMoney("string_type"))
+				return nil
+			},
+		})
+
+		for _, name := range []string{"AllType", "AllTypeCreate", "AllTypeUpdate"} {
+			prefix := "$.components.schemas." + name + ".properties"
+
+			assert.Equal(t, "object", r.json(prefix+".float64.type"), name)
+			assert.Equal(t, []any{"amount", "currency"}, r.json(prefix+".float64.required"), name)
+			assert.Equal(t, "number", r.json(prefix+".float64.properties.amount.type"), name)
+			assert.Equal(t, "string", r.json(prefix+".float64.properties.currency.type"), name)
+			assert.Equal(t, moneyCurrencyPattern, r.json(prefix+".float64.properties.currency.pattern"), name)
+			assert.Nil(t, r.json(prefix+".string_type"), name)
+		}
+
+		assert.Contains(t, r.json(`$.components.schemas.AllTypeCreate.required`), "float64")
+		assert.NotContains(t, r.json(`$.components.schemas.AllTypeCreate.required`), "string_type")
+		assert.Contains(t, r.json(`$.components.schemas.AllType.required`), "float64")
+		assert.NotContains(t, r.json(`$.components.schemas.AllType.required`), "string_type")
+	})
+
+	t.Run("enum-currency", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "AllTypes.int", WithMoney("state"))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "integer", r.json(`$.components.schemas.AllType.properties.int.properties.amount.type`))
+		assert.NotNil(t, r.json(`$.components.schemas.AllType.properties.int.properties.currency`))
+		assert.Nil(t, r.json(`$.components.schemas.AllType.properties.int.properties.currency.pattern`))
+		assert.Nil(t, r.json(`$.components.schemas.AllType.properties.state`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			path     string
+			currency string
+			contains string
+		}{
+			{"AllTypes.float64", "missing", `money currency field "missing" does not exist`},
+			{"AllTypes.text", "string_type", "money amount fields must be numeric fields"},
+			{"AllTypes.float64", "int64", `money currency field "int64" must be a string or enum field`},
+			{"Pet.age", "name", "must have the same optionality"},
+		} {
+			_, err := buildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, tt.path, WithMoney(tt.currency))
+					return ValidateAnnotations(g.Nodes...)
+				},
+			})
+			require.ErrorContains(t, err, tt.contains, tt.path)
+		}
+	})
+
+	t.Run("shared-currency", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "AllTypes.float64", WithMoney("string_type"))
+				injectAnnotations(t, g, "AllTypes.float32", WithMoney("string_type"))
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+		require.ErrorContains(t, err, `money currency field "string_type" is already paired`)
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// FieldNameStyle is the casing applied to the names of fields and edges in request
+// and response bodies (including the JSON tags of the generated ent entities), as
+// well as filter parameters and sort fields.
+type FieldNameStyle string
+
+const (
+	// FieldNameStyleDefault uses field and edge names as provided by the [Namer] and
+	// the graph (typically snake_case), and camelCase for filter parameters.
+	FieldNameStyleDefault FieldNameStyle = ""
+	// FieldNameStyleAsIs uses field and edge names as provided by the [Namer] and the
+	// graph everywhere, including filter parameters.
+	FieldNameStyleAsIs FieldNameStyle = "asis"
+	// FieldNameStyleSnake uses snake_case names, e.g. "created_at".
+	FieldNameStyleSnake FieldNameStyle = "snake"
+	// FieldNameStyleCamel uses camelCase names, e.g. "createdAt".
+	FieldNameStyleCamel FieldNameStyle = "camel"
+)
+
+// AllFieldNameStyles is a list of all supported field name styles.
+var AllFieldNameStyles = []FieldNameStyle{
+	FieldNameStyleDefault,
+	FieldNameStyleAsIs,
+	FieldNameStyleSnake,
+	FieldNameStyleCamel,
+}
+
+// Format applies the style to the provided name.
+func (s FieldNameStyle) Format(name string) string {
+	switch s {
+	case FieldNameStyleSnake:
+		return SnakeCase(name)
+	case FieldNameStyleCamel:
+		return CamelCase(SnakeCase(name))
+	default:
+		return name
+	}
+}
+
+// Namer is a naming policy, which controls how names are derived from the ent graph
+// for both the spec and the generated Go code. Implementations should typically embed
+// [DefaultNamer], and only override the methods they need. Note that annotations
+// which explicitly set a name (e.g. [WithOperationID]) take precedence.
+type Namer interface {
+	// SchemaName returns the name of the entity, used as the base for all component
+	// schemas and parameters of the type (e.g. "Pet", "PetCreate", "PetList").
+	SchemaName(t *gen.Type) string
+
+	// FieldName returns the JSON property name of the field, used within request and
+	// response bodies.
+	FieldName(f *gen.Field) string
+
+	// ParamName returns the name of the path parameter which contains the ID of the
+	// type (e.g. "petID").
+	ParamName(t *gen.Type) string
+
+	// PathSegment returns the path segment for the type (e.g. "pets"), or if an edge is
+	// provided, the path segment for the edge (e.g. "owner").
+	PathSegment(t *gen.Type, e *gen.Edge) string
+
+	// OperationID returns the operation ID for the provided operation, type, and
+	// optionally edge (e.g. "listPets", "getPetOwner").
+	OperationID(op Operation, t *gen.Type, e *gen.Edge) string
+}
+
+var _ Namer = DefaultNamer{} // Ensure that DefaultNamer implements Namer.
+
+// DefaultNamer is the default naming policy.
+type DefaultNamer struct{}
+
+func (DefaultNamer) SchemaName(t *gen.Type) string {
+	return Singularize(t.Name)
+}
+
+func (DefaultNamer) FieldName(f *gen.Field) string {
+	return f.Name
+}
+
+func (DefaultNamer) ParamName(t *gen.Type) string {
+	return CamelCase(Singularize(t.Name)) + "ID"
+}
+
+func (DefaultNamer) PathSegment(t *gen.Type, e *gen.Edge) string {
+	if e != nil {
+		return KebabCase(e.Name)
+	}
+	return Pluralize(KebabCase(t.Name))
+}
+
+func (DefaultNamer) OperationID(op Operation, t *gen.Type, e *gen.Edge) string {
+	if e != nil {
+		switch op {
+		case OperationRead:
+			return "get" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+		case OperationList:
+			return "list" + Singularize(t.Name) + Pluralize(PascalCase(e.Name))
+		case OperationCreate:
+			return "create" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+		case OperationUpdate:
+			return "replace" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+		case OperationDelete:
+			return "unlink" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
+		default:
+			panic(fmt.Sprintf("unsupported operation %q", op))
+		}
+	}
+
+	switch op {
+	case OperationCreate:
+		return "create" + Singularize(t.Name)
+	case OperationUpdate:
+		return "update" + Singularize(t.Name)
+	case OperationRead:
+		return "get" + Singularize(t.Name)
+	case OperationList:
+		return "list" + Pluralize(t.Name)
+	case OperationDelete:
+		return "delete" + Singularize(t.Name)
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+}
+
+// GetNamer returns the naming policy for the provided type, based on [Config.Namer],
+// or [DefaultNamer] if not configured (or if the type isn't part of a graph with a
+// [Config]).
+func GetNamer(t *gen.Type) Namer {
+	if t == nil || !hasConfig(t.Config) {
+		return DefaultNamer{}
+	}
+	return GetConfig(t.Config).GetNamer()
+}
+
+// GetSchemaName returns the schema name of the provided type. See [Namer.SchemaName].
+func GetSchemaName(t *gen.Type) string {
+	return GetNamer(t).SchemaName(t)
+}
+
+// GetFieldName returns the JSON property name of the provided field, which belongs
+// to the provided type. See [Namer.FieldName].
+func GetFieldName(t *gen.Type, f *gen.Field) string {
+	return getFieldNameStyle(t).Format(GetNamer(t).FieldName(f))
+}
+
+// GetEdgeName returns the JSON property name of the provided edge, which belongs to
+// the provided type, with an optional prefix (e.g. "add" for "add_pets"). See
+// [Config.FieldNameStyle].
+func GetEdgeName(t *gen.Type, e *gen.Edge, prefix string) string {
+	name := e.Name
+	if prefix != "" {
+		name = strings.ToLower(prefix) + "_" + name
+	}
+	return getFieldNameStyle(t).Format(name)
+}
+
+// GetSortFieldName returns the name of the provided sort field (see
+// [GetSortableFields]) of the provided type, as exposed through the "sort" query
+// parameter, e.g. "pets.age.sum". See [Config.FieldNameStyle].
+func GetSortFieldName(t *gen.Type, field string) string {
+	style := getFieldNameStyle(t)
+	if style == FieldNameStyleDefault {
+		return field
+	}
+
+	parts := strings.Split(field, ".")
+	for i := range parts {
+		parts[i] = style.Format(parts[i])
+	}
+	return strings.Join(parts, ".")
+}
+
+// getSortFieldColumns returns a map of sort field names (see [GetSortFieldName]) to
+// their internal names, for all sortable fields of the provided type which differ.
+func getSortFieldColumns(t *gen.Type) map[string]string {
+	columns := map[string]string{}
+	for _, field := range GetSortableFields(t, nil) {
+		if name := GetSortFieldName(t, field); name != field {
+			columns[name] = field
+		}
+	}
+	return columns
+}
+
+// getFieldNameStyle returns the [Config.FieldNameStyle] of the graph of the provided
+// type.
+func getFieldNameStyle(t *gen.Type) FieldNameStyle {
+	if t == nil || !hasConfig(t.Config) {
+		return FieldNameStyleDefault
+	}
+	return GetConfig(t.Config).FieldNameStyle
+}
+
+// GetParamName returns the name of the ID path parameter of the provided type. See
+// [Namer.ParamName].
+func GetParamName(t *gen.Type) string {
+	return GetNamer(t).ParamName(t)
+}
+
+// GetPathSegment returns the path segment of the provided type (e.g. "pets"), or if an
+// edge is provided, of the edge (e.g. "owner"). [WithPathName] takes precedence,
+// followed by [Config.PathNameFunc] (types only), and then [Namer.PathSegment].
+func GetPathSegment(t *gen.Type, e *gen.Edge) string {
+	if e != nil {
+		if v := GetAnnotation(e).PathName; v != "" {
+			return v
+		}
+		return GetNamer(t).PathSegment(t, e)
+	}
+
+	if v := GetAnnotation(t).PathName; v != "" {
+		return v
+	}
+
+	if hasConfig(t.Config) {
+		if fn := GetConfig(t.Config).PathNameFunc; fn != nil {
+			if v := fn(t); v != "" {
+				return v
+			}
+		}
+	}
+
+	return GetNamer(t).PathSegment(t, nil)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+type testNamer struct {
+	DefaultNamer
+}
+
+func (testNamer) SchemaName(t *gen.Type) string {
+	return "Api" + Singularize(t.Name)
+}
+
+func (testNamer) FieldName(f *gen.Field) string {
+	return CamelCase(f.Name)
+}
+
+func (testNamer) ParamName(t *gen.Type) string {
+	return SnakeCase(Singularize(t.Name)) + "_id"
+}
+
+func (testNamer) PathSegment(t *gen.Type, e *gen.Edge) string {
+	if e != nil {
+		return SnakeCase(e.Name)
+	}
+	return Pluralize(SnakeCase(t.Name))
+}
+
+func TestNamer_Default(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}.get`))
+	assert.Equal(t, "getPet", r.json(`$.paths./pets/{petID}.get.operationId`))
+	assert.NotNil(t, r.json(`$.components.schemas.PetRead`))
+	assert.NotNil(t, r.json(`$.components.parameters.PetID`))
+}
+
+func TestNamer_Custom(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{Namer: testNamer{}})
+
+	assert.NotNil(t, r.json(`$.paths./pets/{pet_id}.get`))
+	assert.NotNil(t, r.json(`$.paths./pets/{pet_id}/followed_by.get`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.get`))
+
+	// Not overridden, so the default should be used.
+	assert.Equal(t, "getPet", r.json(`$.paths./pets/{pet_id}.get.operationId`))
+
+	assert.NotNil(t, r.json(`$.components.schemas.ApiPetRead`))
+	assert.Nil(t, r.json(`$.components.schemas.PetRead`))
+	assert.Equal(t, "pet_id", r.json(`$.components.parameters.ApiPetID.name`))
+	assert.NotNil(t, r.json(`$.components.schemas.ApiUser.properties.createdAt`))
+	assert.Contains(t, r.json(`$.components.schemas.ApiUserCreate.required`), "name")
+}
+
+func TestNamer_PathName(t *testing.T) {
+	t.Parallel()
+
+	t.Run("annotation", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User", WithPathName("people"))
+				injectAnnotations(t, g, "Pet.best_friend", WithPathName("best-buddy"))
+				return nil
+			},
+		})
+
+		assert.NotNil(t, r.json(`$.paths./people.get`))
+		assert.NotNil(t, r.json(`$.paths./people/{userID}.get`))
+		assert.NotNil(t, r.json(`$.paths./people/{userID}/pets.get`))
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}/best-buddy.get`))
+		assert.Nil(t, r.json(`$.paths./users`))
+		assert.Nil(t, r.json(`$.paths./pets/{petID}/best-friend`))
+
+		// Names other than the path are unaffected.
+		assert.Equal(t, "listUsers", r.json(`$.paths./people.get.operationId`))
+	})
+
+	t.Run("config-func", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PathNameFunc: func(t *gen.Type) string {
+				if t.Name == "Pet" {
+					return "animals"
+				}
+				return ""
+			},
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User", WithPathName("people"))
+				return nil
+			},
+		})
+
+		assert.NotNil(t, r.json(`$.paths./animals/{petID}/owner.get`))
+		assert.NotNil(t, r.json(`$.paths./people/{userID}.get`))
+		assert.NotNil(t, r.json(`$.paths./categories.get`))
+		assert.Nil(t, r.json(`$.paths./pets`))
+	})
+}
+
+func TestNamer_FieldNameStyle(t *testing.T) {
+	t.Parallel()
+
+	hook := func(g *gen.Graph, _ *ogen.Spec) error {
+		injectAnnotations(t, g, "User.created_at", WithFilter(FilterGT), WithSortable(true))
+		injectAnnotations(t, g, "User.followed_pets", WithFilter(FilterEdge))
+		injectAnnotations(t, g, "Pet.name", WithFilter(FilterGroupEqualExact))
+		return nil
+	}
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{PreGenerateHook: hook})
+
+		assert.NotNil(t, r.json(`$.components.schemas.User.properties.created_at`))
+		assert.NotNil(t, r.json(`$.components.schemas.UserUpdate.properties.add_followed_pets`))
+		assert.Contains(t, r.json(`$.components.schemas.UserSortableFields.enum`), "created_at")
+		assert.Equal(t, "createdAt.gt", r.json(`$.components.parameters.UserCreatedAtGT.name`))
+		assert.Equal(t, "has.followedPet", r.json(`$.components.parameters.EdgeHasFollowedPet.name`))
+	})
+
+	t.Run("camel", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{FieldNameStyle: FieldNameStyleCamel, PreGenerateHook: hook})
+
+		assert.NotNil(t, r.json(`$.components.schemas.User.properties.createdAt`))
+		assert.Nil(t, r.json(`$.components.schemas.User.properties.created_at`))
+		assert.Contains(t, r.json(`$.components.schemas.User.required`), "createdAt")
+		assert.NotNil(t, r.json(`$.components.schemas.UserCreate.properties.followedPets`))
+		assert.NotNil(t, r.json(`$.components.schemas.UserUpdate.properties.addFollowedPets`))
+		assert.NotNil(t, r.json(`$.components.schemas.UserUpdate.properties.removeFollowedPets`))
+		assert.Contains(t, r.json(`$.components.schemas.UserSortableFields.enum`), "createdAt")
+		assert.Contains(t, r.json(`$.components.schemas.UserSortableFields.enum`), "followedPets.count")
+		assert.Equal(t, "createdAt.gt", r.json(`$.components.parameters.UserCreatedAtGT.name`))
+		assert.Equal(t, "has.followedPet", r.json(`$.components.parameters.EdgeHasFollowedPet.name`))
+	})
+
+	t.Run("snake", func(t *testing.T) {
+		t.Parallel()
+
+		// The style is applied to the names returned by the namer.
+		r := mustBuildSpec(t, &Config{Namer: testNamer{}, FieldNameStyle: FieldNameStyleSnake, PreGenerateHook: hook})
+
+		assert.NotNil(t, r.json(`$.components.schemas.ApiUser.properties.created_at`))
+		assert.Nil(t, r.json(`$.components.schemas.ApiUser.properties.createdAt`))
+		assert.Equal(t, "created_at.gt", r.json(`$.components.parameters.UserCreatedAtGT.name`))
+		assert.Equal(t, "has.followed_pet", r.json(`$.components.parameters.EdgeHasFollowedPet.name`))
+	})
+}
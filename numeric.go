@@ -0,0 +1,296 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// NumericEncoding represents how high-precision numeric fields (see [NumericFormat])
+// are encoded in response bodies.
+type NumericEncoding string
+
+const (
+	// NumericEncodingString encodes numbers as JSON strings (e.g. "1234.56"), which
+	// preserves their precision in all clients. This is the default.
+	NumericEncodingString NumericEncoding = "string"
+	// NumericEncodingNumber encodes numbers as JSON numbers (e.g. 1234.56). Note that
+	// most JSON decoders (e.g. JavaScript) decode numbers as float64, which loses
+	// precision.
+	NumericEncodingNumber NumericEncoding = "number"
+)
+
+// AllNumericEncodings is a list of all supported numeric encodings.
+var AllNumericEncodings = []NumericEncoding{
+	NumericEncodingString,
+	NumericEncodingNumber,
+}
+
+// RoundingMode represents how high-precision numeric fields are rounded to the
+// [NumericFormat.Scale] in response bodies.
+type RoundingMode string
+
+const (
+	// RoundingNone encodes numbers as-is, without rounding. This is the default.
+	RoundingNone RoundingMode = ""
+	// RoundingHalfEven rounds to the nearest number, and ties to the nearest even
+	// number (i.e. banker's rounding, e.g. 2.345 to 2.34, and 2.355 to 2.36).
+	RoundingHalfEven RoundingMode = "half_even"
+	// RoundingHalfUp rounds to the nearest number, and ties away from zero (e.g. 2.345
+	// to 2.35, and -2.345 to -2.35).
+	RoundingHalfUp RoundingMode = "half_up"
+	// RoundingDown truncates numbers towards zero (e.g. 2.349 to 2.34).
+	RoundingDown RoundingMode = "down"
+)
+
+// AllRoundingModes is a list of all supported rounding modes.
+var AllRoundingModes = []RoundingMode{
+	RoundingNone,
+	RoundingHalfEven,
+	RoundingHalfUp,
+	RoundingDown,
+}
+
+// highPrecisionTypes are the Go types (package path and name) of fields which are
+// high-precision numbers, and thus have a [NumericFormat] by default.
+var highPrecisionTypes = [][2]string{
+	{"math/big", "Int"},
+	{"github.com/shopspring/decimal", "Decimal"},
+}
+
+// NumericFormat represents the format of high-precision numeric fields (e.g. fields
+// with a GoType of *big.Int or decimal.Decimal) in request and response bodies, as well
+// as in query parameters (e.g. filters). Request bodies and query parameters accept
+// both JSON strings and numbers, which are decoded without going through float64.
+//
+// The Go type of the field must implement [encoding.TextMarshaler] and
+// [encoding.TextUnmarshaler], where the text is a decimal number (e.g. "-1234.56"),
+// which allows any high-precision type to be used through [WithNumericFormat].
+type NumericFormat struct {
+	// Encoding is how numbers are encoded in response bodies. Defaults to
+	// [NumericEncodingString].
+	Encoding NumericEncoding `json:",omitempty"`
+
+	// Scale is the number of decimal places numbers are rounded to (or padded with
+	// zeros to) in response bodies, using [NumericFormat.Rounding]. Requires
+	// [NumericFormat.Rounding].
+	Scale int `json:",omitempty"`
+
+	// Rounding is how numbers are rounded to the [NumericFormat.Scale] in response
+	// bodies. Defaults to [RoundingNone].
+	Rounding RoundingMode `json:",omitempty"`
+}
+
+// validate validates the numeric format, and applies defaults.
+func (n *NumericFormat) validate() error {
+	if n.Encoding == "" {
+		n.Encoding = NumericEncodingString
+	}
+
+	if !slices.Contains(AllNumericEncodings, n.Encoding) {
+		return fmt.Errorf("unsupported numeric encoding provided: %s", n.Encoding)
+	}
+
+	if !slices.Contains(AllRoundingModes, n.Rounding) {
+		return fmt.Errorf("unsupported rounding mode provided: %s", n.Rounding)
+	}
+
+	if n.Scale < 0 {
+		return errors.New("NumericFormat.Scale must be >= 0")
+	}
+
+	if n.Scale > 0 && n.Rounding == RoundingNone {
+		return errors.New("NumericFormat.Scale requires NumericFormat.Rounding")
+	}
+	return nil
+}
+
+// isInteger returns true if the format only produces integers for fields of the
+// provided type.
+func (n *NumericFormat) isInteger(f *gen.Field) bool {
+	return (n.Rounding != RoundingNone && n.Scale == 0) || (f.Type.RType != nil && f.Type.RType.PkgPath == "math/big" && f.Type.RType.Name == "Int")
+}
+
+// isHighPrecisionField returns true if the field is a (non-slice) high-precision
+// numeric field, which has a [NumericFormat] by default.
+func isHighPrecisionField(f *gen.Field) bool {
+	rt := f.Type.RType
+	if rt == nil || rt.Kind == reflect.Slice {
+		return false
+	}
+
+	return slices.Contains(highPrecisionTypes, [2]string{rt.PkgPath, rt.Name})
+}
+
+// GetNumericFormat returns the [NumericFormat] of the provided field, through
+// [WithNumericFormat], or [Config.NumericFormat] (applied to all high-precision
+// numeric fields without an explicit format or schema during generation). Returns nil
+// for fields which aren't high-precision numeric fields.
+func GetNumericFormat(f *gen.Field) *NumericFormat {
+	return GetAnnotation(f).NumericFormat
+}
+
+// applyNumericFormats applies [Config.NumericFormat] (or the default format) to all
+// high-precision numeric fields which don't already have a numeric format or a custom
+// schema, as if [WithNumericFormat] was provided.
+func applyNumericFormats(cfg *Config, g *gen.Graph) {
+	format := cfg.NumericFormat
+	if format == nil {
+		format = &NumericFormat{Encoding: NumericEncodingString}
+	}
+
+	for _, t := range g.Nodes {
+		for _, f := range t.Fields {
+			fa := GetAnnotation(f)
+			if !isHighPrecisionField(f) || fa.NumericFormat != nil || fa.Schema != nil {
+				continue
+			}
+
+			f.Annotations = withAnnotation(f.Annotations, func(a *Annotation) { a.NumericFormat = format })
+		}
+	}
+}
+
+// getNumericFormatFields returns all fields of the provided type which have a numeric
+// format (see [GetNumericFormat]), and are included in JSON output (except the amount
+// fields of money objects, see [WithMoney], which are encoded as part of the object).
+func getNumericFormatFields(t *gen.Type) (fields []*gen.Field) {
+	for _, f := range t.Fields {
+		if GetNumericFormat(f) != nil && f.StructTag != `json:"-"` && GetMoneyCurrencyField(t, f) == nil {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// getNumericTypes returns the (sorted, unique) Go types of all fields of the provided
+// types which have a numeric format.
+func getNumericTypes(nodes []*gen.Type) (types []string) {
+	for _, t := range nodes {
+		for _, f := range t.Fields {
+			if GetNumericFormat(f) != nil && !slices.Contains(types, f.Type.String()) {
+				types = append(types, f.Type.String())
+			}
+		}
+	}
+	slices.Sort(types)
+	return types
+}
+
+// formatNumeric returns an expression which encodes the provided value of the provided
+// field to a json.RawMessage, using its numeric format (see the generated
+// FormatNumeric function of the ent package).
+func formatNumeric(f *gen.Field, v string) string {
+	format := GetNumericFormat(f)
+
+	if !f.Nillable && !f.Type.RType.IsPtr() {
+		v = "&" + v
+	}
+
+	scale := -1
+	if format.Rounding != RoundingNone {
+		scale = format.Scale
+	}
+
+	return fmt.Sprintf(
+		"FormatNumeric(%s, %d, %q, %t)",
+		v,
+		scale,
+		format.Rounding,
+		format.Encoding == NumericEncodingNumber,
+	)
+}
+
+// numericExample returns an example of a number in the provided format.
+func numericExample(f *gen.Field, format *NumericFormat) any {
+	v := "1234"
+	if !format.isInteger(f) {
+		scale := 2
+		if format.Rounding != RoundingNone {
+			scale = format.Scale
+		}
+		v += "." + strings.Repeat("5", scale)
+	}
+
+	if format.Encoding == NumericEncodingNumber {
+		return json.Number(v)
+	}
+	return v
+}
+
+// numericSchema returns the schema of the provided high-precision numeric field,
+// based on its numeric format.
+func numericSchema(f *gen.Field) *ogen.Schema {
+	format := GetNumericFormat(f)
+	integer := format.isInteger(f)
+
+	switch {
+	case format.Encoding == NumericEncodingNumber && integer:
+		return &ogen.Schema{Type: "integer"}
+	case format.Encoding == NumericEncodingNumber:
+		return &ogen.Schema{Type: "number", Format: "decimal"}
+	case integer:
+		return &ogen.Schema{Type: "string", Format: "decimal", Pattern: `^-?[0-9]+$`}
+	default:
+		return &ogen.Schema{Type: "string", Format: "decimal", Pattern: `^-?[0-9]+(\.[0-9]+)?$`}
+	}
+}
+
+// applyNumericFormat applies the numeric format of the provided field (if any) to the
+// provided schema, including an example if the schema doesn't already have one.
+func applyNumericFormat(f *gen.Field, schema *ogen.Schema) (err error) {
+	format := GetNumericFormat(f)
+	if format == nil {
+		return nil
+	}
+
+	if format.Rounding != RoundingNone && !format.isInteger(f) {
+		schema.Description = strings.TrimSpace(fmt.Sprintf(
+			"%s\n\nRounded to %d decimal places in responses.",
+			schema.Description,
+			format.Scale,
+		))
+	}
+
+	if schema.Example == nil {
+		schema.Example, err = json.Marshal(numericExample(f, format))
+		if err != nil {
+			return fmt.Errorf("failed to marshal numeric example for field %s: %w", f.StructField(), err)
+		}
+	}
+	return nil
+}
+
+// validateNumericFormat checks that the numeric format of the provided field is valid,
+// and supported by the type of the field.
+func validateNumericFormat(f *gen.Field, fa *Annotation) (errs []error) {
+	if fa.NumericFormat == nil {
+		return nil
+	}
+
+	if err := fa.NumericFormat.validate(); err != nil {
+		errs = append(errs, err)
+	}
+
+	rt := f.Type.RType
+	if rt == nil || rt.Kind == reflect.Slice || !rt.Implements(textMarshalerType) || !rt.Implements(textUnmarshalerType) {
+		errs = append(errs, errors.New(
+			"numeric format is set on a field which doesn't have a (non-slice) GoType implementing encoding.TextMarshaler and encoding.TextUnmarshaler",
+		))
+	}
+
+	if fa.TimeFormat != TimeFormatDefault {
+		errs = append(errs, errors.New("numeric format and time format can't both be set on a field"))
+	}
+	return errs
+}
@@ -0,0 +1,153 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"path"
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// withNumericField changes the Go type of the provided field to the provided
+// high-precision numeric type (e.g. "math/big.Int"), which implements
+// encoding.TextMarshaler and encoding.TextUnmarshaler.
+func withNumericField(t *testing.T, g *gen.Graph, typeName, fieldName, pkgPath, name string, ptr bool) {
+	t.Helper()
+
+	bytesType := &field.RType{Ident: "[]uint8", Kind: reflect.Slice}
+	errType := &field.RType{Name: "error", Ident: "error", Kind: reflect.Interface}
+
+	rtype := &field.RType{
+		Name:    name,
+		Ident:   path.Base(pkgPath) + "." + name,
+		Kind:    reflect.Struct,
+		PkgPath: pkgPath,
+		Methods: map[string]struct{ In, Out []*field.RType }{
+			"MarshalText":   {Out: []*field.RType{bytesType, errType}},
+			"UnmarshalText": {In: []*field.RType{bytesType}, Out: []*field.RType{errType}},
+		},
+	}
+	if ptr {
+		rtype.Kind = reflect.Ptr
+		rtype.Ident = "*" + rtype.Ident
+	}
+
+	for _, n := range g.Nodes {
+		if n.Name != typeName {
+			continue
+		}
+		for _, f := range n.Fields {
+			if f.Name == fieldName {
+				typ := *f.Type // Type info may be shared between test graphs.
+				typ.Ident = rtype.Ident
+				typ.PkgPath = pkgPath
+				typ.RType = rtype
+				f.Type = &typ
+				return
+			}
+		}
+	}
+	t.Fatalf("failed to find field %q in type %q", fieldName, typeName)
+}
+
+func TestSpec_NumericFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withNumericField(t, g, "Pet", "age", "math/big", "Int", true)
+				return nil
+			},
+		})
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.Pet.properties.age.type`))
+		assert.Equal(t, "decimal", r.json(`$.components.schemas.Pet.properties.age.format`))
+		assert.Equal(t, `^-?[0-9]+$`, r.json(`$.components.schemas.Pet.properties.age.pattern`))
+		assert.Equal(t, "1234", r.json(`$.components.schemas.Pet.properties.age.example`))
+	})
+
+	t.Run("config", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			NumericFormat: &NumericFormat{Encoding: NumericEncodingNumber},
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withNumericField(t, g, "Pet", "age", "math/big", "Int", true)
+				return nil
+			},
+		})
+
+		assert.Equal(t, "integer", r.json(`$.components.schemas.Pet.properties.age.type`))
+		assert.Nil(t, r.json(`$.components.schemas.Pet.properties.age.format`))
+		assert.InDelta(t, 1234, r.json(`$.components.schemas.Pet.properties.age.example`), 0)
+	})
+
+	t.Run("field", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withNumericField(t, g, "Pet", "age", "example.com/money", "Amount", false)
+				injectAnnotations(t, g, "Pet.age", WithNumericFormat(NumericFormat{Scale: 2, Rounding: RoundingHalfEven}))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.Pet.properties.age.type`))
+		assert.Equal(t, `^-?[0-9]+(\.[0-9]+)?$`, r.json(`$.components.schemas.Pet.properties.age.pattern`))
+		assert.Equal(t, "1234.55", r.json(`$.components.schemas.Pet.properties.age.example`))
+		assert.Contains(t, r.json(`$.components.schemas.Pet.properties.age.description`), "Rounded to 2 decimal places")
+	})
+
+	t.Run("schema", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				withNumericField(t, g, "Pet", "age", "math/big", "Int", true)
+				injectAnnotations(t, g, "Pet.age", WithSchema(ogen.String()))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.Pet.properties.age.type`))
+		assert.Nil(t, r.json(`$.components.schemas.Pet.properties.age.format`))
+		assert.Nil(t, r.json(`$.components.schemas.Pet.properties.age.example`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			format   *NumericFormat
+			contains string
+		}{
+			{&NumericFormat{Encoding: "float"}, "unsupported numeric encoding"},
+			{&NumericFormat{Rounding: "ceil"}, "unsupported rounding mode"},
+			{&NumericFormat{Scale: -1, Rounding: RoundingDown}, "NumericFormat.Scale must be >= 0"},
+			{&NumericFormat{Scale: 2}, "NumericFormat.Scale requires NumericFormat.Rounding"},
+		} {
+			_, err := NewExtension(&Config{NumericFormat: tt.format})
+			require.ErrorContains(t, err, tt.contains)
+		}
+
+		_, err := buildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.name", WithNumericFormat(NumericFormat{}))
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+		require.ErrorContains(t, err, "numeric format is set on a field which doesn't have a (non-slice) GoType")
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// IsReplaceableEdge returns true if the provided edge is an optional (and mutable) O2O
+// edge (e.g. user -> profile), where the related entity can be replaced and unlinked
+// through the edge endpoint. If the edge has an inverse, it must also be optional, as
+// otherwise the related entity can't be unlinked.
+func IsReplaceableEdge(e *gen.Edge) bool {
+	return e != nil &&
+		e.O2O() &&
+		e.Optional &&
+		!e.Immutable &&
+		e.Type.ID != nil &&
+		(e.Ref == nil || e.Ref.Optional)
+}
+
+// HasEdgeOperation returns true if the provided operation is supported on the endpoint
+// of the provided edge, in addition to reading the edge. Only [OperationUpdate] (PUT,
+// which creates a new entity, replacing the existing one) and [OperationDelete] (DELETE,
+// which unlinks the existing entity) are supported, on replaceable edges (see
+// [IsReplaceableEdge]). Both are considered updates of the owner, and can be excluded
+// using [WithExcludeOperations] on the edge.
+func HasEdgeOperation(t *gen.Type, e *gen.Edge, op Operation) bool {
+	cfg := GetConfig(t.Config)
+	ea := GetAnnotation(e)
+
+	if !IsReplaceableEdge(e) || ea.GetSkip(cfg) || !ea.HasOperation(cfg, op) || !GetAnnotation(t).HasOperation(cfg, OperationUpdate) {
+		return false
+	}
+
+	switch op {
+	case OperationUpdate:
+		return GetAnnotation(e.Type).HasOperation(cfg, OperationCreate)
+	case OperationDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// addEdgeReplace adds the replace (PUT) and unlink (DELETE) operations of a replaceable
+// edge to the provided spec, if supported (see [HasEdgeOperation]).
+func addEdgeReplace(spec *ogen.Spec, t *gen.Type, e *gen.Edge) {
+	ta := GetAnnotation(t)
+	ea := GetAnnotation(e)
+	ra := GetAnnotation(e.Type)
+
+	item, ok := spec.Paths[GetPathName(OperationRead, t, e, true)]
+	if !ok {
+		return
+	}
+
+	refEntityName := GetSchemaName(e.Type)
+	tags := sliceCompact(sliceOr(ea.Tags, append([]string{Pluralize(t.Name), Pluralize(e.Type.Name)}, ea.AdditionalTags...)))
+
+	if HasEdgeOperation(t, e, OperationUpdate) {
+		op := OperationUpdate
+
+		// The reference back to the owner (if any) is set through the path.
+		var exclude []string
+		if e.Ref != nil {
+			exclude = append(exclude, e.Ref.Name)
+			if e.Ref.Field() != nil {
+				exclude = append(exclude, GetFieldName(e.Type, e.Ref.Field()))
+			}
+		}
+
+		name := addEdgeCreateSchema(spec, t, e, exclude...)
+		if name != "" {
+			spec.Components.Schemas[name].Description = fmt.Sprintf(
+				"Create a new %s, replacing the %s of a %s.",
+				refEntityName,
+				CamelCase(e.Name),
+				GetSchemaName(t),
+			)
+
+			item.Put = &ogen.Operation{
+				Tags: tags,
+				Summary: cmp.Or(
+					ea.GetOperationSummary(op),
+					fmt.Sprintf("Replace a %s %s", CamelCase(GetSchemaName(t)), CamelCase(e.Name)),
+				),
+				Description: cmp.Or(
+					ea.GetOperationDescription(op),
+					fmt.Sprintf(
+						"Create a new %s (%s entity type), and set it as the %s of the %s. Any existing %s is unlinked (but not deleted).",
+						CamelCase(e.Name),
+						refEntityName,
+						CamelCase(e.Name),
+						GetSchemaName(t),
+						CamelCase(e.Name),
+					),
+				),
+				OperationID: GetOperationIDName(op, t, e),
+				Deprecated:  ta.Deprecated || ea.Deprecated || ra.Deprecated,
+				RequestBody: ogen.NewRequestBody().
+					SetRequired(true).
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + name}),
+				Responses: ogen.Responses{
+					strconv.Itoa(http.StatusOK): ogen.NewResponse().
+						SetDescription(fmt.Sprintf("The created %s entity.", CamelCase(e.Name))).
+						SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(e.Type)}),
+				},
+			}
+			addMaintenanceResponse(GetConfig(t.Config), item.Put)
+		}
+	}
+
+	if HasEdgeOperation(t, e, OperationDelete) {
+		op := OperationDelete
+
+		item.Delete = &ogen.Operation{
+			Tags: tags,
+			Summary: cmp.Or(
+				ea.GetOperationSummary(op),
+				fmt.Sprintf("Unlink a %s %s", CamelCase(GetSchemaName(t)), CamelCase(e.Name)),
+			),
+			Description: cmp.Or(
+				ea.GetOperationDescription(op),
+				fmt.Sprintf(
+					"Unlink the %s (%s entity type) from the %s. The %s itself is not deleted.",
+					CamelCase(e.Name),
+					refEntityName,
+					GetSchemaName(t),
+					CamelCase(e.Name),
+				),
+			),
+			OperationID: GetOperationIDName(op, t, e),
+			Deprecated:  ta.Deprecated || ea.Deprecated || ra.Deprecated,
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusNoContent): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The %s was unlinked successfully.", CamelCase(e.Name))),
+			},
+		}
+		addMaintenanceResponse(GetConfig(t.Config), item.Delete)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"net/http"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_ReplaceableEdge(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.ElementsMatch(
+			t,
+			[]string{http.MethodGet, http.MethodPut, http.MethodDelete},
+			getPathMethods(t, r, "/pets/{petID}/best-friend"),
+		)
+		assert.Equal(t, "replacePetBestFriend", r.json(`$.paths./pets/{petID}/best-friend.put.operationId`))
+		assert.Equal(t, "unlinkPetBestFriend", r.json(`$.paths./pets/{petID}/best-friend.delete.operationId`))
+		assert.Contains(t, r.json(`$.paths./pets/{petID}/best-friend.put.requestBody.content.application/json.schema.$ref`), "/PetBestFriendCreate")
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}/best-friend.delete.responses.204`))
+
+		// Not O2O, so should only be readable.
+		assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/pets/{petID}/owner"))
+	})
+
+	t.Run("excluded-operations", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.best_friend", WithExcludeOperations(OperationDelete))
+				return nil
+			},
+		})
+
+		assert.ElementsMatch(
+			t,
+			[]string{http.MethodGet, http.MethodPut},
+			getPathMethods(t, r, "/pets/{petID}/best-friend"),
+		)
+	})
+
+	t.Run("owner-not-updatable", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithExcludeOperations(OperationUpdate))
+				return nil
+			},
+		})
+
+		assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/pets/{petID}/best-friend"))
+	})
+}
@@ -0,0 +1,180 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// odataSelectParam is the name of the query parameter of read masks (see
+// [Config.ReadMask]) of list operations with [FlavorOData].
+const odataSelectParam = "$select"
+
+// odataFilterOperators maps the filter operations which can be expressed through OData
+// "$filter" expressions (see [FlavorOData]) to their operator (and argument, if
+// specific). Operators ending with "()" are functions, e.g. "contains(name,'foo')".
+var odataFilterOperators = map[gen.Op]string{
+	gen.EQ:        "eq",
+	gen.NEQ:       "ne",
+	gen.GT:        "gt",
+	gen.GTE:       "ge",
+	gen.LT:        "lt",
+	gen.LTE:       "le",
+	gen.Contains:  "contains()",
+	gen.HasPrefix: "startswith()",
+	gen.HasSuffix: "endswith()",
+	gen.IsNil:     "eq null",
+}
+
+// getODataFilters returns the filters of list operations returning the provided type,
+// which can be used through OData "$filter" expressions (see [FlavorOData]). Paths
+// use a slash as the separator, e.g. "owner/name". Edge filters aren't supported.
+func getODataFilters(t *gen.Type) []*flavorFilter {
+	filters := getFlavorFilters(t, odataFilterOperators, "")
+	for _, f := range filters {
+		f.Path = strings.ReplaceAll(f.Path, ".", "/")
+	}
+	return filters
+}
+
+// odataPagedSchema returns the schema of the pagination fields of paginated list
+// responses (see [FlavorOData]).
+func odataPagedSchema() *ogen.Schema {
+	return &ogen.Schema{
+		Type: "object",
+		Properties: ogen.Properties{
+			{
+				Name: "@odata.count",
+				Schema: &ogen.Schema{
+					Type:        "integer",
+					Description: "The total number of results based on the provided query. Only provided if `$count=true` was requested.",
+					Minimum:     ogen.Int().SetMinimum(ptr(int64(0))).Minimum,
+				},
+			},
+			{
+				Name: "@odata.nextLink",
+				Schema: &ogen.Schema{
+					Type:        "string",
+					Description: "The URL of the next page. Only provided if there are subsequent pages.",
+				},
+			},
+		},
+	}
+}
+
+// odataPaginationParameters returns the "$top", "$skip", "$skiptoken" and "$count"
+// parameters of paginated list operations (see [FlavorOData]).
+func odataPaginationParameters(minItems, maxItems, items int) []*ogen.Parameter {
+	return []*ogen.Parameter{
+		{
+			Name:        "$top",
+			In:          "query",
+			Description: "The maximum number of entities to retrieve per page.",
+			Schema: ogen.Int().
+				SetMinimum(ptr(int64(minItems))).
+				SetMaximum(ptr(int64(maxItems))).
+				SetDefault(json.RawMessage(strconv.Itoa(items))),
+		},
+		{
+			Name:        "$skip",
+			In:          "query",
+			Description: "The number of entities to skip. Requires `$top`, and must be a multiple of it.",
+			Schema:      ogen.Int().SetMinimum(ptr(int64(0))),
+		},
+		{
+			Name:        "$skiptoken",
+			In:          "query",
+			Description: "An opaque token, provided through the `@odata.nextLink` field of a previous call, to retrieve the subsequent page.",
+			Schema:      ogen.String(),
+		},
+		{
+			Name:        "$count",
+			In:          "query",
+			Description: "Include the total number of results based on the provided query in the `@odata.count` field.",
+			Schema:      ogen.Bool().SetDefault(json.RawMessage(`false`)),
+		},
+	}
+}
+
+// odataOrderByParameter returns the "$orderby" parameter of list operations (see
+// [FlavorOData]) returning the provided type, which can be sorted by the provided
+// fields.
+func odataOrderByParameter(t *gen.Type, sortable []string, defaultSort string, defaultOrder SortOrder) *ogen.Parameter {
+	example := defaultSort
+	fields := make([]string, len(sortable))
+	for i, f := range sortable {
+		fields[i] = "`" + strings.ReplaceAll(GetSortFieldName(t, f), ".", "/") + "`"
+		if example == "" && f != "random" {
+			example = f
+		}
+	}
+
+	param := &ogen.Parameter{
+		Name: "$orderby",
+		In:   "query",
+		Description: fmt.Sprintf(
+			"Sort entity results by the given field, optionally followed by `asc` (default) or `desc`, e.g. `%s desc`. Only a single field is supported. Supported fields: %s.",
+			strings.ReplaceAll(GetSortFieldName(t, example), ".", "/"),
+			strings.Join(fields, ", "),
+		),
+		Schema: ogen.String(),
+	}
+
+	if defaultSort != "" {
+		param.Schema = param.Schema.SetDefault(json.RawMessage(strconv.Quote(
+			strings.ReplaceAll(GetSortFieldName(t, defaultSort), ".", "/") + " " + string(defaultOrder),
+		)))
+	}
+	return param
+}
+
+// odataFilterParameter returns the "$filter" parameter of list operations (see
+// [FlavorOData]) returning the provided type, or nil if the type has no filters.
+func odataFilterParameter(t *gen.Type) *ogen.Parameter {
+	supported := getFlavorFilterSummary(getODataFilters(t))
+	if supported == "" {
+		return nil
+	}
+
+	return &ogen.Parameter{
+		Name: "$filter",
+		In:   "query",
+		Description: fmt.Sprintf(
+			"Filter entity results through an OData filter expression, e.g. `name eq 'foo' and age gt 3`. Restrictions can be combined through either `and` or `or` (not both), and string values must be quoted with single quotes. Supported fields and operators: %s.",
+			supported,
+		),
+		Schema: ogen.String(),
+	}
+}
+
+// renameODataSelectParam renames the read mask parameter (see [Config.ReadMask]) of all
+// operations within the provided spec to "$select" (see [FlavorOData]). Must only be
+// used with specs of list operations.
+func renameODataSelectParam(cfg *Config, spec *ogen.Spec) {
+	if cfg.Flavor != FlavorOData || !cfg.ReadMask {
+		return
+	}
+
+	for pathName, pathItem := range spec.Paths {
+		spec.Paths[pathName] = PatchOperations(pathItem, func(_ string, op *ogen.Operation) *ogen.Operation {
+			if op == nil {
+				return op
+			}
+			for _, param := range op.Parameters {
+				if param.Name == readMaskParam && param.In == "query" {
+					param.Name = odataSelectParam
+					param.Description = strings.Replace(param.Description, "separated by a dot, e.g. `owner.name`", "separated by a slash, e.g. `owner/name`", 1)
+				}
+			}
+			return op
+		})
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"slices"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_FlavorOData(t *testing.T) {
+	t.Parallel()
+
+	params := func(op *ogen.Operation) (names []string) {
+		for _, p := range op.Parameters {
+			names = append(names, p.Name)
+		}
+		return names
+	}
+
+	r := mustBuildSpec(t, &Config{
+		Flavor:   FlavorOData,
+		ReadMask: true,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet.name", WithSortable(true), WithFilter(FilterGroupEqual))
+			injectAnnotations(t, g, "Pet.owner", WithFilter(FilterEdge))
+			return nil
+		},
+	})
+
+	for _, path := range []string{"/pets", "/users/{userID}/pets"} {
+		op := r.spec.Paths[path].Get
+		require.NotNil(t, op, path)
+
+		names := params(op)
+		assert.Subset(t, names, []string{"$top", "$skip", "$skiptoken", "$count", "$orderby", "$filter", "$select"}, path)
+		for _, name := range []string{"page", "per_page", "sort", "order", "filter_op", "read_mask"} {
+			assert.NotContains(t, names, name, path)
+		}
+	}
+
+	// Read operations keep the native read mask parameter.
+	assert.Contains(t, params(r.spec.Paths["/pets/{petID}"].Get), "read_mask")
+
+	op := r.spec.Paths["/pets"].Get
+	filter := op.Parameters[slices.IndexFunc(op.Parameters, func(p *ogen.Parameter) bool { return p.Name == "$filter" })]
+	assert.Contains(t, filter.Description, "`name` (`eq`, `ne`, `contains()`")
+	assert.NotContains(t, filter.Description, "`owner`")
+
+	assert.NotNil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.value`))
+	assert.Nil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.content`))
+	assert.NotNil(t, r.json(`$.components.schemas.PagedResponse.properties['@odata.count']`))
+	assert.NotNil(t, r.json(`$.components.schemas.PagedResponse.properties['@odata.nextLink']`))
+	assert.Nil(t, r.json(`$.components.schemas.PagedResponse.properties.page`))
+	assert.Nil(t, r.json(`$.components.parameters.Page`))
+}
+
+func TestFlavorFilter_Example(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		filter *flavorFilter
+		want   string
+	}{
+		{filter: &flavorFilter{Path: "name", Operator: "="}, want: "name = 1"},
+		{filter: &flavorFilter{Path: "owner", Operator: ":*"}, want: "owner:*"},
+		{filter: &flavorFilter{Path: "name", Operator: "eq null"}, want: "name eq null"},
+		{filter: &flavorFilter{Path: "owner/name", Operator: "contains()"}, want: "contains(owner/name,'1')"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, tt.filter.Example())
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
+	"entgo.io/ent/schema/field"
+)
+
+// OutboxSchemaName is the name of the schema which is added to the graph when
+// [Config.WithOutbox] is enabled.
+const OutboxSchemaName = "Outbox"
+
+// outboxSchema returns the schema which stores outbox entries (see [Config.WithOutbox]).
+func outboxSchema() *load.Schema {
+	return &load.Schema{
+		Name: OutboxSchemaName,
+		Fields: []*load.Field{
+			{
+				Name:      "event",
+				Info:      &field.TypeInfo{Type: field.TypeString},
+				Immutable: true,
+				Comment:   `The event which occurred, e.g. "pet.created".`,
+			},
+			{
+				Name:      "entity",
+				Info:      &field.TypeInfo{Type: field.TypeString},
+				Immutable: true,
+				Comment:   `The name of the schema of the entity, e.g. "Pet".`,
+			},
+			{
+				Name:      "entity_id",
+				Info:      &field.TypeInfo{Type: field.TypeInt},
+				Immutable: true,
+				Comment:   "The ID of the entity.",
+			},
+			{
+				Name:      "payload",
+				Info:      &field.TypeInfo{Type: field.TypeBytes, Nillable: true},
+				Immutable: true,
+				Comment:   "The JSON encoded entity, or only its ID for deleted entities.",
+			},
+			{
+				Name:      "created_at",
+				Info:      &field.TypeInfo{Type: field.TypeTime, PkgPath: "time"},
+				Immutable: true,
+				Comment:   "When the event occurred.",
+			},
+			{
+				Name:     "processed_at",
+				Info:     &field.TypeInfo{Type: field.TypeTime, PkgPath: "time"},
+				Optional: true,
+				Nillable: true,
+				Comment:  "When the entry was processed, or nil if it hasn't been processed yet.",
+			},
+		},
+		Indexes: []*load.Index{
+			{Fields: []string{"processed_at"}},
+		},
+	}
+}
+
+// applyOutbox adds the outbox schema (see [Config.WithOutbox]) to the graph, skipped
+// from the REST API.
+func applyOutbox(cfg *Config, g *gen.Graph) error {
+	if !cfg.WithOutbox {
+		return nil
+	}
+
+	if err := addGraphSchema(g, outboxSchema()); err != nil {
+		return fmt.Errorf("failed to add outbox schema (see Config.WithOutbox): %w", err)
+	}
+	return nil
+}
+
+// addGraphSchema adds the provided schema to the graph, skipped from the REST API. The
+// graph is rebuilt, so the schema is generated like any other schema (including
+// migrations).
+func addGraphSchema(g *gen.Graph, schema *load.Schema) error {
+	for _, t := range g.Nodes {
+		if t.Name == schema.Name {
+			return fmt.Errorf("schema %q conflicts with a generated schema", t.Name)
+		}
+	}
+
+	schemas := append(g.Schemas[:len(g.Schemas):len(g.Schemas)], schema)
+
+	ng, err := gen.NewGraph(g.Config, schemas...)
+	if err != nil {
+		return err
+	}
+
+	// Retain the annotations of existing schemas, which may have been modified before
+	// the extension is invoked (e.g. by other extensions).
+	for i, t := range g.Nodes {
+		nt := ng.Nodes[i]
+		nt.Annotations = t.Annotations
+		for j, f := range t.Fields {
+			nt.Fields[j].Annotations = f.Annotations
+		}
+		for j, e := range t.Edges {
+			nt.Edges[j].Annotations = e.Annotations
+		}
+	}
+
+	*g = *ng
+
+	for _, t := range g.Nodes {
+		if t.Name == schema.Name {
+			t.Annotations = withAnnotation(t.Annotations, func(a *Annotation) {
+				a.Skip = true
+			})
+		}
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_WithOutbox(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{Handler: HandlerStdlib, WithOutbox: true})
+
+		var outbox *gen.Type
+		for _, n := range r.graph.Nodes {
+			if n.Name == OutboxSchemaName {
+				outbox = n
+			}
+		}
+		require.NotNil(t, outbox)
+
+		assert.True(t, GetAnnotation(outbox).Skip)
+		assert.Equal(t, "outboxes", outbox.Table())
+
+		var fields []string
+		for _, f := range outbox.Fields {
+			fields = append(fields, f.Name)
+		}
+		assert.Equal(t, []string{"event", "entity", "entity_id", "payload", "created_at", "processed_at"}, fields)
+
+		assert.Nil(t, r.json(`$.paths./outboxes`))
+		assert.Nil(t, r.json(`$.components.schemas.Outbox`))
+		assert.NotNil(t, r.json(`$.paths./pets`))
+	})
+
+	t.Run("no-handler", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{WithOutbox: true})
+
+		for _, n := range r.graph.Nodes {
+			assert.NotEqual(t, OutboxSchemaName, n.Name)
+		}
+	})
+}
@@ -0,0 +1,143 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"fmt"
+	"maps"
+	"regexp"
+	"slices"
+
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// paginationFieldRegex is the pattern which names of pagination fields must match.
+var paginationFieldRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// getPaginationField returns the name which the provided field of paginated list
+// responses is encoded with (see [Config.PaginationSchema]). Returns an empty string
+// if the field isn't included in responses.
+func getPaginationField(cfg *Config, field PaginationField) string {
+	if name, ok := cfg.PaginationSchema[field]; ok {
+		return name
+	}
+
+	switch field {
+	case PaginationFieldPerPage, PaginationFieldNextPage, PaginationFieldPreviousPage:
+		return ""
+	default:
+		return string(field)
+	}
+}
+
+// getPaginationFieldTag returns the JSON struct tag of the provided field of paginated
+// list responses, which is "-" if the field isn't included in responses.
+func getPaginationFieldTag(cfg *Config, field PaginationField) string {
+	return fmt.Sprintf("json:%q", cmp.Or(getPaginationField(cfg, field), "-"))
+}
+
+// paginationFieldSchema returns the schema of the provided field of paginated list
+// responses, other than [PaginationFieldContent].
+func paginationFieldSchema(field PaginationField) *ogen.Schema {
+	switch field {
+	case PaginationFieldPage:
+		return &ogen.Schema{
+			Type:        "integer",
+			Description: "Page which the results are associated with.",
+			Example:     jsonschema.RawValue(`1`),
+			Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
+		}
+	case PaginationFieldLastPage:
+		return &ogen.Schema{
+			Type:        "integer",
+			Description: "The number of the last page of results.",
+			Example:     jsonschema.RawValue(`3`),
+			Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
+		}
+	case PaginationFieldIsFirstPage:
+		return &ogen.Schema{
+			Type:        "boolean",
+			Description: "If true, the current results are the first page of results.",
+			Example:     jsonschema.RawValue(`true`),
+		}
+	case PaginationFieldIsLastPage:
+		return &ogen.Schema{
+			Type:        "boolean",
+			Description: "If true, the current results are the last page of results.",
+			Example:     jsonschema.RawValue(`false`),
+		}
+	case PaginationFieldTotalCount:
+		return &ogen.Schema{
+			Type:        "integer",
+			Description: "The total number of results based on the provided query.",
+			Example:     jsonschema.RawValue(`123`),
+			Minimum:     ogen.Int().SetMinimum(ptr(int64(0))).Minimum,
+		}
+	case PaginationFieldPerPage:
+		return &ogen.Schema{
+			Type:        "integer",
+			Description: "The number of results per page.",
+			Example:     jsonschema.RawValue(`10`),
+			Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
+		}
+	case PaginationFieldNextPage:
+		return &ogen.Schema{
+			Type:        "integer",
+			Description: "The number of the next page of results, or null if the current results are the last page of results.",
+			Example:     jsonschema.RawValue(`2`),
+			Minimum:     ogen.Int().SetMinimum(ptr(int64(2))).Minimum,
+			Nullable:    true,
+		}
+	case PaginationFieldPreviousPage:
+		return &ogen.Schema{
+			Type:        "integer",
+			Description: "The number of the previous page of results, or null if the current results are the first page of results.",
+			Example:     jsonschema.RawValue(`1`),
+			Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
+			Nullable:    true,
+		}
+	default:
+		return nil
+	}
+}
+
+// validatePaginationSchema checks that the fields of paginated list responses are
+// supported, and are encoded with unique, valid names.
+func validatePaginationSchema(cfg *Config) error {
+	if len(cfg.PaginationSchema) == 0 {
+		return nil
+	}
+
+	if cfg.Flavor != FlavorDefault {
+		return fmt.Errorf("Config.PaginationSchema isn't supported with the %s flavor", cfg.Flavor)
+	}
+
+	for _, field := range slices.Sorted(maps.Keys(cfg.PaginationSchema)) {
+		if !slices.Contains(AllPaginationFields, field) {
+			return fmt.Errorf("Config.PaginationSchema: unsupported pagination field provided: %s", field)
+		}
+	}
+
+	names := map[string]PaginationField{}
+
+	for _, field := range AllPaginationFields {
+		name := getPaginationField(cfg, field)
+		if name == "" {
+			continue
+		}
+
+		if !paginationFieldRegex.MatchString(name) {
+			return fmt.Errorf("Config.PaginationSchema: invalid name %q for pagination field %q", name, field)
+		}
+
+		if other, ok := names[name]; ok {
+			return fmt.Errorf("Config.PaginationSchema: pagination fields %q and %q are both named %q", other, field, name)
+		}
+		names[name] = field
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_PaginationSchema(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Equal(t, []any{"page", "last_page", "is_first_page", "is_last_page", "total_count"}, r.json(`$.components.schemas.PagedResponse.required`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.content`))
+	})
+
+	t.Run("custom", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PaginationSchema: map[PaginationField]string{
+				PaginationFieldTotalCount: "total",
+				PaginationFieldContent:    "items",
+				PaginationFieldNextPage:   "next",
+			},
+		})
+
+		assert.Equal(t, []any{"page", "last_page", "is_first_page", "is_last_page", "total", "next"}, r.json(`$.components.schemas.PagedResponse.required`))
+		assert.Equal(t, true, r.json(`$.components.schemas.PagedResponse.properties.next.nullable`))
+		assert.Nil(t, r.json(`$.components.schemas.PagedResponse.properties.total_count`))
+		assert.Nil(t, r.json(`$.components.schemas.PagedResponse.properties.per_page`))
+		assert.NotNil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.items`))
+		assert.Nil(t, r.json(`$.components.schemas.PetList.allOf[1].properties.content`))
+	})
+
+	t.Run("page-parameter", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Equal(t, "integer", r.json(`$.components.parameters.Page.schema.oneOf[0].type`))
+		assert.Equal(t, []any{"first", "last"}, r.json(`$.components.parameters.Page.schema.oneOf[1].enum`))
+		assert.Equal(t, 1.0, r.json(`$.components.parameters.Page.schema.default`)) //nolint:all
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for name, cfg := range map[string]*Config{
+			"unknown-field":  {PaginationSchema: map[PaginationField]string{"cursor": "cursor"}},
+			"invalid-name":   {PaginationSchema: map[PaginationField]string{PaginationFieldPage: "the page"}},
+			"duplicate-name": {PaginationSchema: map[PaginationField]string{PaginationFieldPage: "content"}},
+			"flavor":         {Flavor: FlavorAIP, PaginationSchema: map[PaginationField]string{PaginationFieldPage: "p"}},
+		} {
+			_, err := NewExtension(cfg)
+			assert.Error(t, err, name)
+		}
+	})
+}
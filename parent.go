@@ -0,0 +1,162 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// parentProperty is the name of the property of edge endpoint responses which contains
+// the fields of the parent entity (see [WithParentFields]).
+const parentProperty = "_parent"
+
+// GetParentFields returns the fields of the provided type which are included in the
+// responses of the endpoint of the provided edge (see [WithParentFields]), in the order
+// they were provided. The ID of the type is always included, and isn't returned.
+func GetParentFields(t *gen.Type, e *gen.Edge) (fields []*gen.Field) {
+	for _, name := range GetAnnotation(e).ParentFields {
+		for _, f := range t.Fields {
+			if f.Name == name {
+				fields = append(fields, f)
+				break
+			}
+		}
+	}
+	return fields
+}
+
+// hasParentFields returns true if the responses of the endpoint of the provided edge,
+// which belongs to the provided type, include fields of the parent entity (see
+// [WithParentFields]).
+func hasParentFields(t *gen.Type, e *gen.Edge) bool {
+	cfg := GetConfig(t.Config)
+	ea := GetAnnotation(e)
+
+	return len(ea.ParentFields) > 0 &&
+		t.ID != nil &&
+		!GetAnnotation(t).GetSkip(cfg) &&
+		!ea.GetSkip(cfg) &&
+		ea.GetEdgeEndpoint(cfg)
+}
+
+// getParentTypeName returns the prefix of the names of the generated types (and schemas)
+// for responses of the endpoint of the provided edge which include fields of the parent
+// entity, e.g. "PetOwner" (for "PetOwnerParent" and "PetOwnerWithParent").
+func getParentTypeName(t *gen.Type, e *gen.Edge) string {
+	return t.Name + Singularize(PascalCase(e.Name))
+}
+
+// isPagedEdgeList returns true if the list endpoint of the provided (non-unique) edge
+// returns paginated results, both in the spec and the generated handlers.
+func isPagedEdgeList(cfg *Config, e *gen.Edge) bool {
+	ea := GetAnnotation(e)
+	ra := GetAnnotation(e.Type)
+	return ra.GetPagination(cfg, nil) && (ea.GetPagination(cfg, e) || ra.GetPagination(cfg, e))
+}
+
+// addParentFields adds the fields of the parent entity (see [WithParentFields]) to the
+// successful response of the provided edge endpoint operation, as the "_parent" property.
+func addParentFields(spec *ogen.Spec, t *gen.Type, e *gen.Edge, oper *ogen.Operation) error {
+	if !hasParentFields(t, e) {
+		return nil
+	}
+
+	idSchema, err := GetSchemaID(t)
+	if err != nil {
+		return err
+	}
+	idSchema.Description = fmt.Sprintf("The ID of the %s entity.", GetSchemaName(t))
+
+	parent := &ogen.Schema{
+		Type:        "object",
+		Description: fmt.Sprintf("Fields of the %s entity which the %s edge belongs to.", GetSchemaName(t), CamelCase(e.Name)),
+		Properties:  ogen.Properties{*idSchema.ToProperty("id")},
+		Required:    []string{"id"},
+	}
+
+	for _, f := range GetParentFields(t, e) {
+		fieldSchema, err := GetSchemaField(f)
+		if err != nil {
+			return fmt.Errorf("failed to generate schema for field %s: %w", f.StructField(), err)
+		}
+
+		parent.Properties = append(parent.Properties, *fieldSchema.ToProperty(GetFieldName(t, f)))
+		if !f.Optional {
+			parent.Required = append(parent.Required, GetFieldName(t, f))
+		}
+	}
+
+	name := GetSchemaName(t) + Singularize(PascalCase(e.Name))
+	spec.Components.Schemas[name+"Parent"] = parent
+
+	code := strconv.Itoa(http.StatusOK)
+	resp := oper.Responses[code]
+	if resp == nil || resp.Content["application/json"].Schema == nil {
+		return errors.New("edge endpoint has no JSON response")
+	}
+
+	spec.Components.Schemas[name+"WithParent"] = &ogen.Schema{
+		Description: resp.Description,
+		AllOf: []*ogen.Schema{
+			resp.Content["application/json"].Schema,
+			{
+				Type: "object",
+				Properties: ogen.Properties{
+					{Name: parentProperty, Schema: &ogen.Schema{Ref: "#/components/schemas/" + name + "Parent"}},
+				},
+				Required: []string{parentProperty},
+			},
+		},
+	}
+
+	oper.Responses[code] = ogen.NewResponse().
+		SetDescription(resp.Description).
+		SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + name + "WithParent"})
+	return nil
+}
+
+// validateParentFields checks that the parent fields of an edge (see [WithParentFields])
+// exist and are returned in responses, and that the edge has an endpoint which supports
+// them.
+func validateParentFields(cfg *Config, t *gen.Type, e *gen.Edge, ea *Annotation) (errs []error) {
+	if len(ea.ParentFields) == 0 || ea.GetSkip(cfg) {
+		return nil
+	}
+
+	switch {
+	case t.ID == nil:
+		return []error{errors.New("parent fields are only supported on schemas with a single ID field")}
+	case !ea.GetEdgeEndpoint(cfg):
+		return []error{errors.New("parent fields provided, but the edge has no endpoint")}
+	case !e.Unique && !isPagedEdgeList(cfg, e):
+		return []error{errors.New("parent fields are only supported on unique or paginated edges")}
+	}
+
+	for _, name := range ea.ParentFields {
+		var field *gen.Field
+		for _, f := range t.Fields {
+			if f.Name == name {
+				field = f
+				break
+			}
+		}
+
+		switch {
+		case field == nil:
+			errs = append(errs, fmt.Errorf("parent field %q does not exist on schema %s", name, t.Name))
+		case field.Sensitive() || GetAnnotation(field).GetSkip(cfg) || !GetAnnotation(field).GetReadable():
+			errs = append(errs, fmt.Errorf("parent field %q is sensitive, skipped or not readable", name))
+		case isFileField(field):
+			errs = append(errs, fmt.Errorf("parent field %q is a file field", name))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_ParentFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unique", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.owner", WithParentFields("name", "age"))
+				return nil
+			},
+		})
+
+		assert.Equal(
+			t,
+			"#/components/schemas/PetOwnerWithParent",
+			r.json(`$.paths./pets/{petID}/owner.get.responses.200.content.application/json.schema.$ref`),
+		)
+		assert.Equal(t, "#/components/schemas/UserRead", r.json(`$.components.schemas.PetOwnerWithParent.allOf[0].$ref`))
+		assert.Equal(
+			t,
+			"#/components/schemas/PetOwnerParent",
+			r.json(`$.components.schemas.PetOwnerWithParent.allOf[1].properties._parent.$ref`),
+		)
+		assert.Equal(t, []any{"id", "name"}, r.json(`$.components.schemas.PetOwnerParent.required`))
+		assert.Equal(t, "integer", r.json(`$.components.schemas.PetOwnerParent.properties.age.type`))
+
+		// Other edges are unaffected.
+		assert.Nil(t, r.json(`$.components.schemas.PetBestFriendWithParent`))
+	})
+
+	t.Run("paginated", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.friends", WithParentFields("name"))
+				return nil
+			},
+		})
+
+		assert.Equal(
+			t,
+			"#/components/schemas/PetFriendWithParent",
+			r.json(`$.paths./pets/{petID}/friends.get.responses.200.content.application/json.schema.$ref`),
+		)
+		assert.Equal(t, "#/components/schemas/PetList", r.json(`$.components.schemas.PetFriendWithParent.allOf[0].$ref`))
+		assert.Equal(t, []any{"id", "name"}, r.json(`$.components.schemas.PetFriendParent.required`))
+	})
+}
@@ -0,0 +1,74 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// defaultPayloadSizeBuckets are the default upper bounds (in bytes) of the buckets of
+// payload size histograms (see [PayloadSizes.Buckets]), from 1KiB to 16MiB.
+var defaultPayloadSizeBuckets = []int{
+	1 << 10,
+	4 << 10,
+	16 << 10,
+	64 << 10,
+	256 << 10,
+	1 << 20,
+	4 << 20,
+	16 << 20,
+}
+
+// PayloadSizes enables the observation of the request and response payload sizes of all
+// entity endpoints of the generated server (except file downloads), which are recorded
+// in per-operation histograms (see the generated Server.PayloadSizes, and
+// ServerConfig.ObservePayloadSize to record them in external metrics), and optionally
+// enforces a byte budget on responses, to catch schemas which accidentally eager-load
+// megabytes.
+type PayloadSizes struct {
+	// MaxResponseBytes is the byte budget of responses. Responses which exceed it are
+	// handled according to [PayloadSizes.Action]. If 0, responses are only observed.
+	MaxResponseBytes int `json:",omitempty"`
+
+	// Action is what happens to responses which exceed [PayloadSizes.MaxResponseBytes].
+	// Defaults to [PayloadSizeWarn].
+	Action PayloadSizeAction `json:",omitempty"`
+
+	// Buckets are the upper bounds (in bytes, ascending) of the buckets of the payload
+	// size histograms. Defaults to powers of 4, from 1KiB to 16MiB.
+	Buckets []int `json:",omitempty"`
+}
+
+// validate validates the payload sizes config, and applies defaults.
+func (p *PayloadSizes) validate() error {
+	if p.MaxResponseBytes < 0 {
+		return errors.New("PayloadSizes.MaxResponseBytes must be >= 0")
+	}
+
+	if p.Action == "" {
+		p.Action = PayloadSizeWarn
+	}
+
+	if !slices.Contains(AllSupportedPayloadSizeActions, p.Action) {
+		return fmt.Errorf("unsupported payload size action provided: %s", p.Action)
+	}
+
+	if p.Action == PayloadSizeReject && p.MaxResponseBytes == 0 {
+		return errors.New("PayloadSizes.MaxResponseBytes is required when rejecting responses")
+	}
+
+	if len(p.Buckets) == 0 {
+		p.Buckets = defaultPayloadSizeBuckets
+	}
+
+	for i, b := range p.Buckets {
+		if b <= 0 || (i > 0 && b <= p.Buckets[i-1]) {
+			return errors.New("PayloadSizes.Buckets must be positive, and in ascending order")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_PayloadSizes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Parallel()
+
+		p := &PayloadSizes{}
+		require.NoError(t, p.validate())
+		assert.Equal(t, PayloadSizeWarn, p.Action)
+		assert.Equal(t, defaultPayloadSizeBuckets, p.Buckets)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			sizes    *PayloadSizes
+			contains string
+		}{
+			{&PayloadSizes{MaxResponseBytes: -1}, "PayloadSizes.MaxResponseBytes must be >= 0"},
+			{&PayloadSizes{Action: "truncate"}, "unsupported payload size action"},
+			{&PayloadSizes{Action: PayloadSizeReject}, "PayloadSizes.MaxResponseBytes is required"},
+			{&PayloadSizes{Buckets: []int{1024, 512}}, "ascending order"},
+			{&PayloadSizes{Buckets: []int{0, 512}}, "must be positive"},
+		} {
+			_, err := buildSpec(t, &Config{Handler: HandlerStdlib, PayloadSizes: tt.sizes})
+			require.ErrorContains(t, err, tt.contains)
+		}
+	})
+
+	t.Run("handler-none", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{Handler: HandlerNone, PayloadSizes: &PayloadSizes{}}
+		require.NoError(t, cfg.Validate())
+		assert.Nil(t, cfg.PayloadSizes)
+	})
+}
@@ -0,0 +1,86 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/ogen-go/ogen"
+)
+
+// RequestPolicy enables the request policy of the generated server (see the generated
+// Policy and ServerConfig.Policy), which resolves the pagination caps, rate limits and
+// tree traversal depths of each request (e.g. premium tenants get larger pages), and
+// declares the ranges which policies can grant, which are documented in the spec.
+type RequestPolicy struct {
+	// MaxItemsPerPage is the upper bound of the max number of items per page which
+	// policies can grant to list operations. Policies can always lower the max number
+	// of items per page of an operation, but can only raise it up to this bound.
+	// Defaults to [Config.MaxItemsPerPage].
+	MaxItemsPerPage int `json:",omitempty"`
+
+	// MaxTreeTraversalDepth is the upper bound of the max depth which policies can grant
+	// to tree traversal endpoints (see [WithTreeTraversal]). Policies can always lower
+	// the max depth of an endpoint, but can only raise it up to this bound. Defaults to
+	// the max depth of each endpoint.
+	MaxTreeTraversalDepth int `json:",omitempty"`
+
+	// RateLimit if set to true, will enable rate limits provided by policies, which are
+	// enforced by the generated server (rejecting requests which exceed them with a 429
+	// "Too Many Requests"). The rate limit headers (see [RateLimitHeaders]) are
+	// documented on all responses.
+	RateLimit bool `json:",omitempty"`
+}
+
+// validate validates the request policy, and applies defaults based on the provided
+// config.
+func (p *RequestPolicy) validate(cfg *Config) error {
+	if p.MaxItemsPerPage < 0 {
+		return errors.New("RequestPolicy.MaxItemsPerPage must be >= 0")
+	}
+
+	if p.MaxTreeTraversalDepth < 0 {
+		return errors.New("RequestPolicy.MaxTreeTraversalDepth must be >= 0")
+	}
+
+	if p.MaxItemsPerPage == 0 {
+		p.MaxItemsPerPage = cfg.MaxItemsPerPage
+	}
+
+	if p.RateLimit {
+		headers := ResponseHeaders{}
+		for k, v := range RateLimitHeaders {
+			// Not all requests are rate limited, depending on the policy.
+			h := *v
+			h.Required = false
+			headers[k] = &h
+		}
+
+		cfg.GlobalResponseHeaders = headers.Append(cfg.GlobalResponseHeaders)
+		cfg.GlobalErrorResponses = ErrorResponses{
+			http.StatusTooManyRequests: ErrorResponseObject(http.StatusTooManyRequests),
+		}.Append(cfg.GlobalErrorResponses)
+	}
+	return nil
+}
+
+// withPolicyRange updates the maximum of the schema of the provided parameter (the
+// page size of list operations, or the depth of tree traversal endpoints) to the
+// provided upper bound, which policies can grant, if it's larger than the default
+// maximum.
+func withPolicyRange(param *ogen.Parameter, defaultMax, maxValue int) {
+	if maxValue <= defaultMax {
+		return
+	}
+
+	param.Schema.SetMaximum(ptr(int64(maxValue)))
+	param.Description += fmt.Sprintf(
+		" Limited to %d by default, which may be raised up to %d depending on the caller.",
+		defaultMax,
+		maxValue,
+	)
+}
@@ -0,0 +1,69 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_RequestPolicy(t *testing.T) {
+	t.Parallel()
+
+	perPage := `$.paths./pets.get.parameters[?(@.name == "per_page")]`
+	depth := `$.paths./categories/{categoryID}/descendants.get.parameters[?(@.name == "depth")]`
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{RequestPolicy: &RequestPolicy{}})
+
+		assert.Equal(t, float64(100), r.json(perPage+`.schema.maximum`))
+		assert.Equal(t, "The number of entities to retrieve per page.", r.json(perPage+`.description`))
+		assert.Nil(t, r.json(`$.components.headers.X-Ratelimit-Limit`))
+	})
+
+	t.Run("ranges", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			RequestPolicy: &RequestPolicy{MaxItemsPerPage: 500, MaxTreeTraversalDepth: 20},
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Category.children", WithTreeTraversal(5))
+				return nil
+			},
+		})
+
+		assert.Equal(t, float64(500), r.json(perPage+`.schema.maximum`))
+		assert.Equal(t, float64(10), r.json(perPage+`.schema.default`))
+		assert.Contains(t, r.json(perPage+`.description`), "Limited to 100 by default, which may be raised up to 500")
+
+		assert.Equal(t, float64(20), r.json(depth+`.schema.maximum`))
+		assert.Equal(t, float64(5), r.json(depth+`.schema.default`))
+		assert.Contains(t, r.json(depth+`.description`), "Limited to 5 by default, which may be raised up to 20")
+	})
+
+	t.Run("rate-limit", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{RequestPolicy: &RequestPolicy{RateLimit: true}})
+
+		assert.NotNil(t, r.json(`$.paths./pets.get.responses.429`))
+		assert.NotNil(t, r.json(`$.components.headers.X-Ratelimit-Limit`))
+		assert.Nil(t, r.json(`$.components.headers.X-Ratelimit-Limit.required`))
+		assert.NotNil(t, r.json(`$.paths./pets.get.responses.200.headers.X-Ratelimit-Remaining`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildSpec(t, &Config{RequestPolicy: &RequestPolicy{MaxItemsPerPage: -1}})
+		require.ErrorContains(t, err, "RequestPolicy.MaxItemsPerPage")
+	})
+}
@@ -0,0 +1,67 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"net/http"
+)
+
+// Principal configures the type of the authenticated principal of requests (e.g. the
+// user or service account which a request is authenticated as), for which the generated
+// server provides typed context helpers (rest.Principal, rest.WithPrincipal and
+// rest.PrincipalFromContext), so the identity plumbing isn't reinvented per project.
+// The principal is resolved for each request by the generated
+// ServerConfig.Authenticate, before any other handling of the request, so it's
+// available to authorizers (e.g. ServerConfig.Policy, ServerConfig.FeatureGate and
+// ServerConfig.RecycleBinAccess), request hooks and interceptors (ServerConfig.Hooks
+// and ServerConfig.Interceptors), and is recorded as the actor of history entries (see
+// [Config.WithHistory]).
+type Principal struct {
+	// Type is the Go type of the principal, qualified by the name of its package, e.g.
+	// "*auth.User", or a predeclared type, e.g. "string".
+	Type string `json:",omitempty"`
+
+	// PkgPath is the import path of the package which declares [Principal.Type], e.g.
+	// "github.com/example/app/auth". Not required for predeclared types.
+	PkgPath string `json:",omitempty"`
+}
+
+// validate validates the principal, and documents the error returned for requests
+// which fail to authenticate on all operations.
+func (p *Principal) validate(cfg *Config) error {
+	if p.Type == "" {
+		return errors.New("Principal.Type is required")
+	}
+
+	expr, err := parser.ParseExpr(p.Type)
+	if err != nil {
+		return fmt.Errorf("Principal.Type %q is not a valid Go type: %w", p.Type, err)
+	}
+
+	var qualified bool
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if _, ok := n.(*ast.SelectorExpr); ok {
+			qualified = true
+		}
+		return !qualified
+	})
+
+	if qualified && p.PkgPath == "" {
+		return fmt.Errorf("Principal.PkgPath is required, as Principal.Type %q is qualified by a package", p.Type)
+	}
+
+	if !qualified && p.PkgPath != "" {
+		return fmt.Errorf("Principal.Type %q must be qualified by the name of the package of Principal.PkgPath %q", p.Type, p.PkgPath)
+	}
+
+	cfg.GlobalErrorResponses = ErrorResponses{
+		http.StatusUnauthorized: ErrorResponseObject(http.StatusUnauthorized),
+	}.Append(cfg.GlobalErrorResponses)
+	return nil
+}
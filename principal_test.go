@@ -0,0 +1,81 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"net/http"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_Principal(t *testing.T) {
+	t.Parallel()
+
+	t.Run("unauthorized", func(t *testing.T) {
+		t.Parallel()
+
+		errs := ErrorResponses{http.StatusInternalServerError: ErrorResponseObject(http.StatusInternalServerError)}
+
+		r := mustBuildSpec(t, &Config{GlobalErrorResponses: errs})
+		assert.Nil(t, r.json(`$.paths./pets.get.responses.401`))
+
+		r = mustBuildSpec(t, &Config{
+			Principal:            &Principal{Type: "*auth.User", PkgPath: "example.com/app/auth"},
+			GlobalErrorResponses: errs,
+		})
+		assert.NotNil(t, r.json(`$.paths./pets.get.responses.401`))
+		assert.NotNil(t, r.json(`$.paths./pets/{petID}.delete.responses.401`))
+	})
+
+	t.Run("history-actor", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			Handler:     HandlerStdlib,
+			WithHistory: true,
+			Principal:   &Principal{Type: "string"},
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", Annotation{History: true})
+				return nil
+			},
+		})
+
+		var actor *gen.Field
+		for _, n := range r.graph.Nodes {
+			if n.Name != HistorySchemaName {
+				continue
+			}
+			for _, f := range n.Fields {
+				if f.Name == "actor" {
+					actor = f
+				}
+			}
+		}
+		require.NotNil(t, actor)
+		assert.True(t, actor.Optional)
+		assert.Equal(t, true, r.json(`$.components.schemas.HistoryEntry.properties.actor.nullable`))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			principal *Principal
+			contains  string
+		}{
+			{&Principal{}, "Principal.Type is required"},
+			{&Principal{Type: "*auth."}, "is not a valid Go type"},
+			{&Principal{Type: "*auth.User"}, "Principal.PkgPath is required"},
+			{&Principal{Type: "*User", PkgPath: "example.com/app/auth"}, "must be qualified"},
+		} {
+			_, err := buildSpec(t, &Config{Principal: tt.principal})
+			require.ErrorContains(t, err, tt.contains)
+		}
+	})
+}
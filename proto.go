@@ -0,0 +1,329 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+// Names of the annotations of entproto (entgo.io/contrib/entproto), which are read
+// (without depending on entproto) when [Config.ProtoInterop] is enabled.
+const (
+	protoMessageAnnotation = "ProtoMessage"
+	protoFieldAnnotation   = "ProtoField"
+	protoEnumAnnotation    = "ProtoEnum"
+)
+
+// protoMessage is the subset of the entproto message annotation used by entrest.
+type protoMessage struct {
+	Generate bool
+	Package  string
+}
+
+// protoField is the subset of the entproto field annotation used by entrest.
+type protoField struct {
+	Number int
+}
+
+// protoEnum is the subset of the entproto enum annotation used by entrest.
+type protoEnum struct {
+	Options         map[string]int32
+	OmitFieldPrefix bool
+}
+
+// getProtoMessage returns the entproto message annotation of the provided type, or nil
+// if entproto doesn't generate a message for the type.
+func getProtoMessage(t *gen.Type) *protoMessage {
+	var m protoMessage
+	if !decodeExternalAnnotation(t.Annotations, protoMessageAnnotation, &m) || !m.Generate {
+		return nil
+	}
+	return &m
+}
+
+// getProtoEnum returns the entproto enum annotation of the provided field, or nil if
+// not set.
+func getProtoEnum(f *gen.Field) *protoEnum {
+	var e protoEnum
+	if !f.IsEnum() || !decodeExternalAnnotation(f.Annotations, protoEnumAnnotation, &e) {
+		return nil
+	}
+	return &e
+}
+
+// getProtoFieldNumber returns the protobuf field number of the provided entproto
+// field annotations, or 0 if not set.
+func getProtoFieldNumber(annotations map[string]any) int {
+	var f protoField
+	decodeExternalAnnotation(annotations, protoFieldAnnotation, &f)
+	return f.Number
+}
+
+// ProtoJSONName returns the JSON name which protojson uses for the provided protobuf
+// field name (i.e. lowerCamelCase, the same as protoc's "json_name"), e.g. "created_at"
+// returns "createdAt".
+func ProtoJSONName(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// ProtoEnumValueName returns the name of the protobuf enum value which entproto
+// generates for the provided value of the provided enum field, e.g. "STATUS_ACTIVE".
+func ProtoEnumValueName(f *gen.Field, value string) string {
+	name := strings.Map(func(r rune) rune {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, value)
+
+	if e := getProtoEnum(f); e != nil && e.OmitFieldPrefix {
+		return name
+	}
+	return strings.ToUpper(SnakeCase(f.Name)) + "_" + name
+}
+
+// ProtoMapping is the mapping between the REST API and the protobuf messages generated
+// by entproto from the same graph (see [Config.ProtoInterop]).
+type ProtoMapping struct {
+	Messages []*ProtoMessageMapping `json:"messages"`
+}
+
+// ProtoMessageMapping is the mapping of a single schema and its protobuf message.
+type ProtoMessageMapping struct {
+	// Entity is the name of the ent schema, e.g. "Pet".
+	Entity string `json:"entity"`
+	// Schema is the name of the OpenAPI component schema, e.g. "Pet".
+	Schema string `json:"schema"`
+	// Message is the fully-qualified name of the protobuf message, e.g. "entpb.Pet".
+	Message string `json:"message"`
+	// Fields are the fields (and edges) of the message.
+	Fields []*ProtoFieldMapping `json:"fields"`
+}
+
+// ProtoFieldMapping is the mapping of a single field (or edge) and its protobuf field.
+type ProtoFieldMapping struct {
+	// Name is the name of the field or edge in the ent schema, e.g. "created_at".
+	Name string `json:"name"`
+	// JSON is the name of the property in REST request and response bodies.
+	JSON string `json:"json"`
+	// ProtoJSON is the name of the field in protojson output, e.g. "createdAt".
+	ProtoJSON string `json:"proto_json"`
+	// Number is the protobuf field number, or 0 if entproto has no field annotation.
+	Number int `json:"number,omitempty"`
+	// Edge is true if the field is an edge, which the REST API returns within the
+	// "edges" property of the entity.
+	Edge bool `json:"edge,omitempty"`
+	// Int64AsString is true if protojson encodes the field as a string (64-bit
+	// integers), where the REST API encodes it as a number.
+	Int64AsString bool `json:"int64_as_string,omitempty"`
+	// Enum is the mapping of the values of enum fields.
+	Enum []*ProtoEnumMapping `json:"enum,omitempty"`
+}
+
+// ProtoEnumMapping is the mapping of a single enum value and its protobuf enum value.
+type ProtoEnumMapping struct {
+	// Value is the value in the REST API, e.g. "active".
+	Value string `json:"value"`
+	// Name is the name of the protobuf enum value, e.g. "STATUS_ACTIVE".
+	Name string `json:"name"`
+	// Number is the number of the protobuf enum value, e.g. 1.
+	Number int32 `json:"number"`
+}
+
+// GetProtoMapping returns the mapping between the REST API and the protobuf messages
+// generated by entproto, for all provided types which aren't skipped, and have an
+// entproto message.
+func GetProtoMapping(nodes []*gen.Type) *ProtoMapping {
+	mapping := &ProtoMapping{}
+
+	for _, t := range nodes {
+		cfg := GetConfig(t.Config)
+		msg := getProtoMessage(t)
+
+		if msg == nil || GetAnnotation(t).GetSkip(cfg) {
+			continue
+		}
+
+		mm := &ProtoMessageMapping{
+			Entity:  t.Name,
+			Schema:  GetSchemaName(t),
+			Message: cmp.Or(msg.Package, "entpb") + "." + t.Name,
+		}
+
+		if t.ID != nil {
+			mm.Fields = append(mm.Fields, &ProtoFieldMapping{
+				Name:          t.ID.Name,
+				JSON:          GetFieldName(t, t.ID),
+				ProtoJSON:     ProtoJSONName(t.ID.Name),
+				Number:        cmp.Or(getProtoFieldNumber(t.ID.Annotations), 1),
+				Int64AsString: isProtoInt64(t.ID),
+			})
+		}
+
+		for _, f := range t.Fields {
+			if f.Sensitive() || GetAnnotation(f).GetSkip(cfg) || !GetAnnotation(f).GetReadable() {
+				continue
+			}
+
+			fm := &ProtoFieldMapping{
+				Name:          f.Name,
+				JSON:          GetFieldName(t, f),
+				ProtoJSON:     ProtoJSONName(f.Name),
+				Number:        getProtoFieldNumber(f.Annotations),
+				Int64AsString: isProtoInt64(f),
+			}
+
+			if e := getProtoEnum(f); e != nil {
+				for _, v := range f.EnumValues() {
+					fm.Enum = append(fm.Enum, &ProtoEnumMapping{
+						Value:  v,
+						Name:   ProtoEnumValueName(f, v),
+						Number: e.Options[v],
+					})
+				}
+			}
+			mm.Fields = append(mm.Fields, fm)
+		}
+
+		for _, e := range t.Edges {
+			if GetAnnotation(e).GetSkip(cfg) || getProtoMessage(e.Type) == nil {
+				continue
+			}
+
+			mm.Fields = append(mm.Fields, &ProtoFieldMapping{
+				Name:      e.Name,
+				JSON:      GetEdgeName(t, e, ""),
+				ProtoJSON: ProtoJSONName(e.Name),
+				Number:    getProtoFieldNumber(e.Annotations),
+				Edge:      true,
+			})
+		}
+
+		mapping.Messages = append(mapping.Messages, mm)
+	}
+
+	return mapping
+}
+
+// isProtoInt64 returns true if the provided field is mapped to a 64-bit integer by
+// entproto, which protojson encodes as a string.
+func isProtoInt64(f *gen.Field) bool {
+	if f.IsEnum() {
+		return false
+	}
+	return slices.Contains([]string{"int", "int64", "uint", "uint64"}, f.Type.Type.String())
+}
+
+// GenerateProtoMapping writes the mapping between the REST API and the protobuf
+// messages generated by entproto (see [GetProtoMapping]) to w, as JSON.
+func GenerateProtoMapping(nodes []*gen.Type, w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "    ")
+	enc.SetEscapeHTML(false)
+	return enc.Encode(GetProtoMapping(nodes))
+}
+
+// addProtoEnumNames adds the names of the protobuf enum values generated by entproto
+// (see [ProtoEnumValueName]) to the provided enum schema of the provided field, through
+// the "x-enum-varnames" extension, which is used by most client generators for the
+// names of enum constants (see [Config.ProtoInterop]).
+func addProtoEnumNames(t *gen.Type, f *gen.Field, schema *ogen.Schema) {
+	if schema == nil || !hasConfig(t.Config) || !GetConfig(t.Config).ProtoInterop || getProtoEnum(f) == nil {
+		return
+	}
+
+	names := make([]string, 0, len(f.EnumValues()))
+	for _, v := range f.EnumValues() {
+		names = append(names, ProtoEnumValueName(f, v))
+	}
+
+	var node yaml.Node
+	if err := node.Encode(names); err != nil {
+		panic(fmt.Sprintf("failed to encode enum names of field %s: %v", f.StructField(), err))
+	}
+
+	if schema.Common.Extensions == nil {
+		schema.Common.Extensions = ogen.Extensions{}
+	}
+	schema.Common.Extensions["x-enum-varnames"] = node
+}
+
+// validateProtoInterop checks that the REST API of the provided type doesn't diverge
+// from the protobuf message generated by entproto (see [Config.ProtoInterop]), i.e.
+// that the JSON names of all fields and edges match protojson, all enum values have a
+// protobuf enum value, and integer enums (see [WithIntEnum]) use the same numbers.
+func validateProtoInterop(cfg *Config, t *gen.Type) (errs []error) {
+	if !cfg.ProtoInterop || getProtoMessage(t) == nil || GetAnnotation(t).GetSkip(cfg) {
+		return nil
+	}
+
+	for _, fm := range GetProtoMapping([]*gen.Type{t}).Messages[0].Fields {
+		if fm.JSON == fm.ProtoJSON {
+			continue
+		}
+
+		err := &AnnotationError{
+			Schema: t.Name,
+			Err:    fmt.Errorf("JSON name %q doesn't match the protojson name %q", fm.JSON, fm.ProtoJSON),
+		}
+		if fm.Edge {
+			err.Edge = fm.Name
+		} else {
+			err.Field = fm.Name
+		}
+		errs = append(errs, err)
+	}
+
+	for _, f := range t.Fields {
+		e := getProtoEnum(f)
+		if e == nil || GetAnnotation(f).GetSkip(cfg) {
+			continue
+		}
+
+		fa := GetAnnotation(f)
+		for _, v := range f.EnumValues() {
+			n, ok := e.Options[v]
+			switch {
+			case !ok:
+				errs = append(errs, &AnnotationError{
+					Schema: t.Name,
+					Field:  f.Name,
+					Err:    fmt.Errorf("enum value %q has no protobuf enum option", v),
+				})
+			case HasIntEnumValues(f) && fa.IntEnumValues[v] != int(n):
+				errs = append(errs, &AnnotationError{
+					Schema: t.Name,
+					Field:  f.Name,
+					Err:    fmt.Errorf("integer value %d of enum value %q doesn't match the protobuf enum number %d", fa.IntEnumValues[v], v, n),
+				})
+			}
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,108 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// injectProtoAnnotations injects entproto annotations (as loaded from a schema) into
+// the User schema of the provided graph.
+func injectProtoAnnotations(g *gen.Graph, options map[string]any) {
+	for _, n := range g.Nodes {
+		if n.Name != "User" {
+			continue
+		}
+
+		n.Annotations.Set(protoMessageAnnotation, map[string]any{"Generate": true, "Package": "entpb"})
+		for i, f := range n.Fields {
+			f.Annotations.Set(protoFieldAnnotation, map[string]any{"Number": i + 2})
+			if f.Name == "type" {
+				f.Annotations.Set(protoEnumAnnotation, map[string]any{"Options": options})
+			}
+		}
+	}
+}
+
+func TestProtoInterop(t *testing.T) {
+	t.Parallel()
+
+	t.Run("mapping", func(t *testing.T) {
+		t.Parallel()
+
+		buf := &bytes.Buffer{}
+		r := mustBuildSpec(t, &Config{
+			ProtoInterop:       true,
+			ProtoMappingWriter: buf,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectProtoAnnotations(g, map[string]any{"SYSTEM": 1, "USER": 2})
+				return nil
+			},
+		})
+
+		assert.Equal(t, FieldNameStyleCamel, r.config.FieldNameStyle)
+		assert.Equal(t, []any{"TYPE_SYSTEM", "TYPE_USER"}, r.json(`$.components.schemas.UserTypeEnum['x-enum-varnames']`))
+		assert.NotNil(t, r.json(`$.components.schemas.User.properties.createdAt`))
+
+		var mapping ProtoMapping
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &mapping))
+		require.Len(t, mapping.Messages, 1)
+
+		msg := mapping.Messages[0]
+		assert.Equal(t, "entpb.User", msg.Message)
+		assert.Equal(t, &ProtoFieldMapping{Name: "id", JSON: "id", ProtoJSON: "id", Number: 1, Int64AsString: true}, msg.Fields[0])
+
+		for _, f := range msg.Fields {
+			assert.Equal(t, f.ProtoJSON, f.JSON, f.Name)
+			if f.Name == "type" {
+				assert.Equal(t, []*ProtoEnumMapping{
+					{Value: "SYSTEM", Name: "TYPE_SYSTEM", Number: 1},
+					{Value: "USER", Name: "TYPE_USER", Number: 2},
+				}, f.Enum)
+			}
+		}
+	})
+
+	t.Run("missing-enum-option", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildSpec(t, &Config{
+			ProtoInterop: true,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectProtoAnnotations(g, map[string]any{"SYSTEM": 1})
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+		require.Error(t, err)
+
+		var aerr *AnnotationError
+		require.ErrorAs(t, err, &aerr)
+		assert.Equal(t, "schema User field type", aerr.Location())
+		assert.Contains(t, err.Error(), `enum value "USER" has no protobuf enum option`)
+	})
+
+	t.Run("field-name-style", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildSpec(t, &Config{ProtoInterop: true, FieldNameStyle: FieldNameStyleSnake})
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires the camel field name style")
+	})
+}
+
+func TestProtoJSONName(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "createdAt", ProtoJSONName("created_at"))
+	assert.Equal(t, "address2", ProtoJSONName("address_2"))
+	assert.Equal(t, "id", ProtoJSONName("id"))
+}
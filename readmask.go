@@ -0,0 +1,126 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// readMaskParam is the name of the query parameter of read masks (see [Config.ReadMask]).
+const readMaskParam = "read_mask"
+
+// getReadMaskFields returns the fields of the provided type (including the ID) which
+// are returned in responses, and can be selected through read masks (see
+// [Config.ReadMask]). The currency fields of money objects (see [WithMoney]) are
+// selected through their amount field.
+func getReadMaskFields(cfg *Config, t *gen.Type) (fields []*gen.Field) {
+	if t.ID != nil {
+		fields = append(fields, t.ID)
+	}
+
+	for _, f := range t.Fields {
+		if f.Sensitive() || GetAnnotation(f).GetSkip(cfg) || !GetAnnotation(f).GetReadable() || isMoneyCurrencyField(t, f) {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// getReadMaskEdges returns the edges of the provided type which are eager-loaded, and
+// can be selected through read masks (see [Config.ReadMask]).
+func getReadMaskEdges(cfg *Config, t *gen.Type) (edges []*gen.Edge) {
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+		if ea.GetSkip(cfg) || !ea.GetEagerLoad(cfg) || GetAnnotation(e.Type).GetSkip(cfg) {
+			continue
+		}
+		edges = append(edges, e)
+	}
+	return edges
+}
+
+// getReadMaskPaths returns the paths which can be selected through read masks (see
+// [Config.ReadMask]) on responses of the provided type, including the fields of its
+// eager-loaded edges (e.g. "owner.name").
+func getReadMaskPaths(cfg *Config, t *gen.Type) (paths []string) {
+	for _, f := range getReadMaskFields(cfg, t) {
+		paths = append(paths, GetFieldName(t, f))
+	}
+
+	for _, cf := range GetComputedFields(t) {
+		paths = append(paths, getComputedFieldName(t, cf))
+	}
+
+	for _, e := range getReadMaskEdges(cfg, t) {
+		name := GetEdgeName(t, e, "")
+		paths = append(paths, name)
+
+		for _, f := range getReadMaskFields(cfg, e.Type) {
+			paths = append(paths, name+"."+GetFieldName(e.Type, f))
+		}
+	}
+	return paths
+}
+
+// wrapReadMask wraps the provided handler (Go expression) of a read or list operation
+// which returns the provided type, parsing and validating the read mask of requests
+// (see [Config.ReadMask]).
+func wrapReadMask(cfg *Config, t *gen.Type, op Operation, handler string) string {
+	if !cfg.ReadMask {
+		return handler
+	}
+	return fmt.Sprintf("withReadMask(s, Operation%s, %q, %s)", PascalCase(string(op)), t.Name, handler)
+}
+
+// wrapReadMaskQuery wraps the provided query (Go expression) of the provided type,
+// only selecting the fields of the read mask of the request (see [Config.ReadMask]).
+func wrapReadMaskQuery(cfg *Config, t *gen.Type, query string) string {
+	if !cfg.ReadMask || t.ID == nil {
+		return query
+	}
+	return fmt.Sprintf("readMask%sQuery(r.Context(), %s)", t.Name, query)
+}
+
+// addReadMaskParam adds the read mask query parameter (see [Config.ReadMask]) to all
+// GET operations within the provided spec (and the list operation of the type, which
+// may use a different method, see [WithOperationMethod]), which return the provided
+// type.
+func addReadMaskParam(cfg *Config, spec *ogen.Spec, t *gen.Type) {
+	if !cfg.ReadMask {
+		return
+	}
+
+	paths := getReadMaskPaths(cfg, t)
+	for i := range paths {
+		paths[i] = "`" + paths[i] + "`"
+	}
+
+	listID := GetOperationIDName(OperationList, t, nil)
+
+	for pathName, pathItem := range spec.Paths {
+		spec.Paths[pathName] = PatchOperations(pathItem, func(method string, op *ogen.Operation) *ogen.Operation {
+			if op == nil || (method != http.MethodGet && op.OperationID != listID) {
+				return op
+			}
+
+			op.Parameters = append(op.Parameters, &ogen.Parameter{
+				Name: readMaskParam,
+				In:   "query",
+				Description: fmt.Sprintf(
+					"Comma-separated list of paths (field mask, see AIP-161) to return, where nested fields of eager-loaded edges are separated by a dot, e.g. `owner.name`. Returns all fields if not provided (or `*`). Supported paths: %s.",
+					strings.Join(paths, ", "),
+				),
+				Schema: ogen.String(),
+			})
+			return op
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"slices"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_ReadMask(t *testing.T) {
+	t.Parallel()
+
+	readMask := func(op *ogen.Operation) *ogen.Parameter {
+		idx := slices.IndexFunc(op.Parameters, func(p *ogen.Parameter) bool {
+			return p.Name == readMaskParam && p.In == "query"
+		})
+		if idx == -1 {
+			return nil
+		}
+		return op.Parameters[idx]
+	}
+
+	t.Run("enabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			ReadMask: true,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet.owner", WithEagerLoad(true))
+				return nil
+			},
+		})
+
+		for _, path := range []string{"/pets", "/pets/{petID}", "/pets/{petID}/owner", "/users/{userID}/pets"} {
+			op := r.spec.Paths[path].Get
+			require.NotNil(t, op, path)
+			assert.NotNil(t, readMask(op), path)
+		}
+
+		param := readMask(r.spec.Paths["/pets"].Get)
+		require.NotNil(t, param)
+		assert.Contains(t, param.Description, "`name`")
+		assert.Contains(t, param.Description, "`owner`")
+		assert.Contains(t, param.Description, "`owner.name`")
+
+		// Only read and list operations support read masks.
+		assert.Nil(t, readMask(r.spec.Paths["/pets"].Post))
+		assert.Nil(t, readMask(r.spec.Paths["/pets/{petID}"].Patch))
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+		assert.Nil(t, readMask(r.spec.Paths["/pets"].Get))
+	})
+}
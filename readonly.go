@@ -0,0 +1,59 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// ReadOnlyOperations are the only operations supported on read-only schemas (see
+// [IsReadOnly]).
+var ReadOnlyOperations = []Operation{OperationRead, OperationList}
+
+// IsReadOnly returns true if the provided type is a read-only projection, either
+// because it's an ent view, or because it was annotated with [WithReadOnly]. Read-only
+// schemas only support read and list operations, don't have any create/update schemas
+// or params generated, and use a distinct component name for reads (see
+// [GetReadSchemaName]).
+func IsReadOnly(t *gen.Type) bool {
+	return t.IsView() || GetAnnotation(t).ReadOnly
+}
+
+// GetReadSchemaName returns the name of the component schema used when reading a single
+// entity of the provided type (e.g. "PetRead"). Read-only schemas (see [IsReadOnly])
+// use a "View" suffix instead (e.g. "PetStatsView").
+func GetReadSchemaName(t *gen.Type) string {
+	if IsReadOnly(t) {
+		return GetSchemaName(t) + "View"
+	}
+	return GetSchemaName(t) + "Read"
+}
+
+// applyReadOnlySchemas restricts the operations of all read-only schemas (see
+// [IsReadOnly]) which don't explicitly set operations, to the default operations
+// which are also in [ReadOnlyOperations]. If none remain, the schema is skipped, as
+// if [WithSkip] was provided. Explicitly provided mutation operations are left as-is,
+// and reported during validation.
+func applyReadOnlySchemas(cfg *Config, g *gen.Graph) {
+	for _, t := range g.Nodes {
+		if !IsReadOnly(t) || GetAnnotation(t).Operations != nil {
+			continue
+		}
+
+		var ops []Operation
+		for _, op := range cfg.DefaultOperations {
+			if slices.Contains(ReadOnlyOperations, op) {
+				ops = append(ops, op)
+			}
+		}
+
+		t.Annotations = withAnnotation(t.Annotations, func(a *Annotation) {
+			a.Operations = ops
+			a.Skip = a.Skip || len(ops) == 0
+		})
+	}
+}
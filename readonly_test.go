@@ -0,0 +1,67 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"net/http"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_ReadOnlySchema(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.NotNil(t, r.json(`$.components.schemas.CategoryRead`))
+		assert.Nil(t, r.json(`$.components.schemas.CategoryView`))
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Category", WithReadOnly(true))
+				return nil
+			},
+		})
+
+		assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/categories"))
+		assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/categories/{categoryID}"))
+
+		assert.NotNil(t, r.json(`$.components.schemas.CategoryView`))
+		assert.Nil(t, r.json(`$.components.schemas.CategoryRead`))
+		assert.Nil(t, r.json(`$.components.schemas.CategoryCreate`))
+		assert.Nil(t, r.json(`$.components.schemas.CategoryUpdate`))
+
+		assert.Contains(t, r.json(`$.paths./categories/{categoryID}.get.responses.200.content.application/json.schema.$ref`), "/CategoryView")
+		assert.Contains(t, r.json(`$.components.schemas.CategoryList.allOf[1].properties.content.items.$ref`), "/CategoryView")
+
+		// Edges to read-only schemas can still be set from other schemas.
+		assert.NotNil(t, r.json(`$.components.schemas.PetCreate.properties.categories`))
+	})
+
+	t.Run("restricted-default-operations", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			DefaultOperations: []Operation{OperationCreate, OperationList},
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Category", WithReadOnly(true))
+				return nil
+			},
+		})
+
+		assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/categories"))
+		assert.Nil(t, r.json(`$.paths./categories/{categoryID}`))
+	})
+}
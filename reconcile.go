@@ -0,0 +1,288 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+const (
+	// ReconcileManualExtension is the extension which marks operations and component
+	// schemas of a reconciled spec (see [Config.ReconcileSpecPath]) as manually
+	// maintained, so they are never replaced or removed by generated ones.
+	ReconcileManualExtension = "x-entrest-manual"
+
+	// ReconcileGeneratedExtension is the top-level extension of a reconciled spec (see
+	// [Config.ReconcileSpecPath]), which records the operations and components that
+	// were generated, so they can be removed once they are no longer generated.
+	ReconcileGeneratedExtension = "x-entrest-generated"
+)
+
+// reconcileLedger is the value of [ReconcileGeneratedExtension].
+type reconcileLedger struct {
+	// Operations are the generated operations, e.g. "GET /pets".
+	Operations []string `yaml:"operations,omitempty"`
+
+	// Components are the generated components (and webhooks), e.g. "schemas/Pet".
+	Components []string `yaml:"components,omitempty"`
+}
+
+// isReconcileManual returns true if the provided extensions mark the object as manually
+// maintained (see [ReconcileManualExtension]).
+func isReconcileManual(exts ogen.Extensions) bool {
+	node, ok := exts[ReconcileManualExtension]
+	if !ok {
+		return false
+	}
+
+	var v bool
+	return node.Decode(&v) == nil && v
+}
+
+// loadReconcileSpec reads the spec (JSON or YAML) to reconcile generated paths and
+// components into (see [Config.ReconcileSpecPath]).
+func loadReconcileSpec(fn string) (*ogen.Spec, error) {
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec to reconcile from path %q: %w", fn, err)
+	}
+
+	// Decoded as YAML (which JSON is a subset of), as unlike JSON, it retains the
+	// extensions, which include the reconcile markers.
+	spec, err := ogen.Parse(b)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode spec to reconcile from path %q: %w", fn, err)
+	}
+	return spec, nil
+}
+
+// ReconcileSpec reconciles the generated spec into an existing (hand-written) spec,
+// which is modified in-place:
+//
+//   - Operations and component schemas marked with [ReconcileManualExtension] are
+//     always kept as-is.
+//   - Operations and components which were previously generated (as recorded by
+//     [ReconcileGeneratedExtension]), but no longer are, are removed.
+//   - Generated operations and components are added, replacing any existing ones
+//     which aren't manually maintained.
+//   - Everything else (info, servers, security, tags, other operations and components,
+//     etc) is kept, with generated servers and tags added if missing.
+//
+// The operations and components which were generated are recorded using
+// [ReconcileGeneratedExtension], for the next time the spec is reconciled.
+func ReconcileSpec(existing, generated *ogen.Spec) error {
+	var previous reconcileLedger
+	if node, ok := existing.Extensions[ReconcileGeneratedExtension]; ok {
+		if err := node.Decode(&previous); err != nil {
+			return fmt.Errorf("failed to decode %q extension: %w", ReconcileGeneratedExtension, err)
+		}
+	}
+
+	ledger := &reconcileLedger{}
+
+	existing.OpenAPI = cmp.Or(existing.OpenAPI, generated.OpenAPI)
+	if existing.Info.Title == "" {
+		existing.Info = generated.Info
+	}
+	if existing.Security == nil {
+		existing.Security = generated.Security
+	}
+	if existing.ExternalDocs == nil {
+		existing.ExternalDocs = generated.ExternalDocs
+	}
+
+	existing.Servers = appendCompactFunc(existing.Servers, generated.Servers, func(oldServer, newServer ogen.Server) bool {
+		return oldServer.URL == newServer.URL
+	})
+	existing.Tags = appendCompactFunc(existing.Tags, generated.Tags, func(oldTag, newTag ogen.Tag) bool {
+		return oldTag.Name == newTag.Name
+	})
+
+	if existing.Paths == nil {
+		existing.Paths = ogen.Paths{}
+	}
+	reconcilePaths(existing.Paths, generated.Paths, previous.Operations, ledger)
+
+	existing.Webhooks = reconcileMap("webhooks", existing.Webhooks, generated.Webhooks, previous.Components, nil, ledger)
+
+	if existing.Components == nil {
+		existing.Components = &ogen.Components{}
+	}
+
+	gc := generated.Components
+	if gc == nil {
+		gc = &ogen.Components{}
+	}
+
+	ec := existing.Components
+	ec.Schemas = reconcileMap("schemas", ec.Schemas, gc.Schemas, previous.Components, func(s *ogen.Schema) bool {
+		return s != nil && isReconcileManual(s.Common.Extensions)
+	}, ledger)
+	ec.Responses = reconcileMap("responses", ec.Responses, gc.Responses, previous.Components, nil, ledger)
+	ec.Parameters = reconcileMap("parameters", ec.Parameters, gc.Parameters, previous.Components, nil, ledger)
+	ec.Examples = reconcileMap("examples", ec.Examples, gc.Examples, previous.Components, nil, ledger)
+	ec.RequestBodies = reconcileMap("requestBodies", ec.RequestBodies, gc.RequestBodies, previous.Components, nil, ledger)
+	ec.Headers = reconcileMap("headers", ec.Headers, gc.Headers, previous.Components, nil, ledger)
+	ec.SecuritySchemes = reconcileMap("securitySchemes", ec.SecuritySchemes, gc.SecuritySchemes, previous.Components, nil, ledger)
+	ec.Links = reconcileMap("links", ec.Links, gc.Links, previous.Components, nil, ledger)
+	ec.Callbacks = reconcileMap("callbacks", ec.Callbacks, gc.Callbacks, previous.Components, nil, ledger)
+	ec.PathItems = reconcileMap("pathItems", ec.PathItems, gc.PathItems, previous.Components, nil, ledger)
+
+	if existing.Extensions == nil {
+		existing.Extensions = ogen.Extensions{}
+	}
+	for k, v := range generated.Extensions {
+		existing.Extensions[k] = v
+	}
+
+	slices.Sort(ledger.Operations)
+	slices.Sort(ledger.Components)
+
+	var node yaml.Node
+	if err := node.Encode(ledger); err != nil {
+		return fmt.Errorf("failed to encode %q extension: %w", ReconcileGeneratedExtension, err)
+	}
+	existing.Extensions[ReconcileGeneratedExtension] = node
+	return nil
+}
+
+// reconcilePaths reconciles the generated operations into the existing paths, at the
+// operation level. See [ReconcileSpec] for more information.
+func reconcilePaths(existing, generated ogen.Paths, previous []string, ledger *reconcileLedger) {
+	for _, pathName := range mapKeys(existing) {
+		item := existing[pathName]
+		if item == nil {
+			continue
+		}
+
+		PatchOperations(item, func(method string, op *ogen.Operation) *ogen.Operation {
+			if op == nil || isReconcileManual(op.Common.Extensions) {
+				return op
+			}
+			if slices.Contains(previous, method+" "+pathName) {
+				return nil
+			}
+			return op
+		})
+
+		if item.Ref == "" && !hasOperations(item) {
+			delete(existing, pathName)
+		}
+	}
+
+	for _, pathName := range mapKeys(generated) {
+		item := generated[pathName]
+		if item == nil {
+			continue
+		}
+
+		orig, ok := existing[pathName]
+		if !ok {
+			existing[pathName] = &ogen.PathItem{
+				Summary:     item.Summary,
+				Description: item.Description,
+				Servers:     item.Servers,
+				Parameters:  item.Parameters,
+			}
+			orig = existing[pathName]
+		} else {
+			orig.Parameters = appendCompactFunc(orig.Parameters, item.Parameters, func(oldParam, newParam *ogen.Parameter) bool {
+				return oldParam.Name == newParam.Name && oldParam.Ref == newParam.Ref
+			})
+		}
+
+		PatchOperations(item, func(method string, op *ogen.Operation) *ogen.Operation {
+			if op == nil {
+				return op
+			}
+
+			PatchOperations(orig, func(m string, oldOp *ogen.Operation) *ogen.Operation {
+				if m != method || (oldOp != nil && isReconcileManual(oldOp.Common.Extensions)) {
+					return oldOp
+				}
+				ledger.Operations = append(ledger.Operations, method+" "+pathName)
+				return op
+			})
+			return op
+		})
+	}
+}
+
+// hasOperations returns true if the provided path item has at least one operation.
+func hasOperations(item *ogen.PathItem) (found bool) {
+	PatchOperations(item, func(_ string, op *ogen.Operation) *ogen.Operation {
+		found = found || op != nil
+		return op
+	})
+	return found
+}
+
+// reconcileMap reconciles the generated values into the existing map (which is returned,
+// as it may need to be allocated), where section is the name of the map within the
+// spec (e.g. "schemas"). If provided, manual returns true for values which are manually
+// maintained. See [ReconcileSpec] for more information.
+func reconcileMap[V any](
+	section string,
+	existing, generated map[string]V,
+	previous []string,
+	manual func(v V) bool,
+	ledger *reconcileLedger,
+) map[string]V {
+	isManual := func(k string) bool {
+		v, ok := existing[k]
+		return ok && manual != nil && manual(v)
+	}
+
+	for _, k := range mapKeys(existing) {
+		if !isManual(k) && slices.Contains(previous, section+"/"+k) {
+			delete(existing, k)
+		}
+	}
+
+	for _, k := range mapKeys(generated) {
+		if isManual(k) {
+			continue
+		}
+
+		if existing == nil {
+			existing = make(map[string]V, len(generated))
+		}
+		existing[k] = generated[k]
+		ledger.Components = append(ledger.Components, section+"/"+k)
+	}
+	return existing
+}
+
+// writeReconcileSpec writes the reconciled spec back to the path it was read from (see
+// [Config.ReconcileSpecPath]), as YAML if the path has a ".yaml" or ".yml" extension,
+// otherwise as JSON.
+func writeReconcileSpec(fn string, spec *ogen.Spec) error {
+	var b []byte
+	var err error
+
+	switch strings.ToLower(filepath.Ext(fn)) {
+	case ".yaml", ".yml":
+		b, err = MarshalSpecYAML(spec)
+	default:
+		b, err = MarshalSpec(spec)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to marshal reconciled spec: %w", err)
+	}
+
+	err = os.WriteFile(fn, b, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to write reconciled spec to path %q: %w", fn, err)
+	}
+	return nil
+}
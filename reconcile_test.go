@@ -0,0 +1,140 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testReconcileSpec = `openapi: 3.0.3
+info:
+  title: Hand-written API
+  version: 2.0.0
+paths:
+  /health:
+    get:
+      operationId: getHealth
+      responses:
+        "204":
+          description: Healthy.
+  /pets:
+    get:
+      operationId: listPets
+      summary: Hand-written list
+      x-entrest-manual: true
+      responses:
+        "200":
+          description: Pets.
+  /stale:
+    get:
+      operationId: getStale
+      responses:
+        "204":
+          description: Previously generated.
+components:
+  schemas:
+    Pet:
+      type: string
+    Legacy:
+      type: object
+      x-entrest-manual: true
+    Custom:
+      type: integer
+    Stale:
+      type: string
+x-entrest-generated:
+  operations:
+    - GET /stale
+  components:
+    - schemas/Stale
+`
+
+func TestConfig_ReconcileSpecPath(t *testing.T) {
+	t.Parallel()
+
+	t.Run("reconcile", func(t *testing.T) {
+		t.Parallel()
+
+		fn := filepath.Join(t.TempDir(), "openapi.yaml")
+		require.NoError(t, os.WriteFile(fn, []byte(testReconcileSpec), 0o600))
+
+		r := mustBuildSpec(t, &Config{ReconcileSpecPath: fn})
+
+		// Hand-written.
+		assert.Equal(t, "Hand-written API", r.json(`$.info.title`))
+		assert.Equal(t, "getHealth", r.json(`$.paths./health.get.operationId`))
+		assert.Equal(t, "integer", r.json(`$.components.schemas.Custom.type`))
+
+		// Manually maintained.
+		assert.Equal(t, "Hand-written list", r.json(`$.paths./pets.get.summary`))
+		assert.Equal(t, "object", r.json(`$.components.schemas.Legacy.type`))
+		assert.Equal(t, true, r.json(`$.components.schemas.Legacy.x-entrest-manual`))
+
+		// Generated.
+		assert.Equal(t, "createPet", r.json(`$.paths./pets.post.operationId`))
+		assert.Equal(t, "object", r.json(`$.components.schemas.Pet.type`))
+		assert.NotNil(t, r.json(`$.paths./users.get`))
+
+		// Previously generated.
+		assert.Nil(t, r.json(`$.paths./stale`))
+		assert.Nil(t, r.json(`$.components.schemas.Stale`))
+
+		ops := r.json(`$.x-entrest-generated.operations`)
+		assert.Contains(t, ops, "POST /pets")
+		assert.NotContains(t, ops, "GET /pets")
+		assert.Contains(t, r.json(`$.x-entrest-generated.components`), "schemas/Pet")
+
+		b, err := os.ReadFile(fn)
+		require.NoError(t, err)
+
+		written, err := ogen.Parse(b)
+		require.NoError(t, err)
+		assert.Contains(t, written.Paths, "/health")
+		assert.Contains(t, written.Paths, "/users")
+		assert.True(t, isReconcileManual(written.Paths["/pets"].Get.Common.Extensions))
+		assert.True(t, isReconcileManual(written.Components.Schemas["Legacy"].Common.Extensions))
+		assert.Contains(t, written.Extensions, ReconcileGeneratedExtension)
+	})
+
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+
+		fn := filepath.Join(t.TempDir(), "openapi.json")
+		require.NoError(t, os.WriteFile(fn, []byte(`{"openapi":"3.0.3","info":{"title":"API","version":"1.0.0"},"paths":{}}`), 0o600))
+
+		mustBuildSpec(t, &Config{ReconcileSpecPath: fn})
+
+		b, err := os.ReadFile(fn)
+		require.NoError(t, err)
+		assert.Contains(t, string(b), `"x-entrest-generated": {`)
+
+		// Reconciling again should be stable.
+		mustBuildSpec(t, &Config{ReconcileSpecPath: fn})
+
+		b2, err := os.ReadFile(fn)
+		require.NoError(t, err)
+		assert.Equal(t, string(b), string(b2))
+	})
+
+	t.Run("missing", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := buildSpec(t, &Config{ReconcileSpecPath: filepath.Join(t.TempDir(), "missing.json")})
+		require.ErrorContains(t, err, "failed to read spec to reconcile")
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewExtension(&Config{ReconcileSpecPath: "openapi.yaml", SpecFromPath: "base.json"})
+		require.ErrorContains(t, err, "Config.ReconcileSpecPath cannot be provided")
+	})
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// deduplicatedHeader is the response header which is set on responses returned for
+// duplicate requests (see [WithDeduplication]).
+const deduplicatedHeader = "X-Deduplicated"
+
+// hasDeduplication returns true if create requests of the provided type are
+// deduplicated (see [WithDeduplication]).
+func hasDeduplication(t *gen.Type) bool {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	return ta.DeduplicationWindow > 0 && !ta.GetSkip(cfg) && !IsReadOnly(t) && ta.HasOperation(cfg, OperationCreate)
+}
+
+// hasAnyDeduplication returns true if create requests of any of the provided types are
+// deduplicated.
+func hasAnyDeduplication(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, hasDeduplication)
+}
+
+// wrapDeduplication wraps the provided handler (Go expression) of the provided
+// operation, so identical create requests within the deduplication window of the schema
+// return the original response (see [WithDeduplication]).
+func wrapDeduplication(t *gen.Type, op Operation, handler string) string {
+	if op != OperationCreate || !hasDeduplication(t) {
+		return handler
+	}
+	return fmt.Sprintf(
+		"withDeduplication(s, OperationCreate, time.Duration(%d), %s)",
+		GetAnnotation(t).DeduplicationWindow.Nanoseconds(),
+		handler,
+	)
+}
+
+// addDeduplicationDescription documents the deduplication of the provided (create)
+// operation (see [WithDeduplication]) within its description.
+func addDeduplicationDescription(t *gen.Type, op Operation, oper *ogen.Operation) {
+	if oper == nil || op != OperationCreate || !hasDeduplication(t) {
+		return
+	}
+
+	oper.Description = strings.TrimSpace(fmt.Sprintf(
+		"%s\n\nRequests without an `Idempotency-Key` header, which are identical to a successful "+
+			"request (same body, query parameters and credentials) within %s, aren't executed again, "+
+			"and instead return the original response, with the `%s` header set to `true`.",
+		oper.Description,
+		GetAnnotation(t).DeduplicationWindow,
+		deduplicatedHeader,
+	))
+}
+
+// validateDeduplication checks that the deduplication window of the provided schema
+// (see [WithDeduplication]) is valid, and that the schema has a create operation.
+func validateDeduplication(cfg *Config, ta *Annotation) error {
+	if ta.DeduplicationWindow < 0 {
+		return fmt.Errorf("deduplication window %s must not be negative", ta.DeduplicationWindow)
+	}
+
+	if ta.DeduplicationWindow > 0 && !ta.HasOperation(cfg, OperationCreate) {
+		return errors.New("deduplication requires the create operation")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_Deduplication(t *testing.T) {
+	t.Parallel()
+
+	t.Run("description", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithDeduplication(30*time.Second))
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+
+		assert.Contains(t, r.json(`$.paths./pets.post.description`), "identical to a successful request")
+		assert.Contains(t, r.json(`$.paths./pets.post.description`), "within 30s")
+		assert.NotContains(t, r.json(`$.paths./pets/{petID}.patch.description`), "identical to a successful request")
+		assert.NotContains(t, r.json(`$.paths./users.post.description`), "identical to a successful request")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			annotations []Annotation
+			contains    string
+		}{
+			{[]Annotation{WithDeduplication(-time.Second)}, "deduplication window -1s must not be negative"},
+			{
+				[]Annotation{WithDeduplication(time.Second), WithExcludeOperations(OperationCreate)},
+				"deduplication requires the create operation",
+			},
+		} {
+			_, err := buildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, "Pet", tt.annotations...)
+					return ValidateAnnotations(g.Nodes...)
+				},
+			})
+			require.ErrorContains(t, err, tt.contains)
+		}
+	})
+}
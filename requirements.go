@@ -0,0 +1,245 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// ConditionalRequirement requires fields in create requests, only when another field
+// of the schema is provided with one of the provided values (see
+// [WithConditionalRequirement]).
+type ConditionalRequirement struct {
+	// Fields are the names of the (optional) fields which are required when the
+	// condition is met.
+	Fields []string
+
+	// When is the name of the enum or bool field of the condition.
+	When string
+
+	// Values are the values of the When field (enum values as strings, or bools), which
+	// require the fields when provided.
+	Values []any
+}
+
+// conditionField returns the field of the condition of the requirement.
+func (r *ConditionalRequirement) conditionField(t *gen.Type) *gen.Field {
+	idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == r.When })
+	if idx == -1 {
+		return nil
+	}
+	return t.Fields[idx]
+}
+
+// allValues returns all values which the condition field can have.
+func (r *ConditionalRequirement) allValues(f *gen.Field) []any {
+	if f.IsBool() {
+		return []any{true, false}
+	}
+
+	values := make([]any, 0, len(f.EnumValues()))
+	for _, v := range f.EnumValues() {
+		values = append(values, v)
+	}
+	return values
+}
+
+// describe returns a description of the condition, e.g. `"type" is one of: "DOG"`.
+func (r *ConditionalRequirement) describe(t *gen.Type) string {
+	values := make([]string, len(r.Values))
+	for i, v := range r.Values {
+		values[i] = fmt.Sprintf("%#v", v)
+	}
+
+	return fmt.Sprintf("%q is one of: %s", GetFieldName(t, r.conditionField(t)), strings.Join(values, ", "))
+}
+
+// GetConditionalRequirements returns the conditional requirements of the provided type
+// (see [WithConditionalRequirement]).
+func GetConditionalRequirements(t *gen.Type) []*ConditionalRequirement {
+	return GetAnnotation(t).ConditionalRequirements
+}
+
+// getConditionalRequirementFields returns the required fields of the provided
+// conditional requirement.
+func getConditionalRequirementFields(t *gen.Type, r *ConditionalRequirement) (fields []*gen.Field) {
+	for _, name := range r.Fields {
+		if idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == name }); idx != -1 {
+			fields = append(fields, t.Fields[idx])
+		}
+	}
+	return fields
+}
+
+// getConditionalRequirementCheck returns a Go expression, which is true if the
+// condition of the provided requirement is met by the provided create params
+// (e.g. "c").
+func getConditionalRequirementCheck(t *gen.Type, r *ConditionalRequirement, params string) string {
+	f := r.conditionField(t)
+	v := params + "." + f.StructField()
+
+	var checks []string
+	if f.Optional || f.Default {
+		checks = append(checks, v+" != nil")
+		v = "*" + v
+	}
+
+	values := make([]string, len(r.Values))
+	for i, value := range r.Values {
+		if s, ok := value.(string); ok {
+			values[i] = v + " == " + strconv.Quote(s)
+		} else {
+			values[i] = fmt.Sprintf("%s == %v", v, value)
+		}
+	}
+
+	checks = append(checks, "("+strings.Join(values, " || ")+")")
+	return strings.Join(checks, " && ")
+}
+
+// getConditionalRequirementError returns the error message of the provided requirement,
+// when the provided field isn't provided.
+func getConditionalRequirementError(t *gen.Type, r *ConditionalRequirement, f *gen.Field) string {
+	return fmt.Sprintf("%q is required when %s", GetFieldName(t, f), r.describe(t))
+}
+
+// hasConditionalRequirements returns true if the provided type has conditional
+// requirements, which are enforced in create requests.
+func hasConditionalRequirements(t *gen.Type) bool {
+	ta := GetAnnotation(t)
+	return len(ta.ConditionalRequirements) > 0 && !ta.GetSkip(GetConfig(t.Config)) && !IsReadOnly(t)
+}
+
+// hasAnyConditionalRequirements returns true if any of the provided types have
+// conditional requirements.
+func hasAnyConditionalRequirements(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, hasConditionalRequirements)
+}
+
+// addConditionalRequirements adds the conditional requirements of the provided type
+// to the provided (create) schema. As OpenAPI 3.0 doesn't support "if"/"then", each
+// requirement is expressed as a "oneOf", where either the condition is met and the
+// fields are provided, or the condition field has another value (or isn't provided).
+func addConditionalRequirements(t *gen.Type, schema *ogen.Schema) {
+	for _, r := range GetConditionalRequirements(t) {
+		f := r.conditionField(t)
+		name := GetFieldName(t, f)
+
+		typ := "string"
+		if f.IsBool() {
+			typ = "boolean"
+		}
+
+		var other []any
+		for _, v := range r.allValues(f) {
+			if !slices.Contains(r.Values, v) {
+				other = append(other, v)
+			}
+		}
+
+		required := []string{name}
+		quoted := []string{}
+		for _, rf := range getConditionalRequirementFields(t, r) {
+			required = append(required, GetFieldName(t, rf))
+			quoted = append(quoted, strconv.Quote(GetFieldName(t, rf)))
+		}
+
+		schema.AllOf = append(schema.AllOf, &ogen.Schema{
+			Description: fmt.Sprintf("Requires %s when %s.", strings.Join(quoted, ", "), r.describe(t)),
+			OneOf: []*ogen.Schema{
+				{
+					Properties: ogen.Properties{{Name: name, Schema: &ogen.Schema{Type: typ, Enum: sliceToRawMessage(r.Values)}}},
+					Required:   required,
+				},
+				{
+					Properties: ogen.Properties{{Name: name, Schema: &ogen.Schema{Type: typ, Enum: sliceToRawMessage(other)}}},
+				},
+			},
+		})
+	}
+}
+
+// validateConditionalRequirements checks that the conditional requirements of the
+// provided type (see [WithConditionalRequirement]) only require optional fields, on
+// conditions of enum or bool fields, which can be provided in create requests.
+func validateConditionalRequirements(cfg *Config, t *gen.Type, ta *Annotation) (errs []error) {
+	// creatable returns an error if the provided field can't be provided in create
+	// requests.
+	creatable := func(name string) (*gen.Field, error) {
+		idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == name })
+		if idx == -1 {
+			return nil, fmt.Errorf("conditional requirement references unknown field %q", name)
+		}
+
+		f := t.Fields[idx]
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || !fa.GetCreatable() || isMoneyCurrencyField(t, f) {
+			return nil, fmt.Errorf("conditional requirement references field %q, which can't be provided in create requests", name)
+		}
+		return f, nil
+	}
+
+	for _, r := range ta.ConditionalRequirements {
+		if r == nil {
+			errs = append(errs, errors.New("conditional requirement must not be nil"))
+			continue
+		}
+
+		if len(r.Fields) == 0 {
+			errs = append(errs, fmt.Errorf("conditional requirement on %q must require at least one field", r.When))
+		}
+
+		for _, name := range r.Fields {
+			f, err := creatable(name)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			if !f.Optional && !f.Default {
+				errs = append(errs, fmt.Errorf("conditional requirement references field %q, which is always required", name))
+			}
+			if name == r.When {
+				errs = append(errs, fmt.Errorf("conditional requirement field %q can't require itself", name))
+			}
+		}
+
+		f, err := creatable(r.When)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if (!f.IsEnum() && !f.IsBool()) || IsIntEnum(f) {
+			errs = append(errs, fmt.Errorf("conditional requirement field %q must be a (string) enum or bool field", r.When))
+			continue
+		}
+
+		if len(r.Values) == 0 {
+			errs = append(errs, fmt.Errorf("conditional requirement on %q must have at least one value", r.When))
+			continue
+		}
+
+		all := r.allValues(f)
+		for _, v := range r.Values {
+			if !slices.Contains(all, v) {
+				b, _ := json.Marshal(v)
+				errs = append(errs, fmt.Errorf("conditional requirement on %q has invalid value %s", r.When, b))
+			}
+		}
+
+		if !slices.ContainsFunc(all, func(v any) bool { return !slices.Contains(r.Values, v) }) {
+			errs = append(errs, fmt.Errorf("conditional requirement on %q must not include all values, make the fields required instead", r.When))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,65 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_ConditionalRequirements(t *testing.T) {
+	t.Parallel()
+
+	t.Run("create", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User", WithConditionalRequirement(ConditionalRequirement{
+					Fields: []string{"email", "description"},
+					When:   "type",
+					Values: []any{"USER"},
+				}))
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+
+		prefix := "$.components.schemas.UserCreate.allOf[0]"
+		assert.Equal(t, []any{"USER"}, r.json(prefix+".oneOf[0].properties.type.enum"))
+		assert.Equal(t, []any{"type", "email", "description"}, r.json(prefix+".oneOf[0].required"))
+		assert.Equal(t, []any{"SYSTEM"}, r.json(prefix+".oneOf[1].properties.type.enum"))
+		assert.Nil(t, r.json(prefix+".oneOf[1].required"))
+		assert.Nil(t, r.json("$.components.schemas.UserUpdate.allOf"))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			req      ConditionalRequirement
+			contains string
+		}{
+			{ConditionalRequirement{When: "type", Values: []any{"USER"}}, "must require at least one field"},
+			{ConditionalRequirement{Fields: []string{"foo"}, When: "type", Values: []any{"USER"}}, `unknown field "foo"`},
+			{ConditionalRequirement{Fields: []string{"name"}, When: "type", Values: []any{"USER"}}, `field "name", which is always required`},
+			{ConditionalRequirement{Fields: []string{"email"}, When: "name", Values: []any{"USER"}}, `field "name" must be a (string) enum or bool field`},
+			{ConditionalRequirement{Fields: []string{"email"}, When: "type"}, "must have at least one value"},
+			{ConditionalRequirement{Fields: []string{"email"}, When: "type", Values: []any{"ADMIN"}}, `invalid value "ADMIN"`},
+			{ConditionalRequirement{Fields: []string{"email"}, When: "type", Values: []any{"USER", "SYSTEM"}}, "must not include all values"},
+		} {
+			_, err := buildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, "User", WithConditionalRequirement(tt.req))
+					return ValidateAnnotations(g.Nodes...)
+				},
+			})
+			require.ErrorContains(t, err, tt.contains)
+		}
+	})
+}
@@ -72,6 +72,14 @@ func GetSchemaField(f *gen.Field) (*ogen.Schema, error) {
 
 	baseType := f.Type.String()
 
+	if schema == nil && fa.Geo != nil {
+		schema = geoSchema(f)
+	}
+
+	if schema == nil && fa.Translations != nil {
+		schema = translationsSchema(f)
+	}
+
 	if schema == nil && f.IsEnum() {
 		// TODO: sharing enum schemas between parameters and component schemas,
 		// means that the default is used for both, even if the parameter version
@@ -83,8 +91,7 @@ func GetSchemaField(f *gen.Field) (*ogen.Schema, error) {
 		// 		return nil, err
 		// 	}
 		// }
-		schema = &ogen.Schema{Type: "string"}
-		schema.Enum, err = ToEnum(f.EnumValues())
+		schema, err = getSchemaEnum(f)
 		if err != nil {
 			return nil, err
 		}
@@ -103,6 +110,22 @@ func GetSchemaField(f *gen.Field) (*ogen.Schema, error) {
 		}
 	}
 
+	// Edge fields use the ID format of the referenced type, like the edge itself.
+	var refFormat *IDFormat
+	var refDescription string
+	if ref := getEdgeFieldType(f); ref != nil && fa.Schema == nil {
+		refFormat = GetIDFormat(ref)
+		refDescription = fmt.Sprintf("The ID of the referenced %s entity.", GetSchemaName(ref))
+
+		if (schema == nil && refFormat != nil && f.IsString()) || HasEncodedID(ref) {
+			schema = &ogen.Schema{Type: "string"}
+		}
+	}
+
+	if schema == nil && fa.Schema == nil && GetNumericFormat(f) != nil {
+		schema = numericSchema(f)
+	}
+
 	if schema == nil {
 		return nil, fmt.Errorf("no openapi type exists for type %q of field %s", baseType, f.StructField())
 	}
@@ -122,7 +145,10 @@ func GetSchemaField(f *gen.Field) (*ogen.Schema, error) {
 		}
 	}
 
-	schema.Description = cmp.Or(schema.Description, fa.Description, f.Comment())
+	schema.Description = cmp.Or(schema.Description, fa.Description, f.Comment(), refDescription)
+	if desc := getEnumDescription(f); desc != "" && fa.Schema == nil {
+		schema.Description = strings.TrimSpace(schema.Description + "\n\n" + desc)
+	}
 	schema.Deprecated = cmp.Or(schema.Deprecated, fa.Deprecated)
 
 	if fa.Example != nil && schema.Example == nil {
@@ -132,6 +158,22 @@ func GetSchemaField(f *gen.Field) (*ogen.Schema, error) {
 		}
 	}
 
+	if fa.Schema == nil {
+		if err = applyTimeFormat(f, schema); err != nil {
+			return nil, err
+		}
+
+		if err = applyNumericFormat(f, schema); err != nil {
+			return nil, err
+		}
+	}
+
+	if refFormat != nil {
+		if err = refFormat.apply(schema); err != nil {
+			return nil, err
+		}
+	}
+
 	return schema, nil
 }
 
@@ -148,7 +190,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 
 	var err error
 
-	entityName := Singularize(t.Name)
+	entityName := GetSchemaName(t)
 
 	switch op {
 	case OperationCreate, OperationUpdate:
@@ -165,8 +207,8 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 
 		var fieldSchema *ogen.Schema
 
-		if op == OperationCreate && cfg.AllowClientUUIDs && t.ID != nil && t.ID.IsUUID() {
-			fieldSchema, err = GetSchemaField(t.ID)
+		if op == OperationCreate && HasClientProvidedID(t) {
+			fieldSchema, err = GetSchemaID(t)
 			if err != nil {
 				panic(fmt.Sprintf("failed to generate schema for field %s: %v", t.ID.StructField(), err))
 			}
@@ -182,7 +224,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 			fa := GetAnnotation(f)
 
 			// Sensitive fields are allowed to be set in create/update by default.
-			if fa.GetSkip(cfg) || fa.ReadOnly {
+			if fa.GetSkip(cfg) || !fa.GetWritable(op) {
 				continue
 			}
 
@@ -192,23 +234,33 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 					panic(fmt.Sprintf("failed to generate schema for field %s: %v", f.StructField(), err))
 				}
 
+				if fa.Hashed {
+					fieldSchema = hashedFieldSchema(fieldSchema)
+				}
+
 				// Hoist enums into components to reduce duplication where possible.
 				if updated, asRef, ref, ok := hoistEnums(t, f, fieldSchema); ok {
 					schemas[ref] = updated
 					schema.Properties = append(schema.Properties, ogen.Property{
-						Name:   f.Name,
+						Name:   GetFieldName(t, f),
 						Schema: asRef,
 					})
 				} else {
-					schema.Properties = append(schema.Properties, *updated.ToProperty(f.Name))
+					schema.Properties = append(schema.Properties, *updated.ToProperty(GetFieldName(t, f)))
 				}
 
 				if op == OperationCreate && !f.Optional && !f.Default {
-					schema.Required = append(schema.Required, f.Name)
+					schema.Required = append(schema.Required, GetFieldName(t, f))
 				}
 			}
 		}
 
+		applyMoneyProperties(t, schema)
+
+		if op == OperationCreate {
+			addConditionalRequirements(t, schema)
+		}
+
 		for _, e := range t.Edges {
 			ea := GetAnnotation(e)
 
@@ -224,7 +276,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 				continue
 			}
 
-			fieldSchema, err = GetSchemaField(e.Type.ID)
+			fieldSchema, err = GetSchemaID(e.Type)
 			if err != nil {
 				panic(fmt.Sprintf("failed to generate schema for field %s: %v", e.Type.ID.StructField(), err))
 			}
@@ -236,7 +288,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 			if e.Field() != nil {
 				fa := GetAnnotation(e.Field())
 
-				if fa.ReadOnly {
+				if !fa.GetWritable(op) {
 					continue
 				}
 
@@ -248,7 +300,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 				}
 
 				if (op == OperationCreate && !e.Optional && !e.Field().Default) || (op == OperationUpdate && !e.Field().UpdateDefault) {
-					schema.Required = append(schema.Required, e.Name)
+					schema.Required = append(schema.Required, GetEdgeName(t, e, ""))
 				}
 			}
 
@@ -256,19 +308,19 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 				// Handle adding the "add_<field>" and "remove_<field>" object properties for update operations.
 				schema.Properties = append(
 					schema.Properties,
-					*fieldSchema.ToProperty("add_" + e.Name),
-					*fieldSchema.ToProperty("remove_" + e.Name),
+					*fieldSchema.ToProperty(GetEdgeName(t, e, "add")),
+					*fieldSchema.ToProperty(GetEdgeName(t, e, "remove")),
 				)
 
 				if ea.EdgeUpdateBulk {
-					schema.Properties = append(schema.Properties, *fieldSchema.ToProperty(e.Name))
+					schema.Properties = append(schema.Properties, *fieldSchema.ToProperty(GetEdgeName(t, e, "")))
 				}
 			} else {
-				schema.Properties = append(schema.Properties, *fieldSchema.ToProperty(e.Name))
+				schema.Properties = append(schema.Properties, *fieldSchema.ToProperty(GetEdgeName(t, e, "")))
 			}
 
-			if !slices.Contains(schema.Required, e.Name) && op == OperationCreate && !e.Optional {
-				schema.Required = append(schema.Required, e.Name)
+			if !slices.Contains(schema.Required, GetEdgeName(t, e, "")) && op == OperationCreate && !e.Optional {
+				schema.Required = append(schema.Required, GetEdgeName(t, e, ""))
 			}
 		}
 
@@ -293,7 +345,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 		var fieldSchema *ogen.Schema
 
 		if t.ID != nil {
-			fieldSchema, err = GetSchemaField(t.ID)
+			fieldSchema, err = GetSchemaID(t)
 			if err != nil {
 				panic(fmt.Sprintf("failed to generate schema for field %s: %v", t.ID.StructField(), err))
 			}
@@ -308,12 +360,12 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 		for _, f := range t.Fields {
 			fa := GetAnnotation(f)
 
-			if fa.GetSkip(cfg) || f.Sensitive() {
+			if fa.GetSkip(cfg) || f.Sensitive() || !fa.GetReadable() {
 				continue
 			}
 
 			if !f.Optional {
-				schema.Required = append(schema.Required, f.Name)
+				schema.Required = append(schema.Required, GetFieldName(t, f))
 			}
 
 			fieldSchema, err = GetSchemaField(f)
@@ -325,53 +377,38 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 			if updated, asRef, ref, ok := hoistEnums(t, f, fieldSchema); ok {
 				schemas[ref] = updated
 				schema.Properties = append(schema.Properties, ogen.Property{
-					Name:   f.Name,
+					Name:   GetFieldName(t, f),
 					Schema: asRef,
 				})
 			} else {
-				schema.Properties = append(schema.Properties, *updated.ToProperty(f.Name))
+				schema.Properties = append(schema.Properties, *updated.ToProperty(GetFieldName(t, f)))
 			}
 		}
 
+		applyMoneyProperties(t, schema)
+		applyTranslationProperties(cfg, t, schema)
+		addComputedFieldProperties(t, schema)
+
 		edgeSchema := &ogen.Schema{
 			Type:       "object",
 			Properties: ogen.Properties{},
 			Required:   []string{},
 		}
 
-		for _, e := range t.Edges {
-			ea := GetAnnotation(e)
+		for _, node := range GetEagerLoadEdges(t) {
+			e := node.Edge
 
-			if ea.GetSkip(cfg) || !ea.GetEagerLoad(cfg) {
-				continue
+			if isEagerLoadEdgeRequired(cfg, e) {
+				edgeSchema.Required = append(edgeSchema.Required, GetEdgeName(t, e, ""))
 			}
 
-			prop := ogen.Property{
-				Name:   e.Name,
-				Schema: &ogen.Schema{Ref: "#/components/schemas/" + Singularize(e.Type.Name)},
-			}
-
-			if !e.Unique {
-				prop.Schema = prop.Schema.AsArray()
-
-				if limit := ea.GetEagerLoadLimit(cfg); limit > 0 {
-					prop.Schema.MinItems = ptr(uint64(0))
-					prop.Schema.MaxItems = ptr(uint64(limit))
-					prop.Schema.Description = fmt.Sprintf("A list of %s entities. Limited to %d items. If there are more results than the limit, the results are capped and you must use the associated edge endpoint with pagination -- see also the 'EagerLoadLimit' config option.", Singularize(e.Type.Name), limit)
-				}
-			}
-
-			if !e.Optional {
-				// TODO: nullable?
-				// prop.Schema.Nullable = true
-				edgeSchema.Required = append(edgeSchema.Required, e.Name)
-			}
-
-			edgeSchema.Properties = append(edgeSchema.Properties, prop)
+			edgeSchema.Properties = append(edgeSchema.Properties, eagerLoadProperty(cfg, entityName, node, schemas))
 
 			if edge == nil {
-				for k, v := range GetSchemaType(e.Type, OperationRead, e) {
-					schemas[k] = v
+				for _, n := range node.Walk() {
+					for k, v := range GetSchemaType(n.Edge.Type, OperationRead, n.Edge) {
+						schemas[k] = v
+					}
 				}
 			}
 		}
@@ -380,7 +417,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 		schemas[entityName] = schema
 
 		if len(edgeSchema.Properties) > 0 {
-			schemas[entityName+"Read"] = &ogen.Schema{
+			schemas[GetReadSchemaName(t)] = &ogen.Schema{
 				Description: schema.Description,
 				AllOf: []*ogen.Schema{
 					{Ref: "#/components/schemas/" + entityName},
@@ -396,7 +433,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 			schemas[entityName+"Edges"] = edgeSchema
 		} else {
 			// No-op these references/shortcut them to the main schema.
-			schemas[entityName+"Read"] = &ogen.Schema{Ref: "#/components/schemas/" + entityName}
+			schemas[GetReadSchemaName(t)] = &ogen.Schema{Ref: "#/components/schemas/" + entityName}
 		}
 	case OperationList:
 		if edge != nil {
@@ -409,7 +446,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 
 			if !ea.GetPagination(cfg, edge) || (!ra.GetPagination(cfg, edge) || cfg.DisableEagerLoadNonPagedOpt) {
 				// This should allow setting the normal list operation as well, so don't return.
-				schema := ogen.NewSchema().SetRef("#/components/schemas/" + Singularize(edge.Type.Name) + "Read").AsArray()
+				schema := ogen.NewSchema().SetRef("#/components/schemas/" + GetReadSchemaName(edge.Type)).AsArray()
 				schema.Description = fmt.Sprintf(
 					"List of %s associated with %s (%s entity type).",
 					Pluralize(CamelCase(edge.Name)),
@@ -420,7 +457,7 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 				// If edge pagination is enabled, but edge type isn't paginated, we cannot re-use
 				// the paginated schema from the edge type.
 				if !ra.GetPagination(cfg, edge) && ea.GetPagination(cfg, edge) {
-					schema = toPagedSchema(schema)
+					schema = toPagedSchema(cfg, edge.Type, schema)
 				}
 
 				// We're setting a specific schema for the edge response because we cannot re-use
@@ -430,17 +467,16 @@ func GetSchemaType(t *gen.Type, op Operation, edge *gen.Edge) map[string]*ogen.S
 		}
 
 		if !ta.GetPagination(cfg, nil) {
-			schema := ogen.NewSchema().SetRef("#/components/schemas/" + entityName + "Read").AsArray()
+			schema := ogen.NewSchema().SetRef("#/components/schemas/" + GetReadSchemaName(t)).AsArray()
 			schema.Description = fmt.Sprintf("A list of %s entities. Includes eager-loaded edges (if any) for each entity.", entityName)
 			schemas[entityName+"List"] = schema
 			return schemas
 		}
 
-		schemas[entityName+"List"] = toPagedSchema(
-			ogen.NewSchema().
-				SetRef("#/components/schemas/" + entityName + "Read").
-				SetDescription(fmt.Sprintf("A paginated result set of %s entities. Includes eager-loaded edges (if any) for each entity.", entityName)),
-		)
+		schema := ogen.NewSchema().
+			SetRef("#/components/schemas/" + GetReadSchemaName(t)).
+			SetDescription(fmt.Sprintf("A paginated result set of %s entities. Includes eager-loaded edges (if any) for each entity.", entityName))
+		schemas[entityName+"List"] = toPagedSchema(cfg, t, schema)
 
 		dependencies = append(dependencies, OperationRead)
 	case OperationDelete:
@@ -470,7 +506,7 @@ func hoistEnums(t *gen.Type, f *gen.Field, existing *ogen.Schema) (updated, asRe
 		return nil, nil, "", false
 	}
 
-	name := Singularize(t.Name) + PascalCase(f.Name) + "Enum"
+	name := GetSchemaName(t) + PascalCase(f.Name) + "Enum"
 
 	if existing.Type == "array" {
 		isEnum := existing.Items.Item != nil && existing.Items.Item.Enum != nil
@@ -490,19 +526,22 @@ func hoistEnums(t *gen.Type, f *gen.Field, existing *ogen.Schema) (updated, asRe
 				updated = existing.Items.Items[0]
 			}
 
+			addProtoEnumNames(t, f, updated)
 			asRef = &ogen.Schema{Ref: "#/components/schemas/" + name}
 			return updated, asRef.AsArray(), name, true
 		}
 	}
 	if len(existing.Enum) > 0 {
+		addProtoEnumNames(t, f, existing)
 		return existing, &ogen.Schema{Ref: "#/components/schemas/" + name}, name, true
 	}
 	return existing, nil, "", false
 }
 
-// toPagedSchema converts a response schema to a paged response schema, hoisting the
-// description from the response schema to the paged response schema.
-func toPagedSchema(schema *ogen.Schema) *ogen.Schema {
+// toPagedSchema converts a response schema (of the provided type) to a paged response
+// schema, hoisting the description from the response schema to the paged response
+// schema.
+func toPagedSchema(cfg *Config, t *gen.Type, schema *ogen.Schema) *ogen.Schema {
 	desc := schema.Description
 	schema.Description = ""
 
@@ -510,17 +549,24 @@ func toPagedSchema(schema *ogen.Schema) *ogen.Schema {
 		schema = schema.AsArray()
 	}
 
+	name := getFlavorCollectionName(cfg, t)
+
+	paged := "PagedResponse"
+	if IsSeekPaginated(t) {
+		paged = "SeekPagedResponse"
+	}
+
 	return &ogen.Schema{
 		Description: desc,
 		AllOf: []*ogen.Schema{
-			{Ref: "#/components/schemas/PagedResponse"},
+			{Ref: "#/components/schemas/" + paged},
 			{
 				Type: "object",
 				Properties: ogen.Properties{{
-					Name:   "content",
+					Name:   name,
 					Schema: schema,
 				}},
-				Required: []string{"content"},
+				Required: []string{name},
 			},
 		},
 	}
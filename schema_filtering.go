@@ -97,13 +97,14 @@ func (p Predicate) Remove(v Predicate) Predicate {
 	return p
 }
 
-// Explode returns all individual predicates as []gen.Op.
+// Explode returns all individual predicates as []gen.Op, sorted.
 func (p Predicate) Explode() (ops []gen.Op) {
 	for pred, op := range filterMap {
 		if p.Has(pred) {
 			ops = append(ops, op)
 		}
 	}
+	slices.Sort(ops)
 	return ops
 }
 
@@ -187,15 +188,43 @@ type FilterableFieldOp struct {
 	fieldSchema *ogen.Schema // The base schema for the field, this may change based on the operation provided.
 }
 
-// ParameterName returns the raw query parameter name for the filterable field.
+// ParameterName returns the raw query parameter name for the filterable field. Uses
+// camelCase names, unless [Config.FieldNameStyle] is set.
 func (f *FilterableFieldOp) ParameterName() string {
+	edgeName, fieldName := f.parameterNames()
+
 	if f.Edge != nil {
 		if f.Field == nil {
-			return "has." + CamelCase(SnakeCase(Singularize(f.Edge.Name)))
+			return "has." + edgeName
 		}
-		return CamelCase(SnakeCase(Singularize(f.Edge.Name))) + "." + CamelCase(f.Field.Name) + "." + predicateFormat(f.Operation)
+		return edgeName + "." + fieldName + "." + predicateFormat(f.Operation)
 	}
-	return CamelCase(f.Field.Name) + "." + predicateFormat(f.Operation)
+	return fieldName + "." + predicateFormat(f.Operation)
+}
+
+// parameterNames returns the edge and field name components of the parameter name,
+// if the edge and field are set.
+func (f *FilterableFieldOp) parameterNames() (edgeName, fieldName string) {
+	style := getFieldNameStyle(f.Type)
+
+	if f.Edge != nil {
+		edgeName = CamelCase(SnakeCase(Singularize(f.Edge.Name)))
+		if style != FieldNameStyleDefault {
+			edgeName = style.Format(Singularize(f.Edge.Name))
+		}
+	}
+
+	if f.Field != nil {
+		fieldName = CamelCase(f.Field.Name)
+		if style != FieldNameStyleDefault {
+			owner := f.Type
+			if f.Edge != nil {
+				owner = f.Edge.Type
+			}
+			fieldName = GetFieldName(owner, f.Field)
+		}
+	}
+	return edgeName, fieldName
 }
 
 // ComponentName returns the name/component alias for the parameter.
@@ -254,9 +283,22 @@ func generatePredicateBuilder(
 
 	ftype := structName + "." + componentName
 
-	if op.Variadic() {
+	switch {
+	case isEncodedIDField(t, f) && op.Variadic():
+		ftype = "toIDs(" + ftype + ")..."
+	case isEncodedIDField(t, f):
+		ftype = "int(*" + ftype + ")"
+	case GetTimeFormat(f).isUnix() && op.Variadic():
+		ftype = "toTimes(" + ftype + ")..."
+	case GetTimeFormat(f).isUnix():
+		ftype = "time.Time(*" + ftype + ")"
+	case GetNumericFormat(f) != nil && op.Variadic():
+		ftype = "fromNumerics(" + ftype + ")..."
+	case GetNumericFormat(f) != nil:
+		ftype += ".Value"
+	case op.Variadic():
 		ftype += "..."
-	} else {
+	default:
 		ftype = "*" + ftype
 	}
 
@@ -282,6 +324,41 @@ func generatePredicateBuilder(
 	return builder
 }
 
+// validatedFilterOps are the filter operations where values which can never pass the
+// validators of the field also can't match any entities, and are thus validated.
+var validatedFilterOps = []gen.Op{gen.EQ, gen.NEQ, gen.In, gen.NotIn}
+
+// filterValidator returns the name of the ent validator function (e.g.
+// "pet.StatusValidator") which filter values of the provided field and operation
+// should pass, or an empty string if there is none. Validators of fields with custom Go
+// types (or encoded IDs) aren't used, as they accept the underlying type.
+func filterValidator(t *gen.Type, f *gen.Field, op gen.Op) string {
+	if f == nil || !slices.Contains(validatedFilterOps, op) || GetTimeFormat(f).isUnix() || isEncodedIDField(t, f) {
+		return ""
+	}
+
+	if !f.IsEnum() && (f.Validators == 0 || f.IsJSON() || f.Type.Type.String() != f.Type.String()) {
+		return ""
+	}
+	return t.Package() + "." + f.Validator()
+}
+
+// generateValidatorBuilder returns an expression which validates the filter values of
+// the provided component against the provided validators.
+func generateValidatorBuilder(
+	f *gen.Field,
+	op gen.Op,
+	structName, componentName, parameterName string,
+	validators []string,
+) string {
+	values := structName + "." + componentName
+	if !op.Variadic() {
+		values = fmt.Sprintf("[]%s{*%s}", getFieldGoType(f), values)
+	}
+
+	return fmt.Sprintf("validateFilter(%q, %s, %s)", parameterName, values, strings.Join(validators, ", "))
+}
+
 func (f *FilterableFieldOp) PredicateBuilder(structName string) string {
 	return generatePredicateBuilder(
 		f.Type,
@@ -293,15 +370,40 @@ func (f *FilterableFieldOp) PredicateBuilder(structName string) string {
 	)
 }
 
+// ValidatorBuilder returns an expression which validates the filter values against the
+// enum values or validators of the field, or an empty string if the values can't be
+// validated.
+func (f *FilterableFieldOp) ValidatorBuilder(structName string) string {
+	validator := filterValidator(f.Type, f.Field, f.Operation)
+	if validator == "" {
+		return ""
+	}
+
+	return generateValidatorBuilder(
+		f.Field,
+		f.Operation,
+		structName,
+		f.ComponentName(),
+		f.ParameterName(),
+		[]string{validator},
+	)
+}
+
 // TypeString returns the struct field type for the filterable field.
 func (f *FilterableFieldOp) TypeString() string {
 	if (f.Edge != nil && f.Field == nil) || f.Operation.Niladic() {
 		return "*bool"
 	}
+
+	goType := getFieldGoType(f.Field)
+	if isEncodedIDField(f.Type, f.Field) {
+		goType = "EncodedID"
+	}
+
 	if f.Operation.Variadic() {
-		return "[]" + f.Field.Type.String()
+		return "[]" + goType
 	}
-	return "*" + f.Field.Type.String()
+	return "*" + goType
 }
 
 // Description returns a description for the filterable field.
@@ -378,7 +480,7 @@ func GetFilterableFields(t *gen.Type, edge *gen.Edge) (filters []*FilterableFiel
 
 	if cfg.DefaultFilterID && t.ID != nil && (edge == nil || edge.Field() == nil) {
 		ida := GetAnnotation(t.ID)
-		if ida.Filter == 0 {
+		if ida.Filter == 0 && ida.GetFieldToggle(FieldFilterable, true) {
 			ida.Filter = FilterGroupEqualExact | FilterGroupArray
 		}
 		t.ID.Annotations.Set(ida.Name(), ida)
@@ -392,12 +494,21 @@ func GetFilterableFields(t *gen.Type, edge *gen.Edge) (filters []*FilterableFiel
 			continue
 		}
 
+		encodedID := isEncodedIDField(t, f)
+
 		for _, op := range intersectSorted(f.Ops(), fa.Filter.Explode()) {
 			if f.IsBool() && op == gen.NEQ {
 				continue // Since you can use EQ=false instead.
 			}
 
+			if encodedID && !slices.Contains(encodedIDFilterOps, op) {
+				continue // Encoded IDs aren't ordered.
+			}
+
 			fieldSchema, err := GetSchemaField(f)
+			if f == t.ID && encodedID {
+				fieldSchema, err = GetSchemaID(t)
+			}
 			if err != nil {
 				continue // Just skip things that can't be generated/easily mapped.
 			}
@@ -504,15 +615,43 @@ func (g *FilterGroup) PredicateBuilder(structName string, op gen.Op) string {
 	return fmt.Sprintf("sql.OrPredicates(\n%s,\n)", strings.Join(fields, ",\n"))
 }
 
+// ValidatorBuilder returns an expression which validates the filter values against the
+// enum values or validators of the fields in the group (passing if the values are valid
+// for any of them), or an empty string if the values can't be validated.
+func (g *FilterGroup) ValidatorBuilder(structName string, op gen.Op) string {
+	validators := make([]string, 0, len(g.FieldPairs))
+
+	for _, fp := range g.FieldPairs {
+		validator := filterValidator(fp.Type, fp.Field, op)
+		if validator == "" || getFieldGoType(fp.Field) != getFieldGoType(g.FieldPairs[0].Field) {
+			return "" // Values valid for this field can't be validated.
+		}
+		validators = append(validators, validator)
+	}
+
+	if len(validators) == 0 {
+		return ""
+	}
+
+	return generateValidatorBuilder(
+		g.FieldPairs[0].Field,
+		op,
+		structName,
+		g.ComponentName(op),
+		g.ParameterName(op),
+		validators,
+	)
+}
+
 // TypeString returns the struct field type for the filter group.
 func (g *FilterGroup) TypeString(op gen.Op) string {
 	if op.Niladic() {
 		return "*bool"
 	}
 	if op.Variadic() {
-		return "[]" + g.FieldType.String()
+		return "[]" + getFieldGoType(g.FieldPairs[0].Field)
 	}
-	return "*" + g.FieldType.String()
+	return "*" + getFieldGoType(g.FieldPairs[0].Field)
 }
 
 // ComponentName returns the name/component alias for the parameter.
@@ -591,6 +730,17 @@ func GetFilterGroups(t *gen.Type, edge *gen.Edge) []*FilterGroup {
 			))
 		}
 
+		if pairs := groups[fa.FilterGroup].FieldPairs; len(pairs) > 0 && GetTimeFormat(pairs[0].Field) != GetTimeFormat(f) {
+			panic(fmt.Sprintf(
+				"filter group %q on type %q and field %q has a different time format than another field in the group: %q vs %q",
+				fa.FilterGroup,
+				t.Name,
+				f.StructField(),
+				GetTimeFormat(pairs[0].Field),
+				GetTimeFormat(f),
+			))
+		}
+
 		if newOps := intersectSorted(groups[fa.FilterGroup].Operations, ops); len(groups[fa.FilterGroup].Operations) < len(newOps) {
 			if len(newOps) == 0 {
 				panic(fmt.Sprintf(
@@ -0,0 +1,52 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterableFieldOp_ValidatorBuilder(t *testing.T) {
+	t.Parallel()
+
+	builders := map[string]string{}
+
+	mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.type", WithFilter(FilterGroupEqualExact|FilterGroupArray))
+			injectAnnotations(t, g, "User.email", WithFilter(FilterGroupEqualExact|FilterGroupContains))
+			injectAnnotations(t, g, "User.name", WithFilter(FilterGroupEqualExact))
+
+			for _, n := range g.Nodes {
+				if n.Name != "User" {
+					continue
+				}
+
+				for _, f := range GetFilterableFields(n, nil) {
+					if f.Edge == nil {
+						builders[f.ParameterName()] = f.ValidatorBuilder("l")
+					}
+				}
+			}
+			return nil
+		},
+	})
+
+	// Enums are validated against their values.
+	assert.Equal(t, `validateFilter("type.eq", []user.Type{*l.UserTypeEQ}, user.TypeValidator)`, builders["type.eq"])
+	assert.Equal(t, `validateFilter("type.in", l.UserTypeIn, user.TypeValidator)`, builders["type.in"])
+
+	// Fields with validators are validated on exact matches only.
+	assert.Equal(t, `validateFilter("email.eq", []string{*l.UserEmailEQ}, user.EmailValidator)`, builders["email.eq"])
+	assert.Empty(t, builders["email.contains"])
+
+	// Fields without validators aren't validated.
+	assert.Contains(t, builders, "name.eq")
+	assert.Empty(t, builders["name.eq"])
+}
@@ -39,7 +39,7 @@ func GetSortableFields(t *gen.Type, edge *gen.Edge) (sortable []string) {
 
 	for _, f := range fields {
 		fa := GetAnnotation(f)
-		if fa.GetSkip(cfg) || f.Sensitive() || (!fa.Sortable && f.Name != "id") {
+		if fa.GetSkip(cfg) || f.Sensitive() || !fa.GetFieldToggle(FieldSortable, fa.Sortable || f.Name == "id") {
 			continue
 		}
 		if !f.IsString() && !f.IsTime() && !f.IsBool() && !f.IsInt() && !f.IsInt64() && !f.IsUUID() {
@@ -88,3 +88,26 @@ func GetSortableFields(t *gen.Type, edge *gen.Edge) (sortable []string) {
 	slices.Sort(sortable)
 	return slices.Compact(sortable)
 }
+
+// GetSortTiebreakFields returns the fields which uniquely identify entities of the
+// provided type (the ID field, or the fields of a composite ID). They are appended
+// to the ordering of list queries as a tiebreaker, so paginated results have a total
+// ordering, and don't include duplicate (or skip) results when sorting by non-unique
+// fields.
+func GetSortTiebreakFields(t *gen.Type) []*gen.Field {
+	if t.ID != nil {
+		return []*gen.Field{t.ID}
+	}
+	return GetCompositeIDFields(t)
+}
+
+// getUniqueSortFields returns the fields of the provided type which are unique and
+// required, and as such, already provide a total ordering when sorted by.
+func getUniqueSortFields(t *gen.Type) (fields []*gen.Field) {
+	for _, f := range t.Fields {
+		if f.Unique && !f.Optional && !f.Nillable {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
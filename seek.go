@@ -0,0 +1,122 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/ogen-go/ogen/jsonschema"
+)
+
+// IsSeekPaginated returns true if the paginated list operations returning the provided
+// type use seek pagination (see [WithSeekPagination]).
+func IsSeekPaginated(t *gen.Type) bool {
+	ta := GetAnnotation(t)
+	return ta.SeekPagination && ta.GetPagination(GetConfig(t.Config), nil)
+}
+
+// hasSeekPagination returns true if any of the provided types use seek pagination.
+func hasSeekPagination(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return !GetAnnotation(t).GetSkip(GetConfig(t.Config)) && IsSeekPaginated(t)
+	})
+}
+
+// addSeekPagination adds the schema of the fields of seek paginated list responses
+// (see [WithSeekPagination]) to the provided spec.
+func addSeekPagination(spec *ogen.Spec) {
+	if _, ok := spec.Components.Schemas["SeekPagedResponse"]; ok {
+		return
+	}
+
+	spec.Components.Schemas["SeekPagedResponse"] = &ogen.Schema{
+		Type: "object",
+		Properties: ogen.Properties{
+			{
+				Name: "has_more",
+				Schema: &ogen.Schema{
+					Type:        "boolean",
+					Description: `If true, there are more results following the current results (or preceding them, when using "before_id").`,
+					Example:     jsonschema.RawValue(`true`),
+				},
+			},
+		},
+		Required: []string{"has_more"},
+	}
+}
+
+// getPaginationParameters returns the pagination parameters of paginated list operations
+// returning the provided type, using the provided page sizes. With seek pagination (see
+// [WithSeekPagination]), the "page" parameter is replaced with the "after_id" and
+// "before_id" parameters.
+func getPaginationParameters(cfg *Config, t *gen.Type, minItems, maxItems, items int) ([]*ogen.Parameter, error) {
+	params := getFlavorPaginationParameters(cfg, minItems, maxItems, items)
+
+	if !IsSeekPaginated(t) {
+		return params, nil
+	}
+
+	idSchema, err := GetSchemaID(t)
+	if err != nil {
+		return nil, err
+	}
+
+	entityName := GetSchemaName(t)
+
+	params = slices.DeleteFunc(params, func(p *ogen.Parameter) bool {
+		return p.Ref == "#/components/parameters/Page"
+	})
+
+	return append([]*ogen.Parameter{
+		{
+			Name:        "after_id",
+			In:          "query",
+			Description: fmt.Sprintf("Only return the %s entities which follow the entity with the provided ID (i.e. the ID of the last entity of the current results), to retrieve the next results. Cannot be combined with 'before_id'.", entityName),
+			Schema:      idSchema,
+		},
+		{
+			Name:        "before_id",
+			In:          "query",
+			Description: fmt.Sprintf("Only return the %s entities which precede the entity with the provided ID (i.e. the ID of the first entity of the current results), to retrieve the previous results. Cannot be combined with 'after_id'.", entityName),
+			Schema:      idSchema,
+		},
+	}, params...), nil
+}
+
+// validateSeekPagination checks that the provided schema, which has seek pagination
+// enabled (see [WithSeekPagination]), can be ordered by its ID, which has to be
+// comparable in a query, and isn't ordered otherwise.
+func validateSeekPagination(cfg *Config, t *gen.Type, ta *Annotation) (errs []error) {
+	if !ta.SeekPagination {
+		return nil
+	}
+
+	if cfg.Flavor != FlavorDefault {
+		errs = append(errs, fmt.Errorf("seek pagination isn't supported with the %s flavor", flavorNames[cfg.Flavor]))
+	}
+
+	if t.ID == nil || t.HasCompositeID() {
+		return append(errs, errors.New("seek pagination requires a single ID field"))
+	}
+
+	if t.ID.HasGoType() || !(t.ID.IsString() || t.ID.Type.Numeric()) {
+		errs = append(errs, errors.New("seek pagination requires a numeric or string ID field"))
+	}
+
+	if v := ta.GetDefaultSort(true); v != "id" {
+		errs = append(errs, fmt.Errorf("default sort field %q is set, but seek paginated results are always ordered by ID", v))
+	}
+
+	for _, f := range t.Fields {
+		if fa := GetAnnotation(f); fa.GetFieldToggle(FieldSortable, fa.Sortable) {
+			errs = append(errs, fmt.Errorf("field %q is sortable, but seek paginated results are always ordered by ID", f.Name))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,55 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"strings"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_SeekPagination(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Category", WithSeekPagination())
+			return nil
+		},
+	})
+
+	assert.Equal(t, []any{"has_more"}, r.json(`$.components.schemas.SeekPagedResponse.required`))
+	assert.Contains(t, r.json(`$.components.schemas.CategoryList.allOf.*.$ref`), "#/components/schemas/SeekPagedResponse")
+	assert.Contains(t, r.json(`$.components.schemas.PetList.allOf.*.$ref`), "#/components/schemas/PagedResponse")
+
+	for _, path := range []string{"/categories", "/pets/{petID}/categories"} {
+		var names, refs []string
+		for _, param := range r.spec.Paths[path].Get.Parameters {
+			names = append(names, param.Name)
+			refs = append(refs, strings.TrimPrefix(param.Ref, "#/components/parameters/"))
+		}
+
+		assert.Contains(t, names, "after_id", path)
+		assert.Contains(t, names, "before_id", path)
+		assert.Contains(t, names, "per_page", path)
+		assert.NotContains(t, refs, "Page", path)
+		assert.NotContains(t, names, "sort", path)
+		assert.NotContains(t, names, "order", path)
+	}
+
+	assert.Equal(t, "integer", r.json(`$.paths./categories.get.parameters[?(@.name == 'after_id')].schema.type`))
+
+	// Other list endpoints are unaffected.
+	var names, refs []string
+	for _, param := range r.spec.Paths["/pets"].Get.Parameters {
+		names = append(names, param.Name)
+		refs = append(refs, strings.TrimPrefix(param.Ref, "#/components/parameters/"))
+	}
+	assert.Contains(t, names, "sort")
+	assert.Contains(t, refs, "Page")
+}
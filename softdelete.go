@@ -0,0 +1,223 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent"
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/mixin"
+	"github.com/ogen-go/ogen"
+)
+
+// SoftDeleteFieldName is the name of the field which is added by [SoftDeleteMixin],
+// storing when the entity was soft-deleted.
+const SoftDeleteFieldName = "deleted_at"
+
+// SoftDeleteMixin enables soft deletes for the schema which it's mixed into, adding a
+// read-only "deleted_at" field. Deletes of the schema (including those executed
+// directly through the client, once the generated SoftDeleteHook and
+// SoftDeleteInterceptor are registered by NewServer) set the field rather than
+// removing the row, and queries exclude soft-deleted entities. The following endpoints
+// are added, which are only accessible if allowed by ServerConfig.RecycleBinAccess:
+//
+//   - "GET /<entities>/deleted" lists the soft-deleted entities (if the schema has the
+//     list operation).
+//   - "DELETE /<entities>/{id}/purge" permanently removes a soft-deleted entity.
+type SoftDeleteMixin struct {
+	mixin.Schema
+}
+
+// Fields implements [ent.Mixin].
+func (SoftDeleteMixin) Fields() []ent.Field {
+	return []ent.Field{
+		field.Time(SoftDeleteFieldName).
+			Optional().
+			Nillable().
+			Comment("When the entity was soft-deleted, if it was.").
+			Annotations(WithReadOnly(true)),
+	}
+}
+
+// Annotations implements [ent.Mixin].
+func (SoftDeleteMixin) Annotations() []schema.Annotation {
+	return []schema.Annotation{Annotation{SoftDelete: true}}
+}
+
+var _ ent.Mixin = SoftDeleteMixin{}
+
+// getSoftDeleteField returns the field of the provided type which stores when entities
+// were soft-deleted (see [SoftDeleteMixin]), or nil if it doesn't have one.
+func getSoftDeleteField(t *gen.Type) *gen.Field {
+	for _, f := range t.Fields {
+		if f.Name == SoftDeleteFieldName && f.Type.Type == field.TypeTime && f.Optional && f.Nillable {
+			return f
+		}
+	}
+	return nil
+}
+
+// hasSoftDelete returns true if entities of the provided type are soft-deleted (see
+// [SoftDeleteMixin]).
+func hasSoftDelete(t *gen.Type) bool {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	return ta.SoftDelete &&
+		t.ID != nil &&
+		!ta.GetSkip(cfg) &&
+		!IsReadOnly(t) &&
+		ta.HasOperation(cfg, OperationDelete) &&
+		getSoftDeleteField(t) != nil
+}
+
+// hasAnySoftDelete returns true if entities of any of the provided types are
+// soft-deleted (see [SoftDeleteMixin]).
+func hasAnySoftDelete(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, hasSoftDelete)
+}
+
+// hasRecycleBin returns true if the soft-deleted entities of the provided type can be
+// listed, which requires the list operation.
+func hasRecycleBin(t *gen.Type) bool {
+	return hasSoftDelete(t) && GetAnnotation(t).HasOperation(GetConfig(t.Config), OperationList)
+}
+
+// GetSoftDeletePathName returns the path of the recycle bin endpoint of the provided
+// type (see [SoftDeleteMixin]) for the provided operation: [OperationList] (e.g.
+// "/pets/deleted"), or [OperationDelete] (purge, e.g. "/pets/{id}/purge"). useUniqueID
+// determines if the ID path parameter should be "{id}" or "{type|camel}ID".
+func GetSoftDeletePathName(op Operation, t *gen.Type, useUniqueID bool) string {
+	switch op {
+	case OperationList:
+		return GetPathName(OperationList, t, nil, useUniqueID) + "/deleted"
+	case OperationDelete:
+		return GetPathName(OperationRead, t, nil, useUniqueID) + "/purge"
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+}
+
+// GetSoftDeleteOperationID returns the operation ID of the recycle bin endpoint of the
+// provided type for the provided operation (see [GetSoftDeletePathName]), e.g.
+// "listDeletedPets" or "purgePet".
+func GetSoftDeleteOperationID(op Operation, t *gen.Type) string {
+	switch op {
+	case OperationList:
+		return "listDeleted" + Pluralize(GetSchemaName(t))
+	case OperationDelete:
+		return "purge" + GetSchemaName(t)
+	default:
+		panic(fmt.Sprintf("unsupported operation %q", op))
+	}
+}
+
+// GetSpecSoftDelete generates an independent spec for the recycle bin endpoints of the
+// provided type (see [SoftDeleteMixin]). The listing of soft-deleted entities supports
+// the same parameters as the list operation of the type.
+func GetSpecSoftDelete(t *gen.Type) (*ogen.Spec, error) {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	entityName := GetSchemaName(t)
+
+	spec := newBaseSpec(cfg)
+
+	if hasRecycleBin(t) {
+		var err error
+		spec, err = GetSpecType(t, OperationList)
+		if err != nil {
+			return nil, err
+		}
+
+		listPath := GetPathName(OperationList, t, nil, true)
+		item := spec.Paths[listPath]
+		delete(spec.Paths, listPath)
+
+		var list *ogen.Operation
+		PatchOperations(item, func(_ string, op *ogen.Operation) *ogen.Operation {
+			if op != nil {
+				list = op
+			}
+			return op
+		})
+		if list == nil {
+			return nil, errors.New("list operation not found")
+		}
+
+		list.Summary = "List deleted " + CamelCase(Pluralize(t.Name))
+		list.Description = fmt.Sprintf(
+			"List soft-deleted %s entities (including pagination, filtering, sorting, etc), which can be permanently removed by purging them.",
+			entityName,
+		)
+		list.OperationID = GetSoftDeleteOperationID(OperationList, t)
+
+		spec.Paths[GetSoftDeletePathName(OperationList, t, true)] = withOperationMethod(
+			&ogen.PathItem{Parameters: item.Parameters},
+			ta.GetOperationMethod(OperationList),
+			list,
+		)
+	}
+
+	if err := addIDParameters(spec, t); err != nil {
+		return nil, err
+	}
+
+	purge := &ogen.Operation{
+		Tags:    []string{Pluralize(t.Name)},
+		Summary: "Purge a deleted " + CamelCase(entityName),
+		Description: fmt.Sprintf(
+			"Permanently remove a soft-deleted %s entity by its ID. Entities which aren't soft-deleted can't be purged.",
+			entityName,
+		),
+		OperationID: GetSoftDeleteOperationID(OperationDelete, t),
+		Deprecated:  ta.Deprecated,
+		Responses: ogen.Responses{
+			strconv.Itoa(http.StatusNoContent): ogen.NewResponse().
+				SetDescription(fmt.Sprintf("The %s entity was purged.", entityName)),
+		},
+	}
+	addMaintenanceResponse(cfg, purge)
+
+	spec.Paths[GetSoftDeletePathName(OperationDelete, t, true)] = &ogen.PathItem{
+		Parameters: getIDParameterRefs(t),
+		Delete:     purge,
+	}
+	return spec, nil
+}
+
+// validateSoftDelete checks that a schema with soft deletes (see [SoftDeleteMixin]) has
+// a single ID field, the "deleted_at" field, and the delete operation, and that the
+// recycle bin endpoints don't conflict with the path of any edge.
+func validateSoftDelete(cfg *Config, t *gen.Type, ta *Annotation) (errs []error) {
+	if !ta.SoftDelete || ta.GetSkip(cfg) {
+		return nil
+	}
+
+	if t.ID == nil {
+		return []error{errors.New("soft deletes are only supported on schemas with a single ID field")}
+	}
+
+	if getSoftDeleteField(t) == nil {
+		return []error{fmt.Errorf("soft deletes require an optional and nillable %q time field (see SoftDeleteMixin)", SoftDeleteFieldName)}
+	}
+
+	if !ta.HasOperation(cfg, OperationDelete) {
+		return []error{errors.New("soft deletes are only supported on schemas with a delete operation")}
+	}
+
+	for _, e := range t.Edges {
+		if GetPathSegment(t, e) == "purge" {
+			errs = append(errs, fmt.Errorf("purge endpoint path conflicts with the path of edge %q", e.Name))
+		}
+	}
+	return errs
+}
@@ -0,0 +1,77 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/schema/field"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// injectSoftDelete adds the field of [SoftDeleteMixin] to the provided schema (based on
+// an existing time field, as fields can't be constructed outside of the graph), and
+// enables soft deletes on it.
+func injectSoftDelete(t *testing.T, g *gen.Graph, schema string) {
+	t.Helper()
+
+	var base *gen.Field
+	for _, n := range g.Nodes {
+		for _, f := range n.Fields {
+			if f.Type.Type == field.TypeTime {
+				base = f
+			}
+		}
+	}
+	require.NotNil(t, base)
+
+	for _, n := range g.Nodes {
+		if n.Name != schema {
+			continue
+		}
+
+		f := *base
+		f.Name = SoftDeleteFieldName
+		f.Optional = true
+		f.Nillable = true
+		f.Default = false
+		f.UpdateDefault = false
+		f.Immutable = false
+		f.Annotations = gen.Annotations{}
+		n.Fields = append(n.Fields, &f)
+	}
+
+	injectAnnotations(t, g, schema+"."+SoftDeleteFieldName, WithReadOnly(true))
+	injectAnnotations(t, g, schema, Annotation{SoftDelete: true})
+}
+
+func TestSpec_SoftDelete(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectSoftDelete(t, g, "Pet")
+			return nil
+		},
+	})
+
+	assert.Equal(t, "listDeletedPets", r.json(`$.paths./pets/deleted.get.operationId`))
+	assert.Equal(t, "#/components/schemas/PetList", r.json(`$.paths./pets/deleted.get.responses.200.content['application/json'].schema.$ref`))
+	assert.Equal(t, r.json(`$.paths./pets.get.parameters`), r.json(`$.paths./pets/deleted.get.parameters`))
+	assert.Equal(t, "listPets", r.json(`$.paths./pets.get.operationId`))
+
+	assert.Equal(t, "purgePet", r.json(`$.paths./pets/{petID}/purge.delete.operationId`))
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}/purge.delete.responses.204`))
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}/purge.delete.responses.403`))
+
+	assert.Nil(t, r.json(`$.components.schemas.PetCreate.properties.deleted_at`))
+	assert.Nil(t, r.json(`$.components.schemas.PetUpdate.properties.deleted_at`))
+	assert.NotNil(t, r.json(`$.components.schemas.Pet.properties.deleted_at`))
+
+	assert.Nil(t, r.json(`$.paths./users/deleted`))
+}
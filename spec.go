@@ -20,9 +20,9 @@ import (
 	"github.com/ogen-go/ogen/jsonschema"
 )
 
-const eagerLoadDepthMessage = "If the entity has eager-loaded edges, the depth of when those will be loaded is limited to a depth of 1 (entity -> edge, not entity -> edge -> edge -> etc)."
+const eagerLoadDepthMessage = "If the entity has eager-loaded edges, the depth of when those will be loaded is limited to a depth of 1 (entity -> edge, not entity -> edge -> edge -> etc), unless a larger depth or nested edges are configured for the edge."
 
-func addPagination(spec *ogen.Spec, _ *Config) {
+func addPagination(spec *ogen.Spec, cfg *Config) {
 	if spec.Components == nil {
 		spec.Components = &ogen.Components{}
 	}
@@ -31,14 +31,18 @@ func addPagination(spec *ogen.Spec, _ *Config) {
 		spec.Components.Parameters = make(map[string]*ogen.Parameter)
 	}
 
-	if _, ok := spec.Components.Schemas["Page"]; !ok {
+	if _, ok := spec.Components.Schemas["Page"]; !ok && cfg.Flavor == FlavorDefault {
 		spec.Components.Parameters["Page"] = &ogen.Parameter{
 			Name:        "page",
 			In:          "query",
-			Description: "The page number to retrieve.",
-			Schema: ogen.Int().
-				SetMinimum(ptr(int64(1))).
-				SetDefault(json.RawMessage(`1`)),
+			Description: `The page number to retrieve, or "first" or "last" for the first or last page of results.`,
+			Schema: &ogen.Schema{
+				OneOf: []*ogen.Schema{
+					ogen.Int().SetMinimum(ptr(int64(1))),
+					{Type: "string", Enum: sliceToRawMessage([]string{"first", "last"})},
+				},
+				Default: jsonschema.RawValue(`1`),
+			},
 		}
 	}
 
@@ -50,46 +54,28 @@ func addPagination(spec *ogen.Spec, _ *Config) {
 		return
 	}
 
+	if schema := getFlavorPagedSchema(cfg); schema != nil {
+		spec.Components.Schemas["PagedResponse"] = schema
+		return
+	}
+
 	pagedSchema := &ogen.Schema{
-		Type: "object",
-		Properties: ogen.Properties{
-			{
-				Name: "page",
-				Schema: &ogen.Schema{
-					Type:        "integer",
-					Description: "Page which the results are associated with.",
-					Example:     jsonschema.RawValue(`1`),
-					Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
-				},
-			},
-			{
-				Name: "last_page",
-				Schema: &ogen.Schema{
-					Type:        "integer",
-					Description: "The number of the last page of results.",
-					Example:     jsonschema.RawValue(`3`),
-					Minimum:     ogen.Int().SetMinimum(ptr(int64(1))).Minimum,
-				},
-			},
-			{
-				Name: "is_last_page",
-				Schema: &ogen.Schema{
-					Type:        "boolean",
-					Description: "If true, the current results are the last page of results.",
-					Example:     jsonschema.RawValue(`false`),
-				},
-			},
-			{
-				Name: "total_count",
-				Schema: &ogen.Schema{
-					Type:        "integer",
-					Description: "The total number of results based on the provided query.",
-					Example:     jsonschema.RawValue(`123`),
-					Minimum:     ogen.Int().SetMinimum(ptr(int64(0))).Minimum,
-				},
-			},
-		},
-		Required: []string{"page", "last_page", "is_last_page", "total_count"},
+		Type:       "object",
+		Properties: ogen.Properties{},
+		Required:   []string{},
+	}
+
+	for _, field := range AllPaginationFields {
+		name := getPaginationField(cfg, field)
+		if name == "" || field == PaginationFieldContent {
+			continue
+		}
+
+		pagedSchema.Properties = append(pagedSchema.Properties, ogen.Property{
+			Name:   name,
+			Schema: paginationFieldSchema(field),
+		})
+		pagedSchema.Required = append(pagedSchema.Required, name)
 	}
 
 	spec.Components.Schemas["PagedResponse"] = pagedSchema
@@ -140,7 +126,7 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 	cfg := GetConfig(t.Config)
 	ta := GetAnnotation(t)
 
-	entityName := Singularize(t.Name)
+	entityName := GetSchemaName(t)
 
 	spec := newBaseSpec(cfg)
 	spec.Tags = append(spec.Tags, ogen.Tag{
@@ -149,27 +135,26 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 	})
 
 	if op != OperationList && op != OperationCreate {
-		idSchema, err := GetSchemaField(t.ID)
-		if err != nil {
+		if err := addIDParameters(spec, t); err != nil {
 			return nil, err
 		}
-
-		spec.Components.Parameters[Singularize(t.Name)+"ID"] = &ogen.Parameter{
-			Name:        CamelCase(Singularize(t.Name)) + "ID",
-			In:          "path",
-			Description: fmt.Sprintf("The ID of the %s to act upon.", entityName),
-			Required:    true,
-			Schema:      idSchema,
-		}
 	}
 
 	for k, v := range GetSchemaType(t, op, nil) {
 		spec.Components.Schemas[k] = v
 	}
 
+	if cfg.WithExamples {
+		if err := addSchemaExamples(spec.Components.Schemas); err != nil {
+			return nil, err
+		}
+	}
+
+	var oper *ogen.Operation
+
 	switch op {
 	case OperationCreate:
-		oper := &ogen.Operation{
+		oper = &ogen.Operation{
 			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
 			Summary: cmp.Or(
 				ta.GetOperationSummary(op),
@@ -187,20 +172,41 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			Responses: ogen.Responses{
 				strconv.Itoa(http.StatusCreated): ogen.NewResponse().
 					SetDescription(fmt.Sprintf("The created %s entity.", entityName)).
-					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + entityName + "Read"}),
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(t)}),
 			},
 		}
 
+		switch ta.CreateResponse {
+		case CreateResponseLocation:
+			oper.Responses = ogen.Responses{
+				strconv.Itoa(http.StatusCreated): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The %s entity was created.", entityName)),
+			}
+		case CreateResponseNoContent:
+			oper.Responses = ogen.Responses{
+				strconv.Itoa(http.StatusNoContent): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The %s entity was created.", entityName)),
+			}
+		}
+
+		if hasCreateLocation(t) {
+			oper.Responses[strconv.Itoa(http.StatusCreated)].Headers = map[string]*ogen.Header{
+				"Location": {
+					Description: fmt.Sprintf("The path of the created %s entity.", entityName),
+					Schema:      ogen.String(),
+				},
+			}
+		}
+
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     oper.Summary,
 			Description: oper.Description,
-			Post:        oper,
 			Parameters: []*ogen.Parameter{
 				{Ref: "#/components/parameters/PrettyResponse"},
 			},
 		}
 	case OperationUpdate:
-		oper := &ogen.Operation{
+		oper = &ogen.Operation{
 			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
 			Summary: cmp.Or(
 				ta.GetOperationSummary(op),
@@ -219,21 +225,20 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			Responses: ogen.Responses{
 				strconv.Itoa(http.StatusOK): ogen.NewResponse().
 					SetDescription(fmt.Sprintf("The update %s entity.", entityName)).
-					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + entityName + "Read"}),
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(t)}),
 			},
 		}
 
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     fmt.Sprintf("Operate on a single %s entity", entityName),
 			Description: fmt.Sprintf("Operate on a single %s entity by its ID.", entityName),
-			Patch:       oper,
-			Parameters: []*ogen.Parameter{
-				{Ref: "#/components/parameters/PrettyResponse"},
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
-			},
+			Parameters: append(
+				[]*ogen.Parameter{{Ref: "#/components/parameters/PrettyResponse"}},
+				getIDParameterRefs(t)...,
+			),
 		}
 	case OperationRead:
-		oper := &ogen.Operation{
+		oper = &ogen.Operation{
 			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
 			Summary: cmp.Or(
 				ta.GetOperationSummary(op),
@@ -249,7 +254,7 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			Responses: ogen.Responses{
 				strconv.Itoa(http.StatusOK): ogen.NewResponse().
 					SetDescription(fmt.Sprintf("The requested %s entity.", entityName)).
-					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + entityName + "Read"}),
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(t)}),
 			},
 		}
 
@@ -260,14 +265,13 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     fmt.Sprintf("Operate on a single %s entity", entityName),
 			Description: fmt.Sprintf("Operate on a single %s entity by its ID.", entityName),
-			Get:         oper,
-			Parameters: []*ogen.Parameter{
-				{Ref: "#/components/parameters/PrettyResponse"},
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
-			},
+			Parameters: append(
+				[]*ogen.Parameter{{Ref: "#/components/parameters/PrettyResponse"}},
+				getIDParameterRefs(t)...,
+			),
 		}
 	case OperationList:
-		oper := &ogen.Operation{
+		oper = &ogen.Operation{
 			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
 			Summary: cmp.Or(
 				ta.GetOperationSummary(op),
@@ -290,22 +294,28 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		if ta.GetPagination(cfg, nil) {
 			addPagination(spec, cfg)
 
-			oper.Parameters = append(
-				oper.Parameters,
-				&ogen.Parameter{Ref: "#/components/parameters/Page"},
-				&ogen.Parameter{
-					Name:        "per_page",
-					In:          "query",
-					Description: "The number of entities to retrieve per page.",
-					Schema: ogen.Int().
-						SetMinimum(ptr(int64(ta.GetMinItemsPerPage(cfg)))).
-						SetMaximum(ptr(int64(ta.GetMaxItemsPerPage(cfg)))).
-						SetDefault(json.RawMessage(strconv.Itoa(ta.GetItemsPerPage(cfg)))),
-				},
+			if IsSeekPaginated(t) {
+				addSeekPagination(spec)
+			}
+
+			params, err := getPaginationParameters(
+				cfg,
+				t,
+				ta.GetMinItemsPerPage(cfg),
+				ta.GetMaxItemsPerPage(cfg),
+				ta.GetItemsPerPage(cfg),
 			)
+			if err != nil {
+				return nil, err
+			}
+			oper.Parameters = append(oper.Parameters, params...)
 		}
 
-		if sortable := GetSortableFields(t, nil); len(sortable) > 1 {
+		if sortable := GetSortableFields(t, nil); IsSeekPaginated(t) {
+			// Seek paginated results are always ordered by ID.
+		} else if len(sortable) > 1 && cfg.Flavor != FlavorDefault {
+			oper.Parameters = append(oper.Parameters, getFlavorOrderParameter(cfg, t, sortable, ta.GetDefaultSort(t.ID != nil), ta.GetDefaultOrder()))
+		} else if len(sortable) > 1 {
 			sortParam := &ogen.Parameter{
 				Name:        "sort",
 				In:          "query",
@@ -313,7 +323,7 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 				Schema:      &ogen.Schema{Ref: "#/components/schemas/" + addSortableFields(spec, t, sortable)},
 			}
 			if v := ta.GetDefaultSort(t.ID != nil); v != "" {
-				sortParam.Schema = sortParam.Schema.SetDefault(json.RawMessage(fmt.Sprintf("%q", v)))
+				sortParam.Schema = sortParam.Schema.SetDefault(json.RawMessage(fmt.Sprintf("%q", GetSortFieldName(t, v))))
 			}
 			orderParam := &ogen.Parameter{
 				Name:        "order",
@@ -328,7 +338,13 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			oper.Parameters = append(oper.Parameters, sortParam, orderParam)
 		}
 
-		if filters := GetFilterableFields(t, nil); len(filters) > 0 {
+		if cfg.Flavor != FlavorDefault {
+			if param := getFlavorFilterParameter(cfg, t); param != nil {
+				oper.Parameters = append(oper.Parameters, param)
+			}
+		}
+
+		if filters := GetFilterableFields(t, nil); len(filters) > 0 && cfg.Flavor == FlavorDefault {
 			oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/FilterOperation"})
 
 			for _, f := range filters {
@@ -338,7 +354,7 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			}
 		}
 
-		if groups := GetFilterGroups(t, nil); len(groups) > 0 {
+		if groups := GetFilterGroups(t, nil); len(groups) > 0 && cfg.Flavor == FlavorDefault {
 			for _, g := range groups {
 				for _, op := range g.Operations {
 					name := g.ComponentName(op)
@@ -348,6 +364,14 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 			}
 		}
 
+		if geoFilters := GetGeoFilters(t); len(geoFilters) > 0 && cfg.Flavor == FlavorDefault {
+			for _, f := range geoFilters {
+				name := f.ComponentName()
+				spec.Components.Parameters[name] = f.Parameter()
+				oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/" + name})
+			}
+		}
+
 		if cfg.AddEdgesToTags {
 			oper.Tags = append(oper.Tags, edgesToTags(cfg, t)...)
 		}
@@ -355,13 +379,12 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     oper.Summary,
 			Description: oper.Description,
-			Get:         oper,
 			Parameters: []*ogen.Parameter{
 				{Ref: "#/components/parameters/PrettyResponse"},
 			},
 		}
 	case OperationDelete:
-		oper := &ogen.Operation{
+		oper = &ogen.Operation{
 			Tags: sliceCompact(sliceOr(ta.Tags, append([]string{Pluralize(t.Name)}, ta.AdditionalTags...))),
 			Summary: cmp.Or(
 				ta.GetOperationSummary(op),
@@ -383,15 +406,30 @@ func GetSpecType(t *gen.Type, op Operation) (*ogen.Spec, error) { // nolint:funl
 		spec.Paths[GetPathName(op, t, nil, true)] = &ogen.PathItem{
 			Summary:     fmt.Sprintf("Operate on a single %s entity", entityName),
 			Description: fmt.Sprintf("Operate on a single %s entity by its ID.", entityName),
-			Delete:      oper,
-			Parameters: []*ogen.Parameter{
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
-			},
+			Parameters:  getIDParameterRefs(t),
 		}
 	default:
 		panic(fmt.Sprintf("unsupported operation %q", op))
 	}
 
+	if err := addChangedFields(spec, t, op, oper); err != nil {
+		return nil, err
+	}
+
+	method := ta.GetOperationMethod(op)
+	if op == OperationList && method != http.MethodGet {
+		moveQueryParametersToBody(spec, oper)
+	}
+	addRequestHeaders(oper, ta.RequestHeaders[op])
+	withResponseStatus(oper, ta.GetResponseStatus(op))
+	if op != OperationRead && op != OperationList {
+		addMaintenanceResponse(cfg, oper)
+	}
+	addDryRunParameter(spec, t, op, oper)
+	addCrossValidationRules(t, op, oper)
+	addDeduplicationDescription(t, op, oper)
+	withOperationMethod(spec.Paths[GetPathName(op, t, nil, true)], method, oper)
+
 	return spec, nil
 }
 
@@ -412,8 +450,8 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 		return nil, errors.New("edge has endpoint disabled or edge is eager-loaded with global config to disable endpoints for edges which are also eager-loaded")
 	}
 
-	rootEntityName := Singularize(t.Name)
-	refEntityName := Singularize(e.Type.Name)
+	rootEntityName := GetSchemaName(t)
+	refEntityName := GetSchemaName(e.Type)
 	entityName := Singularize(PascalCase(e.Name))
 
 	spec := newBaseSpec(cfg)
@@ -429,13 +467,13 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 		},
 	)
 
-	idSchema, err := GetSchemaField(t.ID)
+	idSchema, err := GetSchemaID(t)
 	if err != nil {
 		return nil, err
 	}
 
-	spec.Components.Parameters[Singularize(t.Name)+"ID"] = &ogen.Parameter{
-		Name:        CamelCase(Singularize(t.Name)) + "ID",
+	spec.Components.Parameters[GetSchemaName(t)+"ID"] = &ogen.Parameter{
+		Name:        GetParamName(t),
 		In:          "path",
 		Description: fmt.Sprintf("The ID of the %s to act upon.", rootEntityName),
 		Required:    true,
@@ -446,6 +484,12 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 		spec.Components.Schemas[k] = v
 	}
 
+	if cfg.WithExamples {
+		if err := addSchemaExamples(spec.Components.Schemas); err != nil {
+			return nil, err
+		}
+	}
+
 	switch op {
 	case OperationRead: // Unique.
 		if !e.Unique {
@@ -475,19 +519,27 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 			Responses: ogen.Responses{
 				strconv.Itoa(http.StatusOK): ogen.NewResponse().
 					SetDescription(fmt.Sprintf("The requested %s entity.", CamelCase(e.Name))).
-					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + refEntityName + "Read"}),
+					SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(e.Type)}),
 			},
 		}
 
+		if err = addParentFields(spec, t, e, oper); err != nil {
+			return nil, err
+		}
+
 		spec.Paths[GetPathName(op, t, e, true)] = &ogen.PathItem{
 			Summary:     oper.Summary,     // Will probably always be the same.
 			Description: oper.Description, // Will probably always be the same.
 			Get:         oper,
 			Parameters: []*ogen.Parameter{
 				{Ref: "#/components/parameters/PrettyResponse"},
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
+				{Ref: "#/components/parameters/" + GetSchemaName(t) + "ID"},
 			},
 		}
+
+		if IsReplaceableEdge(e) {
+			addEdgeReplace(spec, t, e)
+		}
 	case OperationList: // Not unique.
 		if e.Unique {
 			return nil, errors.New("edge is unique")
@@ -524,18 +576,24 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 
 		if ea.GetPagination(cfg, e) || ra.GetPagination(cfg, e) {
 			addPagination(spec, cfg)
-			oper.Parameters = append(oper.Parameters,
-				&ogen.Parameter{Ref: "#/components/parameters/Page"},
-				&ogen.Parameter{
-					Name:        "per_page",
-					In:          "query",
-					Description: "The number of entities to retrieve per page.",
-					Schema: ogen.Int().
-						SetMinimum(ptr(int64(ta.GetMinItemsPerPage(cfg)))).
-						SetMaximum(ptr(int64(ta.GetMaxItemsPerPage(cfg)))).
-						SetDefault(json.RawMessage(strconv.Itoa(ta.GetItemsPerPage(cfg)))),
-				},
+
+			if IsSeekPaginated(e.Type) {
+				addSeekPagination(spec)
+			}
+
+			// Page sizes set on the edge take precedence over those of the edge type, the
+			// same as the generated handlers.
+			params, err := getPaginationParameters(
+				cfg,
+				e.Type,
+				cmp.Or(ea.MinItemsPerPage, ra.GetMinItemsPerPage(cfg)),
+				cmp.Or(ea.MaxItemsPerPage, ra.GetMaxItemsPerPage(cfg)),
+				cmp.Or(ea.ItemsPerPage, ra.GetItemsPerPage(cfg)),
 			)
+			if err != nil {
+				return nil, err
+			}
+			oper.Parameters = append(oper.Parameters, params...)
 
 			// If edge pagination is enabled, but edge type is not paginated, we cannot re-use
 			// the paginated schema from the edge type.
@@ -552,15 +610,19 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 			})
 		}
 
-		if sortable := GetSortableFields(e.Type, nil); len(sortable) > 1 {
+		if sortable := GetSortableFields(e.Type, nil); IsSeekPaginated(e.Type) {
+			// Seek paginated results are always ordered by ID.
+		} else if len(sortable) > 1 && cfg.Flavor != FlavorDefault {
+			oper.Parameters = append(oper.Parameters, getFlavorOrderParameter(cfg, e.Type, sortable, ra.GetDefaultSort(e.Type.ID != nil), ra.GetDefaultOrder()))
+		} else if len(sortable) > 1 {
 			sortParam := &ogen.Parameter{
 				Name:        "sort",
 				In:          "query",
 				Description: "Sort entity results by the given field.",
 				Schema:      &ogen.Schema{Ref: "#/components/schemas/" + addSortableFields(spec, e.Type, sortable)},
 			}
-			if v := ra.GetDefaultSort(t.ID != nil && (e == nil || e.Field() == nil)); v != "" {
-				sortParam.Schema = sortParam.Schema.SetDefault(json.RawMessage(fmt.Sprintf("%q", v)))
+			if v := ra.GetDefaultSort(e.Type.ID != nil); v != "" {
+				sortParam.Schema = sortParam.Schema.SetDefault(json.RawMessage(fmt.Sprintf("%q", GetSortFieldName(e.Type, v))))
 			}
 			orderParam := &ogen.Parameter{
 				Name:        "order",
@@ -575,7 +637,13 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 			oper.Parameters = append(oper.Parameters, sortParam, orderParam)
 		}
 
-		if filters := GetFilterableFields(e.Type, nil); len(filters) > 0 {
+		if cfg.Flavor != FlavorDefault {
+			if param := getFlavorFilterParameter(cfg, e.Type); param != nil {
+				oper.Parameters = append(oper.Parameters, param)
+			}
+		}
+
+		if filters := GetFilterableFields(e.Type, nil); len(filters) > 0 && cfg.Flavor == FlavorDefault {
 			oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/FilterOperation"})
 
 			for _, f := range filters {
@@ -585,7 +653,7 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 			}
 		}
 
-		if groups := GetFilterGroups(e.Type, nil); len(groups) > 0 {
+		if groups := GetFilterGroups(e.Type, nil); len(groups) > 0 && cfg.Flavor == FlavorDefault {
 			for _, g := range groups {
 				for _, op := range g.Operations {
 					name := g.ComponentName(op)
@@ -595,19 +663,35 @@ func GetSpecEdge(t *gen.Type, e *gen.Edge, op Operation) (*ogen.Spec, error) { /
 			}
 		}
 
+		if geoFilters := GetGeoFilters(e.Type); len(geoFilters) > 0 && cfg.Flavor == FlavorDefault {
+			for _, f := range geoFilters {
+				name := f.ComponentName()
+				spec.Components.Parameters[name] = f.Parameter()
+				oper.Parameters = append(oper.Parameters, &ogen.Parameter{Ref: "#/components/parameters/" + name})
+			}
+		}
+
 		if cfg.AddEdgesToTags {
 			oper.Tags = append(oper.Tags, edgesToTags(cfg, e.Type)...)
 		}
 
+		if err = addParentFields(spec, t, e, oper); err != nil {
+			return nil, err
+		}
+
 		spec.Paths[GetPathName(op, t, e, true)] = &ogen.PathItem{
 			Summary:     oper.Summary,
 			Description: oper.Description,
 			Get:         oper,
 			Parameters: []*ogen.Parameter{
 				{Ref: "#/components/parameters/PrettyResponse"},
-				{Ref: "#/components/parameters/" + Singularize(t.Name) + "ID"},
+				{Ref: "#/components/parameters/" + GetSchemaName(t) + "ID"},
 			},
 		}
+
+		if IsThroughEdge(e) && ra.HasOperation(cfg, OperationCreate) {
+			addThroughEdgeCreate(spec, t, e)
+		}
 	default:
 		panic(fmt.Sprintf("unsupported operation %q", op))
 	}
@@ -627,23 +711,184 @@ func edgesToTags(cfg *Config, t *gen.Type) (tags []string) {
 	return tags
 }
 
+// hasCreateLocation returns true if the create response of the provided type includes
+// a "Location" header (see [WithCreateResponse] and [Config.ReadYourWrites]).
+func hasCreateLocation(t *gen.Type) bool {
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+
+	if ta.CreateResponse == CreateResponseLocation {
+		return true
+	}
+
+	return cfg.ReadYourWrites &&
+		ta.CreateResponse == CreateResponseBody &&
+		t.ID != nil &&
+		ta.HasOperation(cfg, OperationRead)
+}
+
+// hasCreateResponses returns true if any of the provided types, which have create
+// handlers, use one of the provided create responses.
+func hasCreateResponses(nodes []*gen.Type, responses ...CreateResponse) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		cfg := GetConfig(t.Config)
+		ta := GetAnnotation(t)
+		return !ta.GetSkip(cfg) &&
+			!ta.DisableHandler &&
+			ta.HasOperation(cfg, OperationCreate) &&
+			slices.Contains(responses, ta.CreateResponse)
+	})
+}
+
+// hasCreateLocations returns true if any of the provided types, which have create
+// handlers, include a "Location" header in their create response.
+func hasCreateLocations(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		cfg := GetConfig(t.Config)
+		ta := GetAnnotation(t)
+		return !ta.GetSkip(cfg) &&
+			!ta.DisableHandler &&
+			ta.HasOperation(cfg, OperationCreate) &&
+			hasCreateLocation(t)
+	})
+}
+
+// hasResponseStatuses returns true if any of the provided types, which have handlers,
+// use a non-default status code for any of their operations.
+func hasResponseStatuses(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		cfg := GetConfig(t.Config)
+		ta := GetAnnotation(t)
+		return !ta.GetSkip(cfg) &&
+			!ta.DisableHandler &&
+			slices.ContainsFunc(ta.GetOperations(cfg), func(op Operation) bool {
+				return ta.GetResponseStatus(op) != defaultResponseStatuses[op]
+			})
+	})
+}
+
+// wrapResponseStatus wraps the provided handler (Go expression) of the provided
+// operation, so it responds with the status code of the operation, if it isn't the
+// default (see [WithResponseStatus]).
+func wrapResponseStatus(t *gen.Type, op Operation, handler string) string {
+	if code := GetAnnotation(t).GetResponseStatus(op); code != defaultResponseStatuses[op] {
+		return fmt.Sprintf("withResponseStatus(%d, %s)", code, handler)
+	}
+	return handler
+}
+
 // addSortableFields adds a schema entry for the provided type into the spec, returning
 // the name of the schema entry.
 func addSortableFields(spec *ogen.Spec, t *gen.Type, fields []string) (ref string) {
-	ref = Singularize(t.Name) + "SortableFields"
+	ref = GetSchemaName(t) + "SortableFields"
+
+	names := make([]string, len(fields))
+	for i, field := range fields {
+		names[i] = GetSortFieldName(t, field)
+	}
 
 	s := &ogen.Schema{
-		Description: "All potential sortable fields for " + Singularize(t.Name) + " entities.",
+		Description: "All potential sortable fields for " + GetSchemaName(t) + " entities.",
 		Type:        "string",
-		Enum:        sliceToRawMessage(fields),
+		Enum:        sliceToRawMessage(names),
 	}
 	if t.ID != nil {
-		s.Default = jsonschema.RawValue(`"id"`)
+		s.Default = jsonschema.RawValue(strconv.Quote(GetSortFieldName(t, "id")))
 	}
 	spec.Components.Schemas[ref] = s
 	return ref
 }
 
+// parameterLocationOrder is the order in which parameters are sorted by location when
+// canonicalizing the spec. Parameters with unknown locations are sorted last.
+var parameterLocationOrder = map[string]int{
+	"path":   0,
+	"query":  1,
+	"header": 2,
+	"cookie": 3,
+}
+
+// CanonicalizeSpec applies a canonicalization pass to the provided spec, ensuring that
+// the output is stable between runs. Maps (paths, components, responses, etc) are
+// already sorted by key when marshalled, so this only sorts slices where the order
+// has no semantic meaning:
+//   - Parameters are deduplicated, and stably sorted by location, then by the order
+//     they were added within that location.
+//   - Operation tags and required properties are deduplicated.
+//
+// Spec-level tags are intentionally left as-is, as their order is used by most
+// documentation UIs.
+func CanonicalizeSpec(spec *ogen.Spec) {
+	sortParams := func(params []*ogen.Parameter) []*ogen.Parameter {
+		seen := map[string]struct{}{}
+		params = slices.DeleteFunc(params, func(p *ogen.Parameter) bool {
+			if p == nil {
+				return true
+			}
+			key := p.Ref + "|" + p.In + "|" + p.Name
+			if _, ok := seen[key]; ok {
+				return true
+			}
+			seen[key] = struct{}{}
+			return false
+		})
+		slices.SortStableFunc(params, func(a, b *ogen.Parameter) int {
+			return cmp.Compare(parameterLocation(spec, a), parameterLocation(spec, b))
+		})
+		return params
+	}
+
+	for _, pathName := range mapKeys(spec.Paths) {
+		item := spec.Paths[pathName]
+		if item == nil {
+			continue
+		}
+
+		item.Parameters = sortParams(item.Parameters)
+
+		PatchOperations(item, func(_ string, op *ogen.Operation) *ogen.Operation {
+			if op == nil {
+				return nil
+			}
+			op.Parameters = sortParams(op.Parameters)
+			op.Tags = sliceCompact(op.Tags)
+			return op
+		})
+	}
+
+	if spec.Components != nil {
+		for _, k := range mapKeys(spec.Components.Schemas) {
+			if s := spec.Components.Schemas[k]; s != nil && len(s.Required) > 0 {
+				s.Required = sliceCompact(s.Required)
+			}
+		}
+	}
+}
+
+// CanonicalizeGraph stably sorts the types of the provided graph by name, so the
+// declarations generated for them (which iterate over the types of the graph) are in
+// the same order between runs, regardless of the order the schemas were loaded or
+// added in. Fields and edges are left as-is, as their order is defined by the schema.
+func CanonicalizeGraph(g *gen.Graph) {
+	slices.SortStableFunc(g.Nodes, func(a, b *gen.Type) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+}
+
+// parameterLocation returns the sort order of the location of the provided parameter,
+// resolving component references where possible.
+func parameterLocation(spec *ogen.Spec, p *ogen.Parameter) int {
+	if p.Ref != "" && spec.Components != nil {
+		if ref, ok := spec.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]; ok && ref != nil {
+			p = ref
+		}
+	}
+	if v, ok := parameterLocationOrder[p.In]; ok {
+		return v
+	}
+	return len(parameterLocationOrder)
+}
+
 // addGlobalRequestHeaders adds the given headers to shared component parameters,
 // then adds each of those parameters to each path root (rather than each request,
 // to deduplicate references for those headers).
@@ -656,7 +901,7 @@ func addGlobalRequestHeaders(spec *ogen.Spec, headers map[string]*ogen.Header) {
 	}
 
 	for pathName := range spec.Paths {
-		for k := range headers {
+		for _, k := range mapKeys(headers) {
 			spec.Paths[pathName].Parameters = append(
 				spec.Paths[pathName].Parameters,
 				&ogen.Parameter{Ref: "#/components/parameters/" + k},
@@ -753,6 +998,11 @@ func addGlobalErrorResponses(cfg *Config, spec *ogen.Spec, responses map[int]*og
 					continue
 				case !strings.HasPrefix(op.OperationID, "create") && !strings.HasPrefix(op.OperationID, "update") && k == http.StatusConflict:
 					continue
+				case !strings.HasPrefix(op.OperationID, "create") &&
+					!strings.HasPrefix(op.OperationID, "update") &&
+					k != http.StatusBadRequest &&
+					(k == cfg.ValidationErrorStatus || (cfg.StrictMutate && k == cfg.UnknownFieldErrorStatus)):
+					continue
 				}
 
 				op.Responses[strconv.Itoa(k)] = &ogen.Response{Ref: "#/components/responses/Error" + PascalCase(http.StatusText(k))}
@@ -824,50 +1074,33 @@ func GetOperationIDName(op Operation, t *gen.Type, e *gen.Edge) string {
 		if id := GetAnnotation(e).GetOperationID(op); id != "" {
 			return id
 		}
-
-		switch op {
-		case OperationRead:
-			return "get" + Singularize(t.Name) + Singularize(PascalCase(e.Name))
-		case OperationList:
-			return "list" + Singularize(t.Name) + Pluralize(PascalCase(e.Name))
-		default:
-			panic(fmt.Sprintf("unsupported operation %q", op))
-		}
-	}
-
-	if id := GetAnnotation(t).GetOperationID(op); id != "" {
+	} else if id := GetAnnotation(t).GetOperationID(op); id != "" {
 		return id
 	}
 
-	switch op {
-	case OperationCreate:
-		return "create" + Singularize(t.Name)
-	case OperationUpdate:
-		return "update" + Singularize(t.Name)
-	case OperationRead:
-		return "get" + Singularize(t.Name)
-	case OperationList:
-		return "list" + Pluralize(t.Name)
-	case OperationDelete:
-		return "delete" + Singularize(t.Name)
-	default:
-		panic(fmt.Sprintf("unsupported operation %q", op))
-	}
+	return GetNamer(t).OperationID(op, t, e)
 }
 
 // GetPathName returns the path name for the given operation, type, and optional edge,
-// or the OperationID provided by the annotation if it exists. useUniqueID determines
-// if the ID path parameter should be "{id}" or "{type|camel}ID".
+// or the OperationID provided by the annotation if it exists. Paths of schemas with a
+// group (see [WithGroup]) are nested under the group. useUniqueID determines
+// if the ID path parameter should be "{id}" or "{type|camel}ID". Types with a composite
+// ID always use one path parameter per field of the ID (see [GetCompositeIDFields]).
 func GetPathName(op Operation, t *gen.Type, e *gen.Edge, useUniqueID bool) string {
 	id := "{id}"
 	if useUniqueID {
-		id = "{" + CamelCase(Singularize(t.Name)) + "ID}"
+		id = "{" + GetParamName(t) + "}"
+	}
+
+	base := "/" + GetPathSegment(t, nil)
+	if group := GetAnnotation(t).Group; group != "" {
+		base = "/" + group + base
 	}
 
 	if e != nil {
 		switch op {
-		case OperationRead, OperationList:
-			return "/" + Pluralize(KebabCase(t.Name)) + "/" + id + "/" + KebabCase(e.Name)
+		case OperationRead, OperationList, OperationCreate, OperationUpdate, OperationDelete:
+			return base + "/" + id + "/" + GetPathSegment(t, e)
 		default:
 			panic(fmt.Sprintf("unsupported operation %q", op))
 		}
@@ -875,9 +1108,12 @@ func GetPathName(op Operation, t *gen.Type, e *gen.Edge, useUniqueID bool) strin
 
 	switch op {
 	case OperationRead, OperationUpdate, OperationDelete:
-		return "/" + Pluralize(KebabCase(t.Name)) + "/" + id
+		if t.HasCompositeID() {
+			return base + "/" + getCompositeIDPath(t)
+		}
+		return base + "/" + id
 	case OperationCreate, OperationList:
-		return "/" + Pluralize(KebabCase(t.Name))
+		return base
 	default:
 		panic(fmt.Sprintf("unsupported operation %q", op))
 	}
@@ -896,6 +1132,80 @@ func PatchOperations(pathItem *ogen.PathItem, cb func(method string, op *ogen.Op
 	return pathItem
 }
 
+// withOperationMethod sets the operation of the provided path item for the provided HTTP
+// method.
+func withOperationMethod(pathItem *ogen.PathItem, method string, oper *ogen.Operation) *ogen.PathItem {
+	return PatchOperations(pathItem, func(m string, op *ogen.Operation) *ogen.Operation {
+		if m == method {
+			return oper
+		}
+		return op
+	})
+}
+
+// withResponseStatus moves the successful (2xx) response of the provided operation to
+// the provided status code (see [WithResponseStatus]).
+func withResponseStatus(oper *ogen.Operation, code int) {
+	status := strconv.Itoa(code)
+
+	for k, v := range oper.Responses {
+		if k == status || !strings.HasPrefix(k, "2") {
+			continue
+		}
+
+		delete(oper.Responses, k)
+		oper.Responses[status] = v
+	}
+}
+
+// moveQueryParametersToBody moves all query parameters of the provided operation into
+// a form-encoded request body, for operations which would otherwise use GET, but are
+// exposed through a method with a request body (see [WithOperationMethod]). Parameter
+// references are resolved against the components of the provided spec.
+func moveQueryParametersToBody(spec *ogen.Spec, oper *ogen.Operation) {
+	schema := &ogen.Schema{Type: "object", Properties: ogen.Properties{}}
+
+	oper.Parameters = slices.DeleteFunc(oper.Parameters, func(p *ogen.Parameter) bool {
+		param := p
+		if p.Ref != "" {
+			param = spec.Components.Parameters[strings.TrimPrefix(p.Ref, "#/components/parameters/")]
+		}
+
+		if param == nil || param.In != "query" || param.Schema == nil {
+			return false
+		}
+
+		prop := *param.Schema
+		prop.Description = cmp.Or(prop.Description, param.Description)
+		prop.Deprecated = prop.Deprecated || param.Deprecated
+		if prop.Ref != "" {
+			// Siblings of references are ignored, so wrap the reference instead.
+			prop = ogen.Schema{
+				Description: prop.Description,
+				Deprecated:  prop.Deprecated,
+				AllOf:       []*ogen.Schema{{Ref: prop.Ref}},
+			}
+		}
+
+		schema.Properties = append(schema.Properties, *prop.ToProperty(param.Name))
+		if param.Required {
+			schema.Required = append(schema.Required, param.Name)
+		}
+		return true
+	})
+
+	if len(schema.Properties) == 0 {
+		return
+	}
+
+	oper.RequestBody = &ogen.RequestBody{
+		Required: len(schema.Required) > 0,
+		Content: map[string]ogen.Media{
+			"application/x-www-form-urlencoded": {Schema: schema},
+		},
+	}
+}
+
 // PatchPathItem applies a callback to each response in a path inside of the OpenAPI spec.
 func PatchPathItem(pathItem *ogen.PathItem, cb func(resp *ogen.Response) *ogen.Response) *ogen.PathItem {
 	return PatchOperations(pathItem, func(_ string, op *ogen.Operation) *ogen.Operation {
@@ -1130,22 +1440,3 @@ func MergeSpec(orig *ogen.Spec, toMerge ...*ogen.Spec) error {
 func MergeSpecOverlap(orig *ogen.Spec, toMerge ...*ogen.Spec) error {
 	return mergeSpec(true, orig, toMerge...)
 }
-
-// addOpenAPIEndpoint adds an endpoint to the OpenAPI spec that returns the OpenAPI
-// spec itself, as JSON.
-func addOpenAPIEndpoint(path string) *ogen.Spec {
-	return ogen.NewSpec().AddPathItem(path, ogen.NewPathItem().
-		SetGet(
-			ogen.NewOperation().
-				SetSummary("Get OpenAPI spec").
-				SetDescription("Get the OpenAPI specification for this service.").
-				SetOperationID("getOpenAPI").
-				SetTags([]string{"Meta"}).
-				SetResponses(map[string]*ogen.Response{
-					strconv.Itoa(http.StatusOK): ogen.NewResponse().
-						SetDescription("OpenAPI specification was found").
-						SetJSONContent(SchemaObjectAny),
-				}),
-		),
-	)
-}
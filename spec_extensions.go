@@ -0,0 +1,345 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/go-faster/jx"
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+// OperationExtensions are spec extensions to add to operations, keyed by a glob pattern
+// (see [path.Match]) of operation IDs, e.g.:
+//
+//	{
+//	    "*":         {"x-auth-scopes": []string{"api"}},
+//	    "createPet": {"x-lambda-arn": "arn:aws:lambda:us-east-1:123456789012:function:create-pet"},
+//	}
+type OperationExtensions map[string]map[string]any
+
+// validate checks that all patterns are valid, and that all extensions are prefixed
+// with "x-".
+func (e OperationExtensions) validate() error {
+	for pattern, exts := range e {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid operation extension pattern %q: %w", pattern, err)
+		}
+
+		for k := range exts {
+			if !strings.HasPrefix(k, "x-") {
+				return fmt.Errorf("operation extension %q (pattern %q) must be prefixed with \"x-\"", k, pattern)
+			}
+		}
+	}
+	return nil
+}
+
+// merge merges the provided extensions into e, overriding existing extensions with
+// the same pattern and name.
+func (e OperationExtensions) merge(other OperationExtensions) {
+	for pattern, exts := range other {
+		if e[pattern] == nil {
+			e[pattern] = make(map[string]any, len(exts))
+		}
+		maps.Copy(e[pattern], exts)
+	}
+}
+
+// patterns returns the patterns of the extensions, in the order they should be
+// applied. Patterns containing wildcards are applied first, so extensions for a
+// specific operation ID take precedence.
+func (e OperationExtensions) patterns() []string {
+	patterns := mapKeys(e)
+	slices.SortStableFunc(patterns, func(a, b string) int {
+		aw, bw := strings.ContainsAny(a, `*?[\`), strings.ContainsAny(b, `*?[\`)
+		switch {
+		case aw && !bw:
+			return -1
+		case !aw && bw:
+			return 1
+		default:
+			return 0
+		}
+	})
+	return patterns
+}
+
+// loadOperationExtensions reads operation extensions (see [OperationExtensions]) from
+// the provided JSON or YAML file.
+func loadOperationExtensions(fn string) (OperationExtensions, error) {
+	b, err := os.ReadFile(fn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read operation extensions from path %q: %w", fn, err)
+	}
+
+	exts := OperationExtensions{}
+	err = yaml.Unmarshal(b, &exts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode operation extensions from path %q: %w", fn, err)
+	}
+
+	err = exts.validate()
+	if err != nil {
+		return nil, fmt.Errorf("invalid operation extensions in path %q: %w", fn, err)
+	}
+	return exts, nil
+}
+
+// addOperationExtensions adds the provided extensions to all operations in the spec
+// with a matching operation ID.
+func addOperationExtensions(spec *ogen.Spec, exts OperationExtensions) error {
+	if len(exts) == 0 {
+		return nil
+	}
+
+	patterns := exts.patterns()
+
+	var err error
+	for _, pathName := range mapKeys(spec.Paths) {
+		PatchOperations(spec.Paths[pathName], func(_ string, op *ogen.Operation) *ogen.Operation {
+			if op == nil || op.OperationID == "" || err != nil {
+				return op
+			}
+
+			for _, pattern := range patterns {
+				if ok, _ := path.Match(pattern, op.OperationID); !ok {
+					continue
+				}
+
+				for _, k := range mapKeys(exts[pattern]) {
+					var node yaml.Node
+					if err = node.Encode(exts[pattern][k]); err != nil {
+						err = fmt.Errorf("failed to encode operation extension %q: %w", k, err)
+						return op
+					}
+
+					if op.Common.Extensions == nil {
+						op.Common.Extensions = ogen.Extensions{}
+					}
+					op.Common.Extensions[k] = node
+				}
+			}
+			return op
+		})
+	}
+	return err
+}
+
+// specEncoder re-encodes a JSON encoded spec, allowing fields to be replaced or added.
+type specEncoder struct {
+	e *jx.Encoder
+}
+
+// copyRaw copies the value from d, as-is.
+func (se *specEncoder) copyRaw(d *jx.Decoder) error {
+	raw, err := d.Raw()
+	if err != nil {
+		return err
+	}
+	se.e.Raw(raw)
+	return nil
+}
+
+// copyObj copies the object from d, invoking fn for each field, which must consume the
+// value of the field. If provided, extra is invoked before the end of the object, to
+// add additional fields.
+func (se *specEncoder) copyObj(d *jx.Decoder, fn func(d *jx.Decoder, key string) error, extra func() error) error {
+	se.e.ObjStart()
+	err := d.Obj(func(d *jx.Decoder, key string) error {
+		se.e.FieldStart(key)
+		return fn(d, key)
+	})
+	if err == nil && extra != nil {
+		err = extra()
+	}
+	se.e.ObjEnd()
+	return err
+}
+
+// writeExtensions writes the provided extensions as fields of the current object, in
+// sorted order, skipping the provided existing fields.
+func (se *specEncoder) writeExtensions(exts ogen.Extensions, existing []string) error {
+	for _, k := range mapKeys(exts) {
+		if slices.Contains(existing, k) {
+			continue
+		}
+
+		raw, err := marshalExtension(k, exts[k])
+		if err != nil {
+			return err
+		}
+		se.e.FieldStart(k)
+		se.e.Raw(raw)
+	}
+	return nil
+}
+
+// marshalExtension encodes the provided extension value as JSON.
+func marshalExtension(name string, node yaml.Node) ([]byte, error) {
+	var v any
+	if err := node.Decode(&v); err != nil {
+		return nil, fmt.Errorf("failed to decode extension %q: %w", name, err)
+	}
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal extension %q: %w", name, err)
+	}
+	return raw, nil
+}
+
+// marshalOperationExtensions re-encodes the extensions of all operations within the
+// provided JSON encoded spec, as [ogen.Operation] encodes all extensions as strings,
+// regardless of their type.
+func marshalOperationExtensions(spec *ogen.Spec, b []byte) ([]byte, error) {
+	var found bool
+	for _, item := range spec.Paths {
+		PatchOperations(item, func(_ string, op *ogen.Operation) *ogen.Operation {
+			found = found || (op != nil && len(op.Common.Extensions) > 0)
+			return op
+		})
+	}
+
+	if !found {
+		return b, nil
+	}
+
+	se := &specEncoder{e: &jx.Encoder{}}
+
+	err := se.copyObj(jx.DecodeBytes(b), func(d *jx.Decoder, key string) error {
+		if key != "paths" {
+			return se.copyRaw(d)
+		}
+
+		return se.copyObj(d, func(d *jx.Decoder, pathName string) error {
+			operations := map[string]*ogen.Operation{}
+			if item := spec.Paths[pathName]; item != nil {
+				PatchOperations(item, func(method string, op *ogen.Operation) *ogen.Operation {
+					operations[strings.ToLower(method)] = op
+					return op
+				})
+			}
+
+			return se.copyObj(d, func(d *jx.Decoder, method string) error {
+				op := operations[method]
+				if op == nil || len(op.Common.Extensions) == 0 {
+					return se.copyRaw(d)
+				}
+
+				return se.copyObj(d, func(d *jx.Decoder, field string) error {
+					node, ok := op.Common.Extensions[field]
+					if !ok {
+						return se.copyRaw(d)
+					}
+
+					if err := d.Skip(); err != nil {
+						return err
+					}
+
+					raw, err := marshalExtension(field, node)
+					if err != nil {
+						return fmt.Errorf("operation %s: %w", op.OperationID, err)
+					}
+					se.e.Raw(raw)
+					return nil
+				}, nil)
+			}, nil)
+		}, nil)
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	return se.e.Bytes(), nil
+}
+
+// marshalSchemaExtensions adds the extensions of all component schemas (and of their
+// properties) to the provided JSON encoded spec, as [ogen.Schema] doesn't encode extensions
+// to JSON. This is also used for keywords which [ogen.Schema] doesn't support (e.g.
+// "readOnly" for computed fields, see [WithComputedField]).
+func marshalSchemaExtensions(spec *ogen.Spec, b []byte) ([]byte, error) {
+	if spec.Components == nil {
+		return b, nil
+	}
+
+	var found bool
+	for _, schema := range spec.Components.Schemas {
+		found = found || (schema != nil && len(schema.Common.Extensions) > 0)
+		for _, prop := range schema.Properties {
+			found = found || (prop.Schema != nil && len(prop.Schema.Common.Extensions) > 0)
+		}
+	}
+
+	if !found {
+		return b, nil
+	}
+
+	se := &specEncoder{e: &jx.Encoder{}}
+
+	// copyKey copies the object from d, only descending into the provided key.
+	copyKey := func(d *jx.Decoder, key string, fn func(d *jx.Decoder) error) error {
+		return se.copyObj(d, func(d *jx.Decoder, k string) error {
+			if k != key {
+				return se.copyRaw(d)
+			}
+			return fn(d)
+		}, nil)
+	}
+
+	err := copyKey(jx.DecodeBytes(b), "components", func(d *jx.Decoder) error {
+		return copyKey(d, "schemas", func(d *jx.Decoder) error {
+			return se.copyObj(d, func(d *jx.Decoder, name string) error {
+				schema := spec.Components.Schemas[name]
+				if schema == nil {
+					return se.copyRaw(d)
+				}
+
+				var keys []string
+				return se.copyObj(d, func(d *jx.Decoder, key string) error {
+					keys = append(keys, key)
+					if key != "properties" {
+						return se.copyRaw(d)
+					}
+
+					return se.copyObj(d, func(d *jx.Decoder, propName string) error {
+						idx := slices.IndexFunc(schema.Properties, func(p ogen.Property) bool { return p.Name == propName })
+						if idx == -1 || schema.Properties[idx].Schema == nil || len(schema.Properties[idx].Schema.Common.Extensions) == 0 {
+							return se.copyRaw(d)
+						}
+
+						var existing []string
+						return se.copyObj(d, func(d *jx.Decoder, field string) error {
+							existing = append(existing, field)
+							return se.copyRaw(d)
+						}, func() error {
+							err := se.writeExtensions(schema.Properties[idx].Schema.Common.Extensions, existing)
+							if err != nil {
+								return fmt.Errorf("schema %s property %s: %w", name, propName, err)
+							}
+							return nil
+						})
+					}, nil)
+				}, func() error {
+					err := se.writeExtensions(schema.Common.Extensions, keys)
+					if err != nil {
+						return fmt.Errorf("schema %s: %w", name, err)
+					}
+					return nil
+				})
+			}, nil)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return se.e.Bytes(), nil
+}
@@ -0,0 +1,63 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_OperationExtensions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("config", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			OperationExtensions: OperationExtensions{
+				"*":       {"x-auth-scopes": []string{"api"}},
+				"*Pet":    {"x-lambda-arn": "pets"},
+				"getPet":  {"x-lambda-arn": "get-pet"},
+				"listFoo": {"x-unused": true},
+			},
+		})
+
+		assert.Equal(t, []any{"api"}, r.json(`$.paths./users.get.x-auth-scopes`))
+		assert.Equal(t, "pets", r.json(`$.paths./pets.post.x-lambda-arn`))
+		assert.Equal(t, "get-pet", r.json(`$.paths./pets/{petID}.get.x-lambda-arn`))
+		assert.Nil(t, r.json(`$.paths./users.get.x-lambda-arn`))
+	})
+
+	t.Run("from-path", func(t *testing.T) {
+		t.Parallel()
+
+		fn := filepath.Join(t.TempDir(), "extensions.yaml")
+		require.NoError(t, os.WriteFile(fn, []byte("listPets:\n  x-lambda-arn: from-file\n  x-cache-ttl: 60\n"), 0o600))
+
+		r := mustBuildSpec(t, &Config{
+			OperationExtensionsFromPath: fn,
+			OperationExtensions: OperationExtensions{
+				"listPets": {"x-lambda-arn": "from-config"},
+			},
+		})
+
+		assert.Equal(t, "from-config", r.json(`$.paths./pets.get.x-lambda-arn`))
+		assert.InDelta(t, 60, r.json(`$.paths./pets.get.x-cache-ttl`), 0)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewExtension(&Config{OperationExtensions: OperationExtensions{"*": {"lambda-arn": "foo"}}})
+		require.ErrorContains(t, err, `must be prefixed with "x-"`)
+
+		_, err = NewExtension(&Config{OperationExtensions: OperationExtensions{"[": {"x-foo": "foo"}}})
+		require.ErrorContains(t, err, "invalid operation extension pattern")
+	})
+}
@@ -6,12 +6,14 @@ package entrest
 
 import (
 	"encoding/json"
+	"maps"
 	"net/http"
 	"slices"
 	"strings"
 	"testing"
 
 	"entgo.io/ent/entc/gen"
+	"entgo.io/ent/entc/load"
 	"github.com/ogen-go/ogen"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -24,12 +26,12 @@ func TestSpec_ThroughSchema_TwoTypes(t *testing.T) {
 	// Through schemas can be a bit different than normal schemas. Primarily:
 	//   - they may not have an ID field (if composite of two different IDs
 	//     via field.ID() annotation).
-	//   - if they don't have an ID, you cannot individually query them.
-	//   - you should still be able to create them in isolation, but updates/deletes
-	//     either require a where() clause (which we don't do outright), or must
-	//     be deleted through the edges in which they are attached (e.g. on a
-	//     Pet, we have remove_users_following, which removes the user from the
-	//     list of users following the pet).
+	//   - if they have a composite ID, they are individually queried through one
+	//     path parameter per field of the ID (e.g. /follows/{user_id}/{pet_id}).
+	//   - they can still be created in isolation, or attached/removed through the
+	//     edges in which they are attached (e.g. on a Pet, we have
+	//     remove_users_following, which removes the user from the list of users
+	//     following the pet).
 
 	assert.NotNil(t, r.json(`$.paths./follows.get.responses.200`))
 	assert.NotNil(t, r.json(`$.paths./follows.post.responses.201`))
@@ -44,9 +46,15 @@ func TestSpec_ThroughSchema_TwoTypes(t *testing.T) {
 	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/follows"))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/pets/{petID}/followed-by"))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/users/{userID}/followed-pets"))
+	assert.ElementsMatch(
+		t,
+		[]string{http.MethodGet, http.MethodPatch, http.MethodDelete},
+		getPathMethods(t, r, "/follows/{user_id}/{pet_id}"),
+	)
 
 	allowedPaths := []string{
 		"/follows",
+		"/follows/{user_id}/{pet_id}",
 		"/pets/{petID}/followed-by",
 		"/users/{userID}/followed-pets",
 	}
@@ -72,7 +80,7 @@ func TestSpec_ThroughSchema_OneType(t *testing.T) {
 	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPatch, http.MethodDelete}, getPathMethods(t, r, "/friendships/{friendshipID}"))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/friendships/{friendshipID}/friend"))
 	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/friendships/{friendshipID}/user"))
-	assert.ElementsMatch(t, []string{http.MethodGet}, getPathMethods(t, r, "/users/{userID}/friendships"))
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/users/{userID}/friendships"))
 
 	allowedPaths := []string{
 		"/friendships",
@@ -140,6 +148,165 @@ var testRequiredMethods = []string{
 	http.MethodTrace,
 }
 
+func TestSpec_EdgeListParity(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithItemsPerPage(15), WithMaxItemsPerPage(50))
+			injectAnnotations(t, g, "Pet.name", WithFilter(FilterGroupEqual), WithSortable(true))
+			injectAnnotations(t, g, "User.pets", WithMaxItemsPerPage(25))
+			return nil
+		},
+	})
+
+	base := `$.paths./users/{userID}/pets.get.parameters[?(@.name == "per_page")].schema`
+
+	// Edge annotations take precedence, falling back to the edge type.
+	assert.Equal(t, float64(25), r.json(base+`.maximum`)) //nolint:all
+	assert.Equal(t, float64(15), r.json(base+`.default`)) //nolint:all
+
+	for _, path := range []string{"/pets", "/users/{userID}/pets"} {
+		params := r.json(`$.paths.` + path + `.get.parameters[*].$ref`)
+		assert.Contains(t, params, "#/components/parameters/PetNameEQ", path)
+		assert.Contains(t, params, "#/components/parameters/FilterOperation", path)
+		assert.Contains(t, r.json(`$.paths.`+path+`.get.parameters[?(@.name == "sort")].schema.$ref`), "/PetSortableFields", path)
+	}
+}
+
+func TestSpec_OperationMethod(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithOperationMethod(OperationUpdate, "put"))
+			injectAnnotations(
+				t, g, "Category",
+				WithExcludeOperations(OperationCreate),
+				WithOperationMethod(OperationList, http.MethodPost),
+			)
+			injectAnnotations(t, g, "Category.name", WithFilter(FilterGroupEqual))
+			return nil
+		},
+	})
+
+	assert.Equal(t, []string{http.MethodGet, http.MethodPut, http.MethodDelete}, getPathMethods(t, r, "/pets/{petID}"))
+	assert.Equal(t, "updatePet", r.json(`$.paths./pets/{petID}.put.operationId`))
+
+	assert.Equal(t, []string{http.MethodPost}, getPathMethods(t, r, "/categories"))
+	assert.Equal(t, "listCategories", r.json(`$.paths./categories.post.operationId`))
+
+	// Query parameters of lists are moved into a form-encoded request body.
+	body := `$.paths./categories.post.requestBody.content.application/x-www-form-urlencoded.schema`
+	assert.Equal(t, "integer", r.json(body+`.properties.per_page.type`))
+	assert.Equal(t, "#/components/schemas/FilterOperation", r.json(body+`.properties.filter_op.allOf[0].$ref`))
+	assert.Equal(t, "string", r.json(body+`.properties['name.eq'].type`))
+	assert.Nil(t, r.json(`$.paths./categories.post.parameters[?(@.name == "per_page")]`))
+}
+
+func TestSpec_OperationGate(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		OperationGate: func(schema string, op Operation) bool {
+			switch schema {
+			case "Pet":
+				return op != OperationDelete && op != OperationUpdate
+			case "Settings":
+				return false
+			default:
+				return true
+			}
+		},
+	})
+
+	assert.Equal(t, []string{http.MethodGet}, getPathMethods(t, r, "/pets/{petID}"))
+	assert.Equal(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/pets"))
+	assert.Nil(t, r.json(`$.components.schemas.PetUpdate`))
+	assert.Equal(
+		t,
+		[]string{http.MethodGet, http.MethodPatch, http.MethodDelete},
+		getPathMethods(t, r, "/users/{userID}"),
+	)
+
+	assert.Nil(t, r.json(`$.paths./settings`))
+	assert.Nil(t, r.json(`$.components.schemas.Setting`))
+}
+
+func TestSpec_ReadYourWrites(t *testing.T) {
+	t.Parallel()
+
+	location := `$.paths./pets.post.responses.201.headers.Location`
+
+	r := mustBuildSpec(t, &Config{})
+	assert.Nil(t, r.json(location))
+
+	r = mustBuildSpec(t, &Config{ReadYourWrites: true})
+	assert.Equal(t, "string", r.json(location+`.schema.type`))
+
+	// Entities which can't be read have no location.
+	r = mustBuildSpec(t, &Config{
+		ReadYourWrites: true,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithExcludeOperations(OperationRead))
+			return nil
+		},
+	})
+	assert.Nil(t, r.json(location))
+}
+
+func TestSpec_CreateResponse(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithCreateResponse(CreateResponseLocation))
+			injectAnnotations(t, g, "Category", WithCreateResponse(CreateResponseNoContent))
+			return nil
+		},
+	})
+
+	assert.Equal(t, "string", r.json(`$.paths./pets.post.responses.201.headers.Location.schema.type`))
+	assert.Nil(t, r.json(`$.paths./pets.post.responses.201.content`))
+
+	assert.Nil(t, r.json(`$.paths./categories.post.responses.201`))
+	assert.NotNil(t, r.json(`$.paths./categories.post.responses.204`))
+	assert.Nil(t, r.json(`$.paths./categories.post.responses.204.content`))
+
+	// Other schemas are unaffected.
+	assert.NotNil(t, r.json(`$.paths./users.post.responses.201.content`))
+	assert.Nil(t, r.json(`$.paths./users.post.responses.201.headers`))
+}
+
+func TestSpec_ResponseStatus(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(
+				t, g, "Pet",
+				WithResponseStatus(OperationDelete, http.StatusOK),
+				WithResponseStatus(OperationList, http.StatusPartialContent),
+			)
+			injectAnnotations(t, g, "Category", WithResponseStatus(OperationCreate, http.StatusAccepted))
+			return nil
+		},
+	})
+
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}.delete.responses.200`))
+	assert.Nil(t, r.json(`$.paths./pets/{petID}.delete.responses.204`))
+
+	assert.NotNil(t, r.json(`$.paths./pets.get.responses.206.content`))
+	assert.Nil(t, r.json(`$.paths./pets.get.responses.200`))
+
+	assert.NotNil(t, r.json(`$.paths./categories.post.responses.202.content`))
+	assert.Nil(t, r.json(`$.paths./categories.post.responses.201`))
+
+	// Other schemas and operations are unaffected.
+	assert.NotNil(t, r.json(`$.paths./pets/{petID}.get.responses.200`))
+	assert.NotNil(t, r.json(`$.paths./users/{userID}.delete.responses.204`))
+}
+
 func TestPatchOperations(t *testing.T) {
 	spec := ogen.NewSpec().AddPathItem("/test", &ogen.PathItem{
 		Get:     &ogen.Operation{OperationID: http.MethodGet},
@@ -516,3 +683,84 @@ func TestMergeSpec(t *testing.T) {
 		})
 	}
 }
+
+func TestCanonicalizeSpec(t *testing.T) {
+	t.Parallel()
+
+	spec := ogen.NewSpec()
+	spec.Components = &ogen.Components{
+		Parameters: map[string]*ogen.Parameter{
+			"FooID": {Name: "fooID", In: "path"},
+		},
+		Schemas: map[string]*ogen.Schema{
+			"Foo": {Type: "object", Required: []string{"a", "b", "a"}},
+		},
+	}
+	spec.Paths = ogen.Paths{
+		"/foo/{fooID}": &ogen.PathItem{
+			Parameters: []*ogen.Parameter{
+				{Name: "X-Foo", In: "header"},
+				{Name: "q", In: "query"},
+				{Ref: "#/components/parameters/FooID"},
+				{Name: "X-Foo", In: "header"},
+				nil,
+			},
+			Get: &ogen.Operation{
+				OperationID: "getFoo",
+				Tags:        []string{"foo", "bar", "foo"},
+			},
+		},
+	}
+
+	CanonicalizeSpec(spec)
+
+	params := spec.Paths["/foo/{fooID}"].Parameters
+	require.Len(t, params, 3)
+	assert.Equal(t, "#/components/parameters/FooID", params[0].Ref)
+	assert.Equal(t, "q", params[1].Name)
+	assert.Equal(t, "X-Foo", params[2].Name)
+	assert.Equal(t, []string{"foo", "bar"}, spec.Paths["/foo/{fooID}"].Get.Tags)
+	assert.Equal(t, []string{"a", "b"}, spec.Components.Schemas["Foo"].Required)
+}
+
+func TestSpec_Deterministic(t *testing.T) {
+	t.Parallel()
+
+	build := func() []byte {
+		r := mustBuildSpec(t, &Config{
+			GlobalRequestHeaders: map[string]*ogen.Parameter{
+				"X-Request-ID": {In: "header", Schema: ogen.String()},
+				"Foo-Bar":      {In: "header", Schema: ogen.String()},
+				"Baz":          {In: "header", Schema: ogen.String()},
+			},
+		})
+
+		b, err := json.Marshal(r.spec)
+		require.NoError(t, err)
+		return b
+	}
+
+	first := build()
+	for range 5 {
+		assert.Equal(t, string(first), string(build()))
+	}
+}
+
+func TestCanonicalizeGraph(t *testing.T) {
+	t.Parallel()
+
+	want := renderGraph(t, &Config{Handler: HandlerStdlib, WithTesting: true}, nil)
+	require.Contains(t, want, "rest/server.go")
+
+	// The code generated by the extension doesn't depend on the order the schemas were
+	// loaded in (unlike the code generated by ent itself, e.g. foreign keys).
+	got := renderGraph(t, &Config{Handler: HandlerStdlib, WithTesting: true}, func(schemas []*load.Schema) {
+		slices.Reverse(schemas)
+	})
+	require.Equal(t, slices.Sorted(maps.Keys(want)), slices.Sorted(maps.Keys(got)))
+	for fn := range want {
+		if strings.HasPrefix(fn, "rest/") || strings.HasPrefix(fn, "enttest/rest_") {
+			assert.Equal(t, want[fn], got[fn], fn)
+		}
+	}
+}
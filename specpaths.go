@@ -0,0 +1,167 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/go-faster/yaml"
+	"github.com/ogen-go/ogen"
+)
+
+// defaultSpecPath is the path the spec handler is mounted at, if [Config.SpecPaths]
+// isn't provided.
+const defaultSpecPath = "/openapi.json"
+
+// isYAMLSpecPath returns true if the provided spec path (see [Config.SpecPaths]) serves
+// the spec as YAML.
+func isYAMLSpecPath(p string) bool {
+	ext := path.Ext(p)
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// hasYAMLSpecPath returns true if any of the spec paths (see [Config.SpecPaths]) serve
+// the spec as YAML, in which case a YAML version of the spec is generated alongside the
+// JSON version.
+func hasYAMLSpecPath(cfg *Config) bool {
+	return slices.ContainsFunc(cfg.SpecPaths, isYAMLSpecPath)
+}
+
+// getSpecPath returns the primary spec path (see [Config.SpecPaths]), which is the first
+// JSON path, or the first path if only YAML paths are provided. This is the path which
+// is referenced by links and the embedded API reference documentation.
+func getSpecPath(cfg *Config) string {
+	for _, p := range cfg.SpecPaths {
+		if !isYAMLSpecPath(p) {
+			return p
+		}
+	}
+	if len(cfg.SpecPaths) > 0 {
+		return cfg.SpecPaths[0]
+	}
+	return defaultSpecPath
+}
+
+// validateSpecPaths checks that the provided spec paths (see [Config.SpecPaths]) are
+// absolute, unique, and have a supported extension.
+func validateSpecPaths(paths []string) error {
+	seen := map[string]bool{}
+	for _, p := range paths {
+		if !strings.HasPrefix(p, "/") {
+			return fmt.Errorf("spec path %q must start with a slash", p)
+		}
+
+		if strings.ContainsAny(p, "{} \t") {
+			return fmt.Errorf("spec path %q must not contain path parameters or whitespace", p)
+		}
+
+		if ext := path.Ext(p); ext != ".json" && !isYAMLSpecPath(p) {
+			return fmt.Errorf("spec path %q must end with .json, .yaml or .yml", p)
+		}
+
+		if seen[p] {
+			return fmt.Errorf("duplicate spec path %q", p)
+		}
+		seen[p] = true
+	}
+	return nil
+}
+
+// addOpenAPIEndpoints adds an endpoint to the OpenAPI spec for each of the provided
+// spec paths (see [Config.SpecPaths]), which return the OpenAPI spec itself, as JSON
+// or YAML.
+func addOpenAPIEndpoints(paths []string) *ogen.Spec {
+	spec := ogen.NewSpec()
+	counts := map[bool]int{}
+
+	for _, p := range paths {
+		isYAML := isYAMLSpecPath(p)
+		counts[isYAML]++
+
+		id := "getOpenAPI"
+		content := "application/json"
+		if isYAML {
+			id += "YAML"
+			content = "application/yaml"
+		}
+
+		if counts[isYAML] > 1 {
+			id += strconv.Itoa(counts[isYAML])
+		}
+
+		spec.AddPathItem(p, ogen.NewPathItem().
+			SetGet(
+				ogen.NewOperation().
+					SetSummary("Get OpenAPI spec").
+					SetDescription("Get the OpenAPI specification for this service.").
+					SetOperationID(id).
+					SetTags([]string{"Meta"}).
+					SetResponses(map[string]*ogen.Response{
+						strconv.Itoa(http.StatusOK): ogen.NewResponse().
+							SetDescription("OpenAPI specification was found").
+							SetContent(map[string]ogen.Media{
+								content: {Schema: SchemaObjectAny},
+							}),
+					}),
+			),
+		)
+	}
+	return spec
+}
+
+// MarshalSpecYAML marshals the provided spec into YAML, including the top-level spec
+// extensions (see [MarshalSpec]).
+func MarshalSpecYAML(spec *ogen.Spec) ([]byte, error) {
+	b, err := MarshalSpec(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// JSON is valid YAML, so decoding it into a node retains the order of all keys.
+	var node yaml.Node
+	if err = yaml.Unmarshal(b, &node); err != nil {
+		return nil, fmt.Errorf("failed to decode spec: %w", err)
+	}
+
+	if node.Kind != yaml.DocumentNode {
+		return nil, errors.New("failed to decode spec: unexpected document")
+	}
+
+	resetYAMLStyle(&node)
+	return yaml.Marshal(&node)
+}
+
+// writeSpecYAML writes the YAML version of the provided spec (see [MarshalSpecYAML])
+// into the provided directory, as "openapi.yaml".
+func writeSpecYAML(dir string, spec *ogen.Spec) error {
+	b, err := MarshalSpecYAML(spec)
+	if err != nil {
+		return fmt.Errorf("failed to marshal spec as yaml: %w", err)
+	}
+
+	err = os.WriteFile(filepath.Join(dir, "openapi.yaml"), b, 0o640)
+	if err != nil {
+		return fmt.Errorf("failed to write yaml spec: %w", err)
+	}
+	return nil
+}
+
+// resetYAMLStyle resets the style of the provided node and its children (e.g. the flow
+// style of nodes decoded from JSON), so they are encoded using the default block style.
+// Strings which would otherwise be decoded as a different type are still quoted.
+func resetYAMLStyle(node *yaml.Node) {
+	node.Style = 0
+	for _, n := range node.Content {
+		resetYAMLStyle(n)
+	}
+}
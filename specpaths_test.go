@@ -0,0 +1,30 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"github.com/go-faster/yaml"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarshalSpecYAML(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{SpecPaths: []string{"/openapi.yaml"}})
+
+	b, err := MarshalSpecYAML(r.spec)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(b), "openapi: 3.0.3\n")
+	assert.Contains(t, string(b), "\n                \"200\":\n")
+
+	var out map[string]any
+	require.NoError(t, yaml.Unmarshal(b, &out))
+	assert.Contains(t, out["paths"], "/openapi.yaml")
+	assert.Contains(t, out["paths"], "/pets")
+}
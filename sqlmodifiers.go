@@ -0,0 +1,219 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// SQLLock represents the row-level lock of the queries of an operation (see
+// [SQLModifiers]).
+type SQLLock string
+
+const (
+	// SQLLockNone doesn't lock the rows read by the queries of the operation.
+	SQLLockNone SQLLock = ""
+	// SQLLockUpdate locks the rows read by the queries of the operation through
+	// "FOR UPDATE".
+	SQLLockUpdate SQLLock = "update"
+	// SQLLockShare locks the rows read by the queries of the operation through
+	// "FOR SHARE".
+	SQLLockShare SQLLock = "share"
+)
+
+// AllSQLLocks is a list of all supported SQL locks.
+var AllSQLLocks = []SQLLock{
+	SQLLockNone,
+	SQLLockUpdate,
+	SQLLockShare,
+}
+
+// SQLModifiers are the SQL modifiers of the queries of an operation (see
+// [WithSQLModifiers]).
+type SQLModifiers struct {
+	// Timeout is the maximum duration of the queries of the operation. The request
+	// context is canceled once the timeout is reached, which cancels any running
+	// queries (and rolls back any running transaction), and responds with an error.
+	Timeout time.Duration `json:",omitempty"`
+
+	// Hints are optimizer hints (e.g. "IndexScan(pets pets_name_idx)"), which are
+	// prefixed to the queries of the operation as a single hint comment (e.g.
+	// "/*+ IndexScan(pets pets_name_idx) */ SELECT ..."), as used by pg_hint_plan.
+	// Requires the "sql/modifier" ent feature.
+	Hints []string `json:",omitempty"`
+
+	// Lock is the row-level lock of the queries which read the entity within the
+	// update and delete operations, e.g. [SQLLockUpdate]. Requires the "sql/modifier"
+	// ent feature.
+	Lock SQLLock `json:",omitempty"`
+}
+
+// merge merges the provided SQL modifiers into the current SQL modifiers, returning
+// new SQL modifiers. Hints are appended, and all other non-zero values override the
+// current values.
+func (m *SQLModifiers) merge(other *SQLModifiers) *SQLModifiers {
+	if m == nil {
+		return other
+	}
+	if other == nil {
+		return m
+	}
+
+	merged := *m
+	merged.Hints = append(slices.Clip(merged.Hints), other.Hints...)
+
+	if other.Timeout != 0 {
+		merged.Timeout = other.Timeout
+	}
+	if other.Lock != SQLLockNone {
+		merged.Lock = other.Lock
+	}
+	return &merged
+}
+
+// hasQueryModifiers returns true if the SQL modifiers modify the queries themselves
+// (hints or locks), rather than only the context which they run with (timeouts).
+func (m *SQLModifiers) hasQueryModifiers() bool {
+	return m != nil && (len(m.Hints) > 0 || m.Lock != SQLLockNone)
+}
+
+// getSQLModifierOperations returns the operations of the provided type which have
+// SQL modifiers which modify the queries of the operation (see [WithSQLModifiers]).
+func getSQLModifierOperations(t *gen.Type) (ops []Operation) {
+	ta := GetAnnotation(t)
+
+	for _, op := range ta.GetOperations(GetConfig(t.Config)) {
+		if ta.GetSQLModifiers(op).hasQueryModifiers() {
+			ops = append(ops, op)
+		}
+	}
+	return ops
+}
+
+// getSQLHint returns the hint comment of the provided SQL modifiers, e.g.
+// "/*+ IndexScan(pets) */", or an empty string if no hints are provided.
+func getSQLHint(m *SQLModifiers) string {
+	if m == nil || len(m.Hints) == 0 {
+		return ""
+	}
+	return "/*+ " + strings.Join(m.Hints, " ") + " */"
+}
+
+// hasSQLTimeouts returns true if any of the provided types has a query timeout on any
+// of its operations (see [WithSQLModifiers]).
+func hasSQLTimeouts(nodes []*gen.Type) bool {
+	for _, t := range nodes {
+		ta := GetAnnotation(t)
+		if ta.GetSkip(GetConfig(t.Config)) {
+			continue
+		}
+
+		for _, m := range ta.SQLModifiers {
+			if m != nil && m.Timeout > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatDuration returns the provided duration as a Go expression, e.g.
+// "5 * time.Second".
+func formatDuration(d time.Duration) string {
+	switch {
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	case d%time.Millisecond == 0:
+		return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+	default:
+		return fmt.Sprintf("time.Duration(%d)", d)
+	}
+}
+
+// wrapSQLModifiers wraps the provided query (Go expression) of the provided operation,
+// so the SQL modifiers of the operation are applied to it (see [WithSQLModifiers]).
+func wrapSQLModifiers(t *gen.Type, op Operation, query string) string {
+	if !GetAnnotation(t).GetSQLModifiers(op).hasQueryModifiers() {
+		return query
+	}
+	return fmt.Sprintf("modify%sQuery(Operation%s, %s)", t.Name, PascalCase(string(op)), query)
+}
+
+// wrapSQLTimeout wraps the provided handler (Go expression) of the provided operation,
+// so the queries of the operation are canceled once the timeout of the operation is
+// reached (see [WithSQLModifiers]).
+func wrapSQLTimeout(t *gen.Type, op Operation, handler string) string {
+	m := GetAnnotation(t).SQLModifiers[op]
+	if m == nil || m.Timeout <= 0 {
+		return handler
+	}
+	return fmt.Sprintf("withQueryTimeout(%s, %s)", formatDuration(m.Timeout), handler)
+}
+
+// hasModifierFeature returns true if the "sql/modifier" ent feature, which adds the
+// Modify method to the generated query builders, is enabled.
+func hasModifierFeature(t *gen.Type) bool {
+	if t.Config == nil {
+		return false
+	}
+	ok, _ := t.Config.FeatureEnabled(gen.FeatureModifier.Name)
+	return ok
+}
+
+// validateSQLModifiers checks that the SQL modifiers of a schema (see
+// [WithSQLModifiers] and [WithSelectForUpdate]) are provided for known operations,
+// and are supported by the operation and the ent features which are enabled.
+func validateSQLModifiers(t *gen.Type, a *Annotation) (errs []error) {
+	for _, op := range mapKeys(a.SQLModifiers) {
+		if !slices.Contains(AllOperations, op) {
+			errs = append(errs, fmt.Errorf("SQL modifiers provided for unknown operation %q", op))
+			continue
+		}
+
+		m := a.SQLModifiers[op]
+		if m == nil {
+			continue
+		}
+
+		if m.Timeout < 0 {
+			errs = append(errs, fmt.Errorf("SQL timeout of the %s operation must not be negative", op))
+		}
+
+		for _, h := range m.Hints {
+			if strings.TrimSpace(h) == "" || strings.Contains(h, "*/") {
+				errs = append(errs, fmt.Errorf("SQL hint %q of the %s operation is empty, or contains \"*/\"", h, op))
+			}
+		}
+
+		if !slices.Contains(AllSQLLocks, m.Lock) {
+			errs = append(errs, fmt.Errorf("unknown SQL lock %q provided for the %s operation", m.Lock, op))
+		} else if m.Lock != SQLLockNone && op != OperationUpdate && op != OperationDelete {
+			errs = append(errs, fmt.Errorf("SQL locks are only supported on the update and delete operations, not %s", op))
+		}
+
+		if m.hasQueryModifiers() && !hasModifierFeature(t) {
+			errs = append(errs, errors.New("SQL hints and locks require the \"sql/modifier\" ent feature to be enabled"))
+		}
+	}
+
+	if a.SelectForUpdate {
+		if !hasModifierFeature(t) {
+			errs = append(errs, errors.New("select for update requires the \"sql/modifier\" ent feature to be enabled"))
+		}
+
+		for _, op := range []Operation{OperationUpdate, OperationDelete} {
+			if m := a.SQLModifiers[op]; m != nil && m.Lock != SQLLockNone && m.Lock != SQLLockUpdate {
+				errs = append(errs, fmt.Errorf("SQL lock %q of the %s operation conflicts with select for update", m.Lock, op))
+			}
+		}
+	}
+	return errs
+}
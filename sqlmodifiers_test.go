@@ -0,0 +1,86 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSQLModifiers(t *testing.T) {
+	t.Parallel()
+
+	var (
+		ops      []Operation
+		errs     []error
+		timeout  string
+		modified string
+		hint     string
+	)
+
+	_ = mustBuildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet",
+				WithSQLModifiers(OperationList, &SQLModifiers{Timeout: 5 * time.Second, Hints: []string{"SeqScan(pets)"}}),
+				WithSQLModifiers(OperationList, &SQLModifiers{Hints: []string{"Parallel(pets 4)"}}),
+				WithSQLModifiers(OperationUpdate, &SQLModifiers{Lock: SQLLockUpdate}),
+				WithSQLModifiers(OperationDelete, &SQLModifiers{Timeout: 1500 * time.Millisecond}),
+			)
+
+			g.Config.Features = append(g.Config.Features, gen.FeatureModifier)
+
+			for _, n := range g.Nodes {
+				if n.Name != "Pet" {
+					continue
+				}
+				ops = getSQLModifierOperations(n)
+				errs = validateSQLModifiers(n, GetAnnotation(n))
+				timeout = wrapSQLTimeout(n, OperationDelete, "h")
+				modified = wrapSQLModifiers(n, OperationUpdate, "q")
+				hint = getSQLHint(GetAnnotation(n).SQLModifiers[OperationList])
+				assert.Equal(t, "q", wrapSQLModifiers(n, OperationRead, "q"))
+				assert.Equal(t, "h", wrapSQLTimeout(n, OperationRead, "h"))
+			}
+			return nil
+		},
+	})
+
+	assert.Empty(t, errs)
+	assert.Equal(t, []Operation{OperationUpdate, OperationList}, ops)
+	assert.Equal(t, "withQueryTimeout(1500 * time.Millisecond, h)", timeout)
+	assert.Equal(t, "modifyPetQuery(OperationUpdate, q)", modified)
+	assert.Equal(t, "/*+ SeqScan(pets) Parallel(pets 4) */", hint)
+}
+
+func TestAnnotation_GetSQLModifiers(t *testing.T) {
+	t.Parallel()
+
+	a := WithSelectForUpdate(true)
+	a.SQLModifiers = map[Operation]*SQLModifiers{
+		OperationRead:   {Timeout: time.Second},
+		OperationDelete: {Hints: []string{"SeqScan(pets)"}},
+	}
+
+	assert.Equal(t, &SQLModifiers{Timeout: time.Second}, a.GetSQLModifiers(OperationRead))
+	assert.Nil(t, a.GetSQLModifiers(OperationList))
+	assert.Equal(t, &SQLModifiers{Lock: SQLLockUpdate}, a.GetSQLModifiers(OperationUpdate))
+	assert.Equal(t, &SQLModifiers{Lock: SQLLockUpdate, Hints: []string{"SeqScan(pets)"}}, a.GetSQLModifiers(OperationDelete))
+
+	a.SelectForUpdate = false
+	assert.Nil(t, a.GetSQLModifiers(OperationUpdate))
+}
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "5 * time.Second", formatDuration(5*time.Second))
+	assert.Equal(t, "120 * time.Second", formatDuration(2*time.Minute))
+	assert.Equal(t, "250 * time.Millisecond", formatDuration(250*time.Millisecond))
+	assert.Equal(t, "time.Duration(1500)", formatDuration(1500*time.Nanosecond))
+}
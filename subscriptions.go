@@ -0,0 +1,319 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// subscriptionsPath is the path of the endpoints used to manage webhook subscriptions
+// (see [WithSubscriptions]).
+const subscriptionsPath = "/subscriptions"
+
+// subscriptionEventVerbs maps the operations which support subscriptions to the verb
+// used in their event names.
+var subscriptionEventVerbs = map[Operation]string{
+	OperationCreate: "created",
+	OperationUpdate: "updated",
+	OperationDelete: "deleted",
+}
+
+// SubscriptionEvent is an event which clients can subscribe to (see [WithSubscriptions]).
+type SubscriptionEvent struct {
+	Name      string    // The name of the event, e.g. "pet.created".
+	Type      *gen.Type // The type which the event is for.
+	Operation Operation // The operation which triggers the event.
+}
+
+// GetSubscriptionEventName returns the name of the event for the provided type and
+// operation, e.g. "pet.created".
+func GetSubscriptionEventName(t *gen.Type, op Operation) string {
+	return SnakeCase(t.Name) + "." + subscriptionEventVerbs[op]
+}
+
+// GetSubscriptionEvents returns all events which clients can subscribe to (see
+// [WithSubscriptions]), sorted by name.
+func GetSubscriptionEvents(cfg *Config, nodes []*gen.Type) (events []*SubscriptionEvent) {
+	for _, t := range nodes {
+		ta := GetAnnotation(t)
+
+		if ta.GetSkip(cfg) || t.ID == nil {
+			continue
+		}
+
+		for _, op := range ta.Subscriptions {
+			if _, ok := subscriptionEventVerbs[op]; !ok || !ta.HasOperation(cfg, op) {
+				continue
+			}
+
+			events = append(events, &SubscriptionEvent{
+				Name:      GetSubscriptionEventName(t, op),
+				Type:      t,
+				Operation: op,
+			})
+		}
+	}
+
+	slices.SortFunc(events, func(a, b *SubscriptionEvent) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return events
+}
+
+// getSubscriptionEventSchemaName returns the name of the schema of the webhook payload
+// of the provided event, e.g. "PetCreatedEvent".
+func getSubscriptionEventSchemaName(event *SubscriptionEvent) string {
+	return GetSchemaName(event.Type) + PascalCase(subscriptionEventVerbs[event.Operation]) + "Event"
+}
+
+// getSubscriptionEventIdent returns the name of the generated constant of the event for
+// the provided type and operation, e.g. "SubscriptionEventPetCreated".
+func getSubscriptionEventIdent(t *gen.Type, op Operation) string {
+	return "SubscriptionEvent" + t.Name + PascalCase(subscriptionEventVerbs[op])
+}
+
+// wrapSubscriptionEvent wraps the provided handler (Go expression) of the provided
+// operation, so successful requests publish the subscription event of the operation,
+// if the schema has subscriptions for it (see [WithSubscriptions]).
+func wrapSubscriptionEvent(t *gen.Type, op Operation, handler string) string {
+	if _, ok := subscriptionEventVerbs[op]; !ok || t.ID == nil {
+		return handler
+	}
+
+	if !slices.Contains(GetAnnotation(t).Subscriptions, op) {
+		return handler
+	}
+	return fmt.Sprintf("withSubscriptionEvent(%s, %s)", getSubscriptionEventIdent(t, op), handler)
+}
+
+// GetSpecSubscriptions returns the spec for the endpoints used to manage webhook
+// subscriptions, with the payloads of all subscribable events (see [WithSubscriptions])
+// documented as callbacks of the create subscription operation. Returns nil if there
+// are no subscribable events.
+func GetSpecSubscriptions(cfg *Config, nodes []*gen.Type) *ogen.Spec {
+	events := GetSubscriptionEvents(cfg, nodes)
+	if len(events) == 0 {
+		return nil
+	}
+
+	names := make([]string, len(events))
+	for i, event := range events {
+		names[i] = event.Name
+	}
+
+	spec := &ogen.Spec{
+		Paths: ogen.Paths{},
+		Components: &ogen.Components{
+			Schemas: map[string]*ogen.Schema{
+				"SubscriptionEvent": {
+					Description: "An event which can be subscribed to.",
+					Type:        "string",
+					Enum:        sliceToRawMessage(names),
+				},
+				"Subscription": {
+					Type: "object",
+					Properties: []ogen.Property{
+						{Name: "id", Schema: &ogen.Schema{Type: "string", Description: "The ID of the subscription."}},
+						{Name: "url", Schema: &ogen.Schema{Type: "string", Format: "uri", Description: "The URL webhooks are delivered to."}},
+						{Name: "events", Schema: &ogen.Schema{
+							Type:        "array",
+							Description: "The events which are delivered to the URL.",
+							Items:       &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/SubscriptionEvent"}},
+						}},
+						{Name: "created_at", Schema: &ogen.Schema{Type: "string", Format: "date-time", Description: "When the subscription was created."}},
+					},
+					Required: []string{"id", "url", "events", "created_at"},
+				},
+				"SubscriptionCreate": {
+					Type: "object",
+					Properties: []ogen.Property{
+						{Name: "url", Schema: &ogen.Schema{Type: "string", Format: "uri", Description: "The URL webhooks are delivered to (http or https)."}},
+						{Name: "events", Schema: &ogen.Schema{
+							Type:        "array",
+							Description: "The events which are delivered to the URL.",
+							Items:       &ogen.Items{Item: &ogen.Schema{Ref: "#/components/schemas/SubscriptionEvent"}},
+							MinItems:    ptr(uint64(1)),
+						}},
+					},
+					Required: []string{"url", "events"},
+				},
+			},
+			Parameters: map[string]*ogen.Parameter{
+				"SubscriptionID": {
+					Name:        "subscriptionID",
+					In:          "path",
+					Description: "The ID of the subscription.",
+					Required:    true,
+					Schema:      ogen.String(),
+				},
+			},
+		},
+		Tags: []ogen.Tag{
+			{
+				Name:        "Subscriptions",
+				Description: "Manage webhook subscriptions, which deliver events (e.g. entities being created) to a URL.",
+			},
+		},
+	}
+
+	callbacks := map[string]*ogen.Callback{}
+
+	for _, event := range events {
+		data := &ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(event.Type)}
+		if event.Operation == OperationDelete {
+			data = &ogen.Schema{
+				Type: "object",
+				Properties: []ogen.Property{
+					{Name: "id", Schema: &ogen.Schema{Description: "The ID of the deleted entity."}},
+				},
+				Required: []string{"id"},
+			}
+		}
+
+		schemaName := getSubscriptionEventSchemaName(event)
+		spec.Components.Schemas[schemaName] = &ogen.Schema{
+			Type:        "object",
+			Description: fmt.Sprintf("The payload of the %q webhook.", event.Name),
+			Properties: []ogen.Property{
+				{Name: "id", Schema: &ogen.Schema{Type: "string", Description: "The unique ID of the delivery."}},
+				{Name: "event", Schema: &ogen.Schema{Type: "string", Enum: sliceToRawMessage([]string{event.Name})}},
+				{Name: "timestamp", Schema: &ogen.Schema{Type: "string", Format: "date-time", Description: "When the event occurred."}},
+				{Name: "data", Schema: data},
+			},
+			Required: []string{"id", "event", "timestamp", "data"},
+		}
+
+		callback := ogen.Callback{
+			"{$request.body#/url}": ogen.NewPathItem().SetPost(
+				ogen.NewOperation().
+					SetSummary(fmt.Sprintf("The %q webhook", event.Name)).
+					SetDescription(
+						"Delivered when the event occurs. The payload is signed using HMAC-SHA256, with the " +
+							"signature provided through the X-Webhook-Signature header (e.g. \"sha256=<hex>\").",
+					).
+					SetRequestBody(ogen.NewRequestBody().
+						SetRequired(true).
+						SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + schemaName}),
+					).
+					SetResponses(ogen.Responses{
+						"2XX": ogen.NewResponse().SetDescription("The webhook was received."),
+					}),
+			),
+		}
+		callbacks[event.Name] = &callback
+	}
+
+	subscriptionRef := &ogen.Schema{Ref: "#/components/schemas/Subscription"}
+
+	spec.Paths[subscriptionsPath] = &ogen.PathItem{
+		Get: &ogen.Operation{
+			Tags:        []string{"Subscriptions"},
+			Summary:     "List subscriptions",
+			Description: "List all webhook subscriptions.",
+			OperationID: "listSubscriptions",
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription("The list of subscriptions.").
+					SetJSONContent(&ogen.Schema{Type: "array", Items: &ogen.Items{Item: subscriptionRef}}),
+			},
+		},
+		Post: &ogen.Operation{
+			Tags:        []string{"Subscriptions"},
+			Summary:     "Create a new subscription",
+			Description: "Create a new webhook subscription, delivering the provided events to the provided URL.",
+			OperationID: "createSubscription",
+			RequestBody: ogen.NewRequestBody().
+				SetRequired(true).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/SubscriptionCreate"}),
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusCreated): ogen.NewResponse().
+					SetDescription("The created subscription.").
+					SetJSONContent(subscriptionRef),
+			},
+			Callbacks: callbacks,
+		},
+	}
+
+	spec.Paths[subscriptionsPath+"/{subscriptionID}"] = &ogen.PathItem{
+		Parameters: []*ogen.Parameter{{Ref: "#/components/parameters/SubscriptionID"}},
+		Get: &ogen.Operation{
+			Tags:        []string{"Subscriptions"},
+			Summary:     "Get a subscription",
+			Description: "Get a webhook subscription by ID.",
+			OperationID: "getSubscription",
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription("The requested subscription.").
+					SetJSONContent(subscriptionRef),
+			},
+		},
+		Delete: &ogen.Operation{
+			Tags:        []string{"Subscriptions"},
+			Summary:     "Delete a subscription",
+			Description: "Delete a webhook subscription by ID.",
+			OperationID: "deleteSubscription",
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusNoContent): ogen.NewResponse().
+					SetDescription("The subscription was deleted."),
+			},
+		},
+	}
+
+	return spec
+}
+
+// validateSubscriptions checks that subscriptions of a schema (see [WithSubscriptions])
+// are provided for supported and enabled operations.
+func validateSubscriptions(cfg *Config, t *gen.Type, ta *Annotation) (errs []error) {
+	if len(ta.Subscriptions) == 0 || ta.GetSkip(cfg) {
+		return nil
+	}
+
+	if t.ID == nil {
+		return []error{errors.New("subscriptions are only supported on schemas with a single ID field")}
+	}
+
+	for _, op := range ta.Subscriptions {
+		if _, ok := subscriptionEventVerbs[op]; !ok {
+			errs = append(errs, fmt.Errorf("subscriptions are only supported for the create, update and delete operations, got %q", op))
+			continue
+		}
+
+		if !ta.HasOperation(cfg, op) {
+			errs = append(errs, fmt.Errorf("subscriptions provided for the %s operation, which isn't enabled", op))
+		}
+	}
+	return errs
+}
+
+// validateSubscriptionsPath checks that no schema uses the path of the subscription
+// endpoints, if any schema has subscriptions (see [WithSubscriptions]).
+func validateSubscriptionsPath(cfg *Config, nodes []*gen.Type) (errs []error) {
+	if len(GetSubscriptionEvents(cfg, nodes)) == 0 {
+		return nil
+	}
+
+	for _, t := range nodes {
+		if GetAnnotation(t).GetSkip(cfg) {
+			continue
+		}
+
+		if GetPathName(OperationList, t, nil, false) == subscriptionsPath {
+			errs = append(errs, &AnnotationError{
+				Schema: t.Name,
+				Err:    fmt.Errorf("path %q conflicts with the webhook subscription endpoints", subscriptionsPath),
+			})
+		}
+	}
+	return errs
+}
@@ -0,0 +1,75 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_Subscriptions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithSubscriptions(OperationCreate, OperationDelete))
+				injectAnnotations(t, g, "User", WithSubscriptions(OperationUpdate))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "listSubscriptions", r.json(`$.paths./subscriptions.get.operationId`))
+		assert.Equal(t, "createSubscription", r.json(`$.paths./subscriptions.post.operationId`))
+		assert.Equal(t, "getSubscription", r.json(`$.paths./subscriptions/{subscriptionID}.get.operationId`))
+		assert.Equal(t, "deleteSubscription", r.json(`$.paths./subscriptions/{subscriptionID}.delete.operationId`))
+
+		assert.Equal(
+			t,
+			[]any{"pet.created", "pet.deleted", "user.updated"},
+			r.json(`$.components.schemas.SubscriptionEvent.enum`),
+		)
+
+		callback := `$.paths./subscriptions.post.callbacks['pet.created']['{$request.body#/url}'].post`
+		assert.Equal(
+			t,
+			"#/components/schemas/PetCreatedEvent",
+			r.json(callback+`.requestBody.content['application/json'].schema.$ref`),
+		)
+		assert.Equal(t, "#/components/schemas/PetRead", r.json(`$.components.schemas.PetCreatedEvent.properties.data.$ref`))
+		assert.Equal(t, []any{"pet.created"}, r.json(`$.components.schemas.PetCreatedEvent.properties.event.enum`))
+		assert.Equal(t, []any{"id"}, r.json(`$.components.schemas.PetDeletedEvent.properties.data.required`))
+		assert.NotNil(t, r.json(`$.components.schemas.UserUpdatedEvent`))
+		assert.Nil(t, r.json(`$.components.schemas.PetUpdatedEvent`))
+	})
+
+	t.Run("none", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Nil(t, r.json(`$.paths./subscriptions`))
+		assert.Nil(t, r.json(`$.components.schemas.SubscriptionEvent`))
+	})
+
+	t.Run("skipped-schema", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			ExcludeSchemas: []string{"Pet"},
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Pet", WithSubscriptions(OperationCreate))
+				return nil
+			},
+		})
+
+		assert.Nil(t, r.json(`$.paths./subscriptions`))
+	})
+}
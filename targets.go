@@ -0,0 +1,253 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// Target is an additional, named, generation target, which generates an additional
+// OpenAPI spec from the same graph, with a different subset of schemas, operations,
+// base path, etc (e.g. a public and an admin API). All fields which aren't provided
+// are inherited from the parent [Config]. As all targets are generated from the same
+// graph and annotations, component schemas are shared (named identically) between
+// all targets.
+//
+// Note that targets only affect the generated spec. HTTP handlers (if enabled) are
+// generated once, using the parent [Config], however, the specs of targets written
+// within the generated "rest" package (the default) are also served by the generated
+// spec handler, through the "version" query parameter (e.g. "?version=public").
+type Target struct {
+	// Name is the name of the target, which must be unique (e.g. "public", "admin").
+	Name string
+
+	// Dir is the output directory of the spec, relative to the ent target directory.
+	// Defaults to "rest/<name>".
+	Dir string `json:",omitempty"`
+
+	// BasePath is an optional path prefix to add to all paths of the target (e.g.
+	// "/admin").
+	BasePath string `json:",omitempty"`
+
+	// Spec is an optional spec to use for the target, instead of [Config.Spec] (e.g.
+	// with a different title, servers, or security schemes for the intended audience).
+	Spec *ogen.Spec `json:",omitempty"`
+
+	// IncludeSchemas overrides [Config.IncludeSchemas] for the target.
+	IncludeSchemas []string `json:",omitempty"`
+
+	// ExcludeSchemas overrides [Config.ExcludeSchemas] for the target.
+	ExcludeSchemas []string `json:",omitempty"`
+
+	// DefaultOperations overrides [Config.DefaultOperations] for the target.
+	DefaultOperations []Operation `json:",omitempty"`
+
+	// Version restricts the target to the schemas, fields, edges and operations which
+	// are available in the provided API version (see [Config.Versions]). Targets are
+	// generated for each version automatically.
+	Version string `json:",omitempty"`
+}
+
+// config returns a copy of the parent config, with the target overrides applied.
+func (t *Target) config(parent *Config) (*Config, error) {
+	cfg := &Config{}
+
+	// Round-trip through JSON, to ensure we have a deep copy of the config, the same
+	// as would be done through the graph annotations.
+	if err := cfg.Decode(parent); err != nil {
+		return nil, fmt.Errorf("failed to copy config for target %q: %w", t.Name, err)
+	}
+
+	cfg.Targets = nil
+	cfg.DryRun = false
+	cfg.ReconcileSpecPath = ""
+	cfg.LoadTest = LoadTestNone
+	cfg.Changelog = ChangelogNone
+	cfg.PreGenerateHook = parent.PreGenerateHook
+	cfg.PostGenerateHook = parent.PostGenerateHook
+	cfg.WarningWriter = io.Discard // Already reported when generating the parent spec.
+	cfg.TimingWriter = parent.TimingWriter
+
+	if t.Spec != nil {
+		cfg.Spec = t.Spec
+		cfg.SpecFromPath = ""
+	}
+	if t.IncludeSchemas != nil {
+		cfg.IncludeSchemas = t.IncludeSchemas
+	}
+	if t.ExcludeSchemas != nil {
+		cfg.ExcludeSchemas = t.ExcludeSchemas
+	}
+	if t.DefaultOperations != nil {
+		cfg.DefaultOperations = t.DefaultOperations
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid config for target %q: %w", t.Name, err)
+	}
+	return cfg, nil
+}
+
+// validateTargets ensures all targets have a unique name.
+func validateTargets(targets []*Target) error {
+	seen := map[string]bool{}
+	for _, t := range targets {
+		if t == nil || t.Name == "" {
+			return errors.New("all targets must have a name")
+		}
+		if seen[t.Name] {
+			return fmt.Errorf("duplicate target name %q", t.Name)
+		}
+		seen[t.Name] = true
+
+		if t.BasePath != "" && !strings.HasPrefix(t.BasePath, "/") {
+			return fmt.Errorf("base path of target %q must start with a slash", t.Name)
+		}
+	}
+	return nil
+}
+
+// getSpecTargets returns the targets (see [Config.Targets]) whose spec is written
+// within the generated "rest" package (the default), and as such, can be embedded and
+// served by the generated spec handler. Targets are mapped to the path of their spec,
+// relative to the package.
+func getSpecTargets(cfg *Config) map[string]string {
+	targets := map[string]string{}
+	for _, t := range append(slices.Clone(cfg.Targets), getVersionTargets(cfg)...) {
+		dir := t.Dir
+		if dir == "" {
+			dir = filepath.Join("rest", t.Name)
+		}
+
+		rel, err := filepath.Rel("rest", filepath.Clean(dir))
+		if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		targets[t.Name] = filepath.ToSlash(filepath.Join(rel, "openapi.json"))
+	}
+	return targets
+}
+
+// GenerateTarget generates the spec for the provided target, using the provided graph.
+// The graph (including its annotations) is restored to its original state afterwards.
+func (e *Extension) GenerateTarget(g *gen.Graph, target *Target) (*ogen.Spec, error) {
+	cfg, err := target.config(e.config)
+	if err != nil {
+		return nil, err
+	}
+
+	// Generation reads the config and annotations from the graph itself, so we have to
+	// temporarily swap them out.
+	restore := snapshotAnnotations(g)
+	defer restore()
+
+	g.Config.Annotations.Set(cfg.Name(), cfg)
+
+	if target.Version != "" {
+		applyVersion(cfg, g, target.Version)
+	}
+
+	spec, err := (&Extension{config: cfg, target: target.Name}).Generate(g)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate target %q: %w", target.Name, err)
+	}
+
+	if target.Version != "" && cfg.VersionNegotiation == VersionNegotiationMediaType {
+		addVersionMediaTypes(cfg, spec, g.Nodes, target.Version)
+	}
+
+	if base := strings.TrimSuffix(target.BasePath, "/"); base != "" {
+		paths := make(ogen.Paths, len(spec.Paths))
+		for k, v := range spec.Paths {
+			paths[base+k] = v
+		}
+		spec.Paths = paths
+	}
+
+	return spec, nil
+}
+
+// snapshotAnnotations takes a snapshot of the config and all type/field/edge annotations
+// in the graph, returning a function which restores them.
+func snapshotAnnotations(g *gen.Graph) (restore func()) {
+	cfgAnnotations := make(gen.Annotations, len(g.Config.Annotations))
+	for k, v := range g.Config.Annotations {
+		cfgAnnotations[k] = v
+	}
+
+	types := map[*gen.Type]gen.Annotations{}
+	fields := map[*gen.Field]gen.Annotations{}
+	edges := map[*gen.Edge]gen.Annotations{}
+
+	for _, t := range g.Nodes {
+		types[t] = t.Annotations
+		for _, f := range t.Fields {
+			fields[f] = f.Annotations
+		}
+		for _, e := range t.Edges {
+			edges[e] = e.Annotations
+		}
+	}
+
+	return func() {
+		g.Config.Annotations = cfgAnnotations
+		for t, as := range types {
+			t.Annotations = as
+		}
+		for f, as := range fields {
+			f.Annotations = as
+		}
+		for e, as := range edges {
+			e.Annotations = as
+		}
+	}
+}
+
+// writeTargets generates and writes the specs for all configured targets.
+func (e *Extension) writeTargets(g *gen.Graph) error {
+	for _, target := range append(slices.Clone(e.config.Targets), getVersionTargets(e.config)...) {
+		spec, err := e.GenerateTarget(g, target)
+		if err != nil {
+			return err
+		}
+
+		dir := filepath.Join(g.Target, target.Dir)
+		if target.Dir == "" {
+			dir = filepath.Join(g.Target, "rest", target.Name)
+		}
+
+		err = os.MkdirAll(dir, 0o750)
+		if err != nil {
+			return fmt.Errorf("failed to create directory: %w", err)
+		}
+
+		b, err := MarshalSpec(spec)
+		if err != nil {
+			return fmt.Errorf("failed to marshal spec for target %q: %w", target.Name, err)
+		}
+
+		err = os.WriteFile(filepath.Join(dir, "openapi.json"), b, 0o640)
+		if err != nil {
+			return fmt.Errorf("failed to write spec for target %q: %w", target.Name, err)
+		}
+
+		if !e.config.DisableSpecHandler && hasYAMLSpecPath(e.config) {
+			err = writeSpecYAML(dir, spec)
+			if err != nil {
+				return fmt.Errorf("failed to write spec for target %q: %w", target.Name, err)
+			}
+		}
+	}
+	return nil
+}
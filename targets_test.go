@@ -0,0 +1,75 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtension_GenerateTarget(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	ext, err := NewExtension(&Config{})
+	require.NoError(t, err)
+
+	spec, err := ext.GenerateTarget(r.graph, &Target{
+		Name:              "admin",
+		BasePath:          "/admin",
+		IncludeSchemas:    []string{"User"},
+		DefaultOperations: []Operation{OperationRead, OperationList},
+	})
+	require.NoError(t, err)
+	validateSpec(t, spec)
+
+	assert.Contains(t, spec.Paths, "/admin/users")
+	assert.Contains(t, spec.Paths, "/admin/users/{userID}")
+	assert.NotContains(t, spec.Paths, "/admin/pets")
+	assert.NotContains(t, spec.Paths, "/users")
+	assert.Nil(t, spec.Paths["/admin/users"].Post)
+	assert.Contains(t, spec.Components.Schemas, "User")
+
+	// Ensure the graph was restored.
+	assert.Nil(t, GetConfig(r.graph.Config).IncludeSchemas)
+	for _, n := range r.graph.Nodes {
+		assert.False(t, GetAnnotation(n).Skip, n.Name)
+	}
+}
+
+func TestConfig_Targets(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewExtension(&Config{Targets: []*Target{{Name: "a"}, {Name: "a"}}})
+	assert.Error(t, err)
+
+	_, err = NewExtension(&Config{Targets: []*Target{{Name: ""}}})
+	assert.Error(t, err)
+
+	_, err = NewExtension(&Config{Targets: []*Target{{Name: "a", BasePath: "admin"}}})
+	assert.Error(t, err)
+
+	_, err = NewExtension(&Config{Targets: []*Target{{Name: "a", BasePath: "/admin"}, {Name: "b"}}})
+	assert.NoError(t, err)
+}
+
+func TestGetSpecTargets(t *testing.T) {
+	t.Parallel()
+
+	targets := getSpecTargets(&Config{Targets: []*Target{
+		{Name: "public"},
+		{Name: "admin", Dir: "rest/internal/admin"},
+		{Name: "external", Dir: "openapi/external"},
+		{Name: "root", Dir: "rest"},
+	}})
+
+	assert.Equal(t, map[string]string{
+		"public": "public/openapi.json",
+		"admin":  "internal/admin/openapi.json",
+	}, targets)
+}
@@ -6,6 +6,10 @@ package entrest
 
 import (
 	"embed"
+	"fmt"
+	"io/fs"
+	"maps"
+	"path"
 	"text/template"
 
 	"entgo.io/ent/entc/gen"
@@ -22,12 +26,175 @@ var (
 
 		// Use this function when you want to invoke annotation functions (which are
 		// often created if they depend on [Config]).
-		"getAnnotation":       GetAnnotation,
-		"getSortableFields":   GetSortableFields,
-		"getFilterableFields": GetFilterableFields,
-		"getFilterGroups":     GetFilterGroups,
-		"getOperationIDName":  GetOperationIDName,
-		"getPathName":         GetPathName,
+		"getAnnotation":              GetAnnotation,
+		"getSortableFields":          GetSortableFields,
+		"getFilterableFields":        GetFilterableFields,
+		"getFilterGroups":            GetFilterGroups,
+		"getOperationIDName":         GetOperationIDName,
+		"getPathName":                GetPathName,
+		"getExamplePayload":          GetExamplePayload,
+		"getBenchmarkSeed":           getBenchmarkSeed,
+		"getBenchmarkOperations":     getBenchmarkOperations,
+		"getFieldName":               GetFieldName,
+		"getEdgeName":                GetEdgeName,
+		"getSortFieldName":           GetSortFieldName,
+		"getSortFieldColumns":        getSortFieldColumns,
+		"getSortTiebreakFields":      GetSortTiebreakFields,
+		"getSpecTargets":             getSpecTargets,
+		"getSpecPath":                getSpecPath,
+		"hasYAMLSpecPath":            hasYAMLSpecPath,
+		"isYAMLSpecPath":             isYAMLSpecPath,
+		"getUniqueSortFields":        getUniqueSortFields,
+		"getRequestHeaders":          getRequestHeaders,
+		"wrapRequestHeaders":         wrapRequestHeaders,
+		"getSQLModifierOperations":   getSQLModifierOperations,
+		"getSQLHint":                 getSQLHint,
+		"hasSQLTimeouts":             hasSQLTimeouts,
+		"wrapSQLModifiers":           wrapSQLModifiers,
+		"wrapSQLTimeout":             wrapSQLTimeout,
+		"getEagerLoadEdges":          GetEagerLoadEdges,
+		"hasEdgesEncoding":           hasEdgesEncoding,
+		"getPaginationField":         getPaginationField,
+		"getPaginationFieldTag":      getPaginationFieldTag,
+		"isThroughEdge":              IsThroughEdge,
+		"getTreeEdge":                GetTreeEdge,
+		"getTreePathName":            GetTreePathName,
+		"getTreeOperationID":         GetTreeOperationID,
+		"getTreeDirections":          func() []TreeDirection { return TreeDirections },
+		"hasEdgeOperation":           HasEdgeOperation,
+		"isReadOnly":                 IsReadOnly,
+		"hasItemID":                  HasItemID,
+		"hasClientProvidedID":        HasClientProvidedID,
+		"getCompositeIDFields":       GetCompositeIDFields,
+		"getAlternateKeyFields":      GetAlternateKeyFields,
+		"getAlternateKeyPathName":    GetAlternateKeyPathName,
+		"getAlternateKeyOperationID": GetAlternateKeyOperationID,
+		"hasAlternateKeyHandlers":    hasAlternateKeyHandlers,
+		"getIntEnumFields":           getIntEnumFields,
+		"hasIntEnumValues":           HasIntEnumValues,
+		"getIntEnumValues":           GetIntEnumValues,
+		"hasUnixTimeFields":          hasUnixTimeFields,
+		"getTimeFormatFields":        getTimeFormatFields,
+		"getTimeFormat":              GetTimeFormat,
+		"getTimeFormatType":          getTimeFormatType,
+		"getFieldGoType":             getFieldGoType,
+		"convertFieldValue":          convertFieldValue,
+		"formatTime":                 formatTime,
+		"getNumericFormat":           GetNumericFormat,
+		"getNumericFormatFields":     getNumericFormatFields,
+		"getNumericTypes":            getNumericTypes,
+		"formatNumeric":              formatNumeric,
+		"getMoneyCurrencyField":      GetMoneyCurrencyField,
+		"getMoneyFields":             getMoneyFields,
+		"isMoneyCurrencyField":       isMoneyCurrencyField,
+		"hasMoneyFields":             hasMoneyFields,
+		"getMoneyType":               getMoneyType,
+		"getMoneyInputType":          getMoneyInputType,
+		"getMoneyCurrencyTag":        getMoneyCurrencyTag,
+		"getGeoOptions":              GetGeoOptions,
+		"getGeoFields":               getGeoFields,
+		"hasGeoFields":               hasGeoFields,
+		"getGeoType":                 getGeoType,
+		"getGeoFilters":              GetGeoFilters,
+		"getTranslationOptions":      GetTranslationOptions,
+		"getTranslatedFields":        getTranslatedFields,
+		"getTranslationTypes":        getTranslationTypes,
+		"getTranslationEdges":        getTranslationEdges,
+		"getRequirements":            GetConditionalRequirements,
+		"getRequirementFields":       getConditionalRequirementFields,
+		"getRequirementCheck":        getConditionalRequirementCheck,
+		"getRequirementError":        getConditionalRequirementError,
+		"hasRequirements":            hasConditionalRequirements,
+		"hasAnyRequirements":         hasAnyConditionalRequirements,
+		"getCrossValidations":        GetCrossValidations,
+		"hasCrossValidations":        hasCrossValidations,
+		"getCrossValidators":         getCrossValidators,
+		"getCrossValidatorRules":     getCrossValidatorRules,
+		"hasEncodedID":               HasEncodedID,
+		"hasEncodedIDs":              hasEncodedIDs,
+		"getFieldAliases":            getFieldAliases,
+		"hasFieldAliases":            hasFieldAliases,
+		"getAliasPaths":              getAliasPaths,
+		"hasAliasPaths":              hasAliasPaths,
+		"getChiIDPattern":            getChiIDPattern,
+		"getIDGoType":                getIDGoType,
+		"convertIDValue":             convertIDValue,
+		"convertIDValues":            convertIDValues,
+		"encodeIDValue":              encodeIDValue,
+		"encodeFieldValue":           encodeFieldValue,
+		"getFileFields":              GetFileFields,
+		"hasFileFields":              hasFileFields,
+		"getHashedFields":            getHashedFields,
+		"hasHashedFields":            hasHashedFields,
+		"hasSeekPagination":          hasSeekPagination,
+		"isSeekPaginated":            IsSeekPaginated,
+		"hasFileOperation":           hasFileOperation,
+		"getFilePathName":            GetFilePathName,
+		"getFileOperationID":         GetFileOperationID,
+		"getFileContentTypeField":    GetFileContentTypeField,
+		"getFileNameField":           GetFileNameField,
+		"hasCreateLocation":          hasCreateLocation,
+		"hasCreateLocations":         hasCreateLocations,
+		"hasCreateResponses":         hasCreateResponses,
+		"hasResponseStatuses":        hasResponseStatuses,
+		"wrapResponseStatus":         wrapResponseStatus,
+		"getSubscriptionEvents":      GetSubscriptionEvents,
+		"getSubscriptionEventName":   GetSubscriptionEventName,
+		"getSubscriptionEventIdent":  getSubscriptionEventIdent,
+		"wrapSubscriptionEvent":      wrapSubscriptionEvent,
+		"wrapDryRun":                 wrapDryRun,
+		"wrapDeduplication":          wrapDeduplication,
+		"hasAnyDeduplication":        hasAnyDeduplication,
+		"hasDryRun":                  hasDryRun,
+		"hasChangedFields":           hasChangedFields,
+		"hasAnyChangedFields":        hasAnyChangedFields,
+		"getChangedFieldNames":       getChangedFieldNames,
+		"wrapChangedFields":          wrapChangedFields,
+		"hasHistory":                 hasHistory,
+		"hasAnyHistory":              hasAnyHistory,
+		"hasHistoryRevert":           hasHistoryRevert,
+		"getHistoryFieldNames":       getHistoryFieldNames,
+		"getHistoryPathName":         GetHistoryPathName,
+		"getHistoryOperationID":      GetHistoryOperationID,
+		"hasSoftDelete":              hasSoftDelete,
+		"hasAnySoftDelete":           hasAnySoftDelete,
+		"hasRecycleBin":              hasRecycleBin,
+		"getSoftDeleteField":         getSoftDeleteField,
+		"getSoftDeletePathName":      GetSoftDeletePathName,
+		"getSoftDeleteOperationID":   GetSoftDeleteOperationID,
+		"hasAttachments":             hasAttachments,
+		"hasAnyAttachments":          hasAnyAttachments,
+		"getAttachmentPathName":      GetAttachmentPathName,
+		"getAttachmentOperationID":   GetAttachmentOperationID,
+		"getComputedFields":          GetComputedFields,
+		"getComputedFieldName":       getComputedFieldName,
+		"getComputedFieldStruct":     getComputedFieldStructField,
+		"getComputedFieldType":       getComputedFieldType,
+		"getComputedFieldTypes":      getComputedFieldTypes,
+		"getComputedFieldEdges":      getComputedFieldEdges,
+		"getParentFields":            GetParentFields,
+		"hasParentFields":            hasParentFields,
+		"getParentTypeName":          getParentTypeName,
+		"getRouteVersions":           getRouteVersions,
+		"getVersionHiddenFields":     getVersionHiddenFields,
+		"getVersionHiddenEdges":      getVersionHiddenEdges,
+		"getVersionHiddenInputs":     getVersionHiddenInputs,
+		"hasVersionedResponses":      hasVersionedResponses,
+		"getMediaTypeName":           getMediaTypeName,
+		"getDeprecation":             getDeprecation,
+		"hasDeprecations":            hasDeprecations,
+		"wrapDeprecation":            wrapDeprecation,
+		"getLastModifiedField":       getLastModifiedField,
+		"hasLastModified":            hasLastModified,
+		"getReadMaskFields":          getReadMaskFields,
+		"getReadMaskEdges":           getReadMaskEdges,
+		"wrapReadMask":               wrapReadMask,
+		"wrapReadMaskQuery":          wrapReadMaskQuery,
+		"getAIPFilters":              getAIPFilters,
+		"getODataFilters":            getODataFilters,
+		"wrapFlavor":                 wrapFlavor,
+		"getDTOEdges":                getDTOEdges,
+		"wrapTolerantReader":         wrapTolerantReader,
 	}
 
 	//go:embed templates
@@ -51,4 +218,81 @@ var (
 				"templates/testing/*.tmpl",
 			),
 	)
+	benchmarkTemplates = gen.MustParse(
+		gen.NewTemplate("restbenchmark").Funcs(funcMap).
+			SkipIf(func(g *gen.Graph) bool {
+				cfg := GetConfig(g.Config)
+				return !cfg.WithTesting || !cfg.WithBenchmarks
+			}).
+			ParseFS(
+				templateDir,
+				"templates/benchmark/*.tmpl",
+			),
+	)
+
+	// featureTemplates are the templates of optional features, which are only generated
+	// if the graph uses the feature, rather than as empty files.
+	featureTemplates = []*gen.Template{
+		newFeatureTemplate("dto", func(g *gen.Graph) bool { return GetConfig(g.Config).WithDTOs }),
+		newFeatureTemplate("examples", func(g *gen.Graph) bool {
+			cfg := GetConfig(g.Config)
+			return cfg.WithExamples || cfg.WithTesting
+		}),
+		newFeatureTemplate("geo", func(g *gen.Graph) bool { return hasGeoFields(g.Nodes) }),
+		newFeatureTemplate("idcodec", func(g *gen.Graph) bool { return hasEncodedIDs(g.Nodes) }),
+		newFeatureTemplate("money", func(g *gen.Graph) bool { return hasMoneyFields(g.Nodes) }),
+		newFeatureTemplate("numeric", func(g *gen.Graph) bool { return len(getNumericTypes(g.Nodes)) > 0 }),
+		newFeatureTemplate("time", func(g *gen.Graph) bool { return hasUnixTimeFields(g.Nodes) }),
+		newFeatureTemplate("tree", func(g *gen.Graph) bool { return hasTreeEdges(g.Nodes) }),
+	}
 )
+
+// newFeatureTemplate parses the template of the provided optional feature (i.e.
+// "templates/feature/<name>.tmpl"), which is skipped if the graph doesn't use it.
+func newFeatureTemplate(name string, used func(g *gen.Graph) bool) *gen.Template {
+	return gen.MustParse(
+		gen.NewTemplate("rest"+name).Funcs(funcMap).
+			SkipIf(func(g *gen.Graph) bool { return !used(g) }).
+			ParseFS(templateDir, "templates/feature/"+name+".tmpl"),
+	)
+}
+
+// TemplateFuncs returns a copy of the template functions available to all entrest
+// templates (in addition to the functions provided by ent, see [gen.Funcs]), which
+// can be used within [Config.TemplateOverrides].
+func TemplateFuncs() template.FuncMap {
+	return maps.Clone(funcMap)
+}
+
+// parseTemplateOverrides parses all "*.tmpl" files (recursively) in the provided
+// filesystem. Templates which define a template with the same name as a built-in
+// entrest or ent template (e.g. "rest/list" or "helper/rest/server/req") replace it,
+// and templates with new names generate new files.
+func parseTemplateOverrides(fsys fs.FS) (*gen.Template, error) {
+	var files []string
+
+	err := fs.WalkDir(fsys, ".", func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && path.Ext(p) == ".tmpl" {
+			files = append(files, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to walk template overrides: %w", err)
+	}
+
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no template overrides (*.tmpl) found")
+	}
+
+	t, err := gen.NewTemplate("restoverrides").Funcs(funcMap).
+		SkipIf(func(g *gen.Graph) bool { return GetConfig(g.Config).Handler == HandlerNone }).
+		ParseFS(fsys, files...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse template overrides: %w", err)
+	}
+	return t, nil
+}
@@ -52,6 +52,14 @@ func (AllTypes) Fields() []ent.Field {
 			Annotations(entrest.WithSchema(ogen.String())),
 		field.Text("nilable").
 			Nillable(),
+		field.JSON("point", [2]float64{}).
+			SchemaType(map[string]string{dialect.Postgres: "geometry(Point, 4326)"}).
+			Optional().
+			Annotations(entrest.WithSchema(ogen.Double().AsArray())),
+		field.JSON("polygon", [][][2]float64{}).
+			SchemaType(map[string]string{dialect.Postgres: "geometry(Polygon, 4326)"}).
+			Optional().
+			Annotations(entrest.WithSchema(ogen.Double().AsArray().AsArray().AsArray())),
 		field.Other("other", &ExampleValuer{}).
 			SchemaType(map[string]string{
 				dialect.Postgres: "varchar",
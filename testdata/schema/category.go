@@ -25,5 +25,8 @@ func (Category) Fields() []ent.Field {
 func (Category) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("pets", Pet.Type),
+		edge.To("children", Category.Type).
+			From("parent").
+			Unique(),
 	}
 }
@@ -0,0 +1,118 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// IsThroughEdge returns true if the provided edge is the edge from a type to the edge
+// schema (join table) of one of its M2M edges, which is generated by ent when using
+// edge.Through (e.g. the "following" edge of User, which goes to the Follows schema,
+// for the "followed_pets" edge).
+func IsThroughEdge(e *gen.Edge) bool {
+	return e != nil &&
+		!e.Unique &&
+		e.Type.IsEdgeSchema() &&
+		e.Ref != nil &&
+		e.Ref.Owner == e.Type &&
+		e.Ref.Field() != nil
+}
+
+// addThroughEdgeCreate adds an operation to the provided spec, which allows creating
+// the edge schema entity of a through edge (i.e. attaching the edge, including any
+// fields on the edge schema, like "role" or "added_at"), where the reference to the
+// owner is provided through the path.
+func addThroughEdgeCreate(spec *ogen.Spec, t *gen.Type, e *gen.Edge) {
+	ea := GetAnnotation(e)
+	ra := GetAnnotation(e.Type)
+
+	op := OperationCreate
+	throughName := GetSchemaName(e.Type)
+
+	// The reference to the owner is provided through the path, so remove it from the
+	// request body (both the field, and the edge if the field is skipped).
+	name := addEdgeCreateSchema(spec, t, e, GetFieldName(e.Type, e.Ref.Field()), e.Ref.Name)
+	if name == "" {
+		return
+	}
+	spec.Components.Schemas[name].Description = fmt.Sprintf(
+		"Attach a %s to a %s, including all fields of the %s.",
+		Singularize(CamelCase(e.Name)),
+		GetSchemaName(t),
+		throughName,
+	)
+
+	path := GetPathName(op, t, e, true)
+	item, ok := spec.Paths[path]
+	if !ok {
+		return
+	}
+
+	item.Post = &ogen.Operation{
+		Tags: sliceCompact(sliceOr(ea.Tags, append([]string{Pluralize(t.Name), Pluralize(e.Type.Name)}, ea.AdditionalTags...))),
+		Summary: cmp.Or(
+			ea.GetOperationSummary(op),
+			fmt.Sprintf("Attach a %s to a %s", Singularize(CamelCase(e.Name)), CamelCase(GetSchemaName(t))),
+		),
+		Description: cmp.Or(
+			ea.GetOperationDescription(op),
+			fmt.Sprintf(
+				"Create a new %s entity (%s entity type) associated with the %s, which attaches the edge.",
+				Singularize(CamelCase(e.Name)),
+				throughName,
+				GetSchemaName(t),
+			),
+		),
+		OperationID: GetOperationIDName(op, t, e),
+		Deprecated:  ea.Deprecated || ra.Deprecated,
+		RequestBody: ogen.NewRequestBody().
+			SetRequired(true).
+			SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + name}),
+		Responses: ogen.Responses{
+			strconv.Itoa(http.StatusCreated): ogen.NewResponse().
+				SetDescription(fmt.Sprintf("The created %s entity.", throughName)).
+				SetJSONContent(&ogen.Schema{Ref: "#/components/schemas/" + GetReadSchemaName(e.Type)}),
+		},
+	}
+	addMaintenanceResponse(GetConfig(t.Config), item.Post)
+}
+
+// addEdgeCreateSchema adds the create schema of the edge type to the provided spec,
+// along with a dedicated "<Root><Edge>Create" schema, which excludes the provided
+// properties (e.g. the reference back to the owner, which is provided through the
+// path). Returns the name of the dedicated schema, or an empty string if the edge
+// type doesn't have a create schema.
+func addEdgeCreateSchema(spec *ogen.Spec, t *gen.Type, e *gen.Edge, exclude ...string) string {
+	schemas := GetSchemaType(e.Type, OperationCreate, nil)
+	for k, v := range schemas {
+		spec.Components.Schemas[k] = v
+	}
+
+	base, ok := schemas[GetSchemaName(e.Type)+"Create"]
+	if !ok {
+		return ""
+	}
+
+	name := GetSchemaName(t) + Singularize(PascalCase(e.Name)) + "Create"
+
+	schema := *base
+	schema.Properties = slices.DeleteFunc(slices.Clone(base.Properties), func(p ogen.Property) bool {
+		return slices.Contains(exclude, p.Name)
+	})
+	schema.Required = slices.DeleteFunc(slices.Clone(base.Required), func(v string) bool {
+		return slices.Contains(exclude, v)
+	})
+	spec.Components.Schemas[name] = &schema
+
+	return name
+}
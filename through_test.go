@@ -0,0 +1,38 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_ThroughEdge(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	// Composite ID edge schema.
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/users/{userID}/following"))
+	assert.ElementsMatch(t, []string{http.MethodGet, http.MethodPost}, getPathMethods(t, r, "/pets/{petID}/following"))
+	assert.Equal(t, "listUserFollowings", r.json(`$.paths./users/{userID}/following.get.operationId`))
+	assert.Equal(t, "createUserFollowing", r.json(`$.paths./users/{userID}/following.post.operationId`))
+	assert.Contains(t, r.json(`$.paths./users/{userID}/following.post.requestBody.content.application/json.schema.$ref`), "/UserFollowingCreate")
+	assert.Contains(t, r.json(`$.paths./users/{userID}/following.post.responses.201.content.application/json.schema.$ref`), "/FollowRead")
+
+	create := `$.components.schemas.UserFollowingCreate`
+	assert.NotNil(t, r.json(create+`.properties.followed_at`))
+	assert.NotNil(t, r.json(create+`.properties.pet_id`))
+	assert.Nil(t, r.json(create+`.properties.user_id`))
+	assert.Contains(t, r.json(create+`.required`), "pet_id")
+	assert.NotContains(t, r.json(create+`.required`), "user_id")
+
+	// Edge schema with its own ID.
+	assert.Contains(t, r.json(`$.paths./users/{userID}/friendships.post.requestBody.content.application/json.schema.$ref`), "/UserFriendshipCreate")
+	assert.Nil(t, r.json(`$.components.schemas.UserFriendshipCreate.properties.user_id`))
+	assert.NotNil(t, r.json(`$.components.schemas.UserFriendshipCreate.properties.friend_id`))
+}
@@ -0,0 +1,253 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// TimeFormat represents the format of time fields in request and response bodies, as
+// well as in query parameters (e.g. filters).
+type TimeFormat string
+
+const (
+	// TimeFormatDefault uses the default encoding of [time.Time], which is RFC3339 with
+	// nanoseconds, in the timezone of the value.
+	TimeFormatDefault TimeFormat = ""
+	// TimeFormatRFC3339 encodes times as RFC3339 strings with second precision, in UTC
+	// (e.g. "2024-01-02T03:04:05Z").
+	TimeFormatRFC3339 TimeFormat = "rfc3339"
+	// TimeFormatRFC3339Nano encodes times as RFC3339 strings with nanosecond precision,
+	// in UTC (e.g. "2024-01-02T03:04:05.123456789Z").
+	TimeFormatRFC3339Nano TimeFormat = "rfc3339nano"
+	// TimeFormatUnix encodes times as the number of seconds since the Unix epoch.
+	TimeFormatUnix TimeFormat = "unix"
+	// TimeFormatUnixMilli encodes times as the number of milliseconds since the Unix
+	// epoch.
+	TimeFormatUnixMilli TimeFormat = "unixmilli"
+)
+
+// AllTimeFormats is a list of all supported time formats.
+var AllTimeFormats = []TimeFormat{
+	TimeFormatDefault,
+	TimeFormatRFC3339,
+	TimeFormatRFC3339Nano,
+	TimeFormatUnix,
+	TimeFormatUnixMilli,
+}
+
+// exampleTime is the time used for examples of time fields with a [TimeFormat].
+var exampleTime = time.Date(2024, time.January, 2, 3, 4, 5, 123456789, time.UTC)
+
+// isUnix returns true if the format encodes times as integers.
+func (f TimeFormat) isUnix() bool {
+	return f == TimeFormatUnix || f == TimeFormatUnixMilli
+}
+
+// example returns an example of a time in the format.
+func (f TimeFormat) example() any {
+	switch f {
+	case TimeFormatRFC3339:
+		return exampleTime.Format(time.RFC3339)
+	case TimeFormatUnix:
+		return exampleTime.Unix()
+	case TimeFormatUnixMilli:
+		return exampleTime.UnixMilli()
+	default:
+		return exampleTime.Format(time.RFC3339Nano)
+	}
+}
+
+// isTimeField returns true if the field is a standard [time.Time] field (i.e. not a
+// custom Go type, or a slice of times).
+func isTimeField(f *gen.Field) bool {
+	return f.IsTime() && f.Type.String() == "time.Time"
+}
+
+// GetTimeFormat returns the [TimeFormat] of the provided time field, through
+// [WithTimeFormat], or [Config.TimeFormat] (applied to all time fields without an
+// explicit format during generation). Returns [TimeFormatDefault] for fields which
+// aren't standard time fields.
+func GetTimeFormat(f *gen.Field) TimeFormat {
+	if !isTimeField(f) {
+		return TimeFormatDefault
+	}
+	return GetAnnotation(f).TimeFormat
+}
+
+// applyTimeFormats applies [Config.TimeFormat] to all time fields which don't already
+// have a time format, as if [WithTimeFormat] was provided.
+func applyTimeFormats(cfg *Config, g *gen.Graph) {
+	if cfg.TimeFormat == TimeFormatDefault {
+		return
+	}
+
+	for _, t := range g.Nodes {
+		for _, f := range t.Fields {
+			if !isTimeField(f) || GetAnnotation(f).TimeFormat != TimeFormatDefault {
+				continue
+			}
+
+			f.Annotations = withAnnotation(f.Annotations, func(a *Annotation) { a.TimeFormat = cfg.TimeFormat })
+		}
+	}
+}
+
+// getTimeFormatFields returns all fields of the provided type which have a time format
+// (see [GetTimeFormat]), and are included in JSON output.
+func getTimeFormatFields(t *gen.Type) (fields []*gen.Field) {
+	for _, f := range t.Fields {
+		if GetTimeFormat(f) != TimeFormatDefault && f.StructTag != `json:"-"` {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// hasUnixTimeFields returns true if any of the provided types have time fields which
+// are encoded as integers, which requires custom types for request bodies and query
+// parameters.
+func hasUnixTimeFields(nodes []*gen.Type) bool {
+	for _, t := range nodes {
+		for _, f := range t.Fields {
+			if GetTimeFormat(f).isUnix() {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// getFieldGoType returns the Go type used for the provided field in request bodies
+// and query parameters of the generated REST API. This is the type of the field,
+// unless it's a time field encoded as an integer (e.g. "TimeUnixMilli"), a numeric
+// field with a numeric format (e.g. "Numeric[*big.Int]"), a geo field (e.g.
+// "ent.GeoPoint[orb.Point]"), or an edge field referencing a type with an encoded ID
+// (see [Config.IDCodec]).
+func getFieldGoType(f *gen.Field) string {
+	if ref := getEdgeFieldType(f); ref != nil && HasEncodedID(ref) {
+		return "EncodedID"
+	}
+
+	if GetNumericFormat(f) != nil {
+		return "Numeric[" + f.Type.String() + "]"
+	}
+
+	if GetGeoOptions(f) != nil {
+		return "ent." + getGeoType(f)
+	}
+
+	switch GetTimeFormat(f) {
+	case TimeFormatUnix:
+		return "TimeUnix"
+	case TimeFormatUnixMilli:
+		return "TimeUnixMilli"
+	default:
+		return f.Type.String()
+	}
+}
+
+// convertFieldValue returns an expression which converts the provided value (of the
+// type returned by [getFieldGoType]) to the type of the field.
+func convertFieldValue(f *gen.Field, v string) string {
+	if ref := getEdgeFieldType(f); ref != nil && HasEncodedID(ref) {
+		return "int(" + v + ")"
+	}
+
+	if GetTimeFormat(f).isUnix() {
+		return "time.Time(" + v + ")"
+	}
+
+	var field string
+	switch {
+	case GetNumericFormat(f) != nil:
+		field = "Value"
+	case GetGeoOptions(f) != nil:
+		field = "Coordinates"
+	default:
+		return v
+	}
+
+	if strings.HasPrefix(v, "*") {
+		v = "(" + v + ")"
+	}
+	return v + "." + field
+}
+
+// getTimeFormatType returns the Go type used to encode the provided time field in
+// JSON output.
+func getTimeFormatType(f *gen.Field) string {
+	if GetTimeFormat(f).isUnix() {
+		return "int64"
+	}
+	return "string"
+}
+
+// formatTime returns an expression which formats the provided [time.Time] value of
+// the provided field, using its time format.
+func formatTime(f *gen.Field, v string) string {
+	switch GetTimeFormat(f) {
+	case TimeFormatRFC3339:
+		return v + ".UTC().Format(time.RFC3339)"
+	case TimeFormatUnix:
+		return v + ".Unix()"
+	case TimeFormatUnixMilli:
+		return v + ".UnixMilli()"
+	default:
+		return v + ".UTC().Format(time.RFC3339Nano)"
+	}
+}
+
+// applyTimeFormat applies the time format of the provided field (if any) to the
+// provided schema, including an example if the schema doesn't already have one.
+func applyTimeFormat(f *gen.Field, schema *ogen.Schema) (err error) {
+	format := GetTimeFormat(f)
+	if format == TimeFormatDefault {
+		return nil
+	}
+
+	if format.isUnix() {
+		schema.Type = "integer"
+		schema.Format = "int64"
+
+		unit := "seconds"
+		if format == TimeFormatUnixMilli {
+			unit = "milliseconds"
+		}
+		schema.Description = strings.TrimSpace(fmt.Sprintf("%s\n\nNumber of %s since the Unix epoch.", schema.Description, unit))
+	}
+
+	if schema.Example == nil {
+		schema.Example, err = json.Marshal(format.example())
+		if err != nil {
+			return fmt.Errorf("failed to marshal time example for field %s: %w", f.StructField(), err)
+		}
+	}
+	return nil
+}
+
+// validateTimeFormat checks that the time format of the provided field is supported.
+func validateTimeFormat(f *gen.Field, fa *Annotation) (errs []error) {
+	if fa.TimeFormat == TimeFormatDefault {
+		return nil
+	}
+
+	if !isTimeField(f) {
+		errs = append(errs, errors.New("time format is set on a field which isn't a time.Time field"))
+	}
+
+	if !slices.Contains(AllTimeFormats, fa.TimeFormat) {
+		errs = append(errs, fmt.Errorf("unsupported time format provided: %s", fa.TimeFormat))
+	}
+	return errs
+}
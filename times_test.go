@@ -0,0 +1,70 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSpec_TimeFormat(t *testing.T) {
+	t.Parallel()
+
+	t.Run("default", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Equal(t, "string", r.json(`$.components.schemas.User.properties.created_at.type`))
+		assert.Equal(t, "date-time", r.json(`$.components.schemas.User.properties.created_at.format`))
+		assert.Nil(t, r.json(`$.components.schemas.User.properties.created_at.example`))
+	})
+
+	t.Run("field", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User.created_at", WithTimeFormat(TimeFormatUnixMilli))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "integer", r.json(`$.components.schemas.User.properties.created_at.type`))
+		assert.Equal(t, "int64", r.json(`$.components.schemas.User.properties.created_at.format`))
+		assert.InDelta(t, 1704164645123, r.json(`$.components.schemas.User.properties.created_at.example`), 0)
+		assert.Contains(t, r.json(`$.components.schemas.User.properties.created_at.description`), "milliseconds since the Unix epoch")
+
+		assert.Equal(t, "date-time", r.json(`$.components.schemas.User.properties.updated_at.format`))
+	})
+
+	t.Run("config", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			TimeFormat: TimeFormatRFC3339,
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "User.updated_at", WithTimeFormat(TimeFormatUnix))
+				return nil
+			},
+		})
+
+		assert.Equal(t, "date-time", r.json(`$.components.schemas.User.properties.created_at.format`))
+		assert.Equal(t, "2024-01-02T03:04:05Z", r.json(`$.components.schemas.User.properties.created_at.example`))
+
+		assert.Equal(t, "integer", r.json(`$.components.schemas.User.properties.updated_at.type`))
+		assert.InDelta(t, 1704164645, r.json(`$.components.schemas.User.properties.updated_at.example`), 0)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := NewExtension(&Config{TimeFormat: "epoch"})
+		assert.ErrorContains(t, err, "unsupported time format")
+	})
+}
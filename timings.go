@@ -0,0 +1,99 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	"github.com/ogen-go/ogen"
+)
+
+// generateTimings records how long each phase of the spec generation, and the generation
+// of the operations of each schema took, to find slow schemas (see [Config.TimingWriter]).
+type generateTimings struct {
+	start   time.Time
+	phases  []timing
+	schemas []timing
+}
+
+type timing struct {
+	name       string
+	duration   time.Duration
+	operations int
+}
+
+func newGenerateTimings() *generateTimings {
+	return &generateTimings{start: time.Now()}
+}
+
+// phase records the duration of the provided phase, which started at the provided time.
+func (t *generateTimings) phase(name string, start time.Time) {
+	t.phases = append(t.phases, timing{name: name, duration: time.Since(start)})
+}
+
+// schema records the duration of generating the provided specs of the provided schema.
+func (t *generateTimings) schema(name string, duration time.Duration, specs []*ogen.Spec) {
+	var operations int
+	for _, spec := range specs {
+		for _, item := range spec.Paths {
+			PatchOperations(item, func(_ string, op *ogen.Operation) *ogen.Operation {
+				if op != nil {
+					operations++
+				}
+				return op
+			})
+		}
+	}
+
+	t.schemas = append(t.schemas, timing{name: name, duration: duration, operations: operations})
+}
+
+// write writes the timings report to the provided writer, if any, with the slowest
+// schemas first. Note that schemas are generated concurrently, so their durations add
+// up to more than the duration of the "schemas" phase.
+func (t *generateTimings) write(w io.Writer, target string, concurrency int) error {
+	if w == nil {
+		return nil
+	}
+
+	name := "spec"
+	if target != "" {
+		name = fmt.Sprintf("spec of target %q", target)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(tw, "entrest: generated %s in %s (concurrency: %d)\n", name, time.Since(t.start).Round(time.Microsecond), concurrency)
+	for _, p := range t.phases {
+		fmt.Fprintf(tw, "  phase %s\t%s\n", p.name, p.duration.Round(time.Microsecond))
+	}
+
+	schemas := slices.Clone(t.schemas)
+	slices.SortStableFunc(schemas, func(a, b timing) int {
+		return cmp.Compare(b.duration, a.duration)
+	})
+
+	for _, s := range schemas {
+		fmt.Fprintf(tw, "  schema %s\t%s\t%d operations\n", s.name, s.duration.Round(time.Microsecond), s.operations)
+	}
+	return tw.Flush()
+}
+
+// writeCodegenTiming writes how long the code generation (i.e. the rendering and
+// formatting of all templates, including those of ent itself) took to the provided
+// writer, if any.
+func writeCodegenTiming(w io.Writer, duration time.Duration) error {
+	if w == nil {
+		return nil
+	}
+
+	_, err := fmt.Fprintf(w, "entrest: generated code in %s\n", duration.Round(time.Microsecond))
+	return err
+}
@@ -0,0 +1,63 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// getTolerantReaderFields returns the properties of the read schema of the provided
+// type, which aren't accepted by the request body of the provided operation (create
+// or update), e.g. "id", read-only and computed fields, and "edges". These are removed
+// from request bodies when [Config.TolerantReader] is enabled.
+func getTolerantReaderFields(t *gen.Type, op Operation) (fields []string) {
+	cfg := GetConfig(t.Config)
+
+	if t.ID != nil && (op != OperationCreate || !HasClientProvidedID(t)) {
+		fields = append(fields, GetFieldName(t, t.ID))
+	}
+
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.GetSkip(cfg) || f.Sensitive() || !fa.GetReadable() {
+			continue
+		}
+
+		if !fa.GetWritable(op) || (op == OperationUpdate && f.Immutable) {
+			fields = append(fields, GetFieldName(t, f))
+		}
+	}
+
+	for _, cf := range GetComputedFields(t) {
+		fields = append(fields, getComputedFieldName(t, cf))
+	}
+
+	return append(fields, "edges")
+}
+
+// wrapTolerantReader wraps the provided handler (Go expression) of the provided
+// operation, so properties of the read schema of the provided type which can't be
+// written are removed from request bodies (see [Config.TolerantReader]).
+func wrapTolerantReader(t *gen.Type, op Operation, handler string) string {
+	if !GetConfig(t.Config).TolerantReader {
+		return handler
+	}
+
+	fields := getTolerantReaderFields(t, op)
+	for i := range fields {
+		fields[i] = strconv.Quote(fields[i])
+	}
+
+	return fmt.Sprintf(
+		"withTolerantReader(s, Operation%s, []string{%s}, %s)",
+		PascalCase(string(op)),
+		strings.Join(fields, ", "),
+		handler,
+	)
+}
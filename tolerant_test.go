@@ -0,0 +1,38 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTolerantReaderFields(t *testing.T) {
+	t.Parallel()
+
+	var create, update []string
+
+	_ = mustBuildSpec(t, &Config{
+		TolerantReader: true,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.description", WithReadOnly(true))
+
+			for _, n := range g.Nodes {
+				if n.Name != "User" {
+					continue
+				}
+				create = getTolerantReaderFields(n, OperationCreate)
+				update = getTolerantReaderFields(n, OperationUpdate)
+			}
+			return nil
+		},
+	})
+
+	assert.Equal(t, []string{"id", "description", "edges"}, create)
+	assert.Equal(t, []string{"id", "description", "created_at", "updated_at", "edges"}, update)
+}
@@ -0,0 +1,223 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// translationsParam is the name of the query parameter which returns all translations
+// of translated fields, rather than the localized value (see [WithTranslations]).
+const translationsParam = "translations"
+
+// TranslationOptions represents the options of a translated field (see
+// [WithTranslations]).
+type TranslationOptions struct {
+	// Fallback is the list of locales (in order of preference) which are used when
+	// none of the locales accepted by the client (through the Accept-Language header)
+	// have a translation, e.g. []string{"en"}. If none of the fallback locales have a
+	// translation either, the field is returned as null.
+	Fallback []string `json:",omitempty"`
+}
+
+// GetTranslationOptions returns the [TranslationOptions] of the provided field, through
+// [WithTranslations], or nil if it isn't a translated field.
+func GetTranslationOptions(f *gen.Field) *TranslationOptions {
+	if f == nil {
+		return nil
+	}
+	return GetAnnotation(f).Translations
+}
+
+// getTranslatedFields returns the translated fields of the provided type (see
+// [WithTranslations]), which are returned in responses.
+func getTranslatedFields(cfg *Config, t *gen.Type) (fields []*gen.Field) {
+	for _, f := range t.Fields {
+		fa := GetAnnotation(f)
+		if fa.Translations == nil || fa.GetSkip(cfg) || f.Sensitive() || !fa.GetReadable() {
+			continue
+		}
+		fields = append(fields, f)
+	}
+	return fields
+}
+
+// getTranslationTypes returns the types which have translated fields, or which can
+// include types with translated fields in their responses through eager-loaded edges.
+func getTranslationTypes(cfg *Config, nodes []*gen.Type) (types []*gen.Type) {
+	for _, t := range nodes {
+		if len(getTranslatedFields(cfg, t)) > 0 && !GetAnnotation(t).GetSkip(cfg) {
+			types = append(types, t)
+		}
+	}
+
+	for changed := len(types) > 0; changed; {
+		changed = false
+
+		for _, t := range nodes {
+			if slices.Contains(types, t) || GetAnnotation(t).GetSkip(cfg) {
+				continue
+			}
+
+			for _, node := range GetEagerLoadEdges(t) {
+				if slices.Contains(types, node.Edge.Type) {
+					types = append(types, t)
+					changed = true
+					break
+				}
+			}
+		}
+	}
+
+	slices.SortFunc(types, func(a, b *gen.Type) int {
+		return slices.Index(nodes, a) - slices.Index(nodes, b)
+	})
+	return types
+}
+
+// getTranslationEdges returns the eager-loaded edges of the provided type, which can
+// include types with translated fields.
+func getTranslationEdges(cfg *Config, nodes []*gen.Type, t *gen.Type) (edges []*gen.Edge) {
+	types := getTranslationTypes(cfg, nodes)
+
+	for _, node := range GetEagerLoadEdges(t) {
+		if slices.Contains(types, node.Edge.Type) {
+			edges = append(edges, node.Edge)
+		}
+	}
+	return edges
+}
+
+// hasTranslations returns true if responses of the provided type can include
+// translated fields, either of the type itself, or of its eager-loaded edges.
+func hasTranslations(cfg *Config, t *gen.Type) bool {
+	if len(getTranslatedFields(cfg, t)) > 0 {
+		return true
+	}
+
+	for _, node := range GetEagerLoadEdges(t) {
+		for _, n := range node.Walk() {
+			if len(getTranslatedFields(cfg, n.Edge.Type)) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// translationsSchema returns the schema of the provided translated field, as stored,
+// i.e. an object which maps locales to translations.
+func translationsSchema(_ *gen.Field) *ogen.Schema {
+	return &ogen.Schema{
+		Type:                 "object",
+		AdditionalProperties: &ogen.AdditionalProperties{Schema: ogen.Schema{Type: "string"}},
+		Example:              ogen.ExampleValue(`{"en":"Hello","fr":"Bonjour"}`),
+	}
+}
+
+// applyTranslationProperties replaces the properties of all translated fields of the
+// provided type in the provided (read) schema, which are either the localized value,
+// or all translations (see [WithTranslations]).
+func applyTranslationProperties(cfg *Config, t *gen.Type, schema *ogen.Schema) {
+	for _, f := range getTranslatedFields(cfg, t) {
+		name := GetFieldName(t, f)
+
+		i := slices.IndexFunc(schema.Properties, func(p ogen.Property) bool { return p.Name == name })
+		if i == -1 {
+			continue
+		}
+
+		translations := *schema.Properties[i].Schema
+		prop := &ogen.Schema{
+			Description: translations.Description,
+			Nullable:    true,
+			Deprecated:  translations.Deprecated,
+			OneOf: []*ogen.Schema{
+				{
+					Type:        "string",
+					Description: "The translation for the locale preferred by the client (through the Accept-Language header).",
+					Example:     ogen.ExampleValue(`"Hello"`),
+				},
+				&translations,
+			},
+		}
+
+		translations.Description = fmt.Sprintf("All translations, mapped by locale (when the %q query parameter is true).", translationsParam)
+		translations.Nullable = false
+		translations.Deprecated = false
+		translations.Default = nil
+
+		schema.Properties[i].Schema = prop
+	}
+}
+
+// addTranslationParams adds the translations query parameter and the Accept-Language
+// header (see [WithTranslations]) to all GET operations within the provided spec (and
+// the list operation of the type, which may use a different method, see
+// [WithOperationMethod]), if responses of the provided type can include translated
+// fields.
+func addTranslationParams(cfg *Config, spec *ogen.Spec, t *gen.Type) {
+	if !hasTranslations(cfg, t) {
+		return
+	}
+
+	listID := GetOperationIDName(OperationList, t, nil)
+
+	for pathName, pathItem := range spec.Paths {
+		spec.Paths[pathName] = PatchOperations(pathItem, func(method string, op *ogen.Operation) *ogen.Operation {
+			if op == nil || (method != http.MethodGet && op.OperationID != listID) {
+				return op
+			}
+
+			op.Parameters = append(
+				op.Parameters,
+				&ogen.Parameter{
+					Name:        "Accept-Language",
+					In:          "header",
+					Description: "The locales preferred by the client, which translated fields are localized to, e.g. `fr-CA, fr;q=0.9, en;q=0.8`.",
+					Schema:      ogen.String(),
+				},
+				&ogen.Parameter{
+					Name:        translationsParam,
+					In:          "query",
+					Description: "If true, translated fields return all translations (mapped by locale), rather than the translation for the preferred locale.",
+					Schema:      ogen.Bool(),
+				},
+			)
+			return op
+		})
+	}
+}
+
+// validateTranslations checks that the provided translated field is a JSON object
+// field, which isn't also encoded in another format.
+func validateTranslations(f *gen.Field, fa *Annotation) (errs []error) {
+	if fa.Translations == nil {
+		return nil
+	}
+
+	if rt := f.Type.RType; !f.IsJSON() || rt == nil || rt.Kind != reflect.Map {
+		errs = append(errs, errors.New("translated fields must be JSON fields of a map type, e.g. map[string]string"))
+	}
+
+	for _, locale := range fa.Translations.Fallback {
+		if locale == "" || locale == "*" || strings.ContainsAny(locale, ",; ") {
+			errs = append(errs, fmt.Errorf("invalid fallback locale %q", locale))
+		}
+	}
+
+	if fa.TimeFormat != TimeFormatDefault || fa.NumericFormat != nil || fa.Geo != nil || fa.File != nil || fa.Hashed || fa.MoneyCurrencyField != "" {
+		errs = append(errs, errors.New("translated fields can't also be time, numeric, geo, file, hashed or money fields"))
+	}
+	return errs
+}
@@ -0,0 +1,78 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"reflect"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpec_Translations(t *testing.T) {
+	t.Parallel()
+
+	t.Run("localized", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				for _, f := range g.Nodes[0].Fields {
+					if f.Name == "nicknames" {
+						typ, rt := *f.Type, *f.Type.RType
+						rt.Kind = reflect.Map
+						typ.RType = &rt
+						f.Type = &typ
+					}
+				}
+				injectAnnotations(t, g, "AllTypes.nicknames", WithTranslations(TranslationOptions{Fallback: []string{"en"}}))
+				return ValidateAnnotations(g.Nodes...)
+			},
+		})
+
+		for _, name := range []string{"AllTypeCreate", "AllTypeUpdate"} {
+			prefix := "$.components.schemas." + name + ".properties.nicknames"
+
+			assert.Equal(t, "object", r.json(prefix+".type"), name)
+			assert.Equal(t, "string", r.json(prefix+".additionalProperties.type"), name)
+		}
+
+		prefix := "$.components.schemas.AllType.properties.nicknames"
+		assert.Equal(t, true, r.json(prefix+".nullable"))
+		assert.Equal(t, "string", r.json(prefix+".oneOf[0].type"))
+		assert.Equal(t, "object", r.json(prefix+".oneOf[1].type"))
+
+		params := r.json(`$.paths./all-types.get.parameters[*].name`)
+		assert.Contains(t, params, "translations")
+		assert.Contains(t, params, "Accept-Language")
+		assert.Contains(t, r.json(`$.paths./all-types/{alltypeID}.get.parameters[*].name`), "translations")
+		assert.NotContains(t, r.json(`$.paths./pets.get.parameters[*].name`), "translations")
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		t.Parallel()
+
+		for _, tt := range []struct {
+			path     string
+			opts     TranslationOptions
+			contains string
+		}{
+			{"AllTypes.nicknames", TranslationOptions{}, "translated fields must be JSON fields of a map type"},
+			{"AllTypes.string_type", TranslationOptions{}, "translated fields must be JSON fields of a map type"},
+			{"AllTypes.nicknames", TranslationOptions{Fallback: []string{"en, fr"}}, `invalid fallback locale "en, fr"`},
+		} {
+			_, err := buildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, tt.path, WithTranslations(tt.opts))
+					return ValidateAnnotations(g.Nodes...)
+				},
+			})
+			require.ErrorContains(t, err, tt.contains)
+		}
+	})
+}
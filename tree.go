@@ -0,0 +1,171 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strconv"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// TreeDirection is the direction in which a tree is traversed (see [WithTreeTraversal]).
+type TreeDirection string
+
+const (
+	// TreeAncestors traverses up the tree, from the parent through to the root.
+	TreeAncestors TreeDirection = "ancestors"
+	// TreeDescendants traverses down the tree, through the children of each level.
+	TreeDescendants TreeDirection = "descendants"
+)
+
+// TreeDirections holds a list of all supported tree traversal directions.
+var TreeDirections = []TreeDirection{TreeAncestors, TreeDescendants}
+
+// IsTreeEdge returns true if the provided edge (of the provided type) is a
+// self-referential O2M edge (or its inverse M2O edge), e.g. the "children" or "parent"
+// edge of a Category schema, which can be traversed as a tree.
+func IsTreeEdge(t *gen.Type, e *gen.Edge) bool {
+	return e != nil && e.Type == t && (e.O2M() || e.M2O())
+}
+
+// GetTreeEdge returns the edge of the provided type which has tree traversal enabled
+// (see [WithTreeTraversal]), or nil if there isn't one.
+func GetTreeEdge(t *gen.Type) *gen.Edge {
+	if t.ID == nil {
+		return nil
+	}
+
+	cfg := GetConfig(t.Config)
+
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+		if IsTreeEdge(t, e) && !ea.GetSkip(cfg) && ea.GetTreeTraversalDepth() > 0 {
+			return e
+		}
+	}
+	return nil
+}
+
+// hasTreeEdges returns true if any of the provided types have a tree edge (see
+// [GetTreeEdge]).
+func hasTreeEdges(nodes []*gen.Type) bool {
+	return slices.ContainsFunc(nodes, func(t *gen.Type) bool {
+		return !GetAnnotation(t).GetSkip(GetConfig(t.Config)) && GetTreeEdge(t) != nil
+	})
+}
+
+// GetTreePathName returns the path of the tree traversal endpoint of the provided type,
+// for the provided direction (e.g. "/categories/{id}/ancestors").
+func GetTreePathName(t *gen.Type, direction TreeDirection, useUniqueID bool) string {
+	return GetPathName(OperationRead, t, nil, useUniqueID) + "/" + string(direction)
+}
+
+// GetTreeOperationID returns the operation ID of the tree traversal endpoint of the
+// provided type, for the provided direction (e.g. "listCategoryAncestors").
+func GetTreeOperationID(t *gen.Type, direction TreeDirection) string {
+	return "list" + GetSchemaName(t) + PascalCase(string(direction))
+}
+
+// GetSpecTree generates an independent spec for the tree traversal endpoints of the
+// provided type, using the provided self-referential edge (see [WithTreeTraversal]).
+func GetSpecTree(t *gen.Type, e *gen.Edge) (*ogen.Spec, error) {
+	if !IsTreeEdge(t, e) {
+		return nil, errors.New("edge is not a self-referential O2M/M2O edge of the type")
+	}
+
+	cfg := GetConfig(t.Config)
+	ta := GetAnnotation(t)
+	ea := GetAnnotation(e)
+	entityName := GetSchemaName(t)
+	maxDepth := ea.GetTreeTraversalDepth()
+
+	spec := newBaseSpec(cfg)
+	spec.Tags = append(spec.Tags, ogen.Tag{Name: Pluralize(t.Name), Description: ta.Description})
+
+	idSchema, err := GetSchemaID(t)
+	if err != nil {
+		return nil, err
+	}
+
+	spec.Components.Parameters[entityName+"ID"] = &ogen.Parameter{
+		Name:        GetParamName(t),
+		In:          "path",
+		Description: fmt.Sprintf("The ID of the %s to act upon.", entityName),
+		Required:    true,
+		Schema:      idSchema,
+	}
+
+	for k, v := range GetSchemaType(t, OperationRead, nil) {
+		spec.Components.Schemas[k] = v
+	}
+
+	for _, direction := range TreeDirections {
+		var summary, description string
+
+		switch direction {
+		case TreeAncestors:
+			summary = fmt.Sprintf("List the ancestors of a %s", CamelCase(entityName))
+			description = fmt.Sprintf(
+				"List the ancestors of a %s (following the %q edge), ordered from the parent through to the root.",
+				CamelCase(entityName),
+				e.Name,
+			)
+		case TreeDescendants:
+			summary = fmt.Sprintf("List the descendants of a %s", CamelCase(entityName))
+			description = fmt.Sprintf(
+				"List the descendants of a %s (following the %q edge), ordered by their depth relative to the %s.",
+				CamelCase(entityName),
+				e.Name,
+				CamelCase(entityName),
+			)
+		}
+
+		oper := &ogen.Operation{
+			Tags:        []string{Pluralize(t.Name)},
+			Summary:     summary,
+			Description: description,
+			OperationID: GetTreeOperationID(t, direction),
+			Deprecated:  ta.Deprecated || ea.Deprecated,
+			Parameters: []*ogen.Parameter{
+				{
+					Name:        "depth",
+					In:          "query",
+					Description: "The max number of levels of the tree to traverse.",
+					Schema: ogen.Int().
+						SetMinimum(ptr(int64(1))).
+						SetMaximum(ptr(int64(maxDepth))).
+						SetDefault(json.RawMessage(strconv.Itoa(maxDepth))),
+				},
+			},
+			Responses: ogen.Responses{
+				strconv.Itoa(http.StatusOK): ogen.NewResponse().
+					SetDescription(fmt.Sprintf("The requested %s.", string(direction))).
+					SetJSONContent(ogen.NewSchema().SetRef("#/components/schemas/" + GetReadSchemaName(t)).AsArray()),
+			},
+		}
+
+		if cfg.RequestPolicy != nil {
+			withPolicyRange(oper.Parameters[0], maxDepth, cfg.RequestPolicy.MaxTreeTraversalDepth)
+		}
+
+		spec.Paths[GetTreePathName(t, direction, true)] = &ogen.PathItem{
+			Summary:     oper.Summary,
+			Description: oper.Description,
+			Get:         oper,
+			Parameters: []*ogen.Parameter{
+				{Ref: "#/components/parameters/PrettyResponse"},
+				{Ref: "#/components/parameters/" + entityName + "ID"},
+			},
+		}
+	}
+
+	return spec, nil
+}
@@ -0,0 +1,66 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTree_Endpoints(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{})
+
+		assert.Nil(t, r.json(`$.paths./categories/{categoryID}/ancestors`))
+		assert.Nil(t, r.json(`$.paths./categories/{categoryID}/descendants`))
+	})
+
+	for _, edge := range []string{"Category.children", "Category.parent"} {
+		t.Run(edge, func(t *testing.T) {
+			t.Parallel()
+
+			r := mustBuildSpec(t, &Config{
+				PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+					injectAnnotations(t, g, edge, WithTreeTraversal(5))
+					return nil
+				},
+			})
+
+			assert.Equal(t, "listCategoryAncestors", r.json(`$.paths./categories/{categoryID}/ancestors.get.operationId`))
+			assert.Equal(t, "listCategoryDescendants", r.json(`$.paths./categories/{categoryID}/descendants.get.operationId`))
+
+			depth := `$.paths./categories/{categoryID}/descendants.get.parameters[?(@.name == "depth")].schema`
+			assert.Equal(t, float64(5), r.json(depth+`.maximum`))
+			assert.Equal(t, float64(5), r.json(depth+`.default`))
+
+			assert.Equal(t, "array", r.json(`$.paths./categories/{categoryID}/ancestors.get.responses.200.content.application/json.schema.type`))
+			assert.Contains(t, r.json(`$.paths./categories/{categoryID}/ancestors.get.responses.200.content.application/json.schema.items.$ref`), "/CategoryRead")
+		})
+	}
+
+	t.Run("default-depth", func(t *testing.T) {
+		t.Parallel()
+
+		r := mustBuildSpec(t, &Config{
+			PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, "Category.children", WithTreeTraversal(0))
+				return nil
+			},
+		})
+
+		assert.Equal(
+			t,
+			float64(defaultTreeTraversalDepth),
+			r.json(`$.paths./categories/{categoryID}/ancestors.get.parameters[?(@.name == "depth")].schema.maximum`),
+		)
+	})
+}
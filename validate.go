@@ -0,0 +1,908 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+)
+
+// AnnotationError is an error with an annotation, including the location (schema,
+// and optionally the field or edge) of the offending annotation.
+type AnnotationError struct {
+	// Schema is the name of the schema the annotation is on (or the schema the field
+	// or edge belongs to).
+	Schema string
+	// Field is the name of the field the annotation is on, if any.
+	Field string
+	// Edge is the name of the edge the annotation is on, if any.
+	Edge string
+	// Pos is the source position of the field or edge (or the schema, if the position
+	// of the field or edge isn't known), e.g. "schema/user.go:42", if known.
+	Pos string
+	// Err is the underlying error.
+	Err error
+}
+
+// Location returns the location of the annotation, e.g. "schema User field email".
+func (e *AnnotationError) Location() string {
+	var sb strings.Builder
+	sb.WriteString("schema " + e.Schema)
+	if e.Field != "" {
+		sb.WriteString(" field " + e.Field)
+	}
+	if e.Edge != "" {
+		sb.WriteString(" edge " + e.Edge)
+	}
+	return sb.String()
+}
+
+func (e *AnnotationError) Error() string {
+	if e.Pos != "" {
+		return e.Pos + ": " + e.Location() + ": " + e.Err.Error()
+	}
+	return e.Location() + ": " + e.Err.Error()
+}
+
+func (e *AnnotationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateAnnotationConflicts checks all annotations on the provided nodes for
+// conflicts with each other and the provided config (e.g. unknown default sort fields,
+// or eager-loading missing edges), which would otherwise fail deep inside template
+// execution. Conflicts which are ignored during generation (e.g. pagination disabled
+// but items-per-page set, or eager-loading skipped edges) are only returned if
+// [Config.StrictAnnotations] is enabled, and otherwise warned about during generation.
+// All errors are returned (joined), each as an [*AnnotationError], including the
+// source position of the offending schema, field or edge where it's known.
+func ValidateAnnotationConflicts(cfg *Config, nodes ...*gen.Type) error {
+	var errs []error
+
+	if cfg.StrictAnnotations {
+		errs = append(errs, ignoredAnnotationConflicts(cfg, nodes)...)
+	}
+
+	for _, t := range nodes {
+		ta := GetAnnotation(t)
+
+		if ta.DefaultSort != nil && !ta.GetSkip(cfg) {
+			if err := validateDefaultSort(cfg, t, *ta.DefaultSort); err != nil {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+			}
+		}
+
+		if err := validateStableSorting(cfg, t, ta); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateTreeConflicts(cfg, t) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateAlternateKeys(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		errs = append(errs, validateFileFields(cfg, t)...)
+		errs = append(errs, validateMoneyFields(cfg, t)...)
+		errs = append(errs, validateProtoInterop(cfg, t)...)
+
+		for _, err := range validateReadOnlyConflicts(t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		if ta.ClientID != nil && *ta.ClientID && !SupportsClientProvidedID(t) {
+			errs = append(errs, &AnnotationError{
+				Schema: t.Name,
+				Err:    errors.New("client provided IDs are only supported on schemas with a single, user-defined ID field"),
+			})
+		}
+
+		if ta.PathName != "" {
+			if err := validatePathName(ta.PathName); err != nil {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+			}
+		}
+
+		if ta.Group != "" {
+			if err := validatePathName(ta.Group); err != nil {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+			}
+		}
+
+		for _, err := range validateSchemaAliases(t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateFieldAliases(t) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateDryRun(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateChangedFields(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateHistory(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateSoftDelete(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateAttachments(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateConditionalRequirements(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateCrossValidations(cfg, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateOperationMethods(cfg, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		if err := validateCreateResponse(cfg, t, ta); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		if err := validateDeduplication(cfg, ta); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		if err := validateFlavor(cfg, ta); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateSeekPagination(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateResponseStatuses(ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateRequestHeaders(ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateSQLModifiers(t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateSubscriptions(cfg, t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, err := range validateComputedFields(t, ta) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		if ta.IDFormat != nil {
+			if t.ID == nil {
+				errs = append(errs, &AnnotationError{
+					Schema: t.Name,
+					Err:    errors.New("ID formats are only supported on schemas with a single ID field"),
+				})
+			} else if err := ta.IDFormat.validate(); err != nil {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+			}
+		}
+
+		if t.ID != nil {
+			for _, err := range validateFieldToggles(t, t.ID, GetAnnotation(t.ID)) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Field: t.ID.Name, Err: err})
+			}
+
+			for _, err := range validateHashedField(t, t.ID, GetAnnotation(t.ID)) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Field: t.ID.Name, Err: err})
+			}
+		}
+
+		for _, f := range t.Fields {
+			fa := GetAnnotation(f)
+
+			for _, err := range validateFieldConflicts(cfg, f, fa) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Field: f.Name, Err: err})
+			}
+
+			for _, err := range validateFieldToggles(t, f, fa) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Field: f.Name, Err: err})
+			}
+
+			for _, err := range validateHashedField(t, f, fa) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Field: f.Name, Err: err})
+			}
+		}
+
+		for _, e := range t.Edges {
+			ea := GetAnnotation(e)
+
+			for _, err := range validateEdgeConflicts(cfg, t, e, ea) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
+			}
+
+			for _, err := range validateParentFields(cfg, t, e, ea) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
+			}
+
+			if ea.PathName != "" {
+				if err := validatePathName(ea.PathName); err != nil {
+					errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
+				}
+			}
+		}
+	}
+
+	errs = append(errs, validatePathSegments(cfg, nodes)...)
+	errs = append(errs, validateAliasPaths(cfg, nodes)...)
+	errs = append(errs, validateRequestHeaderTypes(cfg, nodes)...)
+	errs = append(errs, validateSubscriptionsPath(cfg, nodes)...)
+	errs = append(errs, validateBatchPath(cfg, nodes)...)
+	errs = append(errs, validateComputedFieldResolvers(nodes)...)
+
+	for _, t := range nodes {
+		errs = append(errs, validateVersionRanges(cfg, t)...)
+		errs = append(errs, validateDeprecations(t)...)
+	}
+
+	setAnnotationPositions(nodes, errs)
+	return errors.Join(errs...)
+}
+
+// ignoredAnnotationConflicts returns the annotation conflicts of the provided nodes
+// which are ignored during generation, e.g. pagination disabled but items-per-page set,
+// or eager-load limits on edges which aren't eager-loaded. See
+// [Config.StrictAnnotations].
+func ignoredAnnotationConflicts(cfg *Config, nodes []*gen.Type) (errs []error) {
+	for _, t := range nodes {
+		for _, err := range validatePaginationConflicts(cfg, GetAnnotation(t)) {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+		}
+
+		for _, f := range t.Fields {
+			for _, err := range validateSkippedField(cfg, GetAnnotation(f)) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Field: f.Name, Err: err})
+			}
+		}
+
+		for _, e := range t.Edges {
+			ea := GetAnnotation(e)
+
+			for _, err := range validatePaginationConflicts(cfg, ea) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
+			}
+
+			for _, err := range validateIgnoredEdgeConflicts(cfg, e, ea) {
+				errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
+			}
+		}
+	}
+	return errs
+}
+
+// warnAnnotationConflicts writes a warning to [Config.WarningWriter] for each annotation
+// conflict which is ignored during generation (see [ignoredAnnotationConflicts]),
+// unless [Config.StrictAnnotations] is enabled, in which case they are returned by
+// [ValidateAnnotationConflicts] instead.
+func warnAnnotationConflicts(cfg *Config, nodes []*gen.Type) error {
+	if cfg.StrictAnnotations {
+		return nil
+	}
+
+	errs := ignoredAnnotationConflicts(cfg, nodes)
+	setAnnotationPositions(nodes, errs)
+
+	for _, err := range errs {
+		if werr := cfg.warnf("%s (ignored)", err.Error()); werr != nil {
+			return werr
+		}
+	}
+	return nil
+}
+
+// pathNameRegex matches a single path segment, consisting only of unreserved URL
+// characters.
+var pathNameRegex = regexp.MustCompile(`^[A-Za-z0-9._~-]+$`)
+
+// validatePathName checks that the provided path name (see [WithPathName]) is a single,
+// valid path segment.
+func validatePathName(v string) error {
+	if !pathNameRegex.MatchString(v) || v == "." || v == ".." {
+		return fmt.Errorf("path name %q is not a valid path segment", v)
+	}
+	return nil
+}
+
+// validateOperationMethods checks that the HTTP method overrides of a schema (see
+// [WithOperationMethod]) are supported by their operations, and that no two enabled
+// operations sharing the same path use the same method.
+func validateOperationMethods(cfg *Config, a *Annotation) (errs []error) {
+	for _, op := range mapKeys(a.OperationMethod) {
+		if !slices.Contains(AllOperations, op) {
+			errs = append(errs, fmt.Errorf("HTTP method provided for unknown operation %q", op))
+			continue
+		}
+
+		if method := a.GetOperationMethod(op); !slices.Contains(AllowedOperationMethods[op], method) {
+			errs = append(errs, fmt.Errorf(
+				"HTTP method %q is not supported for the %s operation (supported: %s)",
+				method,
+				op,
+				strings.Join(AllowedOperationMethods[op], ", "),
+			))
+		}
+	}
+
+	ops := a.GetOperations(cfg)
+
+	for _, group := range [][]Operation{
+		{OperationCreate, OperationList},
+		{OperationRead, OperationUpdate, OperationDelete},
+	} {
+		seen := map[string]Operation{}
+		for _, op := range group {
+			if !slices.Contains(ops, op) {
+				continue
+			}
+
+			method := a.GetOperationMethod(op)
+			if other, ok := seen[method]; ok {
+				errs = append(errs, fmt.Errorf("the %s and %s operations both use HTTP method %q on the same path", other, op, method))
+				continue
+			}
+			seen[method] = op
+		}
+	}
+	return errs
+}
+
+// validateCreateResponse checks that the create response of a schema (see
+// [WithCreateResponse]) is supported, and that entities responding with only a
+// "Location" header can be read through their own endpoint.
+func validateCreateResponse(cfg *Config, t *gen.Type, a *Annotation) error {
+	if !slices.Contains(AllCreateResponses, a.CreateResponse) {
+		return fmt.Errorf("unsupported create response provided: %s", a.CreateResponse)
+	}
+
+	if a.CreateResponse == CreateResponseLocation && a.HasOperation(cfg, OperationCreate) && (t.ID == nil || !a.HasOperation(cfg, OperationRead)) {
+		return errors.New("location create responses require a single ID field, and the read operation to be enabled")
+	}
+	return nil
+}
+
+// validateResponseStatuses checks that the status code overrides of a schema (see
+// [WithResponseStatus]) are successful status codes, which allow a response body when
+// the operation responds with one.
+func validateResponseStatuses(a *Annotation) (errs []error) {
+	for _, op := range mapKeys(a.ResponseStatus) {
+		if !slices.Contains(AllOperations, op) {
+			errs = append(errs, fmt.Errorf("status code provided for unknown operation %q", op))
+			continue
+		}
+
+		code := a.ResponseStatus[op]
+		if code < 200 || code > 299 {
+			errs = append(errs, fmt.Errorf("status code %d is not a successful (2xx) status code for the %s operation", code, op))
+			continue
+		}
+
+		hasBody := op != OperationDelete && (op != OperationCreate || a.CreateResponse == CreateResponseBody)
+		if hasBody && (code == http.StatusNoContent || code == http.StatusResetContent) {
+			errs = append(errs, fmt.Errorf("status code %d does not allow a response body, which the %s operation responds with", code, op))
+		}
+	}
+	return errs
+}
+
+// validatePathSegments checks that no two (non-skipped) schemas share the same path
+// segment (see [GetPathSegment]) within the same group (see [WithGroup]), and that no
+// group shares the path segment of an ungrouped schema, as their endpoints would
+// conflict.
+func validatePathSegments(cfg *Config, nodes []*gen.Type) (errs []error) {
+	seen := map[string]string{}
+	groups := map[string]string{}
+
+	for _, t := range nodes {
+		ta := GetAnnotation(t)
+		if ta.GetSkip(cfg) {
+			continue
+		}
+
+		segment := GetPathSegment(t, nil)
+		if ta.Group != "" {
+			if _, ok := groups[ta.Group]; !ok {
+				groups[ta.Group] = t.Name
+			}
+			segment = ta.Group + "/" + segment
+		}
+
+		if other, ok := seen[segment]; ok {
+			errs = append(errs, &AnnotationError{
+				Schema: t.Name,
+				Err:    fmt.Errorf("path segment %q is already used by schema %s", segment, other),
+			})
+			continue
+		}
+		seen[segment] = t.Name
+	}
+
+	for _, group := range mapKeys(groups) {
+		if other, ok := seen[group]; ok {
+			errs = append(errs, &AnnotationError{
+				Schema: groups[group],
+				Err:    fmt.Errorf("group %q conflicts with the path segment of schema %s", group, other),
+			})
+		}
+	}
+	return errs
+}
+
+// validatePaginationConflicts checks for pagination related conflicts on a schema
+// or edge annotation.
+func validatePaginationConflicts(cfg *Config, a *Annotation) (errs []error) {
+	disabled := (a.Pagination != nil && !*a.Pagination) || (a.Pagination == nil && cfg.DisablePagination)
+
+	if disabled && (a.MinItemsPerPage != 0 || a.MaxItemsPerPage != 0 || a.ItemsPerPage != 0) {
+		errs = append(errs, errors.New("pagination is disabled, but min/max/items per page is set"))
+	}
+
+	if minItems, maxItems := a.GetMinItemsPerPage(cfg), a.GetMaxItemsPerPage(cfg); minItems > maxItems {
+		errs = append(errs, fmt.Errorf("min items per page (%d) is greater than max items per page (%d)", minItems, maxItems))
+	}
+
+	if a.ItemsPerPage != 0 && (a.ItemsPerPage < a.GetMinItemsPerPage(cfg) || a.ItemsPerPage > a.GetMaxItemsPerPage(cfg)) {
+		errs = append(errs, fmt.Errorf(
+			"items per page (%d) is outside of the min/max items per page (%d-%d)",
+			a.ItemsPerPage,
+			a.GetMinItemsPerPage(cfg),
+			a.GetMaxItemsPerPage(cfg),
+		))
+	}
+
+	return errs
+}
+
+// warnSensitiveFields writes a warning to [Config.WarningWriter] for each sensitive field
+// (of the provided types) which has filtering, sorting or an example enabled, which
+// have no effect, as sensitive fields are never exposed.
+func warnSensitiveFields(cfg *Config, nodes []*gen.Type) error {
+	for _, t := range nodes {
+		for _, f := range t.Fields {
+			if !f.Sensitive() {
+				continue
+			}
+
+			fa := GetAnnotation(f)
+
+			var enabled []string
+			if fa.Filter != 0 || fa.FilterGroup != "" {
+				enabled = append(enabled, "filtering")
+			}
+			if fa.Sortable {
+				enabled = append(enabled, "sorting")
+			}
+			if fa.Example != nil {
+				enabled = append(enabled, "an example")
+			}
+
+			if len(enabled) == 0 {
+				continue
+			}
+
+			err := cfg.warnf(
+				"schema %s field %s is sensitive, so enabling %s has no effect",
+				t.Name,
+				f.Name,
+				strings.Join(enabled, " and "),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// validateSkippedField checks that filtering and sorting aren't enabled on a skipped
+// field annotation, as they are ignored.
+func validateSkippedField(cfg *Config, fa *Annotation) (errs []error) {
+	if !fa.GetSkip(cfg) {
+		return nil
+	}
+
+	if fa.Filter != 0 || fa.FilterGroup != "" {
+		errs = append(errs, errors.New("filtering is enabled on a skipped field"))
+	}
+	if fa.Sortable {
+		errs = append(errs, errors.New("sorting is enabled on a skipped field"))
+	}
+	return errs
+}
+
+// validateFieldConflicts checks for conflicts on a field annotation.
+func validateFieldConflicts(_ *Config, f *gen.Field, fa *Annotation) (errs []error) {
+	errs = append(errs, validateEnum(f, fa)...)
+	errs = append(errs, validateTimeFormat(f, fa)...)
+	errs = append(errs, validateNumericFormat(f, fa)...)
+	errs = append(errs, validateGeo(f, fa)...)
+	errs = append(errs, validateTranslations(f, fa)...)
+
+	return errs
+}
+
+// validateIgnoredEdgeConflicts checks for conflicts on an edge annotation which are
+// ignored, e.g. eager-loading a skipped edge.
+func validateIgnoredEdgeConflicts(cfg *Config, e *gen.Edge, ea *Annotation) (errs []error) {
+	skipped := ea.GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg)
+
+	if skipped && ea.EagerLoad != nil && *ea.EagerLoad {
+		errs = append(errs, errors.New("eager-loading is enabled on a skipped edge (or edge to a skipped schema)"))
+	}
+
+	if skipped && ea.EdgeEndpoint != nil && *ea.EdgeEndpoint {
+		errs = append(errs, errors.New("edge endpoint is enabled on a skipped edge (or edge to a skipped schema)"))
+	}
+
+	if ea.EagerLoadLimit != nil && *ea.EagerLoadLimit != 0 && !ea.GetEagerLoad(cfg) {
+		errs = append(errs, errors.New("eager-load limit is set, but the edge is not eager-loaded"))
+	}
+	return errs
+}
+
+// validateEdgeConflicts checks for conflicts on an edge annotation.
+func validateEdgeConflicts(cfg *Config, t *gen.Type, e *gen.Edge, ea *Annotation) (errs []error) {
+	skipped := ea.GetSkip(cfg) || GetAnnotation(e.Type).GetSkip(cfg)
+
+	if ea.EagerLoadDepth < 0 {
+		errs = append(errs, fmt.Errorf("eager-load depth (%d) must be greater than 0", ea.EagerLoadDepth))
+	}
+
+	if (ea.EagerLoadDepth > 1 || ea.EagerLoadEdges != nil) && !ea.GetEagerLoad(cfg) {
+		errs = append(errs, errors.New("eager-load depth or nested edges are set, but the edge is not eager-loaded"))
+	}
+
+	if ea.TreeTraversal != nil {
+		switch {
+		case !IsTreeEdge(t, e):
+			errs = append(errs, errors.New("tree traversal is only supported on self-referential O2M (or inverse M2O) edges"))
+		case skipped:
+			errs = append(errs, errors.New("tree traversal is enabled on a skipped edge"))
+		case t.ID == nil:
+			errs = append(errs, errors.New("tree traversal is not supported on schemas without an ID"))
+		}
+	}
+
+	for _, name := range ea.EagerLoadFields {
+		idx := slices.IndexFunc(e.Type.Fields, func(f *gen.Field) bool { return f.Name == name })
+		switch {
+		case name == "id":
+			continue
+		case idx == -1:
+			errs = append(errs, fmt.Errorf("eager-load field %q does not exist on schema %s", name, e.Type.Name))
+		case e.Type.Fields[idx].Sensitive() || GetAnnotation(e.Type.Fields[idx]).GetSkip(cfg) || !GetAnnotation(e.Type.Fields[idx]).GetReadable():
+			errs = append(errs, fmt.Errorf("eager-load field %q is sensitive, skipped or not readable", name))
+		}
+	}
+
+	for _, p := range ea.EagerLoadEdges {
+		nested := findEdgePath(e.Type, p)
+		if nested == nil {
+			errs = append(errs, fmt.Errorf("nested eager-load edge %q does not exist on schema %s", p, e.Type.Name))
+			continue
+		}
+		if GetAnnotation(nested).GetSkip(cfg) || GetAnnotation(nested.Type).GetSkip(cfg) {
+			errs = append(errs, fmt.Errorf("nested eager-load edge %q is skipped (or is an edge to a skipped schema)", p))
+		}
+	}
+
+	return errs
+}
+
+// validateTreeConflicts checks that at most one edge of a schema has tree traversal
+// enabled, and that the tree traversal endpoints don't conflict with edge endpoints.
+func validateTreeConflicts(cfg *Config, t *gen.Type) (errs []error) {
+	var enabled []string
+
+	for _, e := range t.Edges {
+		if GetAnnotation(e).TreeTraversal != nil && IsTreeEdge(t, e) {
+			enabled = append(enabled, e.Name)
+		}
+	}
+
+	if len(enabled) > 1 {
+		errs = append(errs, fmt.Errorf("tree traversal is enabled on multiple edges (%s), only one is supported", strings.Join(enabled, ", ")))
+	}
+
+	if len(enabled) == 0 {
+		return errs
+	}
+
+	for _, e := range t.Edges {
+		ea := GetAnnotation(e)
+		if ea.GetSkip(cfg) || !ea.GetEdgeEndpoint(cfg) {
+			continue
+		}
+
+		for _, direction := range TreeDirections {
+			if GetPathName(OperationRead, t, e, false) == GetTreePathName(t, direction, false) {
+				errs = append(errs, fmt.Errorf("edge %q endpoint conflicts with the tree traversal %s endpoint", e.Name, direction))
+			}
+		}
+	}
+
+	return errs
+}
+
+// validateReadOnlyConflicts checks that read-only schemas (see [IsReadOnly]) don't
+// explicitly enable any mutation operations.
+func validateReadOnlyConflicts(t *gen.Type, ta *Annotation) (errs []error) {
+	if !IsReadOnly(t) {
+		return nil
+	}
+
+	for _, op := range ta.Operations {
+		if !slices.Contains(ReadOnlyOperations, op) {
+			errs = append(errs, fmt.Errorf("operation %q is enabled on a read-only schema (or view), only read and list are supported", op))
+		}
+	}
+	return errs
+}
+
+// validateStableSorting checks that paginated list endpoints of a schema have a total
+// ordering (see [GetSortTiebreakFields]), as otherwise, results may be duplicated or
+// skipped between pages.
+func validateStableSorting(cfg *Config, t *gen.Type, a *Annotation) error {
+	if a.GetSkip(cfg) || !a.HasOperation(cfg, OperationList) || !a.GetPagination(cfg, nil) {
+		return nil
+	}
+
+	if len(GetSortTiebreakFields(t)) == 0 {
+		return errors.New("paginated schemas require an ID (or composite ID) to provide a stable ordering, consider disabling pagination (see WithPagination)")
+	}
+	return nil
+}
+
+// warnRandomSorting warns about paginated list endpoints which are sorted randomly by
+// default, as results may be duplicated or skipped between pages.
+func warnRandomSorting(cfg *Config, nodes []*gen.Type) error {
+	for _, t := range nodes {
+		ta := GetAnnotation(t)
+		if ta.GetSkip(cfg) || !ta.HasOperation(cfg, OperationList) || !ta.GetPagination(cfg, nil) {
+			continue
+		}
+
+		if ta.GetDefaultSort(t.ID != nil) != "random" {
+			continue
+		}
+
+		err := cfg.warnf(
+			`schema %s default sort field "random" does not provide a stable ordering for paginated results, consider disabling pagination (see WithPagination)`,
+			t.Name,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validateDefaultSort checks that the default sort field of a schema exists and is
+// sortable. Edge sorting (e.g. "<edge>.count") is validated during generation.
+func validateDefaultSort(cfg *Config, t *gen.Type, v string) error {
+	if v == "random" || strings.Contains(v, ".") || (v == "id" && t.ID != nil) {
+		return nil
+	}
+
+	idx := slices.IndexFunc(t.Fields, func(f *gen.Field) bool { return f.Name == v })
+	if idx == -1 {
+		return fmt.Errorf("default sort field %q does not exist", v)
+	}
+
+	f := t.Fields[idx]
+	if fa := GetAnnotation(f); !fa.Sortable || fa.GetSkip(cfg) || f.Sensitive() {
+		return fmt.Errorf("default sort field %q is not sortable (see WithSortable)", v)
+	}
+	return nil
+}
+
+// setAnnotationPositions sets [AnnotationError.Pos] on all annotation errors within
+// the provided errors, resolved from the source of the schema package of the provided
+// nodes. The source is only parsed if there are any annotation errors.
+func setAnnotationPositions(nodes []*gen.Type, errs []error) {
+	var positions schemaPositions
+	var loaded bool
+
+	for _, err := range errs {
+		var aerr *AnnotationError
+		if !errors.As(err, &aerr) || aerr.Pos != "" {
+			continue
+		}
+
+		if !loaded {
+			loaded = true
+			if len(nodes) > 0 && nodes[0].Config != nil {
+				positions = loadSchemaPositions(nodes[0].Config.Schema)
+			}
+		}
+
+		aerr.Pos = positions.lookup(aerr)
+	}
+}
+
+// schemaPositions are the source positions ("file:line") of schemas (keyed by
+// "<schema>"), and of the fields and edges declared directly in them (keyed by
+// "<schema>.<field or edge>").
+type schemaPositions map[string]string
+
+// lookup returns the position of the field or edge of the provided annotation error,
+// falling back to the position of its schema (e.g. for fields provided by mixins).
+func (p schemaPositions) lookup(aerr *AnnotationError) string {
+	if name := cmp.Or(aerr.Field, aerr.Edge); name != "" {
+		if pos, ok := p[aerr.Schema+"."+name]; ok {
+			return pos
+		}
+	}
+	return p[aerr.Schema]
+}
+
+// loadSchemaPositions parses the source of the provided schema package, to resolve
+// the positions of its schemas, and of the fields and edges returned by their Fields
+// and Edges methods (matched by the name passed to e.g. field.String or edge.To).
+// Returns nil if the package source can't be found or parsed.
+func loadSchemaPositions(pkgPath string) schemaPositions {
+	if pkgPath == "" {
+		return nil
+	}
+
+	wd, err := os.Getwd()
+	if err != nil {
+		return nil
+	}
+
+	pkg, err := build.Import(pkgPath, wd, build.FindOnly)
+	if err != nil {
+		return nil
+	}
+
+	files, err := filepath.Glob(filepath.Join(pkg.Dir, "*.go"))
+	if err != nil {
+		return nil
+	}
+
+	fset := token.NewFileSet()
+	positions := schemaPositions{}
+
+	position := func(pos token.Pos) string {
+		p := fset.Position(pos)
+		if rel, err := filepath.Rel(wd, p.Filename); err == nil && !strings.HasPrefix(rel, "..") {
+			p.Filename = rel
+		}
+		return p.Filename + ":" + strconv.Itoa(p.Line)
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		f, err := parser.ParseFile(fset, file, nil, parser.SkipObjectResolution)
+		if err != nil {
+			continue
+		}
+
+		for _, decl := range f.Decls {
+			switch decl := decl.(type) {
+			case *ast.GenDecl:
+				for _, spec := range decl.Specs {
+					if ts, ok := spec.(*ast.TypeSpec); ok {
+						positions[ts.Name.Name] = position(ts.Pos())
+					}
+				}
+			case *ast.FuncDecl:
+				if decl.Recv == nil || len(decl.Recv.List) != 1 || decl.Body == nil {
+					continue
+				}
+
+				if decl.Name.Name != "Fields" && decl.Name.Name != "Edges" {
+					continue
+				}
+
+				schema := receiverTypeName(decl.Recv.List[0].Type)
+				if schema == "" {
+					continue
+				}
+
+				ast.Inspect(decl.Body, func(n ast.Node) bool {
+					lit, ok := n.(*ast.CompositeLit)
+					if !ok {
+						return true
+					}
+
+					for _, elt := range lit.Elts {
+						if name := builderName(elt); name != "" {
+							positions[schema+"."+name] = position(elt.Pos())
+						}
+					}
+					return false
+				})
+			}
+		}
+	}
+
+	return positions
+}
+
+// receiverTypeName returns the type name of a method receiver (e.g. "User" for both
+// "User" and "*User").
+func receiverTypeName(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// builderName returns the name passed to the root call of a field or edge builder
+// chain, e.g. "name" for `field.String("name").Optional()`.
+func builderName(expr ast.Expr) string {
+	for {
+		call, ok := expr.(*ast.CallExpr)
+		if !ok {
+			return ""
+		}
+
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok {
+			return ""
+		}
+
+		if inner, ok := sel.X.(*ast.CallExpr); ok {
+			expr = inner
+			continue
+		}
+
+		if len(call.Args) == 0 {
+			return ""
+		}
+
+		lit, ok := call.Args[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			return ""
+		}
+
+		name, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return ""
+		}
+		return name
+	}
+}
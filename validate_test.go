@@ -0,0 +1,588 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"bytes"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateAnnotationConflicts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		config   *Config
+		path     string
+		inject   []Annotation
+		location string
+		contains string
+	}{
+		{
+			name:     "pagination-disabled-items-per-page",
+			config:   &Config{StrictAnnotations: true},
+			path:     "Pet",
+			inject:   []Annotation{WithPagination(false), WithItemsPerPage(50)},
+			location: "schema Pet",
+			contains: "pagination is disabled",
+		},
+		{
+			name:     "global-pagination-disabled-items-per-page",
+			config:   &Config{DisablePagination: true, StrictAnnotations: true},
+			path:     "Pet",
+			inject:   []Annotation{WithMaxItemsPerPage(50)},
+			location: "schema Pet",
+			contains: "pagination is disabled",
+		},
+		{
+			name:     "items-per-page-out-of-range",
+			config:   &Config{StrictAnnotations: true},
+			path:     "Pet",
+			inject:   []Annotation{WithMinItemsPerPage(10), WithItemsPerPage(5)},
+			location: "schema Pet",
+			contains: "outside of the min/max",
+		},
+		{
+			name:     "enum-unknown-deprecated-value",
+			path:     "User.type",
+			inject:   []Annotation{WithDeprecatedEnumValues("ADMIN")},
+			location: "schema User field type",
+			contains: "unknown enum value \"ADMIN\"",
+		},
+		{
+			name:     "int-enum-not-integer-backed",
+			path:     "User.type",
+			inject:   []Annotation{WithIntEnum(map[string]int{"SYSTEM": 1, "USER": 2})},
+			location: "schema User field type",
+			contains: "isn't integer-backed",
+		},
+		{
+			name:     "time-format-non-time-field",
+			path:     "User.name",
+			inject:   []Annotation{WithTimeFormat(TimeFormatUnixMilli)},
+			location: "schema User field name",
+			contains: "isn't a time.Time field",
+		},
+		{
+			name:     "file-not-bytes",
+			path:     "User.name",
+			inject:   []Annotation{WithFile(FileOptions{})},
+			location: "schema User field name",
+			contains: "must be non-sensitive bytes fields",
+		},
+		{
+			name:     "file-companion-missing",
+			path:     "User.avatar",
+			inject:   []Annotation{WithFile(FileOptions{ContentTypeField: "foo"})},
+			location: "schema User field avatar",
+			contains: `companion field "foo" does not exist`,
+		},
+		{
+			name:     "sort-skipped-field",
+			config:   &Config{StrictAnnotations: true},
+			path:     "Pet.age",
+			inject:   []Annotation{WithSkip(true), WithSortable(true)},
+			location: "schema Pet field age",
+			contains: "skipped field",
+		},
+		{
+			name:     "eager-load-skipped-edge",
+			config:   &Config{StrictAnnotations: true},
+			path:     "Pet.owner",
+			inject:   []Annotation{WithSkip(true), WithEagerLoad(true)},
+			location: "schema Pet edge owner",
+			contains: "skipped edge",
+		},
+		{
+			name:     "nested-eager-load-edge-missing",
+			path:     "User.pets",
+			inject:   []Annotation{WithEagerLoad(true), WithEagerLoadEdges("categories", "foo")},
+			location: "schema User edge pets",
+			contains: `nested eager-load edge "foo" does not exist`,
+		},
+		{
+			name:     "eager-load-field-missing",
+			path:     "Pet.owner",
+			inject:   []Annotation{WithEagerLoad(true), WithEagerLoadFields("name", "foo")},
+			location: "schema Pet edge owner",
+			contains: `eager-load field "foo" does not exist`,
+		},
+		{
+			name:     "tree-traversal-not-self-referential",
+			path:     "User.pets",
+			inject:   []Annotation{WithTreeTraversal(0)},
+			location: "schema User edge pets",
+			contains: "self-referential",
+		},
+		{
+			name:     "read-only-schema-mutation",
+			path:     "Category",
+			inject:   []Annotation{WithReadOnly(true), WithIncludeOperations(OperationRead, OperationCreate)},
+			location: "schema Category",
+			contains: "read-only schema",
+		},
+		{
+			name:     "client-provided-id-not-user-defined",
+			path:     "Category",
+			inject:   []Annotation{WithClientProvidedID(true)},
+			location: "schema Category",
+			contains: "user-defined ID field",
+		},
+		{
+			name:     "id-format-invalid-pattern",
+			path:     "Pet",
+			inject:   []Annotation{WithIDFormat(IDFormat{Pattern: "^[0-9"})},
+			location: "schema Pet",
+			contains: "invalid ID format pattern",
+		},
+		{
+			name:     "path-name-invalid",
+			path:     "User",
+			inject:   []Annotation{WithPathName("people/all")},
+			location: "schema User",
+			contains: "not a valid path segment",
+		},
+		{
+			name:     "path-name-conflict",
+			path:     "User",
+			inject:   []Annotation{WithPathName("pets")},
+			location: "schema User",
+			contains: "is already used by schema",
+		},
+		{
+			name:     "operation-method-unsupported",
+			path:     "Pet",
+			inject:   []Annotation{WithOperationMethod(OperationCreate, "GET")},
+			location: "schema Pet",
+			contains: "not supported for the create operation",
+		},
+		{
+			name:     "operation-method-conflict",
+			path:     "Pet",
+			inject:   []Annotation{WithOperationMethod(OperationList, "POST")},
+			location: "schema Pet",
+			contains: `both use HTTP method "POST"`,
+		},
+		{
+			name:     "create-response-unsupported",
+			path:     "Pet",
+			inject:   []Annotation{WithCreateResponse("foo")},
+			location: "schema Pet",
+			contains: "unsupported create response",
+		},
+		{
+			name:     "create-response-location-without-read",
+			path:     "Pet",
+			inject:   []Annotation{WithCreateResponse(CreateResponseLocation), WithExcludeOperations(OperationRead)},
+			location: "schema Pet",
+			contains: "require a single ID field",
+		},
+		{
+			name:     "response-status-unsuccessful",
+			path:     "Pet",
+			inject:   []Annotation{WithResponseStatus(OperationRead, 302)},
+			location: "schema Pet",
+			contains: "not a successful (2xx) status code",
+		},
+		{
+			name:     "response-status-no-body",
+			path:     "Pet",
+			inject:   []Annotation{WithResponseStatus(OperationList, 204)},
+			location: "schema Pet",
+			contains: "does not allow a response body",
+		},
+		{
+			name:     "alternate-key-missing",
+			path:     "User",
+			inject:   []Annotation{WithAlternateKey("foo")},
+			location: "schema User",
+			contains: "does not exist",
+		},
+		{
+			name:     "alternate-key-not-unique",
+			path:     "User",
+			inject:   []Annotation{WithAlternateKey("name")},
+			location: "schema User",
+			contains: "must be unique",
+		},
+		{
+			name:     "default-sort-missing",
+			path:     "Pet",
+			inject:   []Annotation{WithDefaultSort("foo")},
+			location: "schema Pet",
+			contains: "does not exist",
+		},
+		{
+			name:     "request-header-unsupported-schema",
+			path:     "Pet",
+			inject:   []Annotation{WithRequestHeader(OperationList, "X-Tags", ogen.NewSchema().SetType("array"), false)},
+			location: "schema Pet",
+			contains: "unsupported schema type",
+		},
+		{
+			name:     "sql-modifiers-unknown-operation",
+			path:     "Pet",
+			inject:   []Annotation{WithSQLModifiers("foo", &SQLModifiers{Timeout: time.Second})},
+			location: "schema Pet",
+			contains: "unknown operation",
+		},
+		{
+			name:     "sql-modifiers-lock-unsupported-operation",
+			path:     "Pet",
+			inject:   []Annotation{WithSQLModifiers(OperationList, &SQLModifiers{Lock: SQLLockUpdate})},
+			location: "schema Pet",
+			contains: "only supported on the update and delete operations",
+		},
+		{
+			name:     "sql-modifiers-missing-feature",
+			path:     "Pet",
+			inject:   []Annotation{WithSQLModifiers(OperationList, &SQLModifiers{Hints: []string{"SeqScan(pets)"}})},
+			location: "schema Pet",
+			contains: "\"sql/modifier\" ent feature",
+		},
+		{
+			name:     "select-for-update-missing-feature",
+			path:     "Pet",
+			inject:   []Annotation{WithSelectForUpdate(true)},
+			location: "schema Pet",
+			contains: "select for update requires",
+		},
+		{
+			name:     "group-invalid",
+			path:     "Pet",
+			inject:   []Annotation{WithGroup("a/b")},
+			location: "schema Pet",
+			contains: "not a valid path segment",
+		},
+		{
+			name:     "group-conflicts-path-segment",
+			path:     "Pet",
+			inject:   []Annotation{WithGroup("users")},
+			location: "schema Pet",
+			contains: "conflicts with the path segment of schema User",
+		},
+		{
+			name:     "subscriptions-unsupported-operation",
+			path:     "Pet",
+			inject:   []Annotation{WithSubscriptions(OperationList)},
+			location: "schema Pet",
+			contains: "only supported for the create, update and delete operations",
+		},
+		{
+			name:     "subscriptions-operation-disabled",
+			path:     "Pet",
+			inject:   []Annotation{WithExcludeOperations(OperationDelete), WithSubscriptions(OperationDelete)},
+			location: "schema Pet",
+			contains: "isn't enabled",
+		},
+		{
+			name:     "computed-field-conflict",
+			path:     "Pet",
+			inject:   []Annotation{WithComputedField("name", &ogen.Schema{Type: "string"}, "")},
+			location: "schema Pet",
+			contains: "conflicts with an existing field or edge",
+		},
+		{
+			name:     "computed-field-invalid-name",
+			path:     "Pet",
+			inject:   []Annotation{WithComputedField("DisplayName", &ogen.Schema{Type: "string"}, "")},
+			location: "schema Pet",
+			contains: "must be snake_case",
+		},
+		{
+			name:     "computed-field-no-schema",
+			path:     "Pet",
+			inject:   []Annotation{WithComputedField("display_name", nil, "")},
+			location: "schema Pet",
+			contains: "has no schema",
+		},
+		{
+			name:     "computed-field-struct-conflict",
+			path:     "Pet",
+			inject:   []Annotation{WithComputedField("query_owner", &ogen.Schema{Type: "string"}, "")},
+			location: "schema Pet",
+			contains: `struct field or method "QueryOwner"`,
+		},
+		{
+			name:     "parent-fields-unknown-field",
+			path:     "Pet.owner",
+			inject:   []Annotation{WithParentFields("nope")},
+			location: "schema Pet edge owner",
+			contains: `parent field "nope" does not exist`,
+		},
+		{
+			name:     "parent-fields-no-endpoint",
+			path:     "Pet.owner",
+			inject:   []Annotation{WithEdgeEndpoint(false), WithParentFields("name")},
+			location: "schema Pet edge owner",
+			contains: "the edge has no endpoint",
+		},
+		{
+			name:     "parent-fields-not-paginated",
+			config:   &Config{DisablePagination: true, StrictAnnotations: true},
+			path:     "Pet.friends",
+			inject:   []Annotation{WithParentFields("name")},
+			location: "schema Pet edge friends",
+			contains: "only supported on unique or paginated edges",
+		},
+		{
+			name:     "versions-not-configured",
+			path:     "Pet.age",
+			inject:   []Annotation{WithVersions("v2", "")},
+			location: "schema Pet field age",
+			contains: "no versions are configured",
+		},
+		{
+			name:     "versions-unknown",
+			config:   &Config{Versions: []string{"v1", "v2"}},
+			path:     "Pet",
+			inject:   []Annotation{WithOperationVersions(OperationDelete, "", "v3")},
+			location: "schema Pet",
+			contains: "unknown version \"v3\"",
+		},
+		{
+			name:     "versions-removed-before-introduced",
+			config:   &Config{Versions: []string{"v1", "v2"}},
+			path:     "Pet.owner",
+			inject:   []Annotation{WithVersions("v2", "v1")},
+			location: "schema Pet edge owner",
+			contains: "must be introduced before",
+		},
+		{
+			name:     "deprecation-sunset-before-since",
+			path:     "Pet.owner",
+			inject:   []Annotation{WithDeprecation(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))},
+			location: "schema Pet edge owner",
+			contains: "must be after the deprecation date",
+		},
+		{
+			name:     "aip-flavor-list-method",
+			config:   &Config{Flavor: FlavorAIP},
+			path:     "Pet",
+			inject:   []Annotation{WithOperationMethod(OperationList, http.MethodPost)},
+			location: "schema Pet",
+			contains: "requires the list operation to use the GET method",
+		},
+		{
+			name:     "odata-flavor-list-method",
+			config:   &Config{Flavor: FlavorOData},
+			path:     "Pet",
+			inject:   []Annotation{WithOperationMethod(OperationList, http.MethodPost)},
+			location: "schema Pet",
+			contains: "the OData flavor requires the list operation to use the GET method",
+		},
+		{
+			name:     "field-toggle-unknown",
+			path:     "Pet.name",
+			inject:   []Annotation{WithFieldToggle("searchable", true)},
+			location: "schema Pet field name",
+			contains: "unknown field toggle",
+		},
+		{
+			name:     "field-toggle-id",
+			path:     "Pet.id",
+			inject:   []Annotation{WithReadable(false)},
+			location: "schema Pet field id",
+			contains: "isn't supported on ID fields",
+		},
+		{
+			name:     "field-toggle-no-access",
+			path:     "Pet.name",
+			inject:   []Annotation{WithReadable(false), WithCreatable(false), WithUpdatable(false)},
+			location: "schema Pet field name",
+			contains: "skip it instead",
+		},
+		{
+			name:     "hashed-non-string-field",
+			path:     "Pet.age",
+			inject:   []Annotation{WithHashed(true)},
+			location: "schema Pet field age",
+			contains: "must be string fields",
+		},
+		{
+			name:     "hashed-filter",
+			path:     "User.password_hashed",
+			inject:   []Annotation{WithHashed(true), WithSortable(true)},
+			location: "schema User field password_hashed",
+			contains: "sorting is enabled on a hashed field",
+		},
+		{
+			name:     "seek-pagination-default-sort",
+			path:     "Pet",
+			inject:   []Annotation{WithSeekPagination(), WithDefaultSort("name")},
+			location: "schema Pet",
+			contains: "seek paginated results are always ordered by ID",
+		},
+		{
+			name:     "seek-pagination-flavor",
+			config:   &Config{Flavor: FlavorAIP},
+			path:     "Category",
+			inject:   []Annotation{WithSeekPagination()},
+			location: "schema Category",
+			contains: "seek pagination isn't supported",
+		},
+		{
+			name:     "field-alias-conflict",
+			path:     "Pet.age",
+			inject:   []Annotation{WithAliases("name")},
+			location: "schema Pet",
+			contains: "conflicts with the name of a field or edge",
+		},
+		{
+			name:     "schema-alias-path-conflict",
+			path:     "Pet",
+			inject:   []Annotation{WithAliases("User")},
+			location: "schema Pet",
+			contains: `alias path "/users" is already used by schema User`,
+		},
+		{
+			name:     "dry-run-no-mutations",
+			path:     "Pet",
+			inject:   []Annotation{WithDryRun(true), WithIncludeOperations(OperationRead, OperationList)},
+			location: "schema Pet",
+			contains: "dry runs are only supported on schemas with create, update or delete operations",
+		},
+		{
+			name:     "changed-fields-no-update",
+			path:     "Pet",
+			inject:   []Annotation{WithChangedFields(true), WithExcludeOperations(OperationUpdate)},
+			location: "schema Pet",
+			contains: "changed fields are only supported on schemas with an ID and an update operation",
+		},
+		{
+			name:     "history-not-enabled",
+			path:     "Pet",
+			inject:   []Annotation{{History: true}},
+			location: "schema Pet",
+			contains: "Config.WithHistory isn't",
+		},
+		{
+			name:     "soft-delete-no-field",
+			path:     "Pet",
+			inject:   []Annotation{{SoftDelete: true}},
+			location: "schema Pet",
+			contains: `soft deletes require an optional and nillable "deleted_at" time field`,
+		},
+		{
+			name:     "batch-path-conflict",
+			config:   &Config{Handler: HandlerStdlib, Batch: &Batch{Path: "/pets"}},
+			path:     "Pet",
+			location: "schema Pet",
+			contains: `path "/pets" conflicts with the batch endpoint`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := tt.config
+			if cfg == nil {
+				cfg = &Config{}
+			}
+
+			cfg.PreGenerateHook = func(g *gen.Graph, _ *ogen.Spec) error {
+				injectAnnotations(t, g, tt.path, tt.inject...)
+				return ValidateAnnotations(g.Nodes...)
+			}
+
+			_, err := buildSpec(t, cfg)
+			require.Error(t, err)
+
+			var aerr *AnnotationError
+			require.ErrorAs(t, err, &aerr)
+			assert.Equal(t, tt.location, aerr.Location())
+			assert.Contains(t, err.Error(), tt.contains)
+		})
+	}
+}
+
+func TestValidateAnnotationConflicts_Position(t *testing.T) {
+	t.Parallel()
+
+	_, err := buildSpec(t, &Config{
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.name", WithTimeFormat(TimeFormatUnixMilli))
+			injectAnnotations(t, g, "Pet", WithDefaultSort("foo"))
+			return ValidateAnnotations(g.Nodes...)
+		},
+	})
+	require.Error(t, err)
+
+	assert.Regexp(t, `testdata/schema/user\.go:\d+: schema User field name: `, err.Error())
+	assert.Regexp(t, `testdata/schema/pet\.go:\d+: schema Pet: `, err.Error())
+}
+
+func TestWarnAnnotationConflicts(t *testing.T) {
+	t.Parallel()
+
+	var warnings bytes.Buffer
+
+	mustBuildSpec(t, &Config{
+		WarningWriter: &warnings,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "Pet", WithPagination(false), WithItemsPerPage(50))
+			injectAnnotations(t, g, "Pet.owner", WithEagerLoadLimit(5))
+			return ValidateAnnotations(g.Nodes...)
+		},
+	})
+
+	assert.Regexp(t, `testdata/schema/pet\.go:13: schema Pet: .*pagination is disabled.* \(ignored\)`, warnings.String())
+	assert.Regexp(t, `testdata/schema/pet\.go:29: schema Pet edge owner: eager-load limit is set, but the edge is not eager-loaded \(ignored\)`, warnings.String())
+}
+
+func TestWarnSensitiveFields(t *testing.T) {
+	t.Parallel()
+
+	var warnings bytes.Buffer
+
+	mustBuildSpec(t, &Config{
+		WarningWriter: &warnings,
+		PreGenerateHook: func(g *gen.Graph, _ *ogen.Spec) error {
+			injectAnnotations(t, g, "User.password_hashed", WithFilter(FilterGroupEqual), WithSortable(true))
+			return ValidateAnnotations(g.Nodes...)
+		},
+	})
+
+	assert.Contains(t, warnings.String(), "schema User field password_hashed is sensitive, so enabling filtering and sorting has no effect")
+}
+
+func TestValidateAnnotations_AllErrors(t *testing.T) {
+	t.Parallel()
+
+	err := ValidateAnnotations(
+		&gen.Type{Name: "Foo", Annotations: map[string]any{Annotation{}.Name(): WithEagerLoad(false)}},
+		&gen.Type{Name: "Bar", Annotations: map[string]any{Annotation{}.Name(): WithSortable(true)}},
+	)
+	require.Error(t, err)
+
+	var joined interface{ Unwrap() []error }
+	require.ErrorAs(t, err, &joined)
+	assert.Len(t, joined.Unwrap(), 2)
+
+	var aerr *AnnotationError
+	require.True(t, errors.As(err, &aerr))
+	assert.Equal(t, "Foo", aerr.Schema)
+}
+
+func TestWarnRandomSorting(t *testing.T) {
+	t.Parallel()
+
+	var warnings bytes.Buffer
+
+	r := mustBuildSpec(t, &Config{WarningWriter: &warnings})
+	injectAnnotations(t, r.graph, "Pet", WithDefaultSort("random"))
+	require.NoError(t, warnRandomSorting(r.config, r.graph.Nodes))
+
+	assert.Contains(t, warnings.String(), `schema Pet default sort field "random" does not provide a stable ordering for paginated results`)
+}
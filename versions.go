@@ -0,0 +1,433 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+)
+
+// mediaTypeVendorRegex matches valid vendors of media types (see
+// [Config.MediaTypeVendor]).
+var mediaTypeVendorRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]*$`)
+
+// VersionRange is the range of API versions (see [Config.Versions]) which a schema,
+// field, edge or operation is available in (see [WithVersions]).
+type VersionRange struct {
+	// Introduced is the first version it's available in. If empty, it's available from
+	// the first version.
+	Introduced string `json:",omitempty"`
+
+	// Removed is the first version it's no longer available in. If empty, it's available
+	// through to the latest version.
+	Removed string `json:",omitempty"`
+}
+
+// Contains returns true if the provided version is within the range, using the order
+// of the provided versions (oldest first). A nil range contains all versions.
+func (r *VersionRange) Contains(versions []string, version string) bool {
+	if r == nil {
+		return true
+	}
+
+	idx := slices.Index(versions, version)
+	if r.Introduced != "" && idx < slices.Index(versions, r.Introduced) {
+		return false
+	}
+	if r.Removed != "" && idx >= slices.Index(versions, r.Removed) {
+		return false
+	}
+	return true
+}
+
+// validate checks that the versions of the range are known, and that it contains at
+// least one version.
+func (r *VersionRange) validate(versions []string) error {
+	if r == nil {
+		return nil
+	}
+
+	if len(versions) == 0 {
+		return errors.New("versions provided, but no versions are configured (see Config.Versions)")
+	}
+
+	for _, v := range []string{r.Introduced, r.Removed} {
+		if v != "" && !slices.Contains(versions, v) {
+			return fmt.Errorf("unknown version %q (see Config.Versions)", v)
+		}
+	}
+
+	if r.Introduced != "" && r.Removed != "" && slices.Index(versions, r.Introduced) >= slices.Index(versions, r.Removed) {
+		return fmt.Errorf("version %q must be introduced before it's removed in %q", r.Introduced, r.Removed)
+	}
+	return nil
+}
+
+// validateVersions checks that all versions (see [Config.Versions]) are unique, valid
+// path segments, and don't conflict with the names of targets, as they are generated
+// as targets themselves.
+func validateVersions(cfg *Config) error {
+	for i, v := range cfg.Versions {
+		if err := validatePathName(v); err != nil {
+			return fmt.Errorf("invalid version %q: %w", v, err)
+		}
+
+		if slices.Contains(cfg.Versions[:i], v) {
+			return fmt.Errorf("duplicate version %q", v)
+		}
+
+		if slices.ContainsFunc(cfg.Targets, func(t *Target) bool { return t != nil && t.Name == v }) {
+			return fmt.Errorf("version %q conflicts with the target of the same name", v)
+		}
+	}
+
+	for _, t := range cfg.Targets {
+		if t != nil && t.Version != "" && !slices.Contains(cfg.Versions, t.Version) {
+			return fmt.Errorf("target %q has unknown version %q (see Config.Versions)", t.Name, t.Version)
+		}
+	}
+	return nil
+}
+
+// validateVersionRanges checks the version ranges (see [WithVersions] and
+// [WithOperationVersions]) of the provided type, and its fields and edges.
+func validateVersionRanges(cfg *Config, t *gen.Type) (errs []error) {
+	ta := GetAnnotation(t)
+
+	if err := ta.Versions.validate(cfg.Versions); err != nil {
+		errs = append(errs, &AnnotationError{Schema: t.Name, Err: err})
+	}
+
+	for _, op := range mapKeys(ta.OperationVersions) {
+		if err := ta.OperationVersions[op].validate(cfg.Versions); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Err: fmt.Errorf("operation %q: %w", op, err)})
+		}
+	}
+
+	for _, f := range t.Fields {
+		if err := GetAnnotation(f).Versions.validate(cfg.Versions); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Field: f.Name, Err: err})
+		}
+	}
+
+	for _, e := range t.Edges {
+		if err := GetAnnotation(e).Versions.validate(cfg.Versions); err != nil {
+			errs = append(errs, &AnnotationError{Schema: t.Name, Edge: e.Name, Err: err})
+		}
+	}
+	return errs
+}
+
+// isTypeInVersion returns true if the provided type is available in the provided version.
+func isTypeInVersion(cfg *Config, t *gen.Type, version string) bool {
+	return GetAnnotation(t).Versions.Contains(cfg.Versions, version)
+}
+
+// isFieldInVersion returns true if the provided field is available in the provided
+// version.
+func isFieldInVersion(cfg *Config, f *gen.Field, version string) bool {
+	return GetAnnotation(f).Versions.Contains(cfg.Versions, version)
+}
+
+// isEdgeInVersion returns true if the provided edge, and the type it points to, are
+// available in the provided version.
+func isEdgeInVersion(cfg *Config, e *gen.Edge, version string) bool {
+	return GetAnnotation(e).Versions.Contains(cfg.Versions, version) && isTypeInVersion(cfg, e.Type, version)
+}
+
+// isOperationInVersion returns true if the provided type, and the provided operation
+// of it, are available in the provided version.
+func isOperationInVersion(cfg *Config, t *gen.Type, op Operation, version string) bool {
+	return isTypeInVersion(cfg, t, version) && GetAnnotation(t).OperationVersions[op].Contains(cfg.Versions, version)
+}
+
+// getRouteVersions returns the versions which an endpoint is available in, based on the
+// type, and optionally, the edge or field (e.g. of file endpoints) and operation of the
+// type which the endpoint requires.
+func getRouteVersions(cfg *Config, t *gen.Type, e *gen.Edge, f *gen.Field, op Operation) (versions []string) {
+	for _, v := range cfg.Versions {
+		if !isTypeInVersion(cfg, t, v) ||
+			(op != "" && !isOperationInVersion(cfg, t, op, v)) ||
+			(e != nil && !isEdgeInVersion(cfg, e, v)) ||
+			(f != nil && !isFieldInVersion(cfg, f, v)) {
+			continue
+		}
+		versions = append(versions, v)
+	}
+	return versions
+}
+
+// getVersionHiddenFields returns the JSON property names of the fields of the provided
+// type which are returned in responses, but aren't available in the provided version.
+func getVersionHiddenFields(cfg *Config, t *gen.Type, version string) (names []string) {
+	for _, f := range t.Fields {
+		if GetAnnotation(f).GetSkip(cfg) || f.Sensitive() || !GetAnnotation(f).GetReadable() || isFieldInVersion(cfg, f, version) {
+			continue
+		}
+		names = append(names, GetFieldName(t, f))
+	}
+	return names
+}
+
+// getVersionHiddenEdges returns the JSON property names of the edges of the provided
+// type which aren't available in the provided version.
+func getVersionHiddenEdges(cfg *Config, t *gen.Type, version string) (names []string) {
+	for _, e := range t.Edges {
+		if GetAnnotation(e).GetSkip(cfg) || isEdgeInVersion(cfg, e, version) {
+			continue
+		}
+		names = append(names, GetEdgeName(t, e, ""))
+	}
+	return names
+}
+
+// hasVersionedResponses returns true if any request or response differs between
+// versions (i.e. a field or edge isn't available in all versions).
+func hasVersionedResponses(cfg *Config, nodes []*gen.Type) bool {
+	for _, v := range cfg.Versions {
+		for _, t := range nodes {
+			if GetAnnotation(t).GetSkip(cfg) {
+				continue
+			}
+			if len(getVersionHiddenInputs(cfg, t, v)) > 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// applyVersion marks all schemas, fields and edges which aren't available in the
+// provided version as skipped, as if [WithSkip] was provided, and excludes all
+// operations which aren't available in the provided version. Annotations which would
+// conflict with skipped fields and edges (e.g. filtering, eager-loading and parent
+// fields) are dropped for the version.
+func applyVersion(cfg *Config, g *gen.Graph, version string) {
+	for _, t := range g.Nodes {
+		if !isTypeInVersion(cfg, t, version) {
+			t.Annotations = withAnnotation(t.Annotations, func(a *Annotation) { a.Skip = true })
+			continue
+		}
+
+		ops := GetAnnotation(t).GetOperations(cfg)
+		versioned := slices.DeleteFunc(slices.Clone(ops), func(op Operation) bool {
+			return !isOperationInVersion(cfg, t, op, version)
+		})
+		if len(versioned) != len(ops) {
+			t.Annotations = withAnnotation(t.Annotations, func(a *Annotation) {
+				a.Operations = versioned
+				a.Skip = a.Skip || len(versioned) == 0
+			})
+		}
+	}
+
+	for _, t := range g.Nodes {
+		for _, f := range t.Fields {
+			if isFieldInVersion(cfg, f, version) {
+				continue
+			}
+			f.Annotations = withAnnotation(f.Annotations, func(a *Annotation) {
+				a.Skip = true
+				a.Filter = 0
+				a.FilterGroup = ""
+				a.Sortable = false
+			})
+		}
+
+		for _, e := range t.Edges {
+			if !isEdgeInVersion(cfg, e, version) {
+				e.Annotations = withAnnotation(e.Annotations, func(a *Annotation) {
+					a.Skip = true
+					a.EagerLoad = nil
+					a.EagerLoadLimit = nil
+					a.EagerLoadDepth = 0
+					a.EagerLoadEdges = nil
+					a.EdgeEndpoint = nil
+					a.TreeTraversal = nil
+				})
+				continue
+			}
+
+			e.Annotations = withAnnotation(e.Annotations, func(a *Annotation) {
+				a.ParentFields = slices.DeleteFunc(a.ParentFields, func(name string) bool {
+					f := findField(t, name)
+					return f != nil && !isFieldInVersion(cfg, f, version)
+				})
+				a.EagerLoadFields = slices.DeleteFunc(a.EagerLoadFields, func(name string) bool {
+					f := findField(e.Type, name)
+					return f != nil && !isFieldInVersion(cfg, f, version)
+				})
+				a.EagerLoadEdges = slices.DeleteFunc(a.EagerLoadEdges, func(p string) bool {
+					nested := findEdgePath(e.Type, p)
+					return nested != nil && !isEdgeInVersion(cfg, nested, version)
+				})
+			})
+		}
+	}
+}
+
+// findField returns the field of the provided type with the provided name, or nil if
+// there is no such field.
+func findField(t *gen.Type, name string) *gen.Field {
+	for _, f := range t.Fields {
+		if f.Name == name {
+			return f
+		}
+	}
+	return nil
+}
+
+// getVersionTargets returns a target (see [Config.Targets]) for each version (see
+// [Config.Versions]), which generates the spec of the version, with all paths prefixed
+// with the version (unless using [VersionNegotiationHeader]).
+func getVersionTargets(cfg *Config) []*Target {
+	targets := make([]*Target, 0, len(cfg.Versions))
+	for _, v := range cfg.Versions {
+		t := &Target{Name: v, Version: v}
+		if cfg.VersionNegotiation == VersionNegotiationPath {
+			t.BasePath = "/" + v
+		}
+		targets = append(targets, t)
+	}
+	return targets
+}
+
+// getVersionHeader returns the version request header (see [VersionNegotiationHeader]),
+// which allows all versions, and defaults to the latest version.
+func getVersionHeader(cfg *Config) *ogen.Parameter {
+	enum, _ := ToEnum(cfg.Versions)
+	latest, _ := json.Marshal(cfg.Versions[len(cfg.Versions)-1])
+
+	return &ogen.Parameter{
+		Description: "The API version of the request. Defaults to the latest version.",
+		Schema:      &ogen.Schema{Type: "string", Enum: enum, Default: latest},
+	}
+}
+
+// getVersionHiddenInputs returns the JSON property names of the fields and edges of the
+// provided type which are accepted in create/update requests, but aren't available in
+// the provided version.
+func getVersionHiddenInputs(cfg *Config, t *gen.Type, version string) (names []string) {
+	for _, f := range t.Fields {
+		if GetAnnotation(f).GetSkip(cfg) || isFieldInVersion(cfg, f, version) {
+			continue
+		}
+		names = append(names, GetFieldName(t, f))
+	}
+
+	for _, e := range t.Edges {
+		if GetAnnotation(e).GetSkip(cfg) || isEdgeInVersion(cfg, e, version) {
+			continue
+		}
+		names = append(names, GetEdgeName(t, e, ""), GetEdgeName(t, e, "add"), GetEdgeName(t, e, "remove"))
+	}
+	return names
+}
+
+// getLatestVersionTarget returns a target for the latest version, which unlike
+// [getVersionTargets], isn't prefixed with the version, as it's used for the main spec.
+// Returns nil if no versions are configured.
+func getLatestVersionTarget(cfg *Config) *Target {
+	if len(cfg.Versions) == 0 {
+		return nil
+	}
+	v := cfg.Versions[len(cfg.Versions)-1]
+	return &Target{Name: v, Version: v}
+}
+
+// getMediaTypeName returns the name of the provided type within vendor media types (see
+// [VersionNegotiationMediaType]), e.g. "pet".
+func getMediaTypeName(t *gen.Type) string {
+	return strings.ToLower(GetSchemaName(t))
+}
+
+// getVersionMediaType returns the vendor media type (see [VersionNegotiationMediaType])
+// of the provided type and version, e.g. "application/vnd.myapp.pet.v2+json".
+func getVersionMediaType(cfg *Config, t *gen.Type, version string) string {
+	return fmt.Sprintf("application/vnd.%s.%s.%s+json", cfg.MediaTypeVendor, getMediaTypeName(t), version)
+}
+
+// addVersionMediaTypes adds the vendor media type (see [VersionNegotiationMediaType]) of
+// the provided version to all successful JSON responses which return entities (or lists
+// of entities), alongside "application/json".
+func addVersionMediaTypes(cfg *Config, spec *ogen.Spec, nodes []*gen.Type, version string) {
+	entities := map[string]*gen.Type{}
+	for _, t := range nodes {
+		entities[GetReadSchemaName(t)] = t
+	}
+
+	for pathName, pathItem := range spec.Paths {
+		spec.Paths[pathName] = PatchOperations(pathItem, func(_ string, op *ogen.Operation) *ogen.Operation {
+			if op == nil {
+				return nil
+			}
+
+			for code, resp := range op.Responses {
+				if resp == nil || resp.Ref != "" || !strings.HasPrefix(code, "2") {
+					continue
+				}
+
+				media, ok := resp.Content["application/json"]
+				if !ok {
+					continue
+				}
+
+				if t := getSchemaEntity(spec, media.Schema, entities, 0); t != nil {
+					resp.Content[getVersionMediaType(cfg, t, version)] = media
+				}
+			}
+			return op
+		})
+	}
+}
+
+// getSchemaEntity returns the entity which the provided response schema returns, either
+// directly, as an array, as a paginated list (the "content" property, or the collection
+// property with [FlavorAIP]), or through composition (e.g. responses with parent
+// fields). Returns nil if it doesn't return an entity.
+func getSchemaEntity(spec *ogen.Spec, schema *ogen.Schema, entities map[string]*gen.Type, depth int) *gen.Type {
+	if schema == nil || depth > 5 {
+		return nil
+	}
+
+	if name, ok := strings.CutPrefix(schema.Ref, "#/components/schemas/"); ok {
+		if t, ok := entities[name]; ok {
+			return t
+		}
+		if spec.Components == nil {
+			return nil
+		}
+		return getSchemaEntity(spec, spec.Components.Schemas[name], entities, depth+1)
+	}
+
+	if schema.Items != nil {
+		return getSchemaEntity(spec, schema.Items.Item, entities, depth+1)
+	}
+
+	for _, s := range schema.AllOf {
+		if t := getSchemaEntity(spec, s, entities, depth+1); t != nil {
+			return t
+		}
+	}
+
+	for _, p := range schema.Properties {
+		if p.Name == "content" {
+			return getSchemaEntity(spec, p.Schema, entities, depth+1)
+		}
+		if p.Schema != nil && p.Schema.Items != nil {
+			if t := getSchemaEntity(spec, p.Schema, entities, depth+1); t != nil {
+				return t
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+// Copyright (c) Liam Stanley <liam@liam.sh>. All rights reserved. Use of
+// this source code is governed by the MIT license that can be found in
+// the LICENSE file.
+
+package entrest
+
+import (
+	"slices"
+	"testing"
+
+	"entgo.io/ent/entc/gen"
+	"github.com/ogen-go/ogen"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExtension_Versions(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	injectAnnotations(t, r.graph, "Pet", WithOperationVersions(OperationDelete, "", "v2"))
+	injectAnnotations(t, r.graph, "Pet.age", WithVersions("v2", ""))
+	injectAnnotations(t, r.graph, "Category", WithVersions("", "v2"))
+
+	ext, err := NewExtension(&Config{Versions: []string{"v1", "v2"}})
+	require.NoError(t, err)
+
+	hasAge := func(spec *ogen.Spec) bool {
+		return slices.ContainsFunc(spec.Components.Schemas["Pet"].Properties, func(p ogen.Property) bool {
+			return p.Name == "age"
+		})
+	}
+
+	targets := getVersionTargets(ext.config)
+	require.Len(t, targets, 2)
+
+	v1, err := ext.GenerateTarget(r.graph, targets[0])
+	require.NoError(t, err)
+	validateSpec(t, v1)
+
+	assert.Contains(t, v1.Paths, "/v1/pets")
+	assert.Contains(t, v1.Paths, "/v1/categories")
+	assert.Contains(t, v1.Paths, "/v1/pets/{petID}/categories")
+	assert.NotNil(t, v1.Paths["/v1/pets/{petID}"].Delete)
+	assert.False(t, hasAge(v1))
+
+	v2, err := ext.GenerateTarget(r.graph, targets[1])
+	require.NoError(t, err)
+	validateSpec(t, v2)
+
+	assert.Contains(t, v2.Paths, "/v2/pets")
+	assert.NotContains(t, v2.Paths, "/v2/categories")
+	assert.NotContains(t, v2.Paths, "/v2/pets/{petID}/categories")
+	assert.Nil(t, v2.Paths["/v2/pets/{petID}"].Delete)
+	assert.True(t, hasAge(v2))
+
+	latest, err := ext.GenerateTarget(r.graph, getLatestVersionTarget(ext.config))
+	require.NoError(t, err)
+	assert.Contains(t, latest.Paths, "/pets")
+	assert.NotContains(t, latest.Paths, "/categories")
+
+	pet := r.graph.Nodes[slices.IndexFunc(r.graph.Nodes, func(n *gen.Type) bool { return n.Name == "Pet" })]
+	assert.Equal(t, []string{"v1"}, getRouteVersions(ext.config, pet, nil, nil, OperationDelete))
+	assert.Equal(t, []string{"v1", "v2"}, getRouteVersions(ext.config, pet, nil, nil, OperationRead))
+	assert.Equal(t, []string{"age"}, getVersionHiddenFields(ext.config, pet, "v1"))
+	assert.Equal(t, []string{"categories"}, getVersionHiddenEdges(ext.config, pet, "v2"))
+	assert.True(t, hasVersionedResponses(ext.config, r.graph.Nodes))
+}
+
+func TestExtension_VersionNegotiationHeader(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	ext, err := NewExtension(&Config{
+		Versions:           []string{"v1", "v2"},
+		VersionNegotiation: VersionNegotiationHeader,
+	})
+	require.NoError(t, err)
+
+	targets := getVersionTargets(ext.config)
+	require.Len(t, targets, 2)
+	assert.Empty(t, targets[0].BasePath)
+
+	v1, err := ext.GenerateTarget(r.graph, targets[0])
+	require.NoError(t, err)
+	validateSpec(t, v1)
+
+	assert.Contains(t, v1.Paths, "/pets")
+	assert.Contains(t, v1.Paths["/pets"].Parameters, &ogen.Parameter{Ref: "#/components/parameters/" + VersionHeader})
+
+	header := v1.Components.Parameters[VersionHeader]
+	require.NotNil(t, header)
+	assert.Equal(t, "header", header.In)
+	assert.Len(t, header.Schema.Enum, 2)
+	assert.JSONEq(t, `"v2"`, string(header.Schema.Default))
+
+	assert.Contains(t, v1.Components.Responses, "ErrorNotAcceptable")
+	assert.Contains(t, v1.Paths["/pets"].Get.Responses, "406")
+}
+
+func TestExtension_VersionNegotiationMediaType(t *testing.T) {
+	t.Parallel()
+
+	r := mustBuildSpec(t, &Config{})
+
+	ext, err := NewExtension(&Config{
+		Versions:           []string{"v1", "v2"},
+		VersionNegotiation: VersionNegotiationMediaType,
+		MediaTypeVendor:    "myapp",
+	})
+	require.NoError(t, err)
+
+	v1, err := ext.GenerateTarget(r.graph, getVersionTargets(ext.config)[0])
+	require.NoError(t, err)
+	validateSpec(t, v1)
+
+	assert.Contains(t, v1.Paths, "/pets")
+	assert.Contains(t, v1.Paths["/pets"].Get.Responses["200"].Content, "application/json")
+	assert.Contains(t, v1.Paths["/pets"].Get.Responses["200"].Content, "application/vnd.myapp.pet.v1+json")
+	assert.Contains(t, v1.Paths["/pets/{petID}"].Get.Responses["200"].Content, "application/vnd.myapp.pet.v1+json")
+	assert.Contains(t, v1.Paths["/pets/{petID}/owner"].Get.Responses["200"].Content, "application/vnd.myapp.user.v1+json")
+	assert.Contains(t, v1.Paths["/pets/{petID}"].Get.Responses, "406")
+	assert.NotContains(t, v1.Components.Parameters, VersionHeader)
+}
+
+func TestConfig_Versions(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewExtension(&Config{Versions: []string{"v1", "v1"}})
+	require.ErrorContains(t, err, "duplicate version")
+
+	_, err = NewExtension(&Config{Versions: []string{"v/1"}})
+	require.ErrorContains(t, err, "invalid version")
+
+	_, err = NewExtension(&Config{Versions: []string{"v1"}, Targets: []*Target{{Name: "v1"}}})
+	require.ErrorContains(t, err, "conflicts with the target")
+
+	_, err = NewExtension(&Config{Versions: []string{"v1"}, Targets: []*Target{{Name: "public", Version: "v2"}}})
+	require.ErrorContains(t, err, "unknown version")
+
+	_, err = NewExtension(&Config{Versions: []string{"v1", "v2"}, Targets: []*Target{{Name: "public", Version: "v1"}}})
+	require.NoError(t, err)
+
+	_, err = NewExtension(&Config{VersionNegotiation: VersionNegotiationHeader})
+	require.ErrorContains(t, err, "requires Config.Versions")
+
+	_, err = NewExtension(&Config{Versions: []string{"v1"}, VersionNegotiation: "query"})
+	require.ErrorContains(t, err, "unsupported version negotiation")
+
+	_, err = NewExtension(&Config{Versions: []string{"v1"}, VersionNegotiation: VersionNegotiationMediaType})
+	require.ErrorContains(t, err, "requires a valid Config.MediaTypeVendor")
+
+	_, err = NewExtension(&Config{Versions: []string{"v1"}, VersionNegotiation: VersionNegotiationMediaType, MediaTypeVendor: "My App"})
+	require.ErrorContains(t, err, "requires a valid Config.MediaTypeVendor")
+}
+
+func TestVersionRange_Contains(t *testing.T) {
+	t.Parallel()
+
+	versions := []string{"v1", "v2", "v3"}
+
+	var r *VersionRange
+	assert.True(t, r.Contains(versions, "v1"))
+
+	r = &VersionRange{Introduced: "v2", Removed: "v3"}
+	assert.False(t, r.Contains(versions, "v1"))
+	assert.True(t, r.Contains(versions, "v2"))
+	assert.False(t, r.Contains(versions, "v3"))
+}